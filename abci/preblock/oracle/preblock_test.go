@@ -561,6 +561,8 @@ func (s *PreBlockTestSuite) TestPreBlockStatus() {
 		s.ctx = testutils.UpdateContextWithVEHeight(s.ctx, 2)
 		s.ctx = s.ctx.WithBlockHeight(4)
 		mockOracleKeeper.On("GetAllCurrencyPairs", s.ctx).Return(nil)
+		mockOracleKeeper.On("UpdateValidatorPriceStats", s.ctx, ca, mock.Anything).Return(nil)
+		mockOracleKeeper.On("RecordValidatorParticipation", s.ctx, ca, true).Return(nil)
 
 		// run preblocker
 		_, err := handler.WrappedPreBlocker(s.mm)(s.ctx, &cometabci.RequestFinalizeBlock{
@@ -679,6 +681,20 @@ func (s *PreBlockTestSuite) TestValidatorReports() {
 		mockOracleKeeper.On("SetPriceForCurrencyPair", s.ctx, btcUsd, mock.Anything).Return(nil)
 		mockOracleKeeper.On("SetPriceForCurrencyPair", s.ctx, mogUsd, mock.Anything).Return(nil)
 
+		// expect the deviation of each validator's prices from the aggregated prices to be recorded
+		mockOracleKeeper.On("UpdateValidatorPriceStats", s.ctx, val1, map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation{
+			btcUsd: {DeviationBps: 0},
+			mogUsd: {DeviationBps: 0},
+		}).Return(nil)
+		mockOracleKeeper.On("UpdateValidatorPriceStats", s.ctx, val2, map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation{
+			btcUsd: {DeviationBps: 10_000},
+			mogUsd: {Missed: true},
+		}).Return(nil)
+
+		// expect the participation of each validator that included a vote extension to be recorded
+		mockOracleKeeper.On("RecordValidatorParticipation", s.ctx, val1, true).Return(nil)
+		mockOracleKeeper.On("RecordValidatorParticipation", s.ctx, val2, true).Return(nil)
+
 		// create extended commit info
 		val1Vote, err := testutils.CreateExtendedVoteInfo(val1, map[uint64][]byte{
 			0: big.NewInt(1).Bytes(),
@@ -0,0 +1,66 @@
+package aggregator
+
+import (
+	"math"
+	"math/big"
+
+	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// bpsDenominator is the number of basis points in 100%.
+var bpsDenominator = big.NewInt(10_000)
+
+// CalculatePriceDeviations calculates the deviation of the prices reported by a validator from the
+// aggregated prices, for each currency pair that has an aggregated price. A currency pair that the
+// validator did not report a price for is marked as missed.
+func CalculatePriceDeviations(
+	aggregatedPrices map[slinkytypes.CurrencyPair]*big.Int,
+	reportedPrices map[slinkytypes.CurrencyPair]*big.Int,
+) map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation {
+	deviations := make(map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation, len(aggregatedPrices))
+	for cp, aggregatedPrice := range aggregatedPrices {
+		if aggregatedPrice == nil {
+			continue
+		}
+
+		reportedPrice, ok := reportedPrices[cp]
+		if !ok || reportedPrice == nil {
+			deviations[cp] = oracletypes.PriceDeviation{
+				Missed: true,
+			}
+
+			continue
+		}
+
+		deviations[cp] = oracletypes.PriceDeviation{
+			DeviationBps: deviationBps(reportedPrice, aggregatedPrice),
+		}
+	}
+
+	return deviations
+}
+
+// deviationBps returns the absolute deviation of the price from the reference price, in basis points
+// of the reference price. If the reference price is zero, any non-zero price is treated as the maximum
+// deviation.
+func deviationBps(price, reference *big.Int) uint64 {
+	diff := new(big.Int).Sub(price, reference)
+	diff.Abs(diff)
+
+	if diff.Sign() == 0 {
+		return 0
+	}
+
+	if reference.Sign() == 0 {
+		return math.MaxUint64
+	}
+
+	diff.Mul(diff, bpsDenominator)
+	diff.Quo(diff, new(big.Int).Abs(reference))
+	if !diff.IsUint64() {
+		return math.MaxUint64
+	}
+
+	return diff.Uint64()
+}
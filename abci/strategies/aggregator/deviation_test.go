@@ -0,0 +1,95 @@
+package aggregator_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/abci/strategies/aggregator"
+	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestCalculatePriceDeviations(t *testing.T) {
+	testCases := []struct {
+		name       string
+		aggregated map[slinkytypes.CurrencyPair]*big.Int
+		reported   map[slinkytypes.CurrencyPair]*big.Int
+		expected   map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation
+	}{
+		{
+			name:       "no aggregated prices",
+			aggregated: nil,
+			reported: map[slinkytypes.CurrencyPair]*big.Int{
+				btcUSD: oneHundred,
+			},
+			expected: map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation{},
+		},
+		{
+			name: "no reported prices are missed",
+			aggregated: map[slinkytypes.CurrencyPair]*big.Int{
+				btcUSD: oneHundred,
+				ethUSD: twoHundred,
+			},
+			reported: nil,
+			expected: map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation{
+				btcUSD: {Missed: true},
+				ethUSD: {Missed: true},
+			},
+		},
+		{
+			name: "reported prices deviate from the aggregated prices",
+			aggregated: map[slinkytypes.CurrencyPair]*big.Int{
+				btcUSD: oneHundred,
+				ethUSD: twoHundred,
+				ethBTC: threeHundred,
+			},
+			reported: map[slinkytypes.CurrencyPair]*big.Int{
+				btcUSD: oneHundred,
+				ethUSD: big.NewInt(199),
+				ethBTC: sixHundred,
+			},
+			expected: map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation{
+				btcUSD: {DeviationBps: 0},
+				ethUSD: {DeviationBps: 50},
+				ethBTC: {DeviationBps: 10_000},
+			},
+		},
+		{
+			name: "prices that were not aggregated are ignored",
+			aggregated: map[slinkytypes.CurrencyPair]*big.Int{
+				btcUSD: oneHundred,
+			},
+			reported: map[slinkytypes.CurrencyPair]*big.Int{
+				btcUSD: big.NewInt(101),
+				ethUSD: twoHundred,
+			},
+			expected: map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation{
+				btcUSD: {DeviationBps: 100},
+			},
+		},
+		{
+			name: "any non-zero price deviates maximally from a zero aggregated price",
+			aggregated: map[slinkytypes.CurrencyPair]*big.Int{
+				btcUSD: big.NewInt(0),
+				ethUSD: big.NewInt(0),
+			},
+			reported: map[slinkytypes.CurrencyPair]*big.Int{
+				btcUSD: big.NewInt(0),
+				ethUSD: oneHundred,
+			},
+			expected: map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation{
+				btcUSD: {DeviationBps: 0},
+				ethUSD: {DeviationBps: math.MaxUint64},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, aggregator.CalculatePriceDeviations(tc.aggregated, tc.reported))
+		})
+	}
+}
@@ -11,6 +11,8 @@ import (
 
 	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
 
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+
 	types "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -86,6 +88,54 @@ func (_c *VoteAggregator_AggregateOracleVotes_Call) RunAndReturn(run func(types.
 	return _c
 }
 
+// GetDeviationsForValidator provides a mock function with given fields: validator
+func (_m *VoteAggregator) GetDeviationsForValidator(validator types.ConsAddress) map[pkgtypes.CurrencyPair]oracletypes.PriceDeviation {
+	ret := _m.Called(validator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeviationsForValidator")
+	}
+
+	var r0 map[pkgtypes.CurrencyPair]oracletypes.PriceDeviation
+	if rf, ok := ret.Get(0).(func(types.ConsAddress) map[pkgtypes.CurrencyPair]oracletypes.PriceDeviation); ok {
+		r0 = rf(validator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[pkgtypes.CurrencyPair]oracletypes.PriceDeviation)
+		}
+	}
+
+	return r0
+}
+
+// VoteAggregator_GetDeviationsForValidator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeviationsForValidator'
+type VoteAggregator_GetDeviationsForValidator_Call struct {
+	*mock.Call
+}
+
+// GetDeviationsForValidator is a helper method to define mock.On call
+//   - validator types.ConsAddress
+func (_e *VoteAggregator_Expecter) GetDeviationsForValidator(validator interface{}) *VoteAggregator_GetDeviationsForValidator_Call {
+	return &VoteAggregator_GetDeviationsForValidator_Call{Call: _e.mock.On("GetDeviationsForValidator", validator)}
+}
+
+func (_c *VoteAggregator_GetDeviationsForValidator_Call) Run(run func(validator types.ConsAddress)) *VoteAggregator_GetDeviationsForValidator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.ConsAddress))
+	})
+	return _c
+}
+
+func (_c *VoteAggregator_GetDeviationsForValidator_Call) Return(_a0 map[pkgtypes.CurrencyPair]oracletypes.PriceDeviation) *VoteAggregator_GetDeviationsForValidator_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VoteAggregator_GetDeviationsForValidator_Call) RunAndReturn(run func(types.ConsAddress) map[pkgtypes.CurrencyPair]oracletypes.PriceDeviation) *VoteAggregator_GetDeviationsForValidator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPriceForValidator provides a mock function with given fields: validator
 func (_m *VoteAggregator) GetPriceForValidator(validator types.ConsAddress) map[pkgtypes.CurrencyPair]*big.Int {
 	ret := _m.Called(validator)
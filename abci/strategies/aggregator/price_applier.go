@@ -109,6 +109,8 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 				"currency_pair", cp.String(),
 			)
 
+			opa.recordPriceMissed(ctx, cp)
+
 			continue
 		}
 
@@ -119,6 +121,8 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 				"price", price.String(),
 			)
 
+			opa.recordPriceMissed(ctx, cp)
+
 			continue
 		}
 
@@ -147,9 +151,45 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 		)
 	}
 
+	// Record how far each validator's prices were from the aggregated prices, and whether each
+	// validator included a (non-empty) oracle vote extension at all. These are bookkeeping only,
+	// so a failure to record them is logged, and does not prevent the prices from being applied.
+	for _, vote := range votes {
+		deviations := opa.va.GetDeviationsForValidator(vote.ConsAddress)
+		if err := opa.ok.UpdateValidatorPriceStats(ctx, vote.ConsAddress, deviations); err != nil {
+			opa.logger.Error(
+				"failed to update validator price stats",
+				"validator_address", vote.ConsAddress.String(),
+				"err", err,
+			)
+		}
+
+		participated := len(vote.OracleVoteExtension.Prices) > 0
+		if err := opa.ok.RecordValidatorParticipation(ctx, vote.ConsAddress, participated); err != nil {
+			opa.logger.Error(
+				"failed to record validator participation",
+				"validator_address", vote.ConsAddress.String(),
+				"participated", participated,
+				"err", err,
+			)
+		}
+	}
+
 	return prices, nil
 }
 
+// recordPriceMissed records a currency pair whose price could not be applied at the current height. This is
+// bookkeeping only, so a failure to record it is logged, and does not prevent the other prices from being applied.
+func (opa *oraclePriceApplier) recordPriceMissed(ctx sdk.Context, cp slinkytypes.CurrencyPair) {
+	if err := opa.ok.RecordPriceMissed(ctx, cp); err != nil {
+		opa.logger.Error(
+			"failed to record missed price",
+			"currency_pair", cp.String(),
+			"err", err,
+		)
+	}
+}
+
 func (opa *oraclePriceApplier) GetPricesForValidator(validator sdk.ConsAddress) map[slinkytypes.CurrencyPair]*big.Int {
 	return opa.va.GetPriceForValidator(validator)
 }
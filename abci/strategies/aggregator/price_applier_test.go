@@ -133,6 +133,11 @@ func TestPriceApplier(t *testing.T) {
 			[]slinkytypes.CurrencyPair{cp},
 		)
 
+		ok.On("RecordPriceMissed", ctx, cp).Return(nil).Once()
+		va.On("GetDeviationsForValidator", ca).Return(nil).Once()
+		ok.On("UpdateValidatorPriceStats", ctx, ca, map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation(nil)).Return(nil).Once()
+		ok.On("RecordValidatorParticipation", ctx, ca, true).Return(nil).Once()
+
 		_, err = pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
 			Txs: [][]byte{extCommitInfoBz},
 		})
@@ -202,6 +207,7 @@ func TestPriceApplier(t *testing.T) {
 		ok.On("GetAllCurrencyPairs", ctx).Return(
 			[]slinkytypes.CurrencyPair{cp, slinkytypes.NewCurrencyPair("ETH", "USD")}, // ignore last cp
 		)
+		ok.On("RecordPriceMissed", ctx, slinkytypes.NewCurrencyPair("ETH", "USD")).Return(nil).Once()
 
 		ok.On("SetPriceForCurrencyPair", ctx, cp, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
 			qp := args.Get(2).(oracletypes.QuotePrice)
@@ -211,6 +217,22 @@ func TestPriceApplier(t *testing.T) {
 			require.Equal(t, qp.BlockHeight, uint64(ctx.BlockHeight()))
 		})
 
+		// record the deviations of each validator
+		deviations1 := map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation{
+			cp: {DeviationBps: 3_333},
+		}
+		deviations2 := map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation{
+			cp: {DeviationBps: 3_333},
+		}
+		va.On("GetDeviationsForValidator", ca1).Return(deviations1).Once()
+		va.On("GetDeviationsForValidator", ca2).Return(deviations2).Once()
+		ok.On("UpdateValidatorPriceStats", ctx, ca1, deviations1).Return(nil).Once()
+		ok.On("UpdateValidatorPriceStats", ctx, ca2, deviations2).Return(nil).Once()
+
+		// record the participation of each validator
+		ok.On("RecordValidatorParticipation", ctx, ca1, true).Return(nil).Once()
+		ok.On("RecordValidatorParticipation", ctx, ca2, true).Return(nil).Once()
+
 		prices, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
 			Txs: [][]byte{extCommitInfoBz},
 		})
@@ -229,4 +251,118 @@ func TestPriceApplier(t *testing.T) {
 		valPrices := pa.GetPricesForValidator(ca1)
 		require.Equal(t, expPrices, valPrices)
 	})
+	t.Run("if updating validator price stats fails, prices are still applied", func(t *testing.T) {
+		ca := sdk.ConsAddress("val3")
+		prices := map[uint64][]byte{
+			1: big.NewInt(100).Bytes(),
+		}
+
+		vote, err := testutils.CreateExtendedVoteInfo(
+			ca,
+			prices,
+			veCodec,
+		)
+		require.NoError(t, err)
+
+		_, extCommitInfoBz, err := testutils.CreateExtendedCommitInfo(
+			[]abcitypes.ExtendedVoteInfo{vote},
+			extCommitcodec,
+		)
+		require.NoError(t, err)
+
+		ctx := sdk.Context{}.WithBlockHeight(2)
+
+		cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+		va.On("AggregateOracleVotes", ctx, []aggregator.Vote{
+			{
+				OracleVoteExtension: vetypes.OracleVoteExtension{
+					Prices: prices,
+				},
+				ConsAddress: ca,
+			},
+		}).Return(map[slinkytypes.CurrencyPair]*big.Int{}, nil).Once()
+		ok.On("GetAllCurrencyPairs", ctx).Return([]slinkytypes.CurrencyPair{cp}).Once()
+		ok.On("RecordPriceMissed", ctx, cp).Return(nil).Once()
+
+		deviations := map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation{
+			cp: {Missed: true},
+		}
+		va.On("GetDeviationsForValidator", ca).Return(deviations).Once()
+		ok.On("UpdateValidatorPriceStats", ctx, ca, deviations).Return(fmt.Errorf("fail")).Once()
+
+		// the participation of the validator is still recorded
+		ok.On("RecordValidatorParticipation", ctx, ca, true).Return(nil).Once()
+
+		returnedPrices, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitInfoBz},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[slinkytypes.CurrencyPair]*big.Int{}, returnedPrices)
+	})
+
+	t.Run("if recording validator participation fails, prices are still applied", func(t *testing.T) {
+		ca := sdk.ConsAddress("val4")
+
+		vote, err := testutils.CreateExtendedVoteInfo(
+			ca,
+			nil,
+			veCodec,
+		)
+		require.NoError(t, err)
+
+		_, extCommitInfoBz, err := testutils.CreateExtendedCommitInfo(
+			[]abcitypes.ExtendedVoteInfo{vote},
+			extCommitcodec,
+		)
+		require.NoError(t, err)
+
+		ctx := sdk.Context{}.WithBlockHeight(3)
+
+		cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+		va.On("AggregateOracleVotes", ctx, mock.Anything).Return(map[slinkytypes.CurrencyPair]*big.Int{}, nil).Once()
+		ok.On("GetAllCurrencyPairs", ctx).Return([]slinkytypes.CurrencyPair{cp}).Once()
+		ok.On("RecordPriceMissed", ctx, cp).Return(nil).Once()
+
+		va.On("GetDeviationsForValidator", ca).Return(nil).Once()
+		ok.On("UpdateValidatorPriceStats", ctx, ca, map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation(nil)).Return(nil).Once()
+
+		// the validator did not include any prices in its vote extension
+		ok.On("RecordValidatorParticipation", ctx, ca, false).Return(fmt.Errorf("fail")).Once()
+
+		returnedPrices, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitInfoBz},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[slinkytypes.CurrencyPair]*big.Int{}, returnedPrices)
+	})
+
+	t.Run("fail to record missed price", func(t *testing.T) {
+		_, extCommitInfoBz, err := testutils.CreateExtendedCommitInfo(
+			[]abcitypes.ExtendedVoteInfo{},
+			extCommitcodec,
+		)
+		require.NoError(t, err)
+
+		ctx := sdk.Context{}.WithBlockHeight(4)
+
+		// no price is aggregated for the currency pair
+		cp := slinkytypes.NewCurrencyPair("ETH", "USD")
+		va.On("AggregateOracleVotes", ctx, []aggregator.Vote{}).Return(
+			map[slinkytypes.CurrencyPair]*big.Int{}, nil,
+		).Once()
+
+		ok.On("GetAllCurrencyPairs", ctx).Return(
+			[]slinkytypes.CurrencyPair{cp},
+		).Once()
+
+		ok.On("RecordPriceMissed", ctx, cp).Return(fmt.Errorf("fail")).Once()
+
+		returnedPrices, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitInfoBz},
+		})
+
+		// the missed price is only bookkeeping, so the prices are still applied
+		require.NoError(t, err)
+		require.Empty(t, returnedPrices)
+	})
 }
@@ -13,6 +13,7 @@ import (
 	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
 	"github.com/skip-mev/connect/v2/aggregator"
 	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 // Vote encapsulates the validator and oracle data contained within a vote extension.
@@ -87,6 +88,11 @@ type VoteAggregator interface {
 	// GetPriceForValidator gets the prices reported by a given validator. This method depends
 	// on the prices from the latest set of aggregated votes.
 	GetPriceForValidator(validator sdk.ConsAddress) map[slinkytypes.CurrencyPair]*big.Int
+
+	// GetDeviationsForValidator gets the deviation of the prices reported by a given validator
+	// from the aggregated (stake-weighted median) prices, for each currency pair that has an
+	// aggregated price. This method depends on the prices from the latest set of aggregated votes.
+	GetDeviationsForValidator(validator sdk.ConsAddress) map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation
 }
 
 func NewDefaultVoteAggregator(
@@ -100,6 +106,7 @@ func NewDefaultVoteAggregator(
 			aggregator.WithAggregateFnFromContext(aggregateFn),
 		),
 		currencyPairStrategy: strategy,
+		deviations:           make(map[string]map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation),
 	}
 }
 
@@ -110,6 +117,9 @@ type DefaultVoteAggregator struct {
 	// decoding prices / currency-pair ids
 	currencyPairStrategy currencypair.CurrencyPairStrategy
 
+	// validator address -> currency-pair -> deviation from the aggregated price
+	deviations map[string]map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation
+
 	logger log.Logger
 }
 
@@ -137,6 +147,13 @@ func (dva *DefaultVoteAggregator) AggregateOracleVotes(ctx sdk.Context, votes []
 	dva.priceAggregator.AggregateDataFromContext(ctx)
 	prices := dva.priceAggregator.GetAggregatedData()
 
+	// Compute how far each validator's prices are from the final prices.
+	dva.deviations = make(map[string]map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation, len(votes))
+	for _, vote := range votes {
+		consAddrStr := vote.ConsAddress.String()
+		dva.deviations[consAddrStr] = CalculatePriceDeviations(prices, dva.priceAggregator.GetDataByProvider(consAddrStr))
+	}
+
 	dva.logger.Debug(
 		"aggregated oracle data",
 		"num_prices", len(prices),
@@ -209,3 +226,7 @@ func (dva *DefaultVoteAggregator) GetPriceForValidator(validator sdk.ConsAddress
 	consAddrStr := validator.String()
 	return dva.priceAggregator.GetDataByProvider(consAddrStr)
 }
+
+func (dva *DefaultVoteAggregator) GetDeviationsForValidator(validator sdk.ConsAddress) map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation {
+	return dva.deviations[validator.String()]
+}
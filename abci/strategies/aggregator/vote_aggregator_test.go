@@ -19,6 +19,7 @@ import (
 	"github.com/skip-mev/connect/v2/pkg/math/voteweighted"
 	"github.com/skip-mev/connect/v2/pkg/math/voteweighted/mocks"
 	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 var (
@@ -241,6 +242,15 @@ func (s *VoteAggregatorTestSuite) TestAggregateOracleVotes() {
 
 		// Check that the prices are correct
 		s.Require().Equal(oneHundred.String(), prices[btcUSD].String())
+
+		// Check that the deviations from the aggregated price are recorded per validator
+		s.Require().Equal(map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation{
+			btcUSD: {DeviationBps: 0},
+		}, handler.GetDeviationsForValidator(s.myVal))
+		s.Require().Equal(map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation{
+			btcUSD: {DeviationBps: 10_000},
+		}, handler.GetDeviationsForValidator(val1))
+		s.Require().Nil(handler.GetDeviationsForValidator(val2))
 	})
 
 	s.Run("single price update from multiple validators but not enough voting power", func() {
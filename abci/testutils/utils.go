@@ -3,6 +3,7 @@ package testutils
 import (
 	"testing"
 
+	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/store/types"
 	cometabci "github.com/cometbft/cometbft/abci/types"
 	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -10,9 +11,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/mock"
 
 	compression "github.com/skip-mev/connect/v2/abci/strategies/codec"
 	"github.com/skip-mev/connect/v2/abci/ve/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 	"github.com/skip-mev/connect/v2/x/oracle/keeper"
 	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 	"github.com/skip-mev/connect/v2/x/oracle/types/mocks"
@@ -25,10 +28,14 @@ func CreateTestOracleKeeperWithGenesis(t *testing.T, ctx sdk.Context, key *store
 	ss := runtime.NewKVStoreService(key)
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 
+	// the currency pairs have no markets in the market map
+	mmKeeper := mocks.NewMarketMapKeeper(t)
+	mmKeeper.On("GetMarket", mock.Anything, mock.Anything).Return(marketmaptypes.Market{}, collections.ErrNotFound).Maybe()
+
 	k := keeper.NewKeeper(
 		ss,
 		encCfg.Codec,
-		mocks.NewMarketMapKeeper(t),
+		mmKeeper,
 		sdk.AccAddress("authority"),
 	)
 
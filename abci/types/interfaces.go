@@ -19,6 +19,13 @@ import (
 type OracleKeeper interface { //golint:ignore
 	GetAllCurrencyPairs(ctx sdk.Context) []slinkytypes.CurrencyPair
 	SetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp oracletypes.QuotePrice) error
+	UpdateValidatorPriceStats(
+		ctx sdk.Context,
+		validator sdk.ConsAddress,
+		deviations map[slinkytypes.CurrencyPair]oracletypes.PriceDeviation,
+	) error
+	RecordValidatorParticipation(ctx sdk.Context, validator sdk.ConsAddress, participated bool) error
+	RecordPriceMissed(ctx sdk.Context, cp slinkytypes.CurrencyPair) error
 }
 
 // OracleClient defines the interface that must be fulfilled by the slinky client.
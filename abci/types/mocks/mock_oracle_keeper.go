@@ -24,6 +24,53 @@ func (_m *OracleKeeper) EXPECT() *OracleKeeper_Expecter {
 	return &OracleKeeper_Expecter{mock: &_m.Mock}
 }
 
+// RecordPriceMissed provides a mock function with given fields: ctx, cp
+func (_m *OracleKeeper) RecordPriceMissed(ctx types.Context, cp pkgtypes.CurrencyPair) error {
+	ret := _m.Called(ctx, cp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordPriceMissed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair) error); ok {
+		r0 = rf(ctx, cp)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OracleKeeper_RecordPriceMissed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordPriceMissed'
+type OracleKeeper_RecordPriceMissed_Call struct {
+	*mock.Call
+}
+
+// RecordPriceMissed is a helper method to define mock.On call
+//   - ctx types.Context
+//   - cp pkgtypes.CurrencyPair
+func (_e *OracleKeeper_Expecter) RecordPriceMissed(ctx interface{}, cp interface{}) *OracleKeeper_RecordPriceMissed_Call {
+	return &OracleKeeper_RecordPriceMissed_Call{Call: _e.mock.On("RecordPriceMissed", ctx, cp)}
+}
+
+func (_c *OracleKeeper_RecordPriceMissed_Call) Run(run func(ctx types.Context, cp pkgtypes.CurrencyPair)) *OracleKeeper_RecordPriceMissed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.Context), args[1].(pkgtypes.CurrencyPair))
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_RecordPriceMissed_Call) Return(_a0 error) *OracleKeeper_RecordPriceMissed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OracleKeeper_RecordPriceMissed_Call) RunAndReturn(run func(types.Context, pkgtypes.CurrencyPair) error) *OracleKeeper_RecordPriceMissed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllCurrencyPairs provides a mock function with given fields: ctx
 func (_m *OracleKeeper) GetAllCurrencyPairs(ctx types.Context) []pkgtypes.CurrencyPair {
 	ret := _m.Called(ctx)
@@ -72,6 +119,54 @@ func (_c *OracleKeeper_GetAllCurrencyPairs_Call) RunAndReturn(run func(types.Con
 	return _c
 }
 
+// RecordValidatorParticipation provides a mock function with given fields: ctx, validator, participated
+func (_m *OracleKeeper) RecordValidatorParticipation(ctx types.Context, validator types.ConsAddress, participated bool) error {
+	ret := _m.Called(ctx, validator, participated)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordValidatorParticipation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, types.ConsAddress, bool) error); ok {
+		r0 = rf(ctx, validator, participated)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OracleKeeper_RecordValidatorParticipation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordValidatorParticipation'
+type OracleKeeper_RecordValidatorParticipation_Call struct {
+	*mock.Call
+}
+
+// RecordValidatorParticipation is a helper method to define mock.On call
+//   - ctx types.Context
+//   - validator types.ConsAddress
+//   - participated bool
+func (_e *OracleKeeper_Expecter) RecordValidatorParticipation(ctx interface{}, validator interface{}, participated interface{}) *OracleKeeper_RecordValidatorParticipation_Call {
+	return &OracleKeeper_RecordValidatorParticipation_Call{Call: _e.mock.On("RecordValidatorParticipation", ctx, validator, participated)}
+}
+
+func (_c *OracleKeeper_RecordValidatorParticipation_Call) Run(run func(ctx types.Context, validator types.ConsAddress, participated bool)) *OracleKeeper_RecordValidatorParticipation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.Context), args[1].(types.ConsAddress), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_RecordValidatorParticipation_Call) Return(_a0 error) *OracleKeeper_RecordValidatorParticipation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OracleKeeper_RecordValidatorParticipation_Call) RunAndReturn(run func(types.Context, types.ConsAddress, bool) error) *OracleKeeper_RecordValidatorParticipation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetPriceForCurrencyPair provides a mock function with given fields: ctx, cp, qp
 func (_m *OracleKeeper) SetPriceForCurrencyPair(ctx types.Context, cp pkgtypes.CurrencyPair, qp oracletypes.QuotePrice) error {
 	ret := _m.Called(ctx, cp, qp)
@@ -120,6 +215,54 @@ func (_c *OracleKeeper_SetPriceForCurrencyPair_Call) RunAndReturn(run func(types
 	return _c
 }
 
+// UpdateValidatorPriceStats provides a mock function with given fields: ctx, validator, deviations
+func (_m *OracleKeeper) UpdateValidatorPriceStats(ctx types.Context, validator types.ConsAddress, deviations map[pkgtypes.CurrencyPair]oracletypes.PriceDeviation) error {
+	ret := _m.Called(ctx, validator, deviations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateValidatorPriceStats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, types.ConsAddress, map[pkgtypes.CurrencyPair]oracletypes.PriceDeviation) error); ok {
+		r0 = rf(ctx, validator, deviations)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OracleKeeper_UpdateValidatorPriceStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateValidatorPriceStats'
+type OracleKeeper_UpdateValidatorPriceStats_Call struct {
+	*mock.Call
+}
+
+// UpdateValidatorPriceStats is a helper method to define mock.On call
+//   - ctx types.Context
+//   - validator types.ConsAddress
+//   - deviations map[pkgtypes.CurrencyPair]oracletypes.PriceDeviation
+func (_e *OracleKeeper_Expecter) UpdateValidatorPriceStats(ctx interface{}, validator interface{}, deviations interface{}) *OracleKeeper_UpdateValidatorPriceStats_Call {
+	return &OracleKeeper_UpdateValidatorPriceStats_Call{Call: _e.mock.On("UpdateValidatorPriceStats", ctx, validator, deviations)}
+}
+
+func (_c *OracleKeeper_UpdateValidatorPriceStats_Call) Run(run func(ctx types.Context, validator types.ConsAddress, deviations map[pkgtypes.CurrencyPair]oracletypes.PriceDeviation)) *OracleKeeper_UpdateValidatorPriceStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.Context), args[1].(types.ConsAddress), args[2].(map[pkgtypes.CurrencyPair]oracletypes.PriceDeviation))
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_UpdateValidatorPriceStats_Call) Return(_a0 error) *OracleKeeper_UpdateValidatorPriceStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OracleKeeper_UpdateValidatorPriceStats_Call) RunAndReturn(run func(types.Context, types.ConsAddress, map[pkgtypes.CurrencyPair]oracletypes.PriceDeviation) error) *OracleKeeper_UpdateValidatorPriceStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewOracleKeeper creates a new instance of OracleKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOracleKeeper(t interface {
@@ -4,6 +4,7 @@ package marketmapv1
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -58,10 +59,62 @@ func (x *_Params_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_3_list)(nil)
+
+type _Params_3_list struct {
+	list *[]*MarketAuthorityRole
+}
+
+func (x *_Params_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarketAuthorityRole)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarketAuthorityRole)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_3_list) AppendMutable() protoreflect.Value {
+	v := new(MarketAuthorityRole)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_3_list) NewElement() protoreflect.Value {
+	v := new(MarketAuthorityRole)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                    protoreflect.MessageDescriptor
-	fd_Params_market_authorities protoreflect.FieldDescriptor
-	fd_Params_admin              protoreflect.FieldDescriptor
+	md_Params                        protoreflect.MessageDescriptor
+	fd_Params_market_authorities     protoreflect.FieldDescriptor
+	fd_Params_admin                  protoreflect.FieldDescriptor
+	fd_Params_market_authority_roles protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -69,6 +122,7 @@ func init() {
 	md_Params = File_slinky_marketmap_v1_params_proto.Messages().ByName("Params")
 	fd_Params_market_authorities = md_Params.Fields().ByName("market_authorities")
 	fd_Params_admin = md_Params.Fields().ByName("admin")
+	fd_Params_market_authority_roles = md_Params.Fields().ByName("market_authority_roles")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -148,6 +202,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.MarketAuthorityRoles) != 0 {
+		value := protoreflect.ValueOfList(&_Params_3_list{list: &x.MarketAuthorityRoles})
+		if !f(fd_Params_market_authority_roles, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -167,6 +227,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.MarketAuthorities) != 0
 	case "slinky.marketmap.v1.Params.admin":
 		return x.Admin != ""
+	case "slinky.marketmap.v1.Params.market_authority_roles":
+		return len(x.MarketAuthorityRoles) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -187,6 +249,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MarketAuthorities = nil
 	case "slinky.marketmap.v1.Params.admin":
 		x.Admin = ""
+	case "slinky.marketmap.v1.Params.market_authority_roles":
+		x.MarketAuthorityRoles = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -212,6 +276,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "slinky.marketmap.v1.Params.admin":
 		value := x.Admin
 		return protoreflect.ValueOfString(value)
+	case "slinky.marketmap.v1.Params.market_authority_roles":
+		if len(x.MarketAuthorityRoles) == 0 {
+			return protoreflect.ValueOfList(&_Params_3_list{})
+		}
+		listValue := &_Params_3_list{list: &x.MarketAuthorityRoles}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -238,6 +308,10 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MarketAuthorities = *clv.list
 	case "slinky.marketmap.v1.Params.admin":
 		x.Admin = value.Interface().(string)
+	case "slinky.marketmap.v1.Params.market_authority_roles":
+		lv := value.List()
+		clv := lv.(*_Params_3_list)
+		x.MarketAuthorityRoles = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -264,6 +338,12 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_1_list{list: &x.MarketAuthorities}
 		return protoreflect.ValueOfList(value)
+	case "slinky.marketmap.v1.Params.market_authority_roles":
+		if x.MarketAuthorityRoles == nil {
+			x.MarketAuthorityRoles = []*MarketAuthorityRole{}
+		}
+		value := &_Params_3_list{list: &x.MarketAuthorityRoles}
+		return protoreflect.ValueOfList(value)
 	case "slinky.marketmap.v1.Params.admin":
 		panic(fmt.Errorf("field admin of message slinky.marketmap.v1.Params is not mutable"))
 	default:
@@ -284,6 +364,9 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
 	case "slinky.marketmap.v1.Params.admin":
 		return protoreflect.ValueOfString("")
+	case "slinky.marketmap.v1.Params.market_authority_roles":
+		list := []*MarketAuthorityRole{}
+		return protoreflect.ValueOfList(&_Params_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -363,6 +446,12 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.MarketAuthorityRoles) > 0 {
+			for _, e := range x.MarketAuthorityRoles {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -392,6 +481,22 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MarketAuthorityRoles) > 0 {
+			for iNdEx := len(x.MarketAuthorityRoles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MarketAuthorityRoles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if len(x.Admin) > 0 {
 			i -= len(x.Admin)
 			copy(dAtA[i:], x.Admin)
@@ -521,6 +626,40 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.Admin = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketAuthorityRoles", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MarketAuthorityRoles = append(x.MarketAuthorityRoles, &MarketAuthorityRole{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MarketAuthorityRoles[len(x.MarketAuthorityRoles)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -556,126 +695,1026 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: slinky/marketmap/v1/params.proto
+var _ protoreflect.List = (*_MarketAuthorityRole_2_list)(nil)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+type _MarketAuthorityRole_2_list struct {
+	list *[]string
+}
 
-// Params defines the parameters for the x/marketmap module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *_MarketAuthorityRole_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-	// MarketAuthorities is the list of authority accounts that are able to
-	// control updating the marketmap.
-	MarketAuthorities []string `protobuf:"bytes,1,rep,name=market_authorities,json=marketAuthorities,proto3" json:"market_authorities,omitempty"`
-	// Admin is an address that can remove addresses from the MarketAuthorities
-	// list. Only governance can add to the MarketAuthorities or change the Admin.
-	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+func (x *_MarketAuthorityRole_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_slinky_marketmap_v1_params_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *_MarketAuthorityRole_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_MarketAuthorityRole_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (*Params) ProtoMessage() {}
+func (x *_MarketAuthorityRole_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MarketAuthorityRole at list field AllowedQuotes as it is not of Message kind"))
+}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_slinky_marketmap_v1_params_proto_rawDescGZIP(), []int{0}
+func (x *_MarketAuthorityRole_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-func (x *Params) GetMarketAuthorities() []string {
-	if x != nil {
-		return x.MarketAuthorities
-	}
-	return nil
+func (x *_MarketAuthorityRole_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *Params) GetAdmin() string {
-	if x != nil {
-		return x.Admin
+func (x *_MarketAuthorityRole_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MarketAuthorityRole_3_list)(nil)
+
+type _MarketAuthorityRole_3_list struct {
+	list *[]string
+}
+
+func (x *_MarketAuthorityRole_3_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return ""
+	return len(*x.list)
 }
 
-var File_slinky_marketmap_v1_params_proto protoreflect.FileDescriptor
+func (x *_MarketAuthorityRole_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
 
-var file_slinky_marketmap_v1_params_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
-	0x61, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x13, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x22, 0x4d, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0xc6, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x73,
-	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e,
-	0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
-	0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4d, 0x58, 0xaa, 0x02, 0x13, 0x53, 0x6c, 0x69, 0x6e,
-	0x6b, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x13, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
-	0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
-	0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *_MarketAuthorityRole_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MarketAuthorityRole_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MarketAuthorityRole_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MarketAuthorityRole at list field AllowedProviders as it is not of Message kind"))
+}
+
+func (x *_MarketAuthorityRole_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MarketAuthorityRole_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MarketAuthorityRole_3_list) IsValid() bool {
+	return x.list != nil
 }
 
 var (
-	file_slinky_marketmap_v1_params_proto_rawDescOnce sync.Once
-	file_slinky_marketmap_v1_params_proto_rawDescData = file_slinky_marketmap_v1_params_proto_rawDesc
+	md_MarketAuthorityRole                       protoreflect.MessageDescriptor
+	fd_MarketAuthorityRole_address               protoreflect.FieldDescriptor
+	fd_MarketAuthorityRole_allowed_quotes        protoreflect.FieldDescriptor
+	fd_MarketAuthorityRole_allowed_providers     protoreflect.FieldDescriptor
+	fd_MarketAuthorityRole_enable_disable_only   protoreflect.FieldDescriptor
+	fd_MarketAuthorityRole_max_markets_per_block protoreflect.FieldDescriptor
 )
 
-func file_slinky_marketmap_v1_params_proto_rawDescGZIP() []byte {
-	file_slinky_marketmap_v1_params_proto_rawDescOnce.Do(func() {
-		file_slinky_marketmap_v1_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_slinky_marketmap_v1_params_proto_rawDescData)
-	})
-	return file_slinky_marketmap_v1_params_proto_rawDescData
+func init() {
+	file_slinky_marketmap_v1_params_proto_init()
+	md_MarketAuthorityRole = File_slinky_marketmap_v1_params_proto.Messages().ByName("MarketAuthorityRole")
+	fd_MarketAuthorityRole_address = md_MarketAuthorityRole.Fields().ByName("address")
+	fd_MarketAuthorityRole_allowed_quotes = md_MarketAuthorityRole.Fields().ByName("allowed_quotes")
+	fd_MarketAuthorityRole_allowed_providers = md_MarketAuthorityRole.Fields().ByName("allowed_providers")
+	fd_MarketAuthorityRole_enable_disable_only = md_MarketAuthorityRole.Fields().ByName("enable_disable_only")
+	fd_MarketAuthorityRole_max_markets_per_block = md_MarketAuthorityRole.Fields().ByName("max_markets_per_block")
 }
 
-var file_slinky_marketmap_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_slinky_marketmap_v1_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: slinky.marketmap.v1.Params
+var _ protoreflect.Message = (*fastReflection_MarketAuthorityRole)(nil)
+
+type fastReflection_MarketAuthorityRole MarketAuthorityRole
+
+func (x *MarketAuthorityRole) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketAuthorityRole)(x)
 }
-var file_slinky_marketmap_v1_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (x *MarketAuthorityRole) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_marketmap_v1_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func init() { file_slinky_marketmap_v1_params_proto_init() }
-func file_slinky_marketmap_v1_params_proto_init() {
-	if File_slinky_marketmap_v1_params_proto != nil {
-		return
+var _fastReflection_MarketAuthorityRole_messageType fastReflection_MarketAuthorityRole_messageType
+var _ protoreflect.MessageType = fastReflection_MarketAuthorityRole_messageType{}
+
+type fastReflection_MarketAuthorityRole_messageType struct{}
+
+func (x fastReflection_MarketAuthorityRole_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketAuthorityRole)(nil)
+}
+func (x fastReflection_MarketAuthorityRole_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketAuthorityRole)
+}
+func (x fastReflection_MarketAuthorityRole_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketAuthorityRole
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketAuthorityRole) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketAuthorityRole
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketAuthorityRole) Type() protoreflect.MessageType {
+	return _fastReflection_MarketAuthorityRole_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketAuthorityRole) New() protoreflect.Message {
+	return new(fastReflection_MarketAuthorityRole)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketAuthorityRole) Interface() protoreflect.ProtoMessage {
+	return (*MarketAuthorityRole)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketAuthorityRole) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_MarketAuthorityRole_address, value) {
+			return
+		}
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_slinky_marketmap_v1_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Params); i {
+	if len(x.AllowedQuotes) != 0 {
+		value := protoreflect.ValueOfList(&_MarketAuthorityRole_2_list{list: &x.AllowedQuotes})
+		if !f(fd_MarketAuthorityRole_allowed_quotes, value) {
+			return
+		}
+	}
+	if len(x.AllowedProviders) != 0 {
+		value := protoreflect.ValueOfList(&_MarketAuthorityRole_3_list{list: &x.AllowedProviders})
+		if !f(fd_MarketAuthorityRole_allowed_providers, value) {
+			return
+		}
+	}
+	if x.EnableDisableOnly != false {
+		value := protoreflect.ValueOfBool(x.EnableDisableOnly)
+		if !f(fd_MarketAuthorityRole_enable_disable_only, value) {
+			return
+		}
+	}
+	if x.MaxMarketsPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxMarketsPerBlock)
+		if !f(fd_MarketAuthorityRole_max_markets_per_block, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketAuthorityRole) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketAuthorityRole.address":
+		return x.Address != ""
+	case "slinky.marketmap.v1.MarketAuthorityRole.allowed_quotes":
+		return len(x.AllowedQuotes) != 0
+	case "slinky.marketmap.v1.MarketAuthorityRole.allowed_providers":
+		return len(x.AllowedProviders) != 0
+	case "slinky.marketmap.v1.MarketAuthorityRole.enable_disable_only":
+		return x.EnableDisableOnly != false
+	case "slinky.marketmap.v1.MarketAuthorityRole.max_markets_per_block":
+		return x.MaxMarketsPerBlock != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketAuthorityRole"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketAuthorityRole does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityRole) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketAuthorityRole.address":
+		x.Address = ""
+	case "slinky.marketmap.v1.MarketAuthorityRole.allowed_quotes":
+		x.AllowedQuotes = nil
+	case "slinky.marketmap.v1.MarketAuthorityRole.allowed_providers":
+		x.AllowedProviders = nil
+	case "slinky.marketmap.v1.MarketAuthorityRole.enable_disable_only":
+		x.EnableDisableOnly = false
+	case "slinky.marketmap.v1.MarketAuthorityRole.max_markets_per_block":
+		x.MaxMarketsPerBlock = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketAuthorityRole"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketAuthorityRole does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketAuthorityRole) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.marketmap.v1.MarketAuthorityRole.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "slinky.marketmap.v1.MarketAuthorityRole.allowed_quotes":
+		if len(x.AllowedQuotes) == 0 {
+			return protoreflect.ValueOfList(&_MarketAuthorityRole_2_list{})
+		}
+		listValue := &_MarketAuthorityRole_2_list{list: &x.AllowedQuotes}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.marketmap.v1.MarketAuthorityRole.allowed_providers":
+		if len(x.AllowedProviders) == 0 {
+			return protoreflect.ValueOfList(&_MarketAuthorityRole_3_list{})
+		}
+		listValue := &_MarketAuthorityRole_3_list{list: &x.AllowedProviders}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.marketmap.v1.MarketAuthorityRole.enable_disable_only":
+		value := x.EnableDisableOnly
+		return protoreflect.ValueOfBool(value)
+	case "slinky.marketmap.v1.MarketAuthorityRole.max_markets_per_block":
+		value := x.MaxMarketsPerBlock
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketAuthorityRole"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketAuthorityRole does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityRole) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketAuthorityRole.address":
+		x.Address = value.Interface().(string)
+	case "slinky.marketmap.v1.MarketAuthorityRole.allowed_quotes":
+		lv := value.List()
+		clv := lv.(*_MarketAuthorityRole_2_list)
+		x.AllowedQuotes = *clv.list
+	case "slinky.marketmap.v1.MarketAuthorityRole.allowed_providers":
+		lv := value.List()
+		clv := lv.(*_MarketAuthorityRole_3_list)
+		x.AllowedProviders = *clv.list
+	case "slinky.marketmap.v1.MarketAuthorityRole.enable_disable_only":
+		x.EnableDisableOnly = value.Bool()
+	case "slinky.marketmap.v1.MarketAuthorityRole.max_markets_per_block":
+		x.MaxMarketsPerBlock = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketAuthorityRole"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketAuthorityRole does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityRole) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketAuthorityRole.allowed_quotes":
+		if x.AllowedQuotes == nil {
+			x.AllowedQuotes = []string{}
+		}
+		value := &_MarketAuthorityRole_2_list{list: &x.AllowedQuotes}
+		return protoreflect.ValueOfList(value)
+	case "slinky.marketmap.v1.MarketAuthorityRole.allowed_providers":
+		if x.AllowedProviders == nil {
+			x.AllowedProviders = []string{}
+		}
+		value := &_MarketAuthorityRole_3_list{list: &x.AllowedProviders}
+		return protoreflect.ValueOfList(value)
+	case "slinky.marketmap.v1.MarketAuthorityRole.address":
+		panic(fmt.Errorf("field address of message slinky.marketmap.v1.MarketAuthorityRole is not mutable"))
+	case "slinky.marketmap.v1.MarketAuthorityRole.enable_disable_only":
+		panic(fmt.Errorf("field enable_disable_only of message slinky.marketmap.v1.MarketAuthorityRole is not mutable"))
+	case "slinky.marketmap.v1.MarketAuthorityRole.max_markets_per_block":
+		panic(fmt.Errorf("field max_markets_per_block of message slinky.marketmap.v1.MarketAuthorityRole is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketAuthorityRole"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketAuthorityRole does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketAuthorityRole) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketAuthorityRole.address":
+		return protoreflect.ValueOfString("")
+	case "slinky.marketmap.v1.MarketAuthorityRole.allowed_quotes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MarketAuthorityRole_2_list{list: &list})
+	case "slinky.marketmap.v1.MarketAuthorityRole.allowed_providers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MarketAuthorityRole_3_list{list: &list})
+	case "slinky.marketmap.v1.MarketAuthorityRole.enable_disable_only":
+		return protoreflect.ValueOfBool(false)
+	case "slinky.marketmap.v1.MarketAuthorityRole.max_markets_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketAuthorityRole"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketAuthorityRole does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketAuthorityRole) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.marketmap.v1.MarketAuthorityRole", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketAuthorityRole) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityRole) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketAuthorityRole) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketAuthorityRole) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketAuthorityRole)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AllowedQuotes) > 0 {
+			for _, s := range x.AllowedQuotes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AllowedProviders) > 0 {
+			for _, s := range x.AllowedProviders {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.EnableDisableOnly {
+			n += 2
+		}
+		if x.MaxMarketsPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxMarketsPerBlock))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketAuthorityRole)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxMarketsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMarketsPerBlock))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.EnableDisableOnly {
+			i--
+			if x.EnableDisableOnly {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.AllowedProviders) > 0 {
+			for iNdEx := len(x.AllowedProviders) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedProviders[iNdEx])
+				copy(dAtA[i:], x.AllowedProviders[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedProviders[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.AllowedQuotes) > 0 {
+			for iNdEx := len(x.AllowedQuotes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedQuotes[iNdEx])
+				copy(dAtA[i:], x.AllowedQuotes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedQuotes[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketAuthorityRole)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketAuthorityRole: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketAuthorityRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedQuotes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedQuotes = append(x.AllowedQuotes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedProviders", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedProviders = append(x.AllowedProviders, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableDisableOnly", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EnableDisableOnly = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMarketsPerBlock", wireType)
+				}
+				x.MaxMarketsPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMarketsPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: slinky/marketmap/v1/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the parameters for the x/marketmap module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MarketAuthorities is the list of authority accounts that are able to
+	// control updating the marketmap.
+	MarketAuthorities []string `protobuf:"bytes,1,rep,name=market_authorities,json=marketAuthorities,proto3" json:"market_authorities,omitempty"`
+	// Admin is an address that can remove addresses from the MarketAuthorities
+	// list. Only governance can add to the MarketAuthorities or change the Admin.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// MarketAuthorityRoles restricts the market updates that individual
+	// MarketAuthorities are allowed to make. MarketAuthorities without a role are
+	// allowed to make any market update.
+	MarketAuthorityRoles []*MarketAuthorityRole `protobuf:"bytes,3,rep,name=market_authority_roles,json=marketAuthorityRoles,proto3" json:"market_authority_roles,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_marketmap_v1_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_slinky_marketmap_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetMarketAuthorities() []string {
+	if x != nil {
+		return x.MarketAuthorities
+	}
+	return nil
+}
+
+func (x *Params) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *Params) GetMarketAuthorityRoles() []*MarketAuthorityRole {
+	if x != nil {
+		return x.MarketAuthorityRoles
+	}
+	return nil
+}
+
+// MarketAuthorityRole restricts the market updates that a market authority is
+// allowed to make. Market authorities without a role are unrestricted.
+type MarketAuthorityRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address is the address of the market authority that the role applies to.
+	// It must be one of the MarketAuthorities.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// AllowedQuotes is the list of quote assets of the markets that the
+	// authority is allowed to change. If empty, markets of any quote asset can be
+	// changed.
+	AllowedQuotes []string `protobuf:"bytes,2,rep,name=allowed_quotes,json=allowedQuotes,proto3" json:"allowed_quotes,omitempty"`
+	// AllowedProviders is the list of providers whose provider configs the
+	// authority is allowed to add, remove or change. An authority restricted to
+	// providers cannot change the tickers of existing markets. If empty, any
+	// provider can be used.
+	AllowedProviders []string `protobuf:"bytes,3,rep,name=allowed_providers,json=allowedProviders,proto3" json:"allowed_providers,omitempty"`
+	// EnableDisableOnly restricts the authority to enabling and disabling
+	// existing markets. Such an authority cannot create, remove or otherwise
+	// change markets.
+	EnableDisableOnly bool `protobuf:"varint,4,opt,name=enable_disable_only,json=enableDisableOnly,proto3" json:"enable_disable_only,omitempty"`
+	// MaxMarketsPerBlock is the maximum number of markets that the authority can
+	// change in a single block. If zero, the number of markets is not limited.
+	MaxMarketsPerBlock uint64 `protobuf:"varint,5,opt,name=max_markets_per_block,json=maxMarketsPerBlock,proto3" json:"max_markets_per_block,omitempty"`
+}
+
+func (x *MarketAuthorityRole) Reset() {
+	*x = MarketAuthorityRole{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_marketmap_v1_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketAuthorityRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketAuthorityRole) ProtoMessage() {}
+
+// Deprecated: Use MarketAuthorityRole.ProtoReflect.Descriptor instead.
+func (*MarketAuthorityRole) Descriptor() ([]byte, []int) {
+	return file_slinky_marketmap_v1_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MarketAuthorityRole) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *MarketAuthorityRole) GetAllowedQuotes() []string {
+	if x != nil {
+		return x.AllowedQuotes
+	}
+	return nil
+}
+
+func (x *MarketAuthorityRole) GetAllowedProviders() []string {
+	if x != nil {
+		return x.AllowedProviders
+	}
+	return nil
+}
+
+func (x *MarketAuthorityRole) GetEnableDisableOnly() bool {
+	if x != nil {
+		return x.EnableDisableOnly
+	}
+	return false
+}
+
+func (x *MarketAuthorityRole) GetMaxMarketsPerBlock() uint64 {
+	if x != nil {
+		return x.MaxMarketsPerBlock
+	}
+	return 0
+}
+
+var File_slinky_marketmap_v1_params_proto protoreflect.FileDescriptor
+
+var file_slinky_marketmap_v1_params_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
+	0x61, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x13, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x01,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x64, 0x0a,
+	0x16, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x22, 0xe6, 0x01, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78,
+	0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0xc6, 0x01, 0x0a,
+	0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4d, 0x58, 0xaa,
+	0x02, 0x13, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
+	0x61, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x53, 0x6c,
+	0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15,
+	0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
+	0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_slinky_marketmap_v1_params_proto_rawDescOnce sync.Once
+	file_slinky_marketmap_v1_params_proto_rawDescData = file_slinky_marketmap_v1_params_proto_rawDesc
+)
+
+func file_slinky_marketmap_v1_params_proto_rawDescGZIP() []byte {
+	file_slinky_marketmap_v1_params_proto_rawDescOnce.Do(func() {
+		file_slinky_marketmap_v1_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_slinky_marketmap_v1_params_proto_rawDescData)
+	})
+	return file_slinky_marketmap_v1_params_proto_rawDescData
+}
+
+var file_slinky_marketmap_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_slinky_marketmap_v1_params_proto_goTypes = []interface{}{
+	(*Params)(nil),              // 0: slinky.marketmap.v1.Params
+	(*MarketAuthorityRole)(nil), // 1: slinky.marketmap.v1.MarketAuthorityRole
+}
+var file_slinky_marketmap_v1_params_proto_depIdxs = []int32{
+	1, // 0: slinky.marketmap.v1.Params.market_authority_roles:type_name -> slinky.marketmap.v1.MarketAuthorityRole
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_slinky_marketmap_v1_params_proto_init() }
+func file_slinky_marketmap_v1_params_proto_init() {
+	if File_slinky_marketmap_v1_params_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_slinky_marketmap_v1_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_marketmap_v1_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketAuthorityRole); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -693,7 +1732,7 @@ func file_slinky_marketmap_v1_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_slinky_marketmap_v1_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,10 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_MarketMap_FullMethodName   = "/slinky.marketmap.v1.Query/MarketMap"
-	Query_Market_FullMethodName      = "/slinky.marketmap.v1.Query/Market"
-	Query_LastUpdated_FullMethodName = "/slinky.marketmap.v1.Query/LastUpdated"
-	Query_Params_FullMethodName      = "/slinky.marketmap.v1.Query/Params"
+	Query_MarketMap_FullMethodName               = "/slinky.marketmap.v1.Query/MarketMap"
+	Query_Market_FullMethodName                  = "/slinky.marketmap.v1.Query/Market"
+	Query_LastUpdated_FullMethodName             = "/slinky.marketmap.v1.Query/LastUpdated"
+	Query_Params_FullMethodName                  = "/slinky.marketmap.v1.Query/Params"
+	Query_PendingMarketUpdates_FullMethodName    = "/slinky.marketmap.v1.Query/PendingMarketUpdates"
+	Query_SimulateMarketMapUpdate_FullMethodName = "/slinky.marketmap.v1.Query/SimulateMarketMapUpdate"
 )
 
 // QueryClient is the client API for Query service.
@@ -41,6 +43,13 @@ type QueryClient interface {
 	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// PendingMarketUpdates returns the market updates that are scheduled to be
+	// applied at a future height, ordered by height.
+	PendingMarketUpdates(ctx context.Context, in *PendingMarketUpdatesRequest, opts ...grpc.CallOption) (*PendingMarketUpdatesResponse, error)
+	// SimulateMarketMapUpdate returns the changes that upserting the given
+	// markets would make to the market map, and any errors that the update
+	// would fail with. The update is not committed to state.
+	SimulateMarketMapUpdate(ctx context.Context, in *SimulateMarketMapUpdateRequest, opts ...grpc.CallOption) (*SimulateMarketMapUpdateResponse, error)
 }
 
 type queryClient struct {
@@ -91,6 +100,26 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) PendingMarketUpdates(ctx context.Context, in *PendingMarketUpdatesRequest, opts ...grpc.CallOption) (*PendingMarketUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PendingMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingMarketUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateMarketMapUpdate(ctx context.Context, in *SimulateMarketMapUpdateRequest, opts ...grpc.CallOption) (*SimulateMarketMapUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateMarketMapUpdateResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateMarketMapUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -107,6 +136,13 @@ type QueryServer interface {
 	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// PendingMarketUpdates returns the market updates that are scheduled to be
+	// applied at a future height, ordered by height.
+	PendingMarketUpdates(context.Context, *PendingMarketUpdatesRequest) (*PendingMarketUpdatesResponse, error)
+	// SimulateMarketMapUpdate returns the changes that upserting the given
+	// markets would make to the market map, and any errors that the update
+	// would fail with. The update is not committed to state.
+	SimulateMarketMapUpdate(context.Context, *SimulateMarketMapUpdateRequest) (*SimulateMarketMapUpdateResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -129,6 +165,12 @@ func (UnimplementedQueryServer) LastUpdated(context.Context, *LastUpdatedRequest
 func (UnimplementedQueryServer) Params(context.Context, *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) PendingMarketUpdates(context.Context, *PendingMarketUpdatesRequest) (*PendingMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingMarketUpdates not implemented")
+}
+func (UnimplementedQueryServer) SimulateMarketMapUpdate(context.Context, *SimulateMarketMapUpdateRequest) (*SimulateMarketMapUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateMarketMapUpdate not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -222,6 +264,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingMarketUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingMarketUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingMarketUpdates(ctx, req.(*PendingMarketUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateMarketMapUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateMarketMapUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateMarketMapUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateMarketMapUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateMarketMapUpdate(ctx, req.(*SimulateMarketMapUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -245,6 +323,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PendingMarketUpdates",
+			Handler:    _Query_PendingMarketUpdates_Handler,
+		},
+		{
+			MethodName: "SimulateMarketMapUpdate",
+			Handler:    _Query_SimulateMarketMapUpdate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/marketmap/v1/query.proto",
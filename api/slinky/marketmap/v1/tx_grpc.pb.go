@@ -24,6 +24,9 @@ const (
 	Msg_UpdateParams_FullMethodName            = "/slinky.marketmap.v1.Msg/UpdateParams"
 	Msg_RemoveMarketAuthorities_FullMethodName = "/slinky.marketmap.v1.Msg/RemoveMarketAuthorities"
 	Msg_UpsertMarkets_FullMethodName           = "/slinky.marketmap.v1.Msg/UpsertMarkets"
+	Msg_RemoveMarkets_FullMethodName           = "/slinky.marketmap.v1.Msg/RemoveMarkets"
+	Msg_ScheduleMarketUpdates_FullMethodName   = "/slinky.marketmap.v1.Msg/ScheduleMarketUpdates"
+	Msg_CancelMarketUpdates_FullMethodName     = "/slinky.marketmap.v1.Msg/CancelMarketUpdates"
 )
 
 // MsgClient is the client API for Msg service.
@@ -46,6 +49,17 @@ type MsgClient interface {
 	// Specifically if a market does not exist it will be created, otherwise it
 	// will be updated. The response will be a map between ticker -> updated.
 	UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opts ...grpc.CallOption) (*MsgUpsertMarketsResponse, error)
+	// RemoveMarkets removes the given markets from the market map. A market
+	// cannot be removed while other markets still depend on it. The signer must
+	// be the admin or a market authority.
+	RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates schedules updates of existing markets that are
+	// applied at the beginning of a future block. The signer must be a market
+	// authority.
+	ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error)
+	// CancelMarketUpdates cancels market updates that are scheduled for a future
+	// block. The signer must be the admin.
+	CancelMarketUpdates(ctx context.Context, in *MsgCancelMarketUpdates, opts ...grpc.CallOption) (*MsgCancelMarketUpdatesResponse, error)
 }
 
 type msgClient struct {
@@ -106,6 +120,36 @@ func (c *msgClient) UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opt
 	return out, nil
 }
 
+func (c *msgClient) RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveMarketsResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveMarkets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgScheduleMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleMarketUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelMarketUpdates(ctx context.Context, in *MsgCancelMarketUpdates, opts ...grpc.CallOption) (*MsgCancelMarketUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelMarketUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -126,6 +170,17 @@ type MsgServer interface {
 	// Specifically if a market does not exist it will be created, otherwise it
 	// will be updated. The response will be a map between ticker -> updated.
 	UpsertMarkets(context.Context, *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error)
+	// RemoveMarkets removes the given markets from the market map. A market
+	// cannot be removed while other markets still depend on it. The signer must
+	// be the admin or a market authority.
+	RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates schedules updates of existing markets that are
+	// applied at the beginning of a future block. The signer must be a market
+	// authority.
+	ScheduleMarketUpdates(context.Context, *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error)
+	// CancelMarketUpdates cancels market updates that are scheduled for a future
+	// block. The signer must be the admin.
+	CancelMarketUpdates(context.Context, *MsgCancelMarketUpdates) (*MsgCancelMarketUpdatesResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -151,6 +206,15 @@ func (UnimplementedMsgServer) RemoveMarketAuthorities(context.Context, *MsgRemov
 func (UnimplementedMsgServer) UpsertMarkets(context.Context, *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertMarkets not implemented")
 }
+func (UnimplementedMsgServer) RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarkets not implemented")
+}
+func (UnimplementedMsgServer) ScheduleMarketUpdates(context.Context, *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMarketUpdates not implemented")
+}
+func (UnimplementedMsgServer) CancelMarketUpdates(context.Context, *MsgCancelMarketUpdates) (*MsgCancelMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMarketUpdates not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -262,6 +326,60 @@ func _Msg_UpsertMarkets_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RemoveMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveMarkets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMarkets(ctx, req.(*MsgRemoveMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMarketUpdates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleMarketUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, req.(*MsgScheduleMarketUpdates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMarketUpdates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelMarketUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMarketUpdates(ctx, req.(*MsgCancelMarketUpdates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +407,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertMarkets",
 			Handler:    _Msg_UpsertMarkets_Handler,
 		},
+		{
+			MethodName: "RemoveMarkets",
+			Handler:    _Msg_RemoveMarkets_Handler,
+		},
+		{
+			MethodName: "ScheduleMarketUpdates",
+			Handler:    _Msg_ScheduleMarketUpdates_Handler,
+		},
+		{
+			MethodName: "CancelMarketUpdates",
+			Handler:    _Msg_CancelMarketUpdates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/marketmap/v1/tx.proto",
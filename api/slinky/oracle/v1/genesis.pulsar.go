@@ -564,29 +564,27 @@ func (x *fastReflection_QuotePrice) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_CurrencyPairState       protoreflect.MessageDescriptor
-	fd_CurrencyPairState_price protoreflect.FieldDescriptor
-	fd_CurrencyPairState_nonce protoreflect.FieldDescriptor
-	fd_CurrencyPairState_id    protoreflect.FieldDescriptor
+	md_PriceHistoryEntry       protoreflect.MessageDescriptor
+	fd_PriceHistoryEntry_nonce protoreflect.FieldDescriptor
+	fd_PriceHistoryEntry_price protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_slinky_oracle_v1_genesis_proto_init()
-	md_CurrencyPairState = File_slinky_oracle_v1_genesis_proto.Messages().ByName("CurrencyPairState")
-	fd_CurrencyPairState_price = md_CurrencyPairState.Fields().ByName("price")
-	fd_CurrencyPairState_nonce = md_CurrencyPairState.Fields().ByName("nonce")
-	fd_CurrencyPairState_id = md_CurrencyPairState.Fields().ByName("id")
+	md_PriceHistoryEntry = File_slinky_oracle_v1_genesis_proto.Messages().ByName("PriceHistoryEntry")
+	fd_PriceHistoryEntry_nonce = md_PriceHistoryEntry.Fields().ByName("nonce")
+	fd_PriceHistoryEntry_price = md_PriceHistoryEntry.Fields().ByName("price")
 }
 
-var _ protoreflect.Message = (*fastReflection_CurrencyPairState)(nil)
+var _ protoreflect.Message = (*fastReflection_PriceHistoryEntry)(nil)
 
-type fastReflection_CurrencyPairState CurrencyPairState
+type fastReflection_PriceHistoryEntry PriceHistoryEntry
 
-func (x *CurrencyPairState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CurrencyPairState)(x)
+func (x *PriceHistoryEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceHistoryEntry)(x)
 }
 
-func (x *CurrencyPairState) slowProtoReflect() protoreflect.Message {
+func (x *PriceHistoryEntry) slowProtoReflect() protoreflect.Message {
 	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -598,43 +596,43 @@ func (x *CurrencyPairState) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CurrencyPairState_messageType fastReflection_CurrencyPairState_messageType
-var _ protoreflect.MessageType = fastReflection_CurrencyPairState_messageType{}
+var _fastReflection_PriceHistoryEntry_messageType fastReflection_PriceHistoryEntry_messageType
+var _ protoreflect.MessageType = fastReflection_PriceHistoryEntry_messageType{}
 
-type fastReflection_CurrencyPairState_messageType struct{}
+type fastReflection_PriceHistoryEntry_messageType struct{}
 
-func (x fastReflection_CurrencyPairState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CurrencyPairState)(nil)
+func (x fastReflection_PriceHistoryEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceHistoryEntry)(nil)
 }
-func (x fastReflection_CurrencyPairState_messageType) New() protoreflect.Message {
-	return new(fastReflection_CurrencyPairState)
+func (x fastReflection_PriceHistoryEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceHistoryEntry)
 }
-func (x fastReflection_CurrencyPairState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyPairState
+func (x fastReflection_PriceHistoryEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceHistoryEntry
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CurrencyPairState) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyPairState
+func (x *fastReflection_PriceHistoryEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceHistoryEntry
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CurrencyPairState) Type() protoreflect.MessageType {
-	return _fastReflection_CurrencyPairState_messageType
+func (x *fastReflection_PriceHistoryEntry) Type() protoreflect.MessageType {
+	return _fastReflection_PriceHistoryEntry_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CurrencyPairState) New() protoreflect.Message {
-	return new(fastReflection_CurrencyPairState)
+func (x *fastReflection_PriceHistoryEntry) New() protoreflect.Message {
+	return new(fastReflection_PriceHistoryEntry)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CurrencyPairState) Interface() protoreflect.ProtoMessage {
-	return (*CurrencyPairState)(x)
+func (x *fastReflection_PriceHistoryEntry) Interface() protoreflect.ProtoMessage {
+	return (*PriceHistoryEntry)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -642,22 +640,16 @@ func (x *fastReflection_CurrencyPairState) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CurrencyPairState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Price != nil {
-		value := protoreflect.ValueOfMessage(x.Price.ProtoReflect())
-		if !f(fd_CurrencyPairState_price, value) {
-			return
-		}
-	}
+func (x *fastReflection_PriceHistoryEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Nonce != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Nonce)
-		if !f(fd_CurrencyPairState_nonce, value) {
+		if !f(fd_PriceHistoryEntry_nonce, value) {
 			return
 		}
 	}
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_CurrencyPairState_id, value) {
+	if x.Price != nil {
+		value := protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+		if !f(fd_PriceHistoryEntry_price, value) {
 			return
 		}
 	}
@@ -674,19 +666,17 @@ func (x *fastReflection_CurrencyPairState) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CurrencyPairState) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PriceHistoryEntry) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairState.price":
-		return x.Price != nil
-	case "slinky.oracle.v1.CurrencyPairState.nonce":
+	case "slinky.oracle.v1.PriceHistoryEntry.nonce":
 		return x.Nonce != uint64(0)
-	case "slinky.oracle.v1.CurrencyPairState.id":
-		return x.Id != uint64(0)
+	case "slinky.oracle.v1.PriceHistoryEntry.price":
+		return x.Price != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.PriceHistoryEntry"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.PriceHistoryEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -696,19 +686,17 @@ func (x *fastReflection_CurrencyPairState) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairState) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PriceHistoryEntry) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairState.price":
-		x.Price = nil
-	case "slinky.oracle.v1.CurrencyPairState.nonce":
+	case "slinky.oracle.v1.PriceHistoryEntry.nonce":
 		x.Nonce = uint64(0)
-	case "slinky.oracle.v1.CurrencyPairState.id":
-		x.Id = uint64(0)
+	case "slinky.oracle.v1.PriceHistoryEntry.price":
+		x.Price = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.PriceHistoryEntry"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.PriceHistoryEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -718,22 +706,19 @@ func (x *fastReflection_CurrencyPairState) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CurrencyPairState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceHistoryEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "slinky.oracle.v1.CurrencyPairState.price":
-		value := x.Price
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairState.nonce":
+	case "slinky.oracle.v1.PriceHistoryEntry.nonce":
 		value := x.Nonce
 		return protoreflect.ValueOfUint64(value)
-	case "slinky.oracle.v1.CurrencyPairState.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.PriceHistoryEntry.price":
+		value := x.Price
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.PriceHistoryEntry"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.PriceHistoryEntry does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -747,19 +732,17 @@ func (x *fastReflection_CurrencyPairState) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PriceHistoryEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairState.price":
-		x.Price = value.Message().Interface().(*QuotePrice)
-	case "slinky.oracle.v1.CurrencyPairState.nonce":
+	case "slinky.oracle.v1.PriceHistoryEntry.nonce":
 		x.Nonce = value.Uint()
-	case "slinky.oracle.v1.CurrencyPairState.id":
-		x.Id = value.Uint()
+	case "slinky.oracle.v1.PriceHistoryEntry.price":
+		x.Price = value.Message().Interface().(*QuotePrice)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.PriceHistoryEntry"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.PriceHistoryEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -773,52 +756,48 @@ func (x *fastReflection_CurrencyPairState) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceHistoryEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairState.price":
+	case "slinky.oracle.v1.PriceHistoryEntry.price":
 		if x.Price == nil {
 			x.Price = new(QuotePrice)
 		}
 		return protoreflect.ValueOfMessage(x.Price.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairState.nonce":
-		panic(fmt.Errorf("field nonce of message slinky.oracle.v1.CurrencyPairState is not mutable"))
-	case "slinky.oracle.v1.CurrencyPairState.id":
-		panic(fmt.Errorf("field id of message slinky.oracle.v1.CurrencyPairState is not mutable"))
+	case "slinky.oracle.v1.PriceHistoryEntry.nonce":
+		panic(fmt.Errorf("field nonce of message slinky.oracle.v1.PriceHistoryEntry is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.PriceHistoryEntry"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.PriceHistoryEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CurrencyPairState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceHistoryEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairState.price":
+	case "slinky.oracle.v1.PriceHistoryEntry.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.PriceHistoryEntry.price":
 		m := new(QuotePrice)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairState.nonce":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "slinky.oracle.v1.CurrencyPairState.id":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.PriceHistoryEntry"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.PriceHistoryEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CurrencyPairState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PriceHistoryEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.CurrencyPairState", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.PriceHistoryEntry", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -826,7 +805,7 @@ func (x *fastReflection_CurrencyPairState) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CurrencyPairState) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PriceHistoryEntry) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -837,7 +816,7 @@ func (x *fastReflection_CurrencyPairState) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairState) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PriceHistoryEntry) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -849,7 +828,7 @@ func (x *fastReflection_CurrencyPairState) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CurrencyPairState) IsValid() bool {
+func (x *fastReflection_PriceHistoryEntry) IsValid() bool {
 	return x != nil
 }
 
@@ -859,9 +838,9 @@ func (x *fastReflection_CurrencyPairState) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PriceHistoryEntry) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CurrencyPairState)
+		x := input.Message.Interface().(*PriceHistoryEntry)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -873,15 +852,12 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Price != nil {
-			l = options.Size(x.Price)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.Nonce != 0 {
 			n += 1 + runtime.Sov(uint64(x.Nonce))
 		}
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
+		if x.Price != nil {
+			l = options.Size(x.Price)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -893,7 +869,7 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyPairState)
+		x := input.Message.Interface().(*PriceHistoryEntry)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -912,16 +888,6 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.Nonce != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
-			i--
-			dAtA[i] = 0x10
-		}
 		if x.Price != nil {
 			encoded, err := options.Marshal(x.Price)
 			if err != nil {
@@ -934,7 +900,12 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -947,7 +918,7 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyPairState)
+		x := input.Message.Interface().(*PriceHistoryEntry)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -979,13 +950,32 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairState: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceHistoryEntry: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairState: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 				}
@@ -1021,44 +1011,6 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-				}
-				x.Nonce = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Nonce |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				x.Id = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1095,31 +1047,39 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_CurrencyPairGenesis                     protoreflect.MessageDescriptor
-	fd_CurrencyPairGenesis_currency_pair       protoreflect.FieldDescriptor
-	fd_CurrencyPairGenesis_currency_pair_price protoreflect.FieldDescriptor
-	fd_CurrencyPairGenesis_nonce               protoreflect.FieldDescriptor
-	fd_CurrencyPairGenesis_id                  protoreflect.FieldDescriptor
+	md_CurrencyPairState                protoreflect.MessageDescriptor
+	fd_CurrencyPairState_price          protoreflect.FieldDescriptor
+	fd_CurrencyPairState_nonce          protoreflect.FieldDescriptor
+	fd_CurrencyPairState_id             protoreflect.FieldDescriptor
+	fd_CurrencyPairState_halted         protoreflect.FieldDescriptor
+	fd_CurrencyPairState_decimals       protoreflect.FieldDescriptor
+	fd_CurrencyPairState_missed_heights protoreflect.FieldDescriptor
+	fd_CurrencyPairState_rejected_price protoreflect.FieldDescriptor
+	fd_CurrencyPairState_rejected_count protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_slinky_oracle_v1_genesis_proto_init()
-	md_CurrencyPairGenesis = File_slinky_oracle_v1_genesis_proto.Messages().ByName("CurrencyPairGenesis")
-	fd_CurrencyPairGenesis_currency_pair = md_CurrencyPairGenesis.Fields().ByName("currency_pair")
-	fd_CurrencyPairGenesis_currency_pair_price = md_CurrencyPairGenesis.Fields().ByName("currency_pair_price")
-	fd_CurrencyPairGenesis_nonce = md_CurrencyPairGenesis.Fields().ByName("nonce")
-	fd_CurrencyPairGenesis_id = md_CurrencyPairGenesis.Fields().ByName("id")
+	md_CurrencyPairState = File_slinky_oracle_v1_genesis_proto.Messages().ByName("CurrencyPairState")
+	fd_CurrencyPairState_price = md_CurrencyPairState.Fields().ByName("price")
+	fd_CurrencyPairState_nonce = md_CurrencyPairState.Fields().ByName("nonce")
+	fd_CurrencyPairState_id = md_CurrencyPairState.Fields().ByName("id")
+	fd_CurrencyPairState_halted = md_CurrencyPairState.Fields().ByName("halted")
+	fd_CurrencyPairState_decimals = md_CurrencyPairState.Fields().ByName("decimals")
+	fd_CurrencyPairState_missed_heights = md_CurrencyPairState.Fields().ByName("missed_heights")
+	fd_CurrencyPairState_rejected_price = md_CurrencyPairState.Fields().ByName("rejected_price")
+	fd_CurrencyPairState_rejected_count = md_CurrencyPairState.Fields().ByName("rejected_count")
 }
 
-var _ protoreflect.Message = (*fastReflection_CurrencyPairGenesis)(nil)
+var _ protoreflect.Message = (*fastReflection_CurrencyPairState)(nil)
 
-type fastReflection_CurrencyPairGenesis CurrencyPairGenesis
+type fastReflection_CurrencyPairState CurrencyPairState
 
-func (x *CurrencyPairGenesis) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CurrencyPairGenesis)(x)
+func (x *CurrencyPairState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrencyPairState)(x)
 }
 
-func (x *CurrencyPairGenesis) slowProtoReflect() protoreflect.Message {
+func (x *CurrencyPairState) slowProtoReflect() protoreflect.Message {
 	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1131,43 +1091,43 @@ func (x *CurrencyPairGenesis) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CurrencyPairGenesis_messageType fastReflection_CurrencyPairGenesis_messageType
-var _ protoreflect.MessageType = fastReflection_CurrencyPairGenesis_messageType{}
+var _fastReflection_CurrencyPairState_messageType fastReflection_CurrencyPairState_messageType
+var _ protoreflect.MessageType = fastReflection_CurrencyPairState_messageType{}
 
-type fastReflection_CurrencyPairGenesis_messageType struct{}
+type fastReflection_CurrencyPairState_messageType struct{}
 
-func (x fastReflection_CurrencyPairGenesis_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CurrencyPairGenesis)(nil)
+func (x fastReflection_CurrencyPairState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrencyPairState)(nil)
 }
-func (x fastReflection_CurrencyPairGenesis_messageType) New() protoreflect.Message {
-	return new(fastReflection_CurrencyPairGenesis)
+func (x fastReflection_CurrencyPairState_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairState)
 }
-func (x fastReflection_CurrencyPairGenesis_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyPairGenesis
+func (x fastReflection_CurrencyPairState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairState
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CurrencyPairGenesis) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyPairGenesis
+func (x *fastReflection_CurrencyPairState) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairState
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CurrencyPairGenesis) Type() protoreflect.MessageType {
-	return _fastReflection_CurrencyPairGenesis_messageType
+func (x *fastReflection_CurrencyPairState) Type() protoreflect.MessageType {
+	return _fastReflection_CurrencyPairState_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CurrencyPairGenesis) New() protoreflect.Message {
-	return new(fastReflection_CurrencyPairGenesis)
+func (x *fastReflection_CurrencyPairState) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairState)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CurrencyPairGenesis) Interface() protoreflect.ProtoMessage {
-	return (*CurrencyPairGenesis)(x)
+func (x *fastReflection_CurrencyPairState) Interface() protoreflect.ProtoMessage {
+	return (*CurrencyPairState)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1175,28 +1135,52 @@ func (x *fastReflection_CurrencyPairGenesis) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CurrencyPairGenesis) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.CurrencyPair != nil {
-		value := protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
-		if !f(fd_CurrencyPairGenesis_currency_pair, value) {
-			return
-		}
-	}
-	if x.CurrencyPairPrice != nil {
-		value := protoreflect.ValueOfMessage(x.CurrencyPairPrice.ProtoReflect())
-		if !f(fd_CurrencyPairGenesis_currency_pair_price, value) {
+func (x *fastReflection_CurrencyPairState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Price != nil {
+		value := protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+		if !f(fd_CurrencyPairState_price, value) {
 			return
 		}
 	}
 	if x.Nonce != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Nonce)
-		if !f(fd_CurrencyPairGenesis_nonce, value) {
+		if !f(fd_CurrencyPairState_nonce, value) {
 			return
 		}
 	}
 	if x.Id != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_CurrencyPairGenesis_id, value) {
+		if !f(fd_CurrencyPairState_id, value) {
+			return
+		}
+	}
+	if x.Halted != false {
+		value := protoreflect.ValueOfBool(x.Halted)
+		if !f(fd_CurrencyPairState_halted, value) {
+			return
+		}
+	}
+	if x.Decimals != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Decimals)
+		if !f(fd_CurrencyPairState_decimals, value) {
+			return
+		}
+	}
+	if x.MissedHeights != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissedHeights)
+		if !f(fd_CurrencyPairState_missed_heights, value) {
+			return
+		}
+	}
+	if x.RejectedPrice != nil {
+		value := protoreflect.ValueOfMessage(x.RejectedPrice.ProtoReflect())
+		if !f(fd_CurrencyPairState_rejected_price, value) {
+			return
+		}
+	}
+	if x.RejectedCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RejectedCount)
+		if !f(fd_CurrencyPairState_rejected_count, value) {
 			return
 		}
 	}
@@ -1213,21 +1197,29 @@ func (x *fastReflection_CurrencyPairGenesis) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CurrencyPairGenesis) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CurrencyPairState) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
-		return x.CurrencyPair != nil
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
-		return x.CurrencyPairPrice != nil
-	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+	case "slinky.oracle.v1.CurrencyPairState.price":
+		return x.Price != nil
+	case "slinky.oracle.v1.CurrencyPairState.nonce":
 		return x.Nonce != uint64(0)
-	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+	case "slinky.oracle.v1.CurrencyPairState.id":
 		return x.Id != uint64(0)
+	case "slinky.oracle.v1.CurrencyPairState.halted":
+		return x.Halted != false
+	case "slinky.oracle.v1.CurrencyPairState.decimals":
+		return x.Decimals != uint64(0)
+	case "slinky.oracle.v1.CurrencyPairState.missed_heights":
+		return x.MissedHeights != uint64(0)
+	case "slinky.oracle.v1.CurrencyPairState.rejected_price":
+		return x.RejectedPrice != nil
+	case "slinky.oracle.v1.CurrencyPairState.rejected_count":
+		return x.RejectedCount != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1237,21 +1229,29 @@ func (x *fastReflection_CurrencyPairGenesis) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairGenesis) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CurrencyPairState) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
-		x.CurrencyPair = nil
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
-		x.CurrencyPairPrice = nil
-	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+	case "slinky.oracle.v1.CurrencyPairState.price":
+		x.Price = nil
+	case "slinky.oracle.v1.CurrencyPairState.nonce":
 		x.Nonce = uint64(0)
-	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+	case "slinky.oracle.v1.CurrencyPairState.id":
 		x.Id = uint64(0)
+	case "slinky.oracle.v1.CurrencyPairState.halted":
+		x.Halted = false
+	case "slinky.oracle.v1.CurrencyPairState.decimals":
+		x.Decimals = uint64(0)
+	case "slinky.oracle.v1.CurrencyPairState.missed_heights":
+		x.MissedHeights = uint64(0)
+	case "slinky.oracle.v1.CurrencyPairState.rejected_price":
+		x.RejectedPrice = nil
+	case "slinky.oracle.v1.CurrencyPairState.rejected_count":
+		x.RejectedCount = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1261,25 +1261,37 @@ func (x *fastReflection_CurrencyPairGenesis) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CurrencyPairGenesis) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CurrencyPairState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
-		value := x.CurrencyPair
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
-		value := x.CurrencyPairPrice
+	case "slinky.oracle.v1.CurrencyPairState.price":
+		value := x.Price
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+	case "slinky.oracle.v1.CurrencyPairState.nonce":
 		value := x.Nonce
 		return protoreflect.ValueOfUint64(value)
-	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+	case "slinky.oracle.v1.CurrencyPairState.id":
 		value := x.Id
 		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.CurrencyPairState.halted":
+		value := x.Halted
+		return protoreflect.ValueOfBool(value)
+	case "slinky.oracle.v1.CurrencyPairState.decimals":
+		value := x.Decimals
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.CurrencyPairState.missed_heights":
+		value := x.MissedHeights
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.CurrencyPairState.rejected_price":
+		value := x.RejectedPrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairState.rejected_count":
+		value := x.RejectedCount
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1293,21 +1305,29 @@ func (x *fastReflection_CurrencyPairGenesis) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairGenesis) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CurrencyPairState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
-		x.CurrencyPair = value.Message().Interface().(*v1.CurrencyPair)
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
-		x.CurrencyPairPrice = value.Message().Interface().(*QuotePrice)
-	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+	case "slinky.oracle.v1.CurrencyPairState.price":
+		x.Price = value.Message().Interface().(*QuotePrice)
+	case "slinky.oracle.v1.CurrencyPairState.nonce":
 		x.Nonce = value.Uint()
-	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+	case "slinky.oracle.v1.CurrencyPairState.id":
 		x.Id = value.Uint()
+	case "slinky.oracle.v1.CurrencyPairState.halted":
+		x.Halted = value.Bool()
+	case "slinky.oracle.v1.CurrencyPairState.decimals":
+		x.Decimals = value.Uint()
+	case "slinky.oracle.v1.CurrencyPairState.missed_heights":
+		x.MissedHeights = value.Uint()
+	case "slinky.oracle.v1.CurrencyPairState.rejected_price":
+		x.RejectedPrice = value.Message().Interface().(*QuotePrice)
+	case "slinky.oracle.v1.CurrencyPairState.rejected_count":
+		x.RejectedCount = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1321,60 +1341,76 @@ func (x *fastReflection_CurrencyPairGenesis) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairGenesis) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CurrencyPairState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
-		if x.CurrencyPair == nil {
-			x.CurrencyPair = new(v1.CurrencyPair)
+	case "slinky.oracle.v1.CurrencyPairState.price":
+		if x.Price == nil {
+			x.Price = new(QuotePrice)
 		}
-		return protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
-		if x.CurrencyPairPrice == nil {
-			x.CurrencyPairPrice = new(QuotePrice)
+		return protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairState.rejected_price":
+		if x.RejectedPrice == nil {
+			x.RejectedPrice = new(QuotePrice)
 		}
-		return protoreflect.ValueOfMessage(x.CurrencyPairPrice.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
-		panic(fmt.Errorf("field nonce of message slinky.oracle.v1.CurrencyPairGenesis is not mutable"))
-	case "slinky.oracle.v1.CurrencyPairGenesis.id":
-		panic(fmt.Errorf("field id of message slinky.oracle.v1.CurrencyPairGenesis is not mutable"))
+		return protoreflect.ValueOfMessage(x.RejectedPrice.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairState.nonce":
+		panic(fmt.Errorf("field nonce of message slinky.oracle.v1.CurrencyPairState is not mutable"))
+	case "slinky.oracle.v1.CurrencyPairState.id":
+		panic(fmt.Errorf("field id of message slinky.oracle.v1.CurrencyPairState is not mutable"))
+	case "slinky.oracle.v1.CurrencyPairState.halted":
+		panic(fmt.Errorf("field halted of message slinky.oracle.v1.CurrencyPairState is not mutable"))
+	case "slinky.oracle.v1.CurrencyPairState.decimals":
+		panic(fmt.Errorf("field decimals of message slinky.oracle.v1.CurrencyPairState is not mutable"))
+	case "slinky.oracle.v1.CurrencyPairState.missed_heights":
+		panic(fmt.Errorf("field missed_heights of message slinky.oracle.v1.CurrencyPairState is not mutable"))
+	case "slinky.oracle.v1.CurrencyPairState.rejected_count":
+		panic(fmt.Errorf("field rejected_count of message slinky.oracle.v1.CurrencyPairState is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CurrencyPairGenesis) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CurrencyPairState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
-		m := new(v1.CurrencyPair)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
+	case "slinky.oracle.v1.CurrencyPairState.price":
 		m := new(QuotePrice)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+	case "slinky.oracle.v1.CurrencyPairState.nonce":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+	case "slinky.oracle.v1.CurrencyPairState.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.CurrencyPairState.halted":
+		return protoreflect.ValueOfBool(false)
+	case "slinky.oracle.v1.CurrencyPairState.decimals":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.CurrencyPairState.missed_heights":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.CurrencyPairState.rejected_price":
+		m := new(QuotePrice)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairState.rejected_count":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CurrencyPairGenesis) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CurrencyPairState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.CurrencyPairGenesis", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.CurrencyPairState", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1382,7 +1418,7 @@ func (x *fastReflection_CurrencyPairGenesis) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CurrencyPairGenesis) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CurrencyPairState) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1393,7 +1429,7 @@ func (x *fastReflection_CurrencyPairGenesis) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairGenesis) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CurrencyPairState) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1405,7 +1441,7 @@ func (x *fastReflection_CurrencyPairGenesis) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CurrencyPairGenesis) IsValid() bool {
+func (x *fastReflection_CurrencyPairState) IsValid() bool {
 	return x != nil
 }
 
@@ -1415,9 +1451,9 @@ func (x *fastReflection_CurrencyPairGenesis) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CurrencyPairGenesis)
+		x := input.Message.Interface().(*CurrencyPairState)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1429,12 +1465,8 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.CurrencyPair != nil {
-			l = options.Size(x.CurrencyPair)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.CurrencyPairPrice != nil {
-			l = options.Size(x.CurrencyPairPrice)
+		if x.Price != nil {
+			l = options.Size(x.Price)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.Nonce != 0 {
@@ -1443,6 +1475,22 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 		if x.Id != 0 {
 			n += 1 + runtime.Sov(uint64(x.Id))
 		}
+		if x.Halted {
+			n += 2
+		}
+		if x.Decimals != 0 {
+			n += 1 + runtime.Sov(uint64(x.Decimals))
+		}
+		if x.MissedHeights != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissedHeights))
+		}
+		if x.RejectedPrice != nil {
+			l = options.Size(x.RejectedPrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RejectedCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.RejectedCount))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1453,7 +1501,7 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyPairGenesis)
+		x := input.Message.Interface().(*CurrencyPairState)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1472,18 +1520,13 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.Nonce != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+		if x.RejectedCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RejectedCount))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x40
 		}
-		if x.CurrencyPairPrice != nil {
-			encoded, err := options.Marshal(x.CurrencyPairPrice)
+		if x.RejectedPrice != nil {
+			encoded, err := options.Marshal(x.RejectedPrice)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1494,10 +1537,40 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x3a
 		}
-		if x.CurrencyPair != nil {
-			encoded, err := options.Marshal(x.CurrencyPair)
+		if x.MissedHeights != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedHeights))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Decimals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Halted {
+			i--
+			if x.Halted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Price != nil {
+			encoded, err := options.Marshal(x.Price)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1521,7 +1594,7 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyPairGenesis)
+		x := input.Message.Interface().(*CurrencyPairState)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1553,15 +1626,15 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairGenesis: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairState: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairGenesis: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairState: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1588,18 +1661,18 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.CurrencyPair == nil {
-					x.CurrencyPair = &v1.CurrencyPair{}
+				if x.Price == nil {
+					x.Price = &QuotePrice{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPair); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Price); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairPrice", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 				}
-				var msglen int
+				x.Nonce = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1609,33 +1682,16 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Nonce |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.CurrencyPairPrice == nil {
-					x.CurrencyPairPrice = &QuotePrice{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPairPrice); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				x.Nonce = 0
+				x.Id = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1645,16 +1701,16 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Nonce |= uint64(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
 				}
-				x.Id = 0
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1664,16 +1720,110 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Halted = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				}
+				x.Decimals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Decimals |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedHeights", wireType)
+				}
+				x.MissedHeights = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissedHeights |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectedPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RejectedPrice == nil {
+					x.RejectedPrice = &QuotePrice{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RejectedPrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectedCount", wireType)
+				}
+				x.RejectedCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RejectedCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
@@ -1704,79 +1854,91 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_GenesisState_1_list)(nil)
+var _ protoreflect.List = (*_ValidatorPriceStats_8_list)(nil)
 
-type _GenesisState_1_list struct {
-	list *[]*CurrencyPairGenesis
+type _ValidatorPriceStats_8_list struct {
+	list *[]*ValidatorPriceStatsBucket
 }
 
-func (x *_GenesisState_1_list) Len() int {
+func (x *_ValidatorPriceStats_8_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_GenesisState_1_list) Get(i int) protoreflect.Value {
+func (x *_ValidatorPriceStats_8_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_GenesisState_1_list) Set(i int, value protoreflect.Value) {
+func (x *_ValidatorPriceStats_8_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*CurrencyPairGenesis)
+	concreteValue := valueUnwrapped.Interface().(*ValidatorPriceStatsBucket)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_GenesisState_1_list) Append(value protoreflect.Value) {
+func (x *_ValidatorPriceStats_8_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*CurrencyPairGenesis)
+	concreteValue := valueUnwrapped.Interface().(*ValidatorPriceStatsBucket)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_GenesisState_1_list) AppendMutable() protoreflect.Value {
-	v := new(CurrencyPairGenesis)
+func (x *_ValidatorPriceStats_8_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorPriceStatsBucket)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_GenesisState_1_list) Truncate(n int) {
+func (x *_ValidatorPriceStats_8_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_GenesisState_1_list) NewElement() protoreflect.Value {
-	v := new(CurrencyPairGenesis)
+func (x *_ValidatorPriceStats_8_list) NewElement() protoreflect.Value {
+	v := new(ValidatorPriceStatsBucket)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_GenesisState_1_list) IsValid() bool {
+func (x *_ValidatorPriceStats_8_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_GenesisState                       protoreflect.MessageDescriptor
-	fd_GenesisState_currency_pair_genesis protoreflect.FieldDescriptor
-	fd_GenesisState_next_id               protoreflect.FieldDescriptor
+	md_ValidatorPriceStats                 protoreflect.MessageDescriptor
+	fd_ValidatorPriceStats_cons_address    protoreflect.FieldDescriptor
+	fd_ValidatorPriceStats_start_height    protoreflect.FieldDescriptor
+	fd_ValidatorPriceStats_report_count    protoreflect.FieldDescriptor
+	fd_ValidatorPriceStats_miss_count      protoreflect.FieldDescriptor
+	fd_ValidatorPriceStats_deviation_count protoreflect.FieldDescriptor
+	fd_ValidatorPriceStats_last_height     protoreflect.FieldDescriptor
+	fd_ValidatorPriceStats_bucket_heights  protoreflect.FieldDescriptor
+	fd_ValidatorPriceStats_buckets         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_slinky_oracle_v1_genesis_proto_init()
-	md_GenesisState = File_slinky_oracle_v1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_currency_pair_genesis = md_GenesisState.Fields().ByName("currency_pair_genesis")
-	fd_GenesisState_next_id = md_GenesisState.Fields().ByName("next_id")
+	md_ValidatorPriceStats = File_slinky_oracle_v1_genesis_proto.Messages().ByName("ValidatorPriceStats")
+	fd_ValidatorPriceStats_cons_address = md_ValidatorPriceStats.Fields().ByName("cons_address")
+	fd_ValidatorPriceStats_start_height = md_ValidatorPriceStats.Fields().ByName("start_height")
+	fd_ValidatorPriceStats_report_count = md_ValidatorPriceStats.Fields().ByName("report_count")
+	fd_ValidatorPriceStats_miss_count = md_ValidatorPriceStats.Fields().ByName("miss_count")
+	fd_ValidatorPriceStats_deviation_count = md_ValidatorPriceStats.Fields().ByName("deviation_count")
+	fd_ValidatorPriceStats_last_height = md_ValidatorPriceStats.Fields().ByName("last_height")
+	fd_ValidatorPriceStats_bucket_heights = md_ValidatorPriceStats.Fields().ByName("bucket_heights")
+	fd_ValidatorPriceStats_buckets = md_ValidatorPriceStats.Fields().ByName("buckets")
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorPriceStats)(nil)
 
-type fastReflection_GenesisState GenesisState
+type fastReflection_ValidatorPriceStats ValidatorPriceStats
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *ValidatorPriceStats) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorPriceStats)(x)
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorPriceStats) slowProtoReflect() protoreflect.Message {
 	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1788,43 +1950,43 @@ func (x *GenesisState) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+var _fastReflection_ValidatorPriceStats_messageType fastReflection_ValidatorPriceStats_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorPriceStats_messageType{}
 
-type fastReflection_GenesisState_messageType struct{}
+type fastReflection_ValidatorPriceStats_messageType struct{}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x fastReflection_ValidatorPriceStats_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorPriceStats)(nil)
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x fastReflection_ValidatorPriceStats_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPriceStats)
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x fastReflection_ValidatorPriceStats_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPriceStats
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *fastReflection_ValidatorPriceStats) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPriceStats
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *fastReflection_ValidatorPriceStats) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorPriceStats_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *fastReflection_ValidatorPriceStats) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPriceStats)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *fastReflection_ValidatorPriceStats) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorPriceStats)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1832,16 +1994,52 @@ func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.CurrencyPairGenesis) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_1_list{list: &x.CurrencyPairGenesis})
-		if !f(fd_GenesisState_currency_pair_genesis, value) {
+func (x *fastReflection_ValidatorPriceStats) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ConsAddress != "" {
+		value := protoreflect.ValueOfString(x.ConsAddress)
+		if !f(fd_ValidatorPriceStats_cons_address, value) {
 			return
 		}
 	}
-	if x.NextId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.NextId)
-		if !f(fd_GenesisState_next_id, value) {
+	if x.StartHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartHeight)
+		if !f(fd_ValidatorPriceStats_start_height, value) {
+			return
+		}
+	}
+	if x.ReportCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReportCount)
+		if !f(fd_ValidatorPriceStats_report_count, value) {
+			return
+		}
+	}
+	if x.MissCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissCount)
+		if !f(fd_ValidatorPriceStats_miss_count, value) {
+			return
+		}
+	}
+	if x.DeviationCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DeviationCount)
+		if !f(fd_ValidatorPriceStats_deviation_count, value) {
+			return
+		}
+	}
+	if x.LastHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastHeight)
+		if !f(fd_ValidatorPriceStats_last_height, value) {
+			return
+		}
+	}
+	if x.BucketHeights != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BucketHeights)
+		if !f(fd_ValidatorPriceStats_bucket_heights, value) {
+			return
+		}
+	}
+	if len(x.Buckets) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorPriceStats_8_list{list: &x.Buckets})
+		if !f(fd_ValidatorPriceStats_buckets, value) {
 			return
 		}
 	}
@@ -1858,17 +2056,29 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorPriceStats) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.GenesisState.currency_pair_genesis":
-		return len(x.CurrencyPairGenesis) != 0
-	case "slinky.oracle.v1.GenesisState.next_id":
-		return x.NextId != uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStats.cons_address":
+		return x.ConsAddress != ""
+	case "slinky.oracle.v1.ValidatorPriceStats.start_height":
+		return x.StartHeight != uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStats.report_count":
+		return x.ReportCount != uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStats.miss_count":
+		return x.MissCount != uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStats.deviation_count":
+		return x.DeviationCount != uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStats.last_height":
+		return x.LastHeight != uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStats.bucket_heights":
+		return x.BucketHeights != uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStats.buckets":
+		return len(x.Buckets) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPriceStats"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPriceStats does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1878,17 +2088,29 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorPriceStats) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.GenesisState.currency_pair_genesis":
-		x.CurrencyPairGenesis = nil
-	case "slinky.oracle.v1.GenesisState.next_id":
-		x.NextId = uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStats.cons_address":
+		x.ConsAddress = ""
+	case "slinky.oracle.v1.ValidatorPriceStats.start_height":
+		x.StartHeight = uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStats.report_count":
+		x.ReportCount = uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStats.miss_count":
+		x.MissCount = uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStats.deviation_count":
+		x.DeviationCount = uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStats.last_height":
+		x.LastHeight = uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStats.bucket_heights":
+		x.BucketHeights = uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStats.buckets":
+		x.Buckets = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPriceStats"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPriceStats does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1898,22 +2120,40 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorPriceStats) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "slinky.oracle.v1.GenesisState.currency_pair_genesis":
-		if len(x.CurrencyPairGenesis) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_1_list{})
+	case "slinky.oracle.v1.ValidatorPriceStats.cons_address":
+		value := x.ConsAddress
+		return protoreflect.ValueOfString(value)
+	case "slinky.oracle.v1.ValidatorPriceStats.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorPriceStats.report_count":
+		value := x.ReportCount
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorPriceStats.miss_count":
+		value := x.MissCount
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorPriceStats.deviation_count":
+		value := x.DeviationCount
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorPriceStats.last_height":
+		value := x.LastHeight
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorPriceStats.bucket_heights":
+		value := x.BucketHeights
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorPriceStats.buckets":
+		if len(x.Buckets) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorPriceStats_8_list{})
 		}
-		listValue := &_GenesisState_1_list{list: &x.CurrencyPairGenesis}
+		listValue := &_ValidatorPriceStats_8_list{list: &x.Buckets}
 		return protoreflect.ValueOfList(listValue)
-	case "slinky.oracle.v1.GenesisState.next_id":
-		value := x.NextId
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPriceStats"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.GenesisState does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPriceStats does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1927,19 +2167,31 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorPriceStats) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.GenesisState.currency_pair_genesis":
+	case "slinky.oracle.v1.ValidatorPriceStats.cons_address":
+		x.ConsAddress = value.Interface().(string)
+	case "slinky.oracle.v1.ValidatorPriceStats.start_height":
+		x.StartHeight = value.Uint()
+	case "slinky.oracle.v1.ValidatorPriceStats.report_count":
+		x.ReportCount = value.Uint()
+	case "slinky.oracle.v1.ValidatorPriceStats.miss_count":
+		x.MissCount = value.Uint()
+	case "slinky.oracle.v1.ValidatorPriceStats.deviation_count":
+		x.DeviationCount = value.Uint()
+	case "slinky.oracle.v1.ValidatorPriceStats.last_height":
+		x.LastHeight = value.Uint()
+	case "slinky.oracle.v1.ValidatorPriceStats.bucket_heights":
+		x.BucketHeights = value.Uint()
+	case "slinky.oracle.v1.ValidatorPriceStats.buckets":
 		lv := value.List()
-		clv := lv.(*_GenesisState_1_list)
-		x.CurrencyPairGenesis = *clv.list
-	case "slinky.oracle.v1.GenesisState.next_id":
-		x.NextId = value.Uint()
+		clv := lv.(*_ValidatorPriceStats_8_list)
+		x.Buckets = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPriceStats"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPriceStats does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1953,49 +2205,73 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorPriceStats) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.GenesisState.currency_pair_genesis":
-		if x.CurrencyPairGenesis == nil {
-			x.CurrencyPairGenesis = []*CurrencyPairGenesis{}
+	case "slinky.oracle.v1.ValidatorPriceStats.buckets":
+		if x.Buckets == nil {
+			x.Buckets = []*ValidatorPriceStatsBucket{}
 		}
-		value := &_GenesisState_1_list{list: &x.CurrencyPairGenesis}
+		value := &_ValidatorPriceStats_8_list{list: &x.Buckets}
 		return protoreflect.ValueOfList(value)
-	case "slinky.oracle.v1.GenesisState.next_id":
-		panic(fmt.Errorf("field next_id of message slinky.oracle.v1.GenesisState is not mutable"))
+	case "slinky.oracle.v1.ValidatorPriceStats.cons_address":
+		panic(fmt.Errorf("field cons_address of message slinky.oracle.v1.ValidatorPriceStats is not mutable"))
+	case "slinky.oracle.v1.ValidatorPriceStats.start_height":
+		panic(fmt.Errorf("field start_height of message slinky.oracle.v1.ValidatorPriceStats is not mutable"))
+	case "slinky.oracle.v1.ValidatorPriceStats.report_count":
+		panic(fmt.Errorf("field report_count of message slinky.oracle.v1.ValidatorPriceStats is not mutable"))
+	case "slinky.oracle.v1.ValidatorPriceStats.miss_count":
+		panic(fmt.Errorf("field miss_count of message slinky.oracle.v1.ValidatorPriceStats is not mutable"))
+	case "slinky.oracle.v1.ValidatorPriceStats.deviation_count":
+		panic(fmt.Errorf("field deviation_count of message slinky.oracle.v1.ValidatorPriceStats is not mutable"))
+	case "slinky.oracle.v1.ValidatorPriceStats.last_height":
+		panic(fmt.Errorf("field last_height of message slinky.oracle.v1.ValidatorPriceStats is not mutable"))
+	case "slinky.oracle.v1.ValidatorPriceStats.bucket_heights":
+		panic(fmt.Errorf("field bucket_heights of message slinky.oracle.v1.ValidatorPriceStats is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPriceStats"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPriceStats does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorPriceStats) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.GenesisState.currency_pair_genesis":
-		list := []*CurrencyPairGenesis{}
-		return protoreflect.ValueOfList(&_GenesisState_1_list{list: &list})
-	case "slinky.oracle.v1.GenesisState.next_id":
+	case "slinky.oracle.v1.ValidatorPriceStats.cons_address":
+		return protoreflect.ValueOfString("")
+	case "slinky.oracle.v1.ValidatorPriceStats.start_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorPriceStats.report_count":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorPriceStats.miss_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorPriceStats.deviation_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorPriceStats.last_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorPriceStats.bucket_heights":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorPriceStats.buckets":
+		list := []*ValidatorPriceStatsBucket{}
+		return protoreflect.ValueOfList(&_ValidatorPriceStats_8_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPriceStats"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPriceStats does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorPriceStats) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.GenesisState", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.ValidatorPriceStats", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2003,7 +2279,7 @@ func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorPriceStats) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2014,7 +2290,7 @@ func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorPriceStats) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2026,7 +2302,7 @@ func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
+func (x *fastReflection_ValidatorPriceStats) IsValid() bool {
 	return x != nil
 }
 
@@ -2036,9 +2312,9 @@ func (x *fastReflection_GenesisState) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorPriceStats) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*ValidatorPriceStats)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2050,15 +2326,34 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.CurrencyPairGenesis) > 0 {
-			for _, e := range x.CurrencyPairGenesis {
+		l = len(x.ConsAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.ReportCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReportCount))
+		}
+		if x.MissCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissCount))
+		}
+		if x.DeviationCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeviationCount))
+		}
+		if x.LastHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastHeight))
+		}
+		if x.BucketHeights != 0 {
+			n += 1 + runtime.Sov(uint64(x.BucketHeights))
+		}
+		if len(x.Buckets) > 0 {
+			for _, e := range x.Buckets {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.NextId != 0 {
-			n += 1 + runtime.Sov(uint64(x.NextId))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2069,7 +2364,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*ValidatorPriceStats)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2088,14 +2383,9 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.NextId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextId))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.CurrencyPairGenesis) > 0 {
-			for iNdEx := len(x.CurrencyPairGenesis) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.CurrencyPairGenesis[iNdEx])
+		if len(x.Buckets) > 0 {
+			for iNdEx := len(x.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Buckets[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2106,9 +2396,46 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x42
 			}
 		}
+		if x.BucketHeights != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BucketHeights))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.LastHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.DeviationCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeviationCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MissCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ReportCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReportCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ConsAddress) > 0 {
+			i -= len(x.ConsAddress)
+			copy(dAtA[i:], x.ConsAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2120,7 +2447,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*ValidatorPriceStats)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2152,17 +2479,17 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPriceStats: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPriceStats: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairGenesis", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2172,31 +2499,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CurrencyPairGenesis = append(x.CurrencyPairGenesis, &CurrencyPairGenesis{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPairGenesis[len(x.CurrencyPairGenesis)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.ConsAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 				}
-				x.NextId = 0
+				x.StartHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2206,11 +2531,140 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.NextId |= uint64(b&0x7F) << shift
+					x.StartHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportCount", wireType)
+				}
+				x.ReportCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReportCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
+				}
+				x.MissCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeviationCount", wireType)
+				}
+				x.DeviationCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeviationCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+				}
+				x.LastHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BucketHeights", wireType)
+				}
+				x.BucketHeights = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BucketHeights |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Buckets = append(x.Buckets, &ValidatorPriceStatsBucket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Buckets[len(x.Buckets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2235,140 +2689,3658 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		}
 		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ValidatorPriceStatsBucket                 protoreflect.MessageDescriptor
+	fd_ValidatorPriceStatsBucket_report_count    protoreflect.FieldDescriptor
+	fd_ValidatorPriceStatsBucket_miss_count      protoreflect.FieldDescriptor
+	fd_ValidatorPriceStatsBucket_deviation_count protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_oracle_v1_genesis_proto_init()
+	md_ValidatorPriceStatsBucket = File_slinky_oracle_v1_genesis_proto.Messages().ByName("ValidatorPriceStatsBucket")
+	fd_ValidatorPriceStatsBucket_report_count = md_ValidatorPriceStatsBucket.Fields().ByName("report_count")
+	fd_ValidatorPriceStatsBucket_miss_count = md_ValidatorPriceStatsBucket.Fields().ByName("miss_count")
+	fd_ValidatorPriceStatsBucket_deviation_count = md_ValidatorPriceStatsBucket.Fields().ByName("deviation_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorPriceStatsBucket)(nil)
+
+type fastReflection_ValidatorPriceStatsBucket ValidatorPriceStatsBucket
+
+func (x *ValidatorPriceStatsBucket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorPriceStatsBucket)(x)
+}
+
+func (x *ValidatorPriceStatsBucket) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorPriceStatsBucket_messageType fastReflection_ValidatorPriceStatsBucket_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorPriceStatsBucket_messageType{}
+
+type fastReflection_ValidatorPriceStatsBucket_messageType struct{}
+
+func (x fastReflection_ValidatorPriceStatsBucket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorPriceStatsBucket)(nil)
+}
+func (x fastReflection_ValidatorPriceStatsBucket_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPriceStatsBucket)
+}
+func (x fastReflection_ValidatorPriceStatsBucket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPriceStatsBucket
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorPriceStatsBucket) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPriceStatsBucket
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorPriceStatsBucket) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorPriceStatsBucket_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorPriceStatsBucket) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPriceStatsBucket)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorPriceStatsBucket) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorPriceStatsBucket)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorPriceStatsBucket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ReportCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReportCount)
+		if !f(fd_ValidatorPriceStatsBucket_report_count, value) {
+			return
+		}
+	}
+	if x.MissCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissCount)
+		if !f(fd_ValidatorPriceStatsBucket_miss_count, value) {
+			return
+		}
+	}
+	if x.DeviationCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DeviationCount)
+		if !f(fd_ValidatorPriceStatsBucket_deviation_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorPriceStatsBucket) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.report_count":
+		return x.ReportCount != uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.miss_count":
+		return x.MissCount != uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.deviation_count":
+		return x.DeviationCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPriceStatsBucket"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPriceStatsBucket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPriceStatsBucket) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.report_count":
+		x.ReportCount = uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.miss_count":
+		x.MissCount = uint64(0)
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.deviation_count":
+		x.DeviationCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPriceStatsBucket"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPriceStatsBucket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorPriceStatsBucket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.report_count":
+		value := x.ReportCount
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.miss_count":
+		value := x.MissCount
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.deviation_count":
+		value := x.DeviationCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPriceStatsBucket"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPriceStatsBucket does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPriceStatsBucket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.report_count":
+		x.ReportCount = value.Uint()
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.miss_count":
+		x.MissCount = value.Uint()
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.deviation_count":
+		x.DeviationCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPriceStatsBucket"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPriceStatsBucket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPriceStatsBucket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.report_count":
+		panic(fmt.Errorf("field report_count of message slinky.oracle.v1.ValidatorPriceStatsBucket is not mutable"))
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.miss_count":
+		panic(fmt.Errorf("field miss_count of message slinky.oracle.v1.ValidatorPriceStatsBucket is not mutable"))
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.deviation_count":
+		panic(fmt.Errorf("field deviation_count of message slinky.oracle.v1.ValidatorPriceStatsBucket is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPriceStatsBucket"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPriceStatsBucket does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorPriceStatsBucket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.report_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.miss_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorPriceStatsBucket.deviation_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPriceStatsBucket"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPriceStatsBucket does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorPriceStatsBucket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.ValidatorPriceStatsBucket", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorPriceStatsBucket) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPriceStatsBucket) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorPriceStatsBucket) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorPriceStatsBucket) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorPriceStatsBucket)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ReportCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReportCount))
+		}
+		if x.MissCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissCount))
+		}
+		if x.DeviationCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeviationCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorPriceStatsBucket)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DeviationCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeviationCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MissCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissCount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ReportCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReportCount))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorPriceStatsBucket)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPriceStatsBucket: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPriceStatsBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportCount", wireType)
+				}
+				x.ReportCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReportCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
+				}
+				x.MissCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeviationCount", wireType)
+				}
+				x.DeviationCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeviationCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ValidatorOracleStats                      protoreflect.MessageDescriptor
+	fd_ValidatorOracleStats_cons_address         protoreflect.FieldDescriptor
+	fd_ValidatorOracleStats_start_height         protoreflect.FieldDescriptor
+	fd_ValidatorOracleStats_last_height          protoreflect.FieldDescriptor
+	fd_ValidatorOracleStats_index_offset         protoreflect.FieldDescriptor
+	fd_ValidatorOracleStats_participation_bitmap protoreflect.FieldDescriptor
+	fd_ValidatorOracleStats_participated_count   protoreflect.FieldDescriptor
+	fd_ValidatorOracleStats_missed_count         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_oracle_v1_genesis_proto_init()
+	md_ValidatorOracleStats = File_slinky_oracle_v1_genesis_proto.Messages().ByName("ValidatorOracleStats")
+	fd_ValidatorOracleStats_cons_address = md_ValidatorOracleStats.Fields().ByName("cons_address")
+	fd_ValidatorOracleStats_start_height = md_ValidatorOracleStats.Fields().ByName("start_height")
+	fd_ValidatorOracleStats_last_height = md_ValidatorOracleStats.Fields().ByName("last_height")
+	fd_ValidatorOracleStats_index_offset = md_ValidatorOracleStats.Fields().ByName("index_offset")
+	fd_ValidatorOracleStats_participation_bitmap = md_ValidatorOracleStats.Fields().ByName("participation_bitmap")
+	fd_ValidatorOracleStats_participated_count = md_ValidatorOracleStats.Fields().ByName("participated_count")
+	fd_ValidatorOracleStats_missed_count = md_ValidatorOracleStats.Fields().ByName("missed_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorOracleStats)(nil)
+
+type fastReflection_ValidatorOracleStats ValidatorOracleStats
+
+func (x *ValidatorOracleStats) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorOracleStats)(x)
+}
+
+func (x *ValidatorOracleStats) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorOracleStats_messageType fastReflection_ValidatorOracleStats_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorOracleStats_messageType{}
+
+type fastReflection_ValidatorOracleStats_messageType struct{}
+
+func (x fastReflection_ValidatorOracleStats_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorOracleStats)(nil)
+}
+func (x fastReflection_ValidatorOracleStats_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorOracleStats)
+}
+func (x fastReflection_ValidatorOracleStats_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorOracleStats
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorOracleStats) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorOracleStats
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorOracleStats) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorOracleStats_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorOracleStats) New() protoreflect.Message {
+	return new(fastReflection_ValidatorOracleStats)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorOracleStats) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorOracleStats)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorOracleStats) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ConsAddress != "" {
+		value := protoreflect.ValueOfString(x.ConsAddress)
+		if !f(fd_ValidatorOracleStats_cons_address, value) {
+			return
+		}
+	}
+	if x.StartHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartHeight)
+		if !f(fd_ValidatorOracleStats_start_height, value) {
+			return
+		}
+	}
+	if x.LastHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastHeight)
+		if !f(fd_ValidatorOracleStats_last_height, value) {
+			return
+		}
+	}
+	if x.IndexOffset != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IndexOffset)
+		if !f(fd_ValidatorOracleStats_index_offset, value) {
+			return
+		}
+	}
+	if len(x.ParticipationBitmap) != 0 {
+		value := protoreflect.ValueOfBytes(x.ParticipationBitmap)
+		if !f(fd_ValidatorOracleStats_participation_bitmap, value) {
+			return
+		}
+	}
+	if x.ParticipatedCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParticipatedCount)
+		if !f(fd_ValidatorOracleStats_participated_count, value) {
+			return
+		}
+	}
+	if x.MissedCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissedCount)
+		if !f(fd_ValidatorOracleStats_missed_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorOracleStats) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorOracleStats.cons_address":
+		return x.ConsAddress != ""
+	case "slinky.oracle.v1.ValidatorOracleStats.start_height":
+		return x.StartHeight != uint64(0)
+	case "slinky.oracle.v1.ValidatorOracleStats.last_height":
+		return x.LastHeight != uint64(0)
+	case "slinky.oracle.v1.ValidatorOracleStats.index_offset":
+		return x.IndexOffset != uint64(0)
+	case "slinky.oracle.v1.ValidatorOracleStats.participation_bitmap":
+		return len(x.ParticipationBitmap) != 0
+	case "slinky.oracle.v1.ValidatorOracleStats.participated_count":
+		return x.ParticipatedCount != uint64(0)
+	case "slinky.oracle.v1.ValidatorOracleStats.missed_count":
+		return x.MissedCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorOracleStats"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorOracleStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorOracleStats) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorOracleStats.cons_address":
+		x.ConsAddress = ""
+	case "slinky.oracle.v1.ValidatorOracleStats.start_height":
+		x.StartHeight = uint64(0)
+	case "slinky.oracle.v1.ValidatorOracleStats.last_height":
+		x.LastHeight = uint64(0)
+	case "slinky.oracle.v1.ValidatorOracleStats.index_offset":
+		x.IndexOffset = uint64(0)
+	case "slinky.oracle.v1.ValidatorOracleStats.participation_bitmap":
+		x.ParticipationBitmap = nil
+	case "slinky.oracle.v1.ValidatorOracleStats.participated_count":
+		x.ParticipatedCount = uint64(0)
+	case "slinky.oracle.v1.ValidatorOracleStats.missed_count":
+		x.MissedCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorOracleStats"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorOracleStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorOracleStats) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.oracle.v1.ValidatorOracleStats.cons_address":
+		value := x.ConsAddress
+		return protoreflect.ValueOfString(value)
+	case "slinky.oracle.v1.ValidatorOracleStats.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorOracleStats.last_height":
+		value := x.LastHeight
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorOracleStats.index_offset":
+		value := x.IndexOffset
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorOracleStats.participation_bitmap":
+		value := x.ParticipationBitmap
+		return protoreflect.ValueOfBytes(value)
+	case "slinky.oracle.v1.ValidatorOracleStats.participated_count":
+		value := x.ParticipatedCount
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorOracleStats.missed_count":
+		value := x.MissedCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorOracleStats"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorOracleStats does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorOracleStats) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorOracleStats.cons_address":
+		x.ConsAddress = value.Interface().(string)
+	case "slinky.oracle.v1.ValidatorOracleStats.start_height":
+		x.StartHeight = value.Uint()
+	case "slinky.oracle.v1.ValidatorOracleStats.last_height":
+		x.LastHeight = value.Uint()
+	case "slinky.oracle.v1.ValidatorOracleStats.index_offset":
+		x.IndexOffset = value.Uint()
+	case "slinky.oracle.v1.ValidatorOracleStats.participation_bitmap":
+		x.ParticipationBitmap = value.Bytes()
+	case "slinky.oracle.v1.ValidatorOracleStats.participated_count":
+		x.ParticipatedCount = value.Uint()
+	case "slinky.oracle.v1.ValidatorOracleStats.missed_count":
+		x.MissedCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorOracleStats"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorOracleStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorOracleStats) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorOracleStats.cons_address":
+		panic(fmt.Errorf("field cons_address of message slinky.oracle.v1.ValidatorOracleStats is not mutable"))
+	case "slinky.oracle.v1.ValidatorOracleStats.start_height":
+		panic(fmt.Errorf("field start_height of message slinky.oracle.v1.ValidatorOracleStats is not mutable"))
+	case "slinky.oracle.v1.ValidatorOracleStats.last_height":
+		panic(fmt.Errorf("field last_height of message slinky.oracle.v1.ValidatorOracleStats is not mutable"))
+	case "slinky.oracle.v1.ValidatorOracleStats.index_offset":
+		panic(fmt.Errorf("field index_offset of message slinky.oracle.v1.ValidatorOracleStats is not mutable"))
+	case "slinky.oracle.v1.ValidatorOracleStats.participation_bitmap":
+		panic(fmt.Errorf("field participation_bitmap of message slinky.oracle.v1.ValidatorOracleStats is not mutable"))
+	case "slinky.oracle.v1.ValidatorOracleStats.participated_count":
+		panic(fmt.Errorf("field participated_count of message slinky.oracle.v1.ValidatorOracleStats is not mutable"))
+	case "slinky.oracle.v1.ValidatorOracleStats.missed_count":
+		panic(fmt.Errorf("field missed_count of message slinky.oracle.v1.ValidatorOracleStats is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorOracleStats"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorOracleStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorOracleStats) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorOracleStats.cons_address":
+		return protoreflect.ValueOfString("")
+	case "slinky.oracle.v1.ValidatorOracleStats.start_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorOracleStats.last_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorOracleStats.index_offset":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorOracleStats.participation_bitmap":
+		return protoreflect.ValueOfBytes(nil)
+	case "slinky.oracle.v1.ValidatorOracleStats.participated_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorOracleStats.missed_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorOracleStats"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorOracleStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorOracleStats) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.ValidatorOracleStats", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorOracleStats) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorOracleStats) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorOracleStats) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorOracleStats) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorOracleStats)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ConsAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.LastHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastHeight))
+		}
+		if x.IndexOffset != 0 {
+			n += 1 + runtime.Sov(uint64(x.IndexOffset))
+		}
+		l = len(x.ParticipationBitmap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ParticipatedCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParticipatedCount))
+		}
+		if x.MissedCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissedCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorOracleStats)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MissedCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedCount))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ParticipatedCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParticipatedCount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.ParticipationBitmap) > 0 {
+			i -= len(x.ParticipationBitmap)
+			copy(dAtA[i:], x.ParticipationBitmap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ParticipationBitmap)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.IndexOffset != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IndexOffset))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.LastHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ConsAddress) > 0 {
+			i -= len(x.ConsAddress)
+			copy(dAtA[i:], x.ConsAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorOracleStats)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorOracleStats: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorOracleStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+				}
+				x.LastHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+				}
+				x.IndexOffset = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IndexOffset |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipationBitmap", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ParticipationBitmap = append(x.ParticipationBitmap[:0], dAtA[iNdEx:postIndex]...)
+				if x.ParticipationBitmap == nil {
+					x.ParticipationBitmap = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipatedCount", wireType)
+				}
+				x.ParticipatedCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParticipatedCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedCount", wireType)
+				}
+				x.MissedCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissedCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_CurrencyPairGenesis_5_list)(nil)
+
+type _CurrencyPairGenesis_5_list struct {
+	list *[]*PriceHistoryEntry
+}
+
+func (x *_CurrencyPairGenesis_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CurrencyPairGenesis_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_CurrencyPairGenesis_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceHistoryEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CurrencyPairGenesis_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceHistoryEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CurrencyPairGenesis_5_list) AppendMutable() protoreflect.Value {
+	v := new(PriceHistoryEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrencyPairGenesis_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CurrencyPairGenesis_5_list) NewElement() protoreflect.Value {
+	v := new(PriceHistoryEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrencyPairGenesis_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_CurrencyPairGenesis                     protoreflect.MessageDescriptor
+	fd_CurrencyPairGenesis_currency_pair       protoreflect.FieldDescriptor
+	fd_CurrencyPairGenesis_currency_pair_price protoreflect.FieldDescriptor
+	fd_CurrencyPairGenesis_nonce               protoreflect.FieldDescriptor
+	fd_CurrencyPairGenesis_id                  protoreflect.FieldDescriptor
+	fd_CurrencyPairGenesis_price_history       protoreflect.FieldDescriptor
+	fd_CurrencyPairGenesis_halted              protoreflect.FieldDescriptor
+	fd_CurrencyPairGenesis_decimals            protoreflect.FieldDescriptor
+	fd_CurrencyPairGenesis_missed_heights      protoreflect.FieldDescriptor
+	fd_CurrencyPairGenesis_rejected_price      protoreflect.FieldDescriptor
+	fd_CurrencyPairGenesis_rejected_count      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_oracle_v1_genesis_proto_init()
+	md_CurrencyPairGenesis = File_slinky_oracle_v1_genesis_proto.Messages().ByName("CurrencyPairGenesis")
+	fd_CurrencyPairGenesis_currency_pair = md_CurrencyPairGenesis.Fields().ByName("currency_pair")
+	fd_CurrencyPairGenesis_currency_pair_price = md_CurrencyPairGenesis.Fields().ByName("currency_pair_price")
+	fd_CurrencyPairGenesis_nonce = md_CurrencyPairGenesis.Fields().ByName("nonce")
+	fd_CurrencyPairGenesis_id = md_CurrencyPairGenesis.Fields().ByName("id")
+	fd_CurrencyPairGenesis_price_history = md_CurrencyPairGenesis.Fields().ByName("price_history")
+	fd_CurrencyPairGenesis_halted = md_CurrencyPairGenesis.Fields().ByName("halted")
+	fd_CurrencyPairGenesis_decimals = md_CurrencyPairGenesis.Fields().ByName("decimals")
+	fd_CurrencyPairGenesis_missed_heights = md_CurrencyPairGenesis.Fields().ByName("missed_heights")
+	fd_CurrencyPairGenesis_rejected_price = md_CurrencyPairGenesis.Fields().ByName("rejected_price")
+	fd_CurrencyPairGenesis_rejected_count = md_CurrencyPairGenesis.Fields().ByName("rejected_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_CurrencyPairGenesis)(nil)
+
+type fastReflection_CurrencyPairGenesis CurrencyPairGenesis
+
+func (x *CurrencyPairGenesis) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrencyPairGenesis)(x)
+}
+
+func (x *CurrencyPairGenesis) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CurrencyPairGenesis_messageType fastReflection_CurrencyPairGenesis_messageType
+var _ protoreflect.MessageType = fastReflection_CurrencyPairGenesis_messageType{}
+
+type fastReflection_CurrencyPairGenesis_messageType struct{}
+
+func (x fastReflection_CurrencyPairGenesis_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrencyPairGenesis)(nil)
+}
+func (x fastReflection_CurrencyPairGenesis_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairGenesis)
+}
+func (x fastReflection_CurrencyPairGenesis_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairGenesis
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CurrencyPairGenesis) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairGenesis
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CurrencyPairGenesis) Type() protoreflect.MessageType {
+	return _fastReflection_CurrencyPairGenesis_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CurrencyPairGenesis) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairGenesis)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CurrencyPairGenesis) Interface() protoreflect.ProtoMessage {
+	return (*CurrencyPairGenesis)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CurrencyPairGenesis) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CurrencyPair != nil {
+		value := protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+		if !f(fd_CurrencyPairGenesis_currency_pair, value) {
+			return
+		}
+	}
+	if x.CurrencyPairPrice != nil {
+		value := protoreflect.ValueOfMessage(x.CurrencyPairPrice.ProtoReflect())
+		if !f(fd_CurrencyPairGenesis_currency_pair_price, value) {
+			return
+		}
+	}
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_CurrencyPairGenesis_nonce, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_CurrencyPairGenesis_id, value) {
+			return
+		}
+	}
+	if len(x.PriceHistory) != 0 {
+		value := protoreflect.ValueOfList(&_CurrencyPairGenesis_5_list{list: &x.PriceHistory})
+		if !f(fd_CurrencyPairGenesis_price_history, value) {
+			return
+		}
+	}
+	if x.Halted != false {
+		value := protoreflect.ValueOfBool(x.Halted)
+		if !f(fd_CurrencyPairGenesis_halted, value) {
+			return
+		}
+	}
+	if x.Decimals != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Decimals)
+		if !f(fd_CurrencyPairGenesis_decimals, value) {
+			return
+		}
+	}
+	if x.MissedHeights != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissedHeights)
+		if !f(fd_CurrencyPairGenesis_missed_heights, value) {
+			return
+		}
+	}
+	if x.RejectedPrice != nil {
+		value := protoreflect.ValueOfMessage(x.RejectedPrice.ProtoReflect())
+		if !f(fd_CurrencyPairGenesis_rejected_price, value) {
+			return
+		}
+	}
+	if x.RejectedCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RejectedCount)
+		if !f(fd_CurrencyPairGenesis_rejected_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CurrencyPairGenesis) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
+		return x.CurrencyPair != nil
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
+		return x.CurrencyPairPrice != nil
+	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+		return x.Nonce != uint64(0)
+	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+		return x.Id != uint64(0)
+	case "slinky.oracle.v1.CurrencyPairGenesis.price_history":
+		return len(x.PriceHistory) != 0
+	case "slinky.oracle.v1.CurrencyPairGenesis.halted":
+		return x.Halted != false
+	case "slinky.oracle.v1.CurrencyPairGenesis.decimals":
+		return x.Decimals != uint64(0)
+	case "slinky.oracle.v1.CurrencyPairGenesis.missed_heights":
+		return x.MissedHeights != uint64(0)
+	case "slinky.oracle.v1.CurrencyPairGenesis.rejected_price":
+		return x.RejectedPrice != nil
+	case "slinky.oracle.v1.CurrencyPairGenesis.rejected_count":
+		return x.RejectedCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairGenesis) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
+		x.CurrencyPair = nil
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
+		x.CurrencyPairPrice = nil
+	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+		x.Nonce = uint64(0)
+	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+		x.Id = uint64(0)
+	case "slinky.oracle.v1.CurrencyPairGenesis.price_history":
+		x.PriceHistory = nil
+	case "slinky.oracle.v1.CurrencyPairGenesis.halted":
+		x.Halted = false
+	case "slinky.oracle.v1.CurrencyPairGenesis.decimals":
+		x.Decimals = uint64(0)
+	case "slinky.oracle.v1.CurrencyPairGenesis.missed_heights":
+		x.MissedHeights = uint64(0)
+	case "slinky.oracle.v1.CurrencyPairGenesis.rejected_price":
+		x.RejectedPrice = nil
+	case "slinky.oracle.v1.CurrencyPairGenesis.rejected_count":
+		x.RejectedCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CurrencyPairGenesis) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
+		value := x.CurrencyPair
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
+		value := x.CurrencyPairPrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.CurrencyPairGenesis.price_history":
+		if len(x.PriceHistory) == 0 {
+			return protoreflect.ValueOfList(&_CurrencyPairGenesis_5_list{})
+		}
+		listValue := &_CurrencyPairGenesis_5_list{list: &x.PriceHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.oracle.v1.CurrencyPairGenesis.halted":
+		value := x.Halted
+		return protoreflect.ValueOfBool(value)
+	case "slinky.oracle.v1.CurrencyPairGenesis.decimals":
+		value := x.Decimals
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.CurrencyPairGenesis.missed_heights":
+		value := x.MissedHeights
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.CurrencyPairGenesis.rejected_price":
+		value := x.RejectedPrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.rejected_count":
+		value := x.RejectedCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairGenesis) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
+		x.CurrencyPair = value.Message().Interface().(*v1.CurrencyPair)
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
+		x.CurrencyPairPrice = value.Message().Interface().(*QuotePrice)
+	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+		x.Nonce = value.Uint()
+	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+		x.Id = value.Uint()
+	case "slinky.oracle.v1.CurrencyPairGenesis.price_history":
+		lv := value.List()
+		clv := lv.(*_CurrencyPairGenesis_5_list)
+		x.PriceHistory = *clv.list
+	case "slinky.oracle.v1.CurrencyPairGenesis.halted":
+		x.Halted = value.Bool()
+	case "slinky.oracle.v1.CurrencyPairGenesis.decimals":
+		x.Decimals = value.Uint()
+	case "slinky.oracle.v1.CurrencyPairGenesis.missed_heights":
+		x.MissedHeights = value.Uint()
+	case "slinky.oracle.v1.CurrencyPairGenesis.rejected_price":
+		x.RejectedPrice = value.Message().Interface().(*QuotePrice)
+	case "slinky.oracle.v1.CurrencyPairGenesis.rejected_count":
+		x.RejectedCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairGenesis) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
+		if x.CurrencyPair == nil {
+			x.CurrencyPair = new(v1.CurrencyPair)
+		}
+		return protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
+		if x.CurrencyPairPrice == nil {
+			x.CurrencyPairPrice = new(QuotePrice)
+		}
+		return protoreflect.ValueOfMessage(x.CurrencyPairPrice.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.price_history":
+		if x.PriceHistory == nil {
+			x.PriceHistory = []*PriceHistoryEntry{}
+		}
+		value := &_CurrencyPairGenesis_5_list{list: &x.PriceHistory}
+		return protoreflect.ValueOfList(value)
+	case "slinky.oracle.v1.CurrencyPairGenesis.rejected_price":
+		if x.RejectedPrice == nil {
+			x.RejectedPrice = new(QuotePrice)
+		}
+		return protoreflect.ValueOfMessage(x.RejectedPrice.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+		panic(fmt.Errorf("field nonce of message slinky.oracle.v1.CurrencyPairGenesis is not mutable"))
+	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+		panic(fmt.Errorf("field id of message slinky.oracle.v1.CurrencyPairGenesis is not mutable"))
+	case "slinky.oracle.v1.CurrencyPairGenesis.halted":
+		panic(fmt.Errorf("field halted of message slinky.oracle.v1.CurrencyPairGenesis is not mutable"))
+	case "slinky.oracle.v1.CurrencyPairGenesis.decimals":
+		panic(fmt.Errorf("field decimals of message slinky.oracle.v1.CurrencyPairGenesis is not mutable"))
+	case "slinky.oracle.v1.CurrencyPairGenesis.missed_heights":
+		panic(fmt.Errorf("field missed_heights of message slinky.oracle.v1.CurrencyPairGenesis is not mutable"))
+	case "slinky.oracle.v1.CurrencyPairGenesis.rejected_count":
+		panic(fmt.Errorf("field rejected_count of message slinky.oracle.v1.CurrencyPairGenesis is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CurrencyPairGenesis) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
+		m := new(v1.CurrencyPair)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
+		m := new(QuotePrice)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.CurrencyPairGenesis.price_history":
+		list := []*PriceHistoryEntry{}
+		return protoreflect.ValueOfList(&_CurrencyPairGenesis_5_list{list: &list})
+	case "slinky.oracle.v1.CurrencyPairGenesis.halted":
+		return protoreflect.ValueOfBool(false)
+	case "slinky.oracle.v1.CurrencyPairGenesis.decimals":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.CurrencyPairGenesis.missed_heights":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.CurrencyPairGenesis.rejected_price":
+		m := new(QuotePrice)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.rejected_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CurrencyPairGenesis) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.CurrencyPairGenesis", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CurrencyPairGenesis) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairGenesis) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CurrencyPairGenesis) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CurrencyPairGenesis)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.CurrencyPair != nil {
+			l = options.Size(x.CurrencyPair)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CurrencyPairPrice != nil {
+			l = options.Size(x.CurrencyPairPrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if len(x.PriceHistory) > 0 {
+			for _, e := range x.PriceHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Halted {
+			n += 2
+		}
+		if x.Decimals != 0 {
+			n += 1 + runtime.Sov(uint64(x.Decimals))
+		}
+		if x.MissedHeights != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissedHeights))
+		}
+		if x.RejectedPrice != nil {
+			l = options.Size(x.RejectedPrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RejectedCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.RejectedCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairGenesis)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RejectedCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RejectedCount))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.RejectedPrice != nil {
+			encoded, err := options.Marshal(x.RejectedPrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.MissedHeights != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedHeights))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Decimals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Halted {
+			i--
+			if x.Halted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.PriceHistory) > 0 {
+			for iNdEx := len(x.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.CurrencyPairPrice != nil {
+			encoded, err := options.Marshal(x.CurrencyPairPrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.CurrencyPair != nil {
+			encoded, err := options.Marshal(x.CurrencyPair)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairGenesis)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairGenesis: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairGenesis: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CurrencyPair == nil {
+					x.CurrencyPair = &v1.CurrencyPair{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPair); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CurrencyPairPrice == nil {
+					x.CurrencyPairPrice = &QuotePrice{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPairPrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceHistory = append(x.PriceHistory, &PriceHistoryEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceHistory[len(x.PriceHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Halted = bool(v != 0)
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				}
+				x.Decimals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Decimals |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedHeights", wireType)
+				}
+				x.MissedHeights = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissedHeights |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectedPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RejectedPrice == nil {
+					x.RejectedPrice = &QuotePrice{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RejectedPrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectedCount", wireType)
+				}
+				x.RejectedCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RejectedCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_GenesisState_1_list)(nil)
+
+type _GenesisState_1_list struct {
+	list *[]*CurrencyPairGenesis
+}
+
+func (x *_GenesisState_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CurrencyPairGenesis)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CurrencyPairGenesis)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_1_list) AppendMutable() protoreflect.Value {
+	v := new(CurrencyPairGenesis)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_1_list) NewElement() protoreflect.Value {
+	v := new(CurrencyPairGenesis)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*ValidatorPriceStats
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorPriceStats)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorPriceStats)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorPriceStats)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(ValidatorPriceStats)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*ValidatorOracleStats
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorOracleStats)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorOracleStats)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorOracleStats)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(ValidatorOracleStats)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                        protoreflect.MessageDescriptor
+	fd_GenesisState_currency_pair_genesis  protoreflect.FieldDescriptor
+	fd_GenesisState_next_id                protoreflect.FieldDescriptor
+	fd_GenesisState_params                 protoreflect.FieldDescriptor
+	fd_GenesisState_validator_price_stats  protoreflect.FieldDescriptor
+	fd_GenesisState_validator_oracle_stats protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_oracle_v1_genesis_proto_init()
+	md_GenesisState = File_slinky_oracle_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_currency_pair_genesis = md_GenesisState.Fields().ByName("currency_pair_genesis")
+	fd_GenesisState_next_id = md_GenesisState.Fields().ByName("next_id")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_validator_price_stats = md_GenesisState.Fields().ByName("validator_price_stats")
+	fd_GenesisState_validator_oracle_stats = md_GenesisState.Fields().ByName("validator_oracle_stats")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.CurrencyPairGenesis) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_1_list{list: &x.CurrencyPairGenesis})
+		if !f(fd_GenesisState_currency_pair_genesis, value) {
+			return
+		}
+	}
+	if x.NextId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextId)
+		if !f(fd_GenesisState_next_id, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.ValidatorPriceStats) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.ValidatorPriceStats})
+		if !f(fd_GenesisState_validator_price_stats, value) {
+			return
+		}
+	}
+	if len(x.ValidatorOracleStats) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.ValidatorOracleStats})
+		if !f(fd_GenesisState_validator_oracle_stats, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.GenesisState.currency_pair_genesis":
+		return len(x.CurrencyPairGenesis) != 0
+	case "slinky.oracle.v1.GenesisState.next_id":
+		return x.NextId != uint64(0)
+	case "slinky.oracle.v1.GenesisState.params":
+		return x.Params != nil
+	case "slinky.oracle.v1.GenesisState.validator_price_stats":
+		return len(x.ValidatorPriceStats) != 0
+	case "slinky.oracle.v1.GenesisState.validator_oracle_stats":
+		return len(x.ValidatorOracleStats) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.GenesisState.currency_pair_genesis":
+		x.CurrencyPairGenesis = nil
+	case "slinky.oracle.v1.GenesisState.next_id":
+		x.NextId = uint64(0)
+	case "slinky.oracle.v1.GenesisState.params":
+		x.Params = nil
+	case "slinky.oracle.v1.GenesisState.validator_price_stats":
+		x.ValidatorPriceStats = nil
+	case "slinky.oracle.v1.GenesisState.validator_oracle_stats":
+		x.ValidatorOracleStats = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.oracle.v1.GenesisState.currency_pair_genesis":
+		if len(x.CurrencyPairGenesis) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_1_list{})
+		}
+		listValue := &_GenesisState_1_list{list: &x.CurrencyPairGenesis}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.oracle.v1.GenesisState.next_id":
+		value := x.NextId
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.oracle.v1.GenesisState.validator_price_stats":
+		if len(x.ValidatorPriceStats) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.ValidatorPriceStats}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.oracle.v1.GenesisState.validator_oracle_stats":
+		if len(x.ValidatorOracleStats) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.ValidatorOracleStats}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.GenesisState.currency_pair_genesis":
+		lv := value.List()
+		clv := lv.(*_GenesisState_1_list)
+		x.CurrencyPairGenesis = *clv.list
+	case "slinky.oracle.v1.GenesisState.next_id":
+		x.NextId = value.Uint()
+	case "slinky.oracle.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "slinky.oracle.v1.GenesisState.validator_price_stats":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.ValidatorPriceStats = *clv.list
+	case "slinky.oracle.v1.GenesisState.validator_oracle_stats":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.ValidatorOracleStats = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.GenesisState.currency_pair_genesis":
+		if x.CurrencyPairGenesis == nil {
+			x.CurrencyPairGenesis = []*CurrencyPairGenesis{}
+		}
+		value := &_GenesisState_1_list{list: &x.CurrencyPairGenesis}
+		return protoreflect.ValueOfList(value)
+	case "slinky.oracle.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "slinky.oracle.v1.GenesisState.validator_price_stats":
+		if x.ValidatorPriceStats == nil {
+			x.ValidatorPriceStats = []*ValidatorPriceStats{}
+		}
+		value := &_GenesisState_4_list{list: &x.ValidatorPriceStats}
+		return protoreflect.ValueOfList(value)
+	case "slinky.oracle.v1.GenesisState.validator_oracle_stats":
+		if x.ValidatorOracleStats == nil {
+			x.ValidatorOracleStats = []*ValidatorOracleStats{}
+		}
+		value := &_GenesisState_5_list{list: &x.ValidatorOracleStats}
+		return protoreflect.ValueOfList(value)
+	case "slinky.oracle.v1.GenesisState.next_id":
+		panic(fmt.Errorf("field next_id of message slinky.oracle.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.GenesisState.currency_pair_genesis":
+		list := []*CurrencyPairGenesis{}
+		return protoreflect.ValueOfList(&_GenesisState_1_list{list: &list})
+	case "slinky.oracle.v1.GenesisState.next_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.oracle.v1.GenesisState.validator_price_stats":
+		list := []*ValidatorPriceStats{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "slinky.oracle.v1.GenesisState.validator_oracle_stats":
+		list := []*ValidatorOracleStats{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.CurrencyPairGenesis) > 0 {
+			for _, e := range x.CurrencyPairGenesis {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextId))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ValidatorPriceStats) > 0 {
+			for _, e := range x.ValidatorPriceStats {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ValidatorOracleStats) > 0 {
+			for _, e := range x.ValidatorOracleStats {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ValidatorOracleStats) > 0 {
+			for iNdEx := len(x.ValidatorOracleStats) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorOracleStats[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ValidatorPriceStats) > 0 {
+			for iNdEx := len(x.ValidatorPriceStats) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorPriceStats[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.NextId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.CurrencyPairGenesis) > 0 {
+			for iNdEx := len(x.CurrencyPairGenesis) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CurrencyPairGenesis[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairGenesis", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CurrencyPairGenesis = append(x.CurrencyPairGenesis, &CurrencyPairGenesis{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPairGenesis[len(x.CurrencyPairGenesis)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextId", wireType)
+				}
+				x.NextId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPriceStats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorPriceStats = append(x.ValidatorPriceStats, &ValidatorPriceStats{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorPriceStats[len(x.ValidatorPriceStats)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorOracleStats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorOracleStats = append(x.ValidatorOracleStats, &ValidatorOracleStats{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorOracleStats[len(x.ValidatorOracleStats)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: slinky/oracle/v1/genesis.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QuotePrice is the representation of the aggregated prices for a CurrencyPair,
+// where price represents the price of Base in terms of Quote
+type QuotePrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	// BlockTimestamp tracks the block height associated with this price update.
+	// We include block timestamp alongside the price to ensure that smart
+	// contracts and applications are not utilizing stale oracle prices
+	BlockTimestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	// BlockHeight is height of block mentioned above
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *QuotePrice) Reset() {
+	*x = QuotePrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotePrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePrice) ProtoMessage() {}
+
+// Deprecated: Use QuotePrice.ProtoReflect.Descriptor instead.
+func (*QuotePrice) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuotePrice) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *QuotePrice) GetBlockTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockTimestamp
+	}
+	return nil
+}
+
+func (x *QuotePrice) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// PriceHistoryEntry is a historical price of a CurrencyPair, along with the
+// nonce of the CurrencyPair at the time the price was written.
+type PriceHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Nonce is the nonce of the CurrencyPair when the price was written.
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Price is the historical quote-price of the CurrencyPair.
+	Price *QuotePrice `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *PriceHistoryEntry) Reset() {
+	*x = PriceHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistoryEntry) ProtoMessage() {}
+
+// Deprecated: Use PriceHistoryEntry.ProtoReflect.Descriptor instead.
+func (*PriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PriceHistoryEntry) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *PriceHistoryEntry) GetPrice() *QuotePrice {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+// CurrencyPairState represents the stateful information tracked by the x/oracle
+// module per-currency-pair.
+type CurrencyPairState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// QuotePrice is the latest price for a currency-pair, notice this value can
+	// be null in the case that no price exists for the currency-pair
+	Price *QuotePrice `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	// Nonce is the number of updates this currency-pair has received
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// ID is the ID of the CurrencyPair
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// Halted is true if the latest price update of the currency-pair tripped the
+	// circuit breaker, i.e. it was clamped or rejected.
+	Halted bool `protobuf:"varint,4,opt,name=halted,proto3" json:"halted,omitempty"`
+	// Decimals is the number of decimals of the market of the currency-pair in
+	// x/marketmap, which its QuotePrice is quoted in. Zero if it is unknown.
+	Decimals uint64 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// MissedHeights is the number of consecutive heights the currency-pair did
+	// not receive a price for.
+	MissedHeights uint64 `protobuf:"varint,6,opt,name=missed_heights,json=missedHeights,proto3" json:"missed_heights,omitempty"`
+	// RejectedPrice is the latest price update of the currency-pair that was
+	// rejected by the circuit breaker, if its latest price update was rejected.
+	RejectedPrice *QuotePrice `protobuf:"bytes,7,opt,name=rejected_price,json=rejectedPrice,proto3" json:"rejected_price,omitempty"`
+	// RejectedCount is the number of consecutive rejected price updates of the
+	// currency-pair, each within the maximum price change of the previous one.
+	RejectedCount uint64 `protobuf:"varint,8,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+}
+
+func (x *CurrencyPairState) Reset() {
+	*x = CurrencyPairState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrencyPairState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyPairState) ProtoMessage() {}
+
+// Deprecated: Use CurrencyPairState.ProtoReflect.Descriptor instead.
+func (*CurrencyPairState) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CurrencyPairState) GetPrice() *QuotePrice {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CurrencyPairState) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *CurrencyPairState) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CurrencyPairState) GetHalted() bool {
+	if x != nil {
+		return x.Halted
+	}
+	return false
+}
+
+func (x *CurrencyPairState) GetDecimals() uint64 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *CurrencyPairState) GetMissedHeights() uint64 {
+	if x != nil {
+		return x.MissedHeights
 	}
+	return 0
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: slinky/oracle/v1/genesis.proto
+func (x *CurrencyPairState) GetRejectedPrice() *QuotePrice {
+	if x != nil {
+		return x.RejectedPrice
+	}
+	return nil
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *CurrencyPairState) GetRejectedCount() uint64 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
 
-// QuotePrice is the representation of the aggregated prices for a CurrencyPair,
-// where price represents the price of Base in terms of Quote
-type QuotePrice struct {
+// ValidatorPriceStats tracks how often a validator did not report a price, or
+// reported a price that deviated too far from the aggregated price, for the
+// currency pairs that received an aggregated price within the most recent
+// heights of the validator stats window. The window slides in buckets of
+// BucketHeights heights, so the oldest bucket is evicted from the window as a
+// whole.
+type ValidatorPriceStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Price string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
-	// BlockTimestamp tracks the block height associated with this price update.
-	// We include block timestamp alongside the price to ensure that smart
-	// contracts and applications are not utilizing stale oracle prices
-	BlockTimestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
-	// BlockHeight is height of block mentioned above
-	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-}
-
-func (x *QuotePrice) Reset() {
-	*x = QuotePrice{}
+	// ConsAddress is the bech32 consensus address of the validator.
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// StartHeight is the height at which the stats of the validator started
+	// being tracked.
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// ReportCount is the number of aggregated prices the validator was measured
+	// against within the window.
+	ReportCount uint64 `protobuf:"varint,3,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	// MissCount is the number of aggregated prices the validator did not report
+	// a price for within the window.
+	MissCount uint64 `protobuf:"varint,4,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
+	// DeviationCount is the number of aggregated prices the validator reported a
+	// price for that deviated more than the maximum price deviation within the
+	// window.
+	DeviationCount uint64 `protobuf:"varint,5,opt,name=deviation_count,json=deviationCount,proto3" json:"deviation_count,omitempty"`
+	// LastHeight is the most recent height at which the stats were updated.
+	LastHeight uint64 `protobuf:"varint,6,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// BucketHeights is the number of consecutive heights whose counters are
+	// accumulated in each bucket.
+	BucketHeights uint64 `protobuf:"varint,7,opt,name=bucket_heights,json=bucketHeights,proto3" json:"bucket_heights,omitempty"`
+	// Buckets is a ring buffer of the counters of each bucket of heights within
+	// the window, indexed by the height divided by BucketHeights.
+	Buckets []*ValidatorPriceStatsBucket `protobuf:"bytes,8,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (x *ValidatorPriceStats) Reset() {
+	*x = ValidatorPriceStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[0]
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuotePrice) String() string {
+func (x *ValidatorPriceStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuotePrice) ProtoMessage() {}
+func (*ValidatorPriceStats) ProtoMessage() {}
 
-// Deprecated: Use QuotePrice.ProtoReflect.Descriptor instead.
-func (*QuotePrice) Descriptor() ([]byte, []int) {
-	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ValidatorPriceStats.ProtoReflect.Descriptor instead.
+func (*ValidatorPriceStats) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *QuotePrice) GetPrice() string {
+func (x *ValidatorPriceStats) GetConsAddress() string {
 	if x != nil {
-		return x.Price
+		return x.ConsAddress
 	}
 	return ""
 }
 
-func (x *QuotePrice) GetBlockTimestamp() *timestamppb.Timestamp {
+func (x *ValidatorPriceStats) GetStartHeight() uint64 {
 	if x != nil {
-		return x.BlockTimestamp
+		return x.StartHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *QuotePrice) GetBlockHeight() uint64 {
+func (x *ValidatorPriceStats) GetReportCount() uint64 {
 	if x != nil {
-		return x.BlockHeight
+		return x.ReportCount
 	}
 	return 0
 }
 
-// CurrencyPairState represents the stateful information tracked by the x/oracle
-// module per-currency-pair.
-type CurrencyPairState struct {
+func (x *ValidatorPriceStats) GetMissCount() uint64 {
+	if x != nil {
+		return x.MissCount
+	}
+	return 0
+}
+
+func (x *ValidatorPriceStats) GetDeviationCount() uint64 {
+	if x != nil {
+		return x.DeviationCount
+	}
+	return 0
+}
+
+func (x *ValidatorPriceStats) GetLastHeight() uint64 {
+	if x != nil {
+		return x.LastHeight
+	}
+	return 0
+}
+
+func (x *ValidatorPriceStats) GetBucketHeights() uint64 {
+	if x != nil {
+		return x.BucketHeights
+	}
+	return 0
+}
+
+func (x *ValidatorPriceStats) GetBuckets() []*ValidatorPriceStatsBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+// ValidatorPriceStatsBucket holds the miss and deviation counters of a
+// validator for a bucket of consecutive heights.
+type ValidatorPriceStatsBucket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// QuotePrice is the latest price for a currency-pair, notice this value can
-	// be null in the case that no price exists for the currency-pair
-	Price *QuotePrice `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
-	// Nonce is the number of updates this currency-pair has received
-	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// ID is the ID of the CurrencyPair
-	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// ReportCount is the number of aggregated prices the validator was measured
+	// against within the bucket.
+	ReportCount uint64 `protobuf:"varint,1,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	// MissCount is the number of aggregated prices the validator did not report
+	// a price for within the bucket.
+	MissCount uint64 `protobuf:"varint,2,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
+	// DeviationCount is the number of aggregated prices the validator reported a
+	// price for that deviated more than the maximum price deviation within the
+	// bucket.
+	DeviationCount uint64 `protobuf:"varint,3,opt,name=deviation_count,json=deviationCount,proto3" json:"deviation_count,omitempty"`
 }
 
-func (x *CurrencyPairState) Reset() {
-	*x = CurrencyPairState{}
+func (x *ValidatorPriceStatsBucket) Reset() {
+	*x = ValidatorPriceStatsBucket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[1]
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CurrencyPairState) String() string {
+func (x *ValidatorPriceStatsBucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyPairState) ProtoMessage() {}
+func (*ValidatorPriceStatsBucket) ProtoMessage() {}
 
-// Deprecated: Use CurrencyPairState.ProtoReflect.Descriptor instead.
-func (*CurrencyPairState) Descriptor() ([]byte, []int) {
-	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ValidatorPriceStatsBucket.ProtoReflect.Descriptor instead.
+func (*ValidatorPriceStatsBucket) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CurrencyPairState) GetPrice() *QuotePrice {
+func (x *ValidatorPriceStatsBucket) GetReportCount() uint64 {
 	if x != nil {
-		return x.Price
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *ValidatorPriceStatsBucket) GetMissCount() uint64 {
+	if x != nil {
+		return x.MissCount
+	}
+	return 0
+}
+
+func (x *ValidatorPriceStatsBucket) GetDeviationCount() uint64 {
+	if x != nil {
+		return x.DeviationCount
+	}
+	return 0
+}
+
+// ValidatorOracleStats tracks whether a validator included a valid oracle vote
+// extension at each of the most recent heights within the participation
+// window.
+type ValidatorOracleStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ConsAddress is the bech32 consensus address of the validator.
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// StartHeight is the height at which the participation of the validator
+	// started being tracked.
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// LastHeight is the most recent height at which the participation of the
+	// validator was recorded.
+	LastHeight uint64 `protobuf:"varint,3,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// IndexOffset is the number of heights the participation of the validator
+	// has been recorded for. The participation at the most recent height is
+	// stored at bit (IndexOffset - 1) % window of the bitmap.
+	IndexOffset uint64 `protobuf:"varint,4,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// ParticipationBitmap is a ring buffer with a bit per height in the window,
+	// which is set if the validator included a valid oracle vote extension at
+	// that height.
+	ParticipationBitmap []byte `protobuf:"bytes,5,opt,name=participation_bitmap,json=participationBitmap,proto3" json:"participation_bitmap,omitempty"`
+	// ParticipatedCount is the number of heights within the window at which the
+	// validator included a valid oracle vote extension.
+	ParticipatedCount uint64 `protobuf:"varint,6,opt,name=participated_count,json=participatedCount,proto3" json:"participated_count,omitempty"`
+	// MissedCount is the number of heights within the window at which the
+	// validator did not include a valid oracle vote extension.
+	MissedCount uint64 `protobuf:"varint,7,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty"`
+}
+
+func (x *ValidatorOracleStats) Reset() {
+	*x = ValidatorOracleStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorOracleStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorOracleStats) ProtoMessage() {}
+
+// Deprecated: Use ValidatorOracleStats.ProtoReflect.Descriptor instead.
+func (*ValidatorOracleStats) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ValidatorOracleStats) GetConsAddress() string {
+	if x != nil {
+		return x.ConsAddress
+	}
+	return ""
+}
+
+func (x *ValidatorOracleStats) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *ValidatorOracleStats) GetLastHeight() uint64 {
+	if x != nil {
+		return x.LastHeight
+	}
+	return 0
+}
+
+func (x *ValidatorOracleStats) GetIndexOffset() uint64 {
+	if x != nil {
+		return x.IndexOffset
+	}
+	return 0
+}
+
+func (x *ValidatorOracleStats) GetParticipationBitmap() []byte {
+	if x != nil {
+		return x.ParticipationBitmap
 	}
 	return nil
 }
 
-func (x *CurrencyPairState) GetNonce() uint64 {
+func (x *ValidatorOracleStats) GetParticipatedCount() uint64 {
 	if x != nil {
-		return x.Nonce
+		return x.ParticipatedCount
 	}
 	return 0
 }
 
-func (x *CurrencyPairState) GetId() uint64 {
+func (x *ValidatorOracleStats) GetMissedCount() uint64 {
 	if x != nil {
-		return x.Id
+		return x.MissedCount
 	}
 	return 0
 }
@@ -2390,12 +6362,30 @@ type CurrencyPairGenesis struct {
 	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// id is the ID of the CurrencyPair
 	Id uint64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// price_history is the set of most recent prices of the CurrencyPair, in
+	// ascending order of their nonces
+	PriceHistory []*PriceHistoryEntry `protobuf:"bytes,5,rep,name=price_history,json=priceHistory,proto3" json:"price_history,omitempty"`
+	// halted is true if the latest price update of the CurrencyPair tripped the
+	// circuit breaker
+	Halted bool `protobuf:"varint,6,opt,name=halted,proto3" json:"halted,omitempty"`
+	// decimals is the number of decimals of the market of the CurrencyPair. If
+	// zero, it is set to the decimals of the market in the x/marketmap genesis.
+	Decimals uint64 `protobuf:"varint,7,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// missed_heights is the number of consecutive heights the CurrencyPair did
+	// not receive a price for
+	MissedHeights uint64 `protobuf:"varint,8,opt,name=missed_heights,json=missedHeights,proto3" json:"missed_heights,omitempty"`
+	// rejected_price is the latest price update of the CurrencyPair that was
+	// rejected by the circuit breaker, if its latest price update was rejected
+	RejectedPrice *QuotePrice `protobuf:"bytes,9,opt,name=rejected_price,json=rejectedPrice,proto3" json:"rejected_price,omitempty"`
+	// rejected_count is the number of consecutive rejected price updates of the
+	// CurrencyPair, each within the maximum price change of the previous one
+	RejectedCount uint64 `protobuf:"varint,10,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
 }
 
 func (x *CurrencyPairGenesis) Reset() {
 	*x = CurrencyPairGenesis{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[2]
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2409,7 +6399,7 @@ func (*CurrencyPairGenesis) ProtoMessage() {}
 
 // Deprecated: Use CurrencyPairGenesis.ProtoReflect.Descriptor instead.
 func (*CurrencyPairGenesis) Descriptor() ([]byte, []int) {
-	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{2}
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CurrencyPairGenesis) GetCurrencyPair() *v1.CurrencyPair {
@@ -2440,6 +6430,48 @@ func (x *CurrencyPairGenesis) GetId() uint64 {
 	return 0
 }
 
+func (x *CurrencyPairGenesis) GetPriceHistory() []*PriceHistoryEntry {
+	if x != nil {
+		return x.PriceHistory
+	}
+	return nil
+}
+
+func (x *CurrencyPairGenesis) GetHalted() bool {
+	if x != nil {
+		return x.Halted
+	}
+	return false
+}
+
+func (x *CurrencyPairGenesis) GetDecimals() uint64 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *CurrencyPairGenesis) GetMissedHeights() uint64 {
+	if x != nil {
+		return x.MissedHeights
+	}
+	return 0
+}
+
+func (x *CurrencyPairGenesis) GetRejectedPrice() *QuotePrice {
+	if x != nil {
+		return x.RejectedPrice
+	}
+	return nil
+}
+
+func (x *CurrencyPairGenesis) GetRejectedCount() uint64 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
 // GenesisState is the genesis-state for the x/oracle module, it takes a set of
 // predefined CurrencyPairGeneses
 type GenesisState struct {
@@ -2453,12 +6485,20 @@ type GenesisState struct {
 	CurrencyPairGenesis []*CurrencyPairGenesis `protobuf:"bytes,1,rep,name=currency_pair_genesis,json=currencyPairGenesis,proto3" json:"currency_pair_genesis,omitempty"`
 	// NextID is the next ID to be used for a CurrencyPair
 	NextId uint64 `protobuf:"varint,2,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	// Params are the parameters for the x/oracle module.
+	Params *Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	// ValidatorPriceStats are the miss and deviation counters of each validator
+	// in the current window.
+	ValidatorPriceStats []*ValidatorPriceStats `protobuf:"bytes,4,rep,name=validator_price_stats,json=validatorPriceStats,proto3" json:"validator_price_stats,omitempty"`
+	// ValidatorOracleStats is the oracle participation of each validator within
+	// the participation window.
+	ValidatorOracleStats []*ValidatorOracleStats `protobuf:"bytes,5,rep,name=validator_oracle_stats,json=validatorOracleStats,proto3" json:"validator_oracle_stats,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
 	*x = GenesisState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[3]
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2472,7 +6512,7 @@ func (*GenesisState) ProtoMessage() {}
 
 // Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{3}
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GenesisState) GetCurrencyPairGenesis() []*CurrencyPairGenesis {
@@ -2489,6 +6529,27 @@ func (x *GenesisState) GetNextId() uint64 {
 	return 0
 }
 
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetValidatorPriceStats() []*ValidatorPriceStats {
+	if x != nil {
+		return x.ValidatorPriceStats
+	}
+	return nil
+}
+
+func (x *GenesisState) GetValidatorOracleStats() []*ValidatorOracleStats {
+	if x != nil {
+		return x.ValidatorOracleStats
+	}
+	return nil
+}
+
 var File_slinky_oracle_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_slinky_oracle_v1_genesis_proto_rawDesc = []byte{
@@ -2502,61 +6563,165 @@ var file_slinky_oracle_v1_genesis_proto_rawDesc = []byte{
 	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x74, 0x79, 0x70,
 	0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70,
-	0x61, 0x69, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc1, 0x01, 0x0a, 0x0a, 0x51, 0x75,
-	0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x73, 0x0a,
-	0x11, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0xd9, 0x01, 0x0a, 0x13, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50,
-	0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x50, 0x61, 0x69, 0x72, 0x12, 0x52, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x5f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50,
-	0x61, 0x69, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x88,
-	0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x5f, 0x0a, 0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72,
-	0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x61, 0x69, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x73, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc1, 0x01, 0x0a, 0x0a, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x63, 0x0a, 0x11,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x22, 0xc0, 0x02, 0x0a, 0x11, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61,
+	0x69, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x6c, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x12, 0x49, 0x0a, 0x0e, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x69,
+	0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x0d,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdb, 0x02, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x69, 0x73, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64,
+	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x19, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa5, 0x02, 0x0a, 0x14,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x31,
+	0x0a, 0x14, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x6d, 0x61,
+	0x70, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xf6, 0x03, 0x0a, 0x13, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x50, 0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69,
+	0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x52, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x4e, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x0e, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x85, 0x03, 0x0a,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x5f, 0x0a,
+	0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x67,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73,
+	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x5f, 0x0a, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
 	0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x42, 0xb2, 0x01, 0x0a, 0x14, 0x63, 0x6f,
-	0x6d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x53, 0x4f, 0x58, 0xaa, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x4f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
-	0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x53, 0x6c, 0x69,
-	0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x53, 0x6c, 0x69, 0x6e,
-	0x6b, 0x79, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x62, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x42, 0xb2, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x6c, 0x69,
+	0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31,
+	0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4f, 0x58, 0xaa,
+	0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a, 0x3a, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2571,26 +6736,39 @@ func file_slinky_oracle_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_slinky_oracle_v1_genesis_proto_rawDescData
 }
 
-var file_slinky_oracle_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_slinky_oracle_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_slinky_oracle_v1_genesis_proto_goTypes = []interface{}{
-	(*QuotePrice)(nil),            // 0: slinky.oracle.v1.QuotePrice
-	(*CurrencyPairState)(nil),     // 1: slinky.oracle.v1.CurrencyPairState
-	(*CurrencyPairGenesis)(nil),   // 2: slinky.oracle.v1.CurrencyPairGenesis
-	(*GenesisState)(nil),          // 3: slinky.oracle.v1.GenesisState
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
-	(*v1.CurrencyPair)(nil),       // 5: slinky.types.v1.CurrencyPair
+	(*QuotePrice)(nil),                // 0: slinky.oracle.v1.QuotePrice
+	(*PriceHistoryEntry)(nil),         // 1: slinky.oracle.v1.PriceHistoryEntry
+	(*CurrencyPairState)(nil),         // 2: slinky.oracle.v1.CurrencyPairState
+	(*ValidatorPriceStats)(nil),       // 3: slinky.oracle.v1.ValidatorPriceStats
+	(*ValidatorPriceStatsBucket)(nil), // 4: slinky.oracle.v1.ValidatorPriceStatsBucket
+	(*ValidatorOracleStats)(nil),      // 5: slinky.oracle.v1.ValidatorOracleStats
+	(*CurrencyPairGenesis)(nil),       // 6: slinky.oracle.v1.CurrencyPairGenesis
+	(*GenesisState)(nil),              // 7: slinky.oracle.v1.GenesisState
+	(*timestamppb.Timestamp)(nil),     // 8: google.protobuf.Timestamp
+	(*v1.CurrencyPair)(nil),           // 9: slinky.types.v1.CurrencyPair
+	(*Params)(nil),                    // 10: slinky.oracle.v1.Params
 }
 var file_slinky_oracle_v1_genesis_proto_depIdxs = []int32{
-	4, // 0: slinky.oracle.v1.QuotePrice.block_timestamp:type_name -> google.protobuf.Timestamp
-	0, // 1: slinky.oracle.v1.CurrencyPairState.price:type_name -> slinky.oracle.v1.QuotePrice
-	5, // 2: slinky.oracle.v1.CurrencyPairGenesis.currency_pair:type_name -> slinky.types.v1.CurrencyPair
-	0, // 3: slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price:type_name -> slinky.oracle.v1.QuotePrice
-	2, // 4: slinky.oracle.v1.GenesisState.currency_pair_genesis:type_name -> slinky.oracle.v1.CurrencyPairGenesis
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	8,  // 0: slinky.oracle.v1.QuotePrice.block_timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 1: slinky.oracle.v1.PriceHistoryEntry.price:type_name -> slinky.oracle.v1.QuotePrice
+	0,  // 2: slinky.oracle.v1.CurrencyPairState.price:type_name -> slinky.oracle.v1.QuotePrice
+	0,  // 3: slinky.oracle.v1.CurrencyPairState.rejected_price:type_name -> slinky.oracle.v1.QuotePrice
+	4,  // 4: slinky.oracle.v1.ValidatorPriceStats.buckets:type_name -> slinky.oracle.v1.ValidatorPriceStatsBucket
+	9,  // 5: slinky.oracle.v1.CurrencyPairGenesis.currency_pair:type_name -> slinky.types.v1.CurrencyPair
+	0,  // 6: slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price:type_name -> slinky.oracle.v1.QuotePrice
+	1,  // 7: slinky.oracle.v1.CurrencyPairGenesis.price_history:type_name -> slinky.oracle.v1.PriceHistoryEntry
+	0,  // 8: slinky.oracle.v1.CurrencyPairGenesis.rejected_price:type_name -> slinky.oracle.v1.QuotePrice
+	6,  // 9: slinky.oracle.v1.GenesisState.currency_pair_genesis:type_name -> slinky.oracle.v1.CurrencyPairGenesis
+	10, // 10: slinky.oracle.v1.GenesisState.params:type_name -> slinky.oracle.v1.Params
+	3,  // 11: slinky.oracle.v1.GenesisState.validator_price_stats:type_name -> slinky.oracle.v1.ValidatorPriceStats
+	5,  // 12: slinky.oracle.v1.GenesisState.validator_oracle_stats:type_name -> slinky.oracle.v1.ValidatorOracleStats
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_slinky_oracle_v1_genesis_proto_init() }
@@ -2598,6 +6776,7 @@ func file_slinky_oracle_v1_genesis_proto_init() {
 	if File_slinky_oracle_v1_genesis_proto != nil {
 		return
 	}
+	file_slinky_oracle_v1_params_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_slinky_oracle_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QuotePrice); i {
@@ -2612,7 +6791,7 @@ func file_slinky_oracle_v1_genesis_proto_init() {
 			}
 		}
 		file_slinky_oracle_v1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrencyPairState); i {
+			switch v := v.(*PriceHistoryEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2624,7 +6803,7 @@ func file_slinky_oracle_v1_genesis_proto_init() {
 			}
 		}
 		file_slinky_oracle_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrencyPairGenesis); i {
+			switch v := v.(*CurrencyPairState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2636,6 +6815,54 @@ func file_slinky_oracle_v1_genesis_proto_init() {
 			}
 		}
 		file_slinky_oracle_v1_genesis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPriceStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_oracle_v1_genesis_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPriceStatsBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_oracle_v1_genesis_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorOracleStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_oracle_v1_genesis_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrencyPairGenesis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_oracle_v1_genesis_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
 			case 0:
 				return &v.state
@@ -2654,7 +6881,7 @@ func file_slinky_oracle_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_slinky_oracle_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,1317 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package oraclev1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_Params                                protoreflect.MessageDescriptor
+	fd_Params_validator_stats_window         protoreflect.FieldDescriptor
+	fd_Params_max_price_deviation_bps        protoreflect.FieldDescriptor
+	fd_Params_participation_window           protoreflect.FieldDescriptor
+	fd_Params_price_history_length           protoreflect.FieldDescriptor
+	fd_Params_max_price_change_bps           protoreflect.FieldDescriptor
+	fd_Params_circuit_breaker_mode           protoreflect.FieldDescriptor
+	fd_Params_max_price_age                  protoreflect.FieldDescriptor
+	fd_Params_emit_price_events              protoreflect.FieldDescriptor
+	fd_Params_decimals_change_mode           protoreflect.FieldDescriptor
+	fd_Params_max_missed_heights             protoreflect.FieldDescriptor
+	fd_Params_circuit_breaker_reanchor_count protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_oracle_v1_params_proto_init()
+	md_Params = File_slinky_oracle_v1_params_proto.Messages().ByName("Params")
+	fd_Params_validator_stats_window = md_Params.Fields().ByName("validator_stats_window")
+	fd_Params_max_price_deviation_bps = md_Params.Fields().ByName("max_price_deviation_bps")
+	fd_Params_participation_window = md_Params.Fields().ByName("participation_window")
+	fd_Params_price_history_length = md_Params.Fields().ByName("price_history_length")
+	fd_Params_max_price_change_bps = md_Params.Fields().ByName("max_price_change_bps")
+	fd_Params_circuit_breaker_mode = md_Params.Fields().ByName("circuit_breaker_mode")
+	fd_Params_max_price_age = md_Params.Fields().ByName("max_price_age")
+	fd_Params_emit_price_events = md_Params.Fields().ByName("emit_price_events")
+	fd_Params_decimals_change_mode = md_Params.Fields().ByName("decimals_change_mode")
+	fd_Params_max_missed_heights = md_Params.Fields().ByName("max_missed_heights")
+	fd_Params_circuit_breaker_reanchor_count = md_Params.Fields().ByName("circuit_breaker_reanchor_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_oracle_v1_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorStatsWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidatorStatsWindow)
+		if !f(fd_Params_validator_stats_window, value) {
+			return
+		}
+	}
+	if x.MaxPriceDeviationBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPriceDeviationBps)
+		if !f(fd_Params_max_price_deviation_bps, value) {
+			return
+		}
+	}
+	if x.ParticipationWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParticipationWindow)
+		if !f(fd_Params_participation_window, value) {
+			return
+		}
+	}
+	if x.PriceHistoryLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PriceHistoryLength)
+		if !f(fd_Params_price_history_length, value) {
+			return
+		}
+	}
+	if x.MaxPriceChangeBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPriceChangeBps)
+		if !f(fd_Params_max_price_change_bps, value) {
+			return
+		}
+	}
+	if x.CircuitBreakerMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.CircuitBreakerMode))
+		if !f(fd_Params_circuit_breaker_mode, value) {
+			return
+		}
+	}
+	if x.MaxPriceAge != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPriceAge)
+		if !f(fd_Params_max_price_age, value) {
+			return
+		}
+	}
+	if x.EmitPriceEvents != false {
+		value := protoreflect.ValueOfBool(x.EmitPriceEvents)
+		if !f(fd_Params_emit_price_events, value) {
+			return
+		}
+	}
+	if x.DecimalsChangeMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.DecimalsChangeMode))
+		if !f(fd_Params_decimals_change_mode, value) {
+			return
+		}
+	}
+	if x.MaxMissedHeights != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxMissedHeights)
+		if !f(fd_Params_max_missed_heights, value) {
+			return
+		}
+	}
+	if x.CircuitBreakerReanchorCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CircuitBreakerReanchorCount)
+		if !f(fd_Params_circuit_breaker_reanchor_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.Params.validator_stats_window":
+		return x.ValidatorStatsWindow != uint64(0)
+	case "slinky.oracle.v1.Params.max_price_deviation_bps":
+		return x.MaxPriceDeviationBps != uint64(0)
+	case "slinky.oracle.v1.Params.participation_window":
+		return x.ParticipationWindow != uint64(0)
+	case "slinky.oracle.v1.Params.price_history_length":
+		return x.PriceHistoryLength != uint64(0)
+	case "slinky.oracle.v1.Params.max_price_change_bps":
+		return x.MaxPriceChangeBps != uint64(0)
+	case "slinky.oracle.v1.Params.circuit_breaker_mode":
+		return x.CircuitBreakerMode != 0
+	case "slinky.oracle.v1.Params.max_price_age":
+		return x.MaxPriceAge != uint64(0)
+	case "slinky.oracle.v1.Params.emit_price_events":
+		return x.EmitPriceEvents != false
+	case "slinky.oracle.v1.Params.decimals_change_mode":
+		return x.DecimalsChangeMode != 0
+	case "slinky.oracle.v1.Params.max_missed_heights":
+		return x.MaxMissedHeights != uint64(0)
+	case "slinky.oracle.v1.Params.circuit_breaker_reanchor_count":
+		return x.CircuitBreakerReanchorCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.Params"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.Params.validator_stats_window":
+		x.ValidatorStatsWindow = uint64(0)
+	case "slinky.oracle.v1.Params.max_price_deviation_bps":
+		x.MaxPriceDeviationBps = uint64(0)
+	case "slinky.oracle.v1.Params.participation_window":
+		x.ParticipationWindow = uint64(0)
+	case "slinky.oracle.v1.Params.price_history_length":
+		x.PriceHistoryLength = uint64(0)
+	case "slinky.oracle.v1.Params.max_price_change_bps":
+		x.MaxPriceChangeBps = uint64(0)
+	case "slinky.oracle.v1.Params.circuit_breaker_mode":
+		x.CircuitBreakerMode = 0
+	case "slinky.oracle.v1.Params.max_price_age":
+		x.MaxPriceAge = uint64(0)
+	case "slinky.oracle.v1.Params.emit_price_events":
+		x.EmitPriceEvents = false
+	case "slinky.oracle.v1.Params.decimals_change_mode":
+		x.DecimalsChangeMode = 0
+	case "slinky.oracle.v1.Params.max_missed_heights":
+		x.MaxMissedHeights = uint64(0)
+	case "slinky.oracle.v1.Params.circuit_breaker_reanchor_count":
+		x.CircuitBreakerReanchorCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.Params"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.oracle.v1.Params.validator_stats_window":
+		value := x.ValidatorStatsWindow
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.Params.max_price_deviation_bps":
+		value := x.MaxPriceDeviationBps
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.Params.participation_window":
+		value := x.ParticipationWindow
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.Params.price_history_length":
+		value := x.PriceHistoryLength
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.Params.max_price_change_bps":
+		value := x.MaxPriceChangeBps
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.Params.circuit_breaker_mode":
+		value := x.CircuitBreakerMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "slinky.oracle.v1.Params.max_price_age":
+		value := x.MaxPriceAge
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.Params.emit_price_events":
+		value := x.EmitPriceEvents
+		return protoreflect.ValueOfBool(value)
+	case "slinky.oracle.v1.Params.decimals_change_mode":
+		value := x.DecimalsChangeMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "slinky.oracle.v1.Params.max_missed_heights":
+		value := x.MaxMissedHeights
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.Params.circuit_breaker_reanchor_count":
+		value := x.CircuitBreakerReanchorCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.Params"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.Params.validator_stats_window":
+		x.ValidatorStatsWindow = value.Uint()
+	case "slinky.oracle.v1.Params.max_price_deviation_bps":
+		x.MaxPriceDeviationBps = value.Uint()
+	case "slinky.oracle.v1.Params.participation_window":
+		x.ParticipationWindow = value.Uint()
+	case "slinky.oracle.v1.Params.price_history_length":
+		x.PriceHistoryLength = value.Uint()
+	case "slinky.oracle.v1.Params.max_price_change_bps":
+		x.MaxPriceChangeBps = value.Uint()
+	case "slinky.oracle.v1.Params.circuit_breaker_mode":
+		x.CircuitBreakerMode = (CircuitBreakerMode)(value.Enum())
+	case "slinky.oracle.v1.Params.max_price_age":
+		x.MaxPriceAge = value.Uint()
+	case "slinky.oracle.v1.Params.emit_price_events":
+		x.EmitPriceEvents = value.Bool()
+	case "slinky.oracle.v1.Params.decimals_change_mode":
+		x.DecimalsChangeMode = (DecimalsChangeMode)(value.Enum())
+	case "slinky.oracle.v1.Params.max_missed_heights":
+		x.MaxMissedHeights = value.Uint()
+	case "slinky.oracle.v1.Params.circuit_breaker_reanchor_count":
+		x.CircuitBreakerReanchorCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.Params"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.Params.validator_stats_window":
+		panic(fmt.Errorf("field validator_stats_window of message slinky.oracle.v1.Params is not mutable"))
+	case "slinky.oracle.v1.Params.max_price_deviation_bps":
+		panic(fmt.Errorf("field max_price_deviation_bps of message slinky.oracle.v1.Params is not mutable"))
+	case "slinky.oracle.v1.Params.participation_window":
+		panic(fmt.Errorf("field participation_window of message slinky.oracle.v1.Params is not mutable"))
+	case "slinky.oracle.v1.Params.price_history_length":
+		panic(fmt.Errorf("field price_history_length of message slinky.oracle.v1.Params is not mutable"))
+	case "slinky.oracle.v1.Params.max_price_change_bps":
+		panic(fmt.Errorf("field max_price_change_bps of message slinky.oracle.v1.Params is not mutable"))
+	case "slinky.oracle.v1.Params.circuit_breaker_mode":
+		panic(fmt.Errorf("field circuit_breaker_mode of message slinky.oracle.v1.Params is not mutable"))
+	case "slinky.oracle.v1.Params.max_price_age":
+		panic(fmt.Errorf("field max_price_age of message slinky.oracle.v1.Params is not mutable"))
+	case "slinky.oracle.v1.Params.emit_price_events":
+		panic(fmt.Errorf("field emit_price_events of message slinky.oracle.v1.Params is not mutable"))
+	case "slinky.oracle.v1.Params.decimals_change_mode":
+		panic(fmt.Errorf("field decimals_change_mode of message slinky.oracle.v1.Params is not mutable"))
+	case "slinky.oracle.v1.Params.max_missed_heights":
+		panic(fmt.Errorf("field max_missed_heights of message slinky.oracle.v1.Params is not mutable"))
+	case "slinky.oracle.v1.Params.circuit_breaker_reanchor_count":
+		panic(fmt.Errorf("field circuit_breaker_reanchor_count of message slinky.oracle.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.Params"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.Params.validator_stats_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.Params.max_price_deviation_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.Params.participation_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.Params.price_history_length":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.Params.max_price_change_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.Params.circuit_breaker_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "slinky.oracle.v1.Params.max_price_age":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.Params.emit_price_events":
+		return protoreflect.ValueOfBool(false)
+	case "slinky.oracle.v1.Params.decimals_change_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "slinky.oracle.v1.Params.max_missed_heights":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.Params.circuit_breaker_reanchor_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.Params"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ValidatorStatsWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorStatsWindow))
+		}
+		if x.MaxPriceDeviationBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPriceDeviationBps))
+		}
+		if x.ParticipationWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParticipationWindow))
+		}
+		if x.PriceHistoryLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.PriceHistoryLength))
+		}
+		if x.MaxPriceChangeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPriceChangeBps))
+		}
+		if x.CircuitBreakerMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.CircuitBreakerMode))
+		}
+		if x.MaxPriceAge != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPriceAge))
+		}
+		if x.EmitPriceEvents {
+			n += 2
+		}
+		if x.DecimalsChangeMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.DecimalsChangeMode))
+		}
+		if x.MaxMissedHeights != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxMissedHeights))
+		}
+		if x.CircuitBreakerReanchorCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.CircuitBreakerReanchorCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CircuitBreakerReanchorCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CircuitBreakerReanchorCount))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.MaxMissedHeights != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMissedHeights))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.DecimalsChangeMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DecimalsChangeMode))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.EmitPriceEvents {
+			i--
+			if x.EmitPriceEvents {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MaxPriceAge != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPriceAge))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.CircuitBreakerMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CircuitBreakerMode))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MaxPriceChangeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPriceChangeBps))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.PriceHistoryLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceHistoryLength))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ParticipationWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParticipationWindow))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxPriceDeviationBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPriceDeviationBps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ValidatorStatsWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorStatsWindow))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorStatsWindow", wireType)
+				}
+				x.ValidatorStatsWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorStatsWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviationBps", wireType)
+				}
+				x.MaxPriceDeviationBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPriceDeviationBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipationWindow", wireType)
+				}
+				x.ParticipationWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParticipationWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryLength", wireType)
+				}
+				x.PriceHistoryLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceHistoryLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChangeBps", wireType)
+				}
+				x.MaxPriceChangeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPriceChangeBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerMode", wireType)
+				}
+				x.CircuitBreakerMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CircuitBreakerMode |= CircuitBreakerMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+				}
+				x.MaxPriceAge = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPriceAge |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmitPriceEvents", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EmitPriceEvents = bool(v != 0)
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecimalsChangeMode", wireType)
+				}
+				x.DecimalsChangeMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DecimalsChangeMode |= DecimalsChangeMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMissedHeights", wireType)
+				}
+				x.MaxMissedHeights = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMissedHeights |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerReanchorCount", wireType)
+				}
+				x.CircuitBreakerReanchorCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CircuitBreakerReanchorCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: slinky/oracle/v1/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CircuitBreakerMode determines how price updates that exceed the maximum price
+// change are handled.
+type CircuitBreakerMode int32
+
+const (
+	// CIRCUIT_BREAKER_MODE_CLAMP clamps the price to the maximum price change.
+	CircuitBreakerMode_CIRCUIT_BREAKER_MODE_CLAMP CircuitBreakerMode = 0
+	// CIRCUIT_BREAKER_MODE_REJECT rejects the price update, the previous price is
+	// kept.
+	CircuitBreakerMode_CIRCUIT_BREAKER_MODE_REJECT CircuitBreakerMode = 1
+)
+
+// Enum value maps for CircuitBreakerMode.
+var (
+	CircuitBreakerMode_name = map[int32]string{
+		0: "CIRCUIT_BREAKER_MODE_CLAMP",
+		1: "CIRCUIT_BREAKER_MODE_REJECT",
+	}
+	CircuitBreakerMode_value = map[string]int32{
+		"CIRCUIT_BREAKER_MODE_CLAMP":  0,
+		"CIRCUIT_BREAKER_MODE_REJECT": 1,
+	}
+)
+
+func (x CircuitBreakerMode) Enum() *CircuitBreakerMode {
+	p := new(CircuitBreakerMode)
+	*p = x
+	return p
+}
+
+func (x CircuitBreakerMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CircuitBreakerMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_slinky_oracle_v1_params_proto_enumTypes[0].Descriptor()
+}
+
+func (CircuitBreakerMode) Type() protoreflect.EnumType {
+	return &file_slinky_oracle_v1_params_proto_enumTypes[0]
+}
+
+func (x CircuitBreakerMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CircuitBreakerMode.Descriptor instead.
+func (CircuitBreakerMode) EnumDescriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+// DecimalsChangeMode determines how the latest price of a currency pair is
+// handled when the decimals of its market are changed.
+type DecimalsChangeMode int32
+
+const (
+	// DECIMALS_CHANGE_MODE_RESCALE rescales the price to the new decimals. Prices
+	// that are rescaled to fewer decimals are truncated.
+	DecimalsChangeMode_DECIMALS_CHANGE_MODE_RESCALE DecimalsChangeMode = 0
+	// DECIMALS_CHANGE_MODE_INVALIDATE removes the price, until a price is written
+	// with the new decimals.
+	DecimalsChangeMode_DECIMALS_CHANGE_MODE_INVALIDATE DecimalsChangeMode = 1
+)
+
+// Enum value maps for DecimalsChangeMode.
+var (
+	DecimalsChangeMode_name = map[int32]string{
+		0: "DECIMALS_CHANGE_MODE_RESCALE",
+		1: "DECIMALS_CHANGE_MODE_INVALIDATE",
+	}
+	DecimalsChangeMode_value = map[string]int32{
+		"DECIMALS_CHANGE_MODE_RESCALE":    0,
+		"DECIMALS_CHANGE_MODE_INVALIDATE": 1,
+	}
+)
+
+func (x DecimalsChangeMode) Enum() *DecimalsChangeMode {
+	p := new(DecimalsChangeMode)
+	*p = x
+	return p
+}
+
+func (x DecimalsChangeMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DecimalsChangeMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_slinky_oracle_v1_params_proto_enumTypes[1].Descriptor()
+}
+
+func (DecimalsChangeMode) Type() protoreflect.EnumType {
+	return &file_slinky_oracle_v1_params_proto_enumTypes[1]
+}
+
+func (x DecimalsChangeMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DecimalsChangeMode.Descriptor instead.
+func (DecimalsChangeMode) EnumDescriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_params_proto_rawDescGZIP(), []int{1}
+}
+
+// Params defines the parameters for the x/oracle module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ValidatorStatsWindow is the number of most recent blocks over which the
+	// miss and deviation counters of each validator are accumulated. The window
+	// slides in buckets of at most 1% of the window. A window of zero disables
+	// the tracking of validator price statistics.
+	ValidatorStatsWindow uint64 `protobuf:"varint,1,opt,name=validator_stats_window,json=validatorStatsWindow,proto3" json:"validator_stats_window,omitempty"`
+	// MaxPriceDeviationBps is the maximum deviation (in basis points) of the
+	// price reported by a validator from the aggregated price of a currency
+	// pair. Reported prices that deviate further are counted as deviations.
+	MaxPriceDeviationBps uint64 `protobuf:"varint,2,opt,name=max_price_deviation_bps,json=maxPriceDeviationBps,proto3" json:"max_price_deviation_bps,omitempty"`
+	// ParticipationWindow is the number of most recent heights for which it is
+	// tracked whether each validator included a valid oracle vote extension. A
+	// window of zero disables the tracking of validator oracle participation.
+	ParticipationWindow uint64 `protobuf:"varint,3,opt,name=participation_window,json=participationWindow,proto3" json:"participation_window,omitempty"`
+	// PriceHistoryLength is the number of most recent prices that are stored per
+	// currency pair, in addition to its latest price. Reducing the length prunes
+	// the stored prices, and a length of zero disables the storage of historical
+	// prices.
+	PriceHistoryLength uint64 `protobuf:"varint,4,opt,name=price_history_length,json=priceHistoryLength,proto3" json:"price_history_length,omitempty"`
+	// MaxPriceChangeBps is the maximum change (in basis points) of the price of
+	// a currency pair from one update to the next. Updates that exceed it trip
+	// the circuit breaker of the currency pair. A maximum of zero disables the
+	// circuit breaker. If the circuit breaker is enabled, it can be overridden
+	// per market by the max_price_change_bps field of the ticker metadata in
+	// x/marketmap.
+	MaxPriceChangeBps uint64 `protobuf:"varint,5,opt,name=max_price_change_bps,json=maxPriceChangeBps,proto3" json:"max_price_change_bps,omitempty"`
+	// CircuitBreakerMode determines how price updates that trip the circuit
+	// breaker are handled.
+	CircuitBreakerMode CircuitBreakerMode `protobuf:"varint,6,opt,name=circuit_breaker_mode,json=circuitBreakerMode,proto3,enum=slinky.oracle.v1.CircuitBreakerMode" json:"circuit_breaker_mode,omitempty"`
+	// MaxPriceAge is the number of blocks after which the price of a currency
+	// pair that has not been updated is considered stale. A maximum age of zero
+	// disables the staleness guard.
+	MaxPriceAge uint64 `protobuf:"varint,7,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
+	// EmitPriceEvents determines whether an event is emitted for every price of a
+	// currency pair that is updated, or missed, while applying the oracle vote
+	// extensions of a block. See EventPriceUpdated and EventPriceMissed.
+	EmitPriceEvents bool `protobuf:"varint,8,opt,name=emit_price_events,json=emitPriceEvents,proto3" json:"emit_price_events,omitempty"`
+	// DecimalsChangeMode determines how the latest price of a currency pair is
+	// handled when the decimals of its market are changed in x/marketmap.
+	DecimalsChangeMode DecimalsChangeMode `protobuf:"varint,9,opt,name=decimals_change_mode,json=decimalsChangeMode,proto3,enum=slinky.oracle.v1.DecimalsChangeMode" json:"decimals_change_mode,omitempty"`
+	// MaxMissedHeights is the number of consecutive heights a currency pair may
+	// miss a price for, before its market is disabled in x/marketmap. Disabled
+	// markets must be re-enabled by a market authority. Markets that enabled
+	// markets are normalized by or derived from are not disabled. A maximum of
+	// zero disables the automatic disabling of markets.
+	MaxMissedHeights uint64 `protobuf:"varint,10,opt,name=max_missed_heights,json=maxMissedHeights,proto3" json:"max_missed_heights,omitempty"`
+	// CircuitBreakerReanchorCount is the number of consecutive price updates of
+	// a currency pair that the circuit breaker rejects in the reject mode, each
+	// within the maximum price change of the previously rejected price, after
+	// which the latest of them is accepted as the new price of the currency
+	// pair. It must be positive in the reject mode, so that a currency pair
+	// whose price moved for good is not halted forever.
+	CircuitBreakerReanchorCount uint64 `protobuf:"varint,11,opt,name=circuit_breaker_reanchor_count,json=circuitBreakerReanchorCount,proto3" json:"circuit_breaker_reanchor_count,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_oracle_v1_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetValidatorStatsWindow() uint64 {
+	if x != nil {
+		return x.ValidatorStatsWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPriceDeviationBps() uint64 {
+	if x != nil {
+		return x.MaxPriceDeviationBps
+	}
+	return 0
+}
+
+func (x *Params) GetParticipationWindow() uint64 {
+	if x != nil {
+		return x.ParticipationWindow
+	}
+	return 0
+}
+
+func (x *Params) GetPriceHistoryLength() uint64 {
+	if x != nil {
+		return x.PriceHistoryLength
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPriceChangeBps() uint64 {
+	if x != nil {
+		return x.MaxPriceChangeBps
+	}
+	return 0
+}
+
+func (x *Params) GetCircuitBreakerMode() CircuitBreakerMode {
+	if x != nil {
+		return x.CircuitBreakerMode
+	}
+	return CircuitBreakerMode_CIRCUIT_BREAKER_MODE_CLAMP
+}
+
+func (x *Params) GetMaxPriceAge() uint64 {
+	if x != nil {
+		return x.MaxPriceAge
+	}
+	return 0
+}
+
+func (x *Params) GetEmitPriceEvents() bool {
+	if x != nil {
+		return x.EmitPriceEvents
+	}
+	return false
+}
+
+func (x *Params) GetDecimalsChangeMode() DecimalsChangeMode {
+	if x != nil {
+		return x.DecimalsChangeMode
+	}
+	return DecimalsChangeMode_DECIMALS_CHANGE_MODE_RESCALE
+}
+
+func (x *Params) GetMaxMissedHeights() uint64 {
+	if x != nil {
+		return x.MaxMissedHeights
+	}
+	return 0
+}
+
+func (x *Params) GetCircuitBreakerReanchorCount() uint64 {
+	if x != nil {
+		return x.CircuitBreakerReanchorCount
+	}
+	return 0
+}
+
+var File_slinky_oracle_v1_params_proto protoreflect.FileDescriptor
+
+var file_slinky_oracle_v1_params_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x10, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x22, 0xfe, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x34, 0x0a, 0x16,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x44, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x30, 0x0a, 0x14,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2f,
+	0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61,
+	0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x70, 0x73, 0x12,
+	0x56, 0x0a, 0x14, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x12, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x65,
+	0x6d, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x56, 0x0a, 0x14, 0x64, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x12, 0x64, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78,
+	0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x43, 0x0a,
+	0x1e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1b, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x2a, 0x55, 0x0a, 0x12, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x49, 0x52, 0x43,
+	0x55, 0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x43, 0x4c, 0x41, 0x4d, 0x50, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x49, 0x52, 0x43,
+	0x55, 0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x01, 0x2a, 0x5b, 0x0a, 0x12, 0x44, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x20, 0x0a, 0x1c, 0x44, 0x45, 0x43, 0x49, 0x4d, 0x41, 0x4c, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x43, 0x41, 0x4c, 0x45, 0x10,
+	0x00, 0x12, 0x23, 0x0a, 0x1f, 0x44, 0x45, 0x43, 0x49, 0x4d, 0x41, 0x4c, 0x53, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x42, 0xb1, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x73,
+	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42,
+	0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76,
+	0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4f, 0x58,
+	0xaa, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a, 0x3a,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_slinky_oracle_v1_params_proto_rawDescOnce sync.Once
+	file_slinky_oracle_v1_params_proto_rawDescData = file_slinky_oracle_v1_params_proto_rawDesc
+)
+
+func file_slinky_oracle_v1_params_proto_rawDescGZIP() []byte {
+	file_slinky_oracle_v1_params_proto_rawDescOnce.Do(func() {
+		file_slinky_oracle_v1_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_slinky_oracle_v1_params_proto_rawDescData)
+	})
+	return file_slinky_oracle_v1_params_proto_rawDescData
+}
+
+var file_slinky_oracle_v1_params_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_slinky_oracle_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_slinky_oracle_v1_params_proto_goTypes = []interface{}{
+	(CircuitBreakerMode)(0), // 0: slinky.oracle.v1.CircuitBreakerMode
+	(DecimalsChangeMode)(0), // 1: slinky.oracle.v1.DecimalsChangeMode
+	(*Params)(nil),          // 2: slinky.oracle.v1.Params
+}
+var file_slinky_oracle_v1_params_proto_depIdxs = []int32{
+	0, // 0: slinky.oracle.v1.Params.circuit_breaker_mode:type_name -> slinky.oracle.v1.CircuitBreakerMode
+	1, // 1: slinky.oracle.v1.Params.decimals_change_mode:type_name -> slinky.oracle.v1.DecimalsChangeMode
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_slinky_oracle_v1_params_proto_init() }
+func file_slinky_oracle_v1_params_proto_init() {
+	if File_slinky_oracle_v1_params_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_slinky_oracle_v1_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_slinky_oracle_v1_params_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_slinky_oracle_v1_params_proto_goTypes,
+		DependencyIndexes: file_slinky_oracle_v1_params_proto_depIdxs,
+		EnumInfos:         file_slinky_oracle_v1_params_proto_enumTypes,
+		MessageInfos:      file_slinky_oracle_v1_params_proto_msgTypes,
+	}.Build()
+	File_slinky_oracle_v1_params_proto = out.File
+	file_slinky_oracle_v1_params_proto_rawDesc = nil
+	file_slinky_oracle_v1_params_proto_goTypes = nil
+	file_slinky_oracle_v1_params_proto_depIdxs = nil
+}
@@ -19,10 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_GetAllCurrencyPairs_FullMethodName    = "/slinky.oracle.v1.Query/GetAllCurrencyPairs"
-	Query_GetPrice_FullMethodName               = "/slinky.oracle.v1.Query/GetPrice"
-	Query_GetPrices_FullMethodName              = "/slinky.oracle.v1.Query/GetPrices"
-	Query_GetCurrencyPairMapping_FullMethodName = "/slinky.oracle.v1.Query/GetCurrencyPairMapping"
+	Query_GetAllCurrencyPairs_FullMethodName        = "/slinky.oracle.v1.Query/GetAllCurrencyPairs"
+	Query_GetPrice_FullMethodName                   = "/slinky.oracle.v1.Query/GetPrice"
+	Query_GetPrices_FullMethodName                  = "/slinky.oracle.v1.Query/GetPrices"
+	Query_GetAllPrices_FullMethodName               = "/slinky.oracle.v1.Query/GetAllPrices"
+	Query_GetCurrencyPairMapping_FullMethodName     = "/slinky.oracle.v1.Query/GetCurrencyPairMapping"
+	Query_GetPriceHistory_FullMethodName            = "/slinky.oracle.v1.Query/GetPriceHistory"
+	Query_GetMarketStatus_FullMethodName            = "/slinky.oracle.v1.Query/GetMarketStatus"
+	Query_GetValidatorPriceStats_FullMethodName     = "/slinky.oracle.v1.Query/GetValidatorPriceStats"
+	Query_GetAllValidatorPriceStats_FullMethodName  = "/slinky.oracle.v1.Query/GetAllValidatorPriceStats"
+	Query_GetValidatorOracleStats_FullMethodName    = "/slinky.oracle.v1.Query/GetValidatorOracleStats"
+	Query_GetAllValidatorOracleStats_FullMethodName = "/slinky.oracle.v1.Query/GetAllValidatorOracleStats"
+	Query_GetParams_FullMethodName                  = "/slinky.oracle.v1.Query/GetParams"
 )
 
 // QueryClient is the client API for Query service.
@@ -37,10 +45,35 @@ type QueryClient interface {
 	// that CurrencyPair.
 	GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error)
 	GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error)
+	// Get the latest QuotePrice of all the currency pairs that have a price,
+	// paginated by currency pair.
+	GetAllPrices(ctx context.Context, in *GetAllPricesRequest, opts ...grpc.CallOption) (*GetAllPricesResponse, error)
 	// Get the mapping of currency pair ID -> currency pair. This is useful for
 	// indexers that have access to the ID of a currency pair, but no way to get
 	// the underlying currency pair from it.
 	GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error)
+	// Given a CurrencyPair, return its most recent historical prices within the
+	// given height or time range.
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair, return whether its price is active, stale or halted
+	// by the circuit breaker.
+	GetMarketStatus(ctx context.Context, in *GetMarketStatusRequest, opts ...grpc.CallOption) (*GetMarketStatusResponse, error)
+	// Given the consensus address of a validator, return the number of
+	// aggregated prices the validator did not report a price for, or reported a
+	// price that deviated too far from, within the current window.
+	GetValidatorPriceStats(ctx context.Context, in *GetValidatorPriceStatsRequest, opts ...grpc.CallOption) (*GetValidatorPriceStatsResponse, error)
+	// Get the miss and deviation counters of all validators within the current
+	// window.
+	GetAllValidatorPriceStats(ctx context.Context, in *GetAllValidatorPriceStatsRequest, opts ...grpc.CallOption) (*GetAllValidatorPriceStatsResponse, error)
+	// Given the consensus address of a validator, return whether the validator
+	// included a valid oracle vote extension at each of the most recent heights
+	// within the participation window.
+	GetValidatorOracleStats(ctx context.Context, in *GetValidatorOracleStatsRequest, opts ...grpc.CallOption) (*GetValidatorOracleStatsResponse, error)
+	// Get the oracle participation of all validators within the participation
+	// window.
+	GetAllValidatorOracleStats(ctx context.Context, in *GetAllValidatorOracleStatsRequest, opts ...grpc.CallOption) (*GetAllValidatorOracleStatsResponse, error)
+	// Get the current x/oracle module parameters.
+	GetParams(ctx context.Context, in *GetParamsRequest, opts ...grpc.CallOption) (*GetParamsResponse, error)
 }
 
 type queryClient struct {
@@ -81,6 +114,16 @@ func (c *queryClient) GetPrices(ctx context.Context, in *GetPricesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) GetAllPrices(ctx context.Context, in *GetAllPricesRequest, opts ...grpc.CallOption) (*GetAllPricesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllPricesResponse)
+	err := c.cc.Invoke(ctx, Query_GetAllPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCurrencyPairMappingResponse)
@@ -91,6 +134,76 @@ func (c *queryClient) GetCurrencyPairMapping(ctx context.Context, in *GetCurrenc
 	return out, nil
 }
 
+func (c *queryClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetMarketStatus(ctx context.Context, in *GetMarketStatusRequest, opts ...grpc.CallOption) (*GetMarketStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarketStatusResponse)
+	err := c.cc.Invoke(ctx, Query_GetMarketStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetValidatorPriceStats(ctx context.Context, in *GetValidatorPriceStatsRequest, opts ...grpc.CallOption) (*GetValidatorPriceStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetValidatorPriceStatsResponse)
+	err := c.cc.Invoke(ctx, Query_GetValidatorPriceStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAllValidatorPriceStats(ctx context.Context, in *GetAllValidatorPriceStatsRequest, opts ...grpc.CallOption) (*GetAllValidatorPriceStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllValidatorPriceStatsResponse)
+	err := c.cc.Invoke(ctx, Query_GetAllValidatorPriceStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetValidatorOracleStats(ctx context.Context, in *GetValidatorOracleStatsRequest, opts ...grpc.CallOption) (*GetValidatorOracleStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetValidatorOracleStatsResponse)
+	err := c.cc.Invoke(ctx, Query_GetValidatorOracleStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAllValidatorOracleStats(ctx context.Context, in *GetAllValidatorOracleStatsRequest, opts ...grpc.CallOption) (*GetAllValidatorOracleStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllValidatorOracleStatsResponse)
+	err := c.cc.Invoke(ctx, Query_GetAllValidatorOracleStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetParams(ctx context.Context, in *GetParamsRequest, opts ...grpc.CallOption) (*GetParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetParamsResponse)
+	err := c.cc.Invoke(ctx, Query_GetParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -103,10 +216,35 @@ type QueryServer interface {
 	// that CurrencyPair.
 	GetPrice(context.Context, *GetPriceRequest) (*GetPriceResponse, error)
 	GetPrices(context.Context, *GetPricesRequest) (*GetPricesResponse, error)
+	// Get the latest QuotePrice of all the currency pairs that have a price,
+	// paginated by currency pair.
+	GetAllPrices(context.Context, *GetAllPricesRequest) (*GetAllPricesResponse, error)
 	// Get the mapping of currency pair ID -> currency pair. This is useful for
 	// indexers that have access to the ID of a currency pair, but no way to get
 	// the underlying currency pair from it.
 	GetCurrencyPairMapping(context.Context, *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error)
+	// Given a CurrencyPair, return its most recent historical prices within the
+	// given height or time range.
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair, return whether its price is active, stale or halted
+	// by the circuit breaker.
+	GetMarketStatus(context.Context, *GetMarketStatusRequest) (*GetMarketStatusResponse, error)
+	// Given the consensus address of a validator, return the number of
+	// aggregated prices the validator did not report a price for, or reported a
+	// price that deviated too far from, within the current window.
+	GetValidatorPriceStats(context.Context, *GetValidatorPriceStatsRequest) (*GetValidatorPriceStatsResponse, error)
+	// Get the miss and deviation counters of all validators within the current
+	// window.
+	GetAllValidatorPriceStats(context.Context, *GetAllValidatorPriceStatsRequest) (*GetAllValidatorPriceStatsResponse, error)
+	// Given the consensus address of a validator, return whether the validator
+	// included a valid oracle vote extension at each of the most recent heights
+	// within the participation window.
+	GetValidatorOracleStats(context.Context, *GetValidatorOracleStatsRequest) (*GetValidatorOracleStatsResponse, error)
+	// Get the oracle participation of all validators within the participation
+	// window.
+	GetAllValidatorOracleStats(context.Context, *GetAllValidatorOracleStatsRequest) (*GetAllValidatorOracleStatsResponse, error)
+	// Get the current x/oracle module parameters.
+	GetParams(context.Context, *GetParamsRequest) (*GetParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -126,9 +264,33 @@ func (UnimplementedQueryServer) GetPrice(context.Context, *GetPriceRequest) (*Ge
 func (UnimplementedQueryServer) GetPrices(context.Context, *GetPricesRequest) (*GetPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPrices not implemented")
 }
+func (UnimplementedQueryServer) GetAllPrices(context.Context, *GetAllPricesRequest) (*GetAllPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPrices not implemented")
+}
 func (UnimplementedQueryServer) GetCurrencyPairMapping(context.Context, *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMapping not implemented")
 }
+func (UnimplementedQueryServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedQueryServer) GetMarketStatus(context.Context, *GetMarketStatusRequest) (*GetMarketStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketStatus not implemented")
+}
+func (UnimplementedQueryServer) GetValidatorPriceStats(context.Context, *GetValidatorPriceStatsRequest) (*GetValidatorPriceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPriceStats not implemented")
+}
+func (UnimplementedQueryServer) GetAllValidatorPriceStats(context.Context, *GetAllValidatorPriceStatsRequest) (*GetAllValidatorPriceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllValidatorPriceStats not implemented")
+}
+func (UnimplementedQueryServer) GetValidatorOracleStats(context.Context, *GetValidatorOracleStatsRequest) (*GetValidatorOracleStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorOracleStats not implemented")
+}
+func (UnimplementedQueryServer) GetAllValidatorOracleStats(context.Context, *GetAllValidatorOracleStatsRequest) (*GetAllValidatorOracleStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllValidatorOracleStats not implemented")
+}
+func (UnimplementedQueryServer) GetParams(context.Context, *GetParamsRequest) (*GetParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParams not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -204,6 +366,24 @@ func _Query_GetPrices_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetAllPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetAllPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllPrices(ctx, req.(*GetAllPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetCurrencyPairMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCurrencyPairMappingRequest)
 	if err := dec(in); err != nil {
@@ -222,6 +402,132 @@ func _Query_GetCurrencyPairMapping_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMarketStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetMarketStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketStatus(ctx, req.(*GetMarketStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetValidatorPriceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorPriceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetValidatorPriceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetValidatorPriceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetValidatorPriceStats(ctx, req.(*GetValidatorPriceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAllValidatorPriceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllValidatorPriceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllValidatorPriceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetAllValidatorPriceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllValidatorPriceStats(ctx, req.(*GetAllValidatorPriceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetValidatorOracleStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorOracleStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetValidatorOracleStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetValidatorOracleStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetValidatorOracleStats(ctx, req.(*GetValidatorOracleStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAllValidatorOracleStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllValidatorOracleStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllValidatorOracleStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetAllValidatorOracleStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllValidatorOracleStats(ctx, req.(*GetAllValidatorOracleStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetParams(ctx, req.(*GetParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,10 +547,42 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPrices",
 			Handler:    _Query_GetPrices_Handler,
 		},
+		{
+			MethodName: "GetAllPrices",
+			Handler:    _Query_GetAllPrices_Handler,
+		},
 		{
 			MethodName: "GetCurrencyPairMapping",
 			Handler:    _Query_GetCurrencyPairMapping_Handler,
 		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _Query_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetMarketStatus",
+			Handler:    _Query_GetMarketStatus_Handler,
+		},
+		{
+			MethodName: "GetValidatorPriceStats",
+			Handler:    _Query_GetValidatorPriceStats_Handler,
+		},
+		{
+			MethodName: "GetAllValidatorPriceStats",
+			Handler:    _Query_GetAllValidatorPriceStats_Handler,
+		},
+		{
+			MethodName: "GetValidatorOracleStats",
+			Handler:    _Query_GetValidatorOracleStats_Handler,
+		},
+		{
+			MethodName: "GetAllValidatorOracleStats",
+			Handler:    _Query_GetAllValidatorOracleStats_Handler,
+		},
+		{
+			MethodName: "GetParams",
+			Handler:    _Query_GetParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/oracle/v1/query.proto",
@@ -350,6 +350,120 @@ func TestReadOracleConfigWithOverrides(t *testing.T) {
 		require.Equal(t, expectedConfig.Metrics.PrometheusServerAddress, cfg.Metrics.PrometheusServerAddress)
 	})
 
+	t.Run("adding a generic provider via config", func(t *testing.T) {
+		// create a temp file in the current directory
+		tmpfile, err := os.CreateTemp("", "slinky-config-*.json")
+		require.NoError(t, err)
+
+		defer os.Remove(tmpfile.Name())
+
+		tmpfile.Write([]byte(`
+		{
+			"providers": {
+				"regional_api": {
+					"name": "regional_api",
+					"type": "price_provider",
+					"api": {
+						"name": "regional_api",
+						"enabled": true,
+						"timeout": "3s",
+						"interval": "1s",
+						"reconnectTimeout": "2s",
+						"maxQueries": 1,
+						"atomic": true,
+						"endpoints": [
+							{
+								"url": "https://api.regional.com/tickers?symbols={tickers}"
+							}
+						],
+						"generic": {
+							"batchMode": "list",
+							"results": "$.data",
+							"ticker": "$.symbol",
+							"price": "$.last"
+						}
+					}
+				}
+			}
+		}
+		`))
+
+		cfg, err := cmdconfig.ReadOracleConfigWithOverrides(tmpfile.Name(), marketmap.Name)
+		require.NoError(t, err)
+
+		provider, ok := cfg.Providers["regional_api"]
+		require.True(t, ok)
+		require.Equal(t, &oracleconfig.GenericAPIConfig{
+			BatchMode: oracleconfig.BatchModeList,
+			Results:   "$.data",
+			Ticker:    "$.symbol",
+			Price:     "$.last",
+		}, provider.API.Generic)
+
+		// Providers that are not generic are not affected.
+		require.Equal(t, cmdconfig.DefaultOracleConfig().Providers[raydium.Name].API, cfg.Providers[raydium.Name].API)
+	})
+
+	t.Run("adding a generic websocket provider via config", func(t *testing.T) {
+		// create a temp file in the current directory
+		tmpfile, err := os.CreateTemp("", "slinky-config-*.json")
+		require.NoError(t, err)
+
+		defer os.Remove(tmpfile.Name())
+
+		tmpfile.Write([]byte(`
+		{
+			"providers": {
+				"regional_ws": {
+					"name": "regional_ws",
+					"type": "price_provider",
+					"webSocket": {
+						"name": "regional_ws",
+						"enabled": true,
+						"maxBufferSize": 1024,
+						"reconnectionTimeout": "10s",
+						"endpoints": [
+							{
+								"url": "wss://ws.regional.com"
+							}
+						],
+						"handshakeTimeout": "10s",
+						"readTimeout": "10s",
+						"writeTimeout": "5s",
+						"pingInterval": "15s",
+						"writeInterval": "100ms",
+						"maxSubscriptionsPerBatch": 10,
+						"generic": {
+							"subscribeMessage": "{\"op\":\"subscribe\",\"args\":{tickers}}",
+							"heartbeatMessage": "{\"op\":\"ping\"}",
+							"channel": "$.channel",
+							"channelValue": "tickers",
+							"results": "$.data",
+							"ticker": "$.symbol",
+							"price": "$.last"
+						}
+					}
+				}
+			}
+		}
+		`))
+
+		cfg, err := cmdconfig.ReadOracleConfigWithOverrides(tmpfile.Name(), marketmap.Name)
+		require.NoError(t, err)
+
+		provider, ok := cfg.Providers["regional_ws"]
+		require.True(t, ok)
+		require.Equal(t, &oracleconfig.GenericWebSocketConfig{
+			SubscribeMessage: `{"op":"subscribe","args":{tickers}}`,
+			HeartbeatMessage: `{"op":"ping"}`,
+			Channel:          "$.channel",
+			ChannelValue:     "tickers",
+			Results:          "$.data",
+			Ticker:           "$.symbol",
+			Price:            "$.last",
+		}, provider.WebSocket.Generic)
+	})
+
 	t.Run("overriding a nonexistent provider via config fails", func(t *testing.T) {
 		// create a temp file in the current directory
 		tmpfile, err := os.CreateTemp("", "slinky-config-*.json")
@@ -45,6 +45,11 @@ type APIConfig struct {
 	// block height incremented.  In the case where a data source has exceeded this limit and the block
 	// height is not increasing, price reporting will be skipped until the block height increases.
 	MaxBlockHeightAge time.Duration `json:"maxBlockHeightAge"`
+
+	// Generic is the declarative configuration of a generic REST API price provider. If set,
+	// the provider is created from this configuration, rather than from a provider
+	// implementation with the same name.
+	Generic *GenericAPIConfig `json:"generic"`
 }
 
 // Endpoint holds all data necessary for an API provider to connect to a given endpoint
@@ -132,5 +137,11 @@ func (c *APIConfig) ValidateBasic() error {
 		return fmt.Errorf("max_block_height_age cannot be negative")
 	}
 
+	if c.Generic != nil {
+		if err := c.Generic.ValidateBasic(*c); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
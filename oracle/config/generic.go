@@ -0,0 +1,292 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/skip-mev/connect/v2/pkg/json"
+)
+
+const (
+	// TickerPlaceholder is the placeholder in the URL template of a generic API provider, or the
+	// message templates of a generic websocket provider, that is replaced with the off-chain
+	// ticker of a single ticker.
+	TickerPlaceholder = "{ticker}"
+
+	// TickersPlaceholder is the placeholder in the URL template of a generic API provider that is
+	// replaced with the off-chain tickers of a batch of tickers, joined by the separator. In the
+	// message templates of a generic websocket provider, it is replaced with a JSON array of the
+	// off-chain tickers of a batch of tickers.
+	TickersPlaceholder = "{tickers}"
+
+	// DefaultTickersSeparator is the default separator between the tickers of a batch.
+	DefaultTickersSeparator = ","
+)
+
+// BatchMode determines how a generic API provider requests the prices of multiple tickers.
+type BatchMode string
+
+const (
+	// BatchModeSingle requests the price of a single ticker per request. The URL template must
+	// contain the {ticker} placeholder.
+	BatchModeSingle BatchMode = "single"
+
+	// BatchModeList requests the prices of a batch of tickers per request. The URL template must
+	// contain the {tickers} placeholder.
+	BatchModeList BatchMode = "list"
+
+	// BatchModeAll requests the prices of all tickers that the API supports in a single request.
+	// The URL template must not contain a placeholder.
+	BatchModeAll BatchMode = "all"
+)
+
+// TimestampUnit is the unit of the numeric timestamps returned by a generic provider.
+type TimestampUnit string
+
+const (
+	// TimestampUnitSeconds indicates that timestamps are in seconds since the unix epoch.
+	TimestampUnitSeconds TimestampUnit = "s"
+
+	// TimestampUnitMilliseconds indicates that timestamps are in milliseconds since the unix epoch.
+	TimestampUnitMilliseconds TimestampUnit = "ms"
+
+	// TimestampUnitNanoseconds indicates that timestamps are in nanoseconds since the unix epoch.
+	TimestampUnitNanoseconds TimestampUnit = "ns"
+)
+
+// ResultsType is the type of the results that the results path of a generic websocket provider
+// selects in a message.
+type ResultsType string
+
+const (
+	// ResultsTypeArray indicates that the results are an array of results.
+	ResultsTypeArray ResultsType = "array"
+
+	// ResultsTypeObject indicates that the results are an object whose values are the results,
+	// keyed by their off-chain tickers.
+	ResultsTypeObject ResultsType = "object"
+)
+
+// GenericAPIConfig defines the declarative configuration of a generic REST API price provider.
+// The URL of each endpoint of the API config is used as the URL template of the requests. All
+// paths are JSONPath-style expressions, as supported by the pkg/json package.
+type GenericAPIConfig struct {
+	// BatchMode determines how the prices of multiple tickers are requested, i.e. whether the
+	// URL template contains the {ticker} placeholder, the {tickers} placeholder, or neither.
+	BatchMode BatchMode `json:"batchMode"`
+
+	// Separator is the separator between the tickers that replace the {tickers} placeholder.
+	// This defaults to a comma.
+	Separator string `json:"separator"`
+
+	// Results is the path of the results in the response. This must select an array of results,
+	// or an object whose values are the results. If empty, the response is a single result.
+	Results string `json:"results"`
+
+	// Ticker is the path of the off-chain ticker in a result. If empty, the ticker of a result
+	// is its key if the results are an object, or the requested ticker if a single ticker is
+	// requested.
+	Ticker string `json:"ticker"`
+
+	// Price is the path of the price in a result. The price may be a string or a number.
+	Price string `json:"price"`
+
+	// Timestamp is the path of the timestamp of the price in a result. The timestamp may be a
+	// number in the configured unit, or an RFC 3339 string. If empty, the time at which the
+	// response is received is used.
+	Timestamp string `json:"timestamp"`
+
+	// TimestampUnit is the unit of numeric timestamps. This defaults to seconds.
+	TimestampUnit TimestampUnit `json:"timestampUnit"`
+}
+
+// ValidateBasic performs basic validation of the generic API config, given the API config that
+// it belongs to.
+func (c *GenericAPIConfig) ValidateBasic(api APIConfig) error {
+	var placeholder string
+	switch c.BatchMode {
+	case BatchModeSingle:
+		if api.Atomic || api.BatchSize > 1 {
+			return fmt.Errorf("generic api with batch mode %s cannot be atomic or have a batch size", c.BatchMode)
+		}
+
+		placeholder = TickerPlaceholder
+	case BatchModeList:
+		placeholder = TickersPlaceholder
+	case BatchModeAll:
+		if !api.Atomic {
+			return fmt.Errorf("generic api with batch mode %s must be atomic", c.BatchMode)
+		}
+	default:
+		return fmt.Errorf("invalid generic api batch mode %q", c.BatchMode)
+	}
+
+	for i, endpoint := range api.Endpoints {
+		hasTicker := strings.Contains(endpoint.URL, TickerPlaceholder)
+		hasTickers := strings.Contains(endpoint.URL, TickersPlaceholder)
+
+		switch placeholder {
+		case TickerPlaceholder:
+			if !hasTicker || hasTickers {
+				return fmt.Errorf("endpoint %d url must contain the %s placeholder", i, TickerPlaceholder)
+			}
+		case TickersPlaceholder:
+			if !hasTickers || hasTicker {
+				return fmt.Errorf("endpoint %d url must contain the %s placeholder", i, TickersPlaceholder)
+			}
+		default:
+			if hasTicker || hasTickers {
+				return fmt.Errorf("endpoint %d url cannot contain a placeholder with batch mode %s", i, c.BatchMode)
+			}
+		}
+	}
+
+	if len(c.Results) == 0 && c.BatchMode != BatchModeSingle {
+		return fmt.Errorf("generic api results path is required with batch mode %s", c.BatchMode)
+	}
+
+	if len(c.Price) == 0 {
+		return fmt.Errorf("generic api price path cannot be empty")
+	}
+
+	for _, path := range []string{c.Results, c.Ticker, c.Price, c.Timestamp} {
+		if len(path) == 0 {
+			continue
+		}
+
+		if _, err := json.ParsePath(path); err != nil {
+			return fmt.Errorf("invalid generic api path: %w", err)
+		}
+	}
+
+	return validateTimestampUnit(c.TimestampUnit)
+}
+
+// GenericWebSocketConfig defines the declarative configuration of a generic websocket price
+// provider. The message templates are sent as is, after their placeholders are replaced. All
+// paths are JSONPath-style expressions, as supported by the pkg/json package.
+type GenericWebSocketConfig struct {
+	// SubscribeMessage is the template of the messages that subscribe to the tickers. If it
+	// contains the {ticker} placeholder, a message is sent per ticker. If it contains the
+	// {tickers} placeholder, a message is sent per batch of up to MaxSubscriptionsPerBatch
+	// tickers.
+	SubscribeMessage string `json:"subscribeMessage"`
+
+	// UnsubscribeMessage is the template of the messages that unsubscribe from the tickers, with
+	// the same placeholders as the subscribe message. If empty, the connection is restarted to
+	// unsubscribe from tickers instead.
+	UnsubscribeMessage string `json:"unsubscribeMessage"`
+
+	// HeartbeatMessage is the message that is sent every ping interval of the websocket config
+	// to keep the connection alive. If empty, no heartbeat messages are sent.
+	HeartbeatMessage string `json:"heartbeatMessage"`
+
+	// Channel is the path of the channel in a message. If set, only the messages whose channel
+	// is equal to ChannelValue are parsed for prices, and all other messages are ignored.
+	Channel string `json:"channel"`
+
+	// ChannelValue is the channel of the messages that contain prices.
+	ChannelValue string `json:"channelValue"`
+
+	// Results is the path of the results in a message. This must select an array of results,
+	// or an object whose values are the results. If empty, the message is a single result.
+	Results string `json:"results"`
+
+	// ResultsType is the type of the results that the results path selects. If set, messages
+	// whose results are of another type are rejected.
+	ResultsType ResultsType `json:"resultsType"`
+
+	// Ticker is the path of the off-chain ticker in a result. If empty, the ticker of a result
+	// is its key, which requires the results type to be an object.
+	Ticker string `json:"ticker"`
+
+	// Price is the path of the price in a result. The price may be a string or a number.
+	Price string `json:"price"`
+
+	// Timestamp is the path of the timestamp of the price in a result. The timestamp may be a
+	// number in the configured unit, or an RFC 3339 string. If empty, the time at which the
+	// message is received is used.
+	Timestamp string `json:"timestamp"`
+
+	// TimestampUnit is the unit of numeric timestamps. This defaults to seconds.
+	TimestampUnit TimestampUnit `json:"timestampUnit"`
+}
+
+// ValidateBasic performs basic validation of the generic websocket config, given the websocket
+// config that it belongs to.
+func (c *GenericWebSocketConfig) ValidateBasic(ws WebSocketConfig) error {
+	if len(c.SubscribeMessage) == 0 {
+		return fmt.Errorf("generic websocket subscribe message cannot be empty")
+	}
+
+	if err := validateMessageTemplate(c.SubscribeMessage); err != nil {
+		return fmt.Errorf("invalid generic websocket subscribe message: %w", err)
+	}
+
+	if len(c.UnsubscribeMessage) > 0 {
+		if err := validateMessageTemplate(c.UnsubscribeMessage); err != nil {
+			return fmt.Errorf("invalid generic websocket unsubscribe message: %w", err)
+		}
+	}
+
+	if len(c.HeartbeatMessage) > 0 && ws.PingInterval <= 0 {
+		return fmt.Errorf("generic websocket with a heartbeat message must have a ping interval")
+	}
+
+	if len(c.Channel) > 0 && len(c.ChannelValue) == 0 {
+		return fmt.Errorf("generic websocket channel value cannot be empty if the channel path is set")
+	}
+
+	switch c.ResultsType {
+	case "", ResultsTypeArray, ResultsTypeObject:
+	default:
+		return fmt.Errorf("invalid generic websocket results type %q", c.ResultsType)
+	}
+
+	if len(c.Results) == 0 && len(c.ResultsType) > 0 {
+		return fmt.Errorf("generic websocket results type cannot be set if the results path is empty")
+	}
+
+	if len(c.Ticker) == 0 && c.ResultsType != ResultsTypeObject {
+		return fmt.Errorf("generic websocket ticker path is required unless the results type is %s", ResultsTypeObject)
+	}
+
+	if len(c.Price) == 0 {
+		return fmt.Errorf("generic websocket price path cannot be empty")
+	}
+
+	for _, path := range []string{c.Channel, c.Results, c.Ticker, c.Price, c.Timestamp} {
+		if len(path) == 0 {
+			continue
+		}
+
+		if _, err := json.ParsePath(path); err != nil {
+			return fmt.Errorf("invalid generic websocket path: %w", err)
+		}
+	}
+
+	return validateTimestampUnit(c.TimestampUnit)
+}
+
+// validateMessageTemplate returns an error if the given message template does not contain
+// exactly one of the {ticker} and {tickers} placeholders.
+func validateMessageTemplate(template string) error {
+	hasTicker := strings.Contains(template, TickerPlaceholder)
+	hasTickers := strings.Contains(template, TickersPlaceholder)
+	if hasTicker == hasTickers {
+		return fmt.Errorf("message must contain either the %s or the %s placeholder", TickerPlaceholder, TickersPlaceholder)
+	}
+
+	return nil
+}
+
+// validateTimestampUnit returns an error if the given timestamp unit is not supported. An empty
+// unit defaults to seconds.
+func validateTimestampUnit(unit TimestampUnit) error {
+	switch unit {
+	case "", TimestampUnitSeconds, TimestampUnitMilliseconds, TimestampUnitNanoseconds:
+		return nil
+	default:
+		return fmt.Errorf("invalid timestamp unit %q", unit)
+	}
+}
@@ -0,0 +1,365 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+)
+
+func TestGenericAPIConfig(t *testing.T) {
+	newConfig := func(url string, generic config.GenericAPIConfig) config.APIConfig {
+		return config.APIConfig{
+			Enabled:          true,
+			Timeout:          time.Second,
+			Interval:         time.Second,
+			ReconnectTimeout: time.Second,
+			MaxQueries:       1,
+			Name:             "test",
+			Endpoints:        []config.Endpoint{{URL: url}},
+			Generic:          &generic,
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		config      config.APIConfig
+		atomic      bool
+		expectedErr bool
+	}{
+		{
+			name: "good config with a single ticker per request",
+			config: newConfig("http://test.com/ticker/{ticker}", config.GenericAPIConfig{
+				BatchMode: config.BatchModeSingle,
+				Price:     "$.price",
+			}),
+		},
+		{
+			name: "good config with a list of tickers per request",
+			config: newConfig("http://test.com/tickers?symbols={tickers}", config.GenericAPIConfig{
+				BatchMode:     config.BatchModeList,
+				Results:       "$.data",
+				Ticker:        "$.symbol",
+				Price:         "$.price",
+				Timestamp:     "$.time",
+				TimestampUnit: config.TimestampUnitMilliseconds,
+			}),
+		},
+		{
+			name: "good config with all tickers per request",
+			config: newConfig("http://test.com/tickers", config.GenericAPIConfig{
+				BatchMode: config.BatchModeAll,
+				Results:   "$",
+				Price:     "$.price",
+			}),
+			atomic: true,
+		},
+		{
+			name: "bad config with an invalid batch mode",
+			config: newConfig("http://test.com/tickers", config.GenericAPIConfig{
+				BatchMode: "some",
+				Results:   "$",
+				Price:     "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with an atomic single ticker per request",
+			config: newConfig("http://test.com/ticker/{ticker}", config.GenericAPIConfig{
+				BatchMode: config.BatchModeSingle,
+				Price:     "$.price",
+			}),
+			atomic:      true,
+			expectedErr: true,
+		},
+		{
+			name: "bad config with all tickers per request that is not atomic",
+			config: newConfig("http://test.com/tickers", config.GenericAPIConfig{
+				BatchMode: config.BatchModeAll,
+				Results:   "$",
+				Price:     "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with a missing placeholder",
+			config: newConfig("http://test.com/tickers", config.GenericAPIConfig{
+				BatchMode: config.BatchModeList,
+				Results:   "$",
+				Price:     "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with the wrong placeholder",
+			config: newConfig("http://test.com/ticker/{ticker}", config.GenericAPIConfig{
+				BatchMode: config.BatchModeList,
+				Results:   "$",
+				Price:     "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with a placeholder for all tickers per request",
+			config: newConfig("http://test.com/ticker/{ticker}", config.GenericAPIConfig{
+				BatchMode: config.BatchModeAll,
+				Results:   "$",
+				Price:     "$.price",
+			}),
+			atomic:      true,
+			expectedErr: true,
+		},
+		{
+			name: "bad config with no results path for a list of tickers",
+			config: newConfig("http://test.com/tickers?symbols={tickers}", config.GenericAPIConfig{
+				BatchMode: config.BatchModeList,
+				Price:     "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with no price path",
+			config: newConfig("http://test.com/ticker/{ticker}", config.GenericAPIConfig{
+				BatchMode: config.BatchModeSingle,
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with an invalid path",
+			config: newConfig("http://test.com/ticker/{ticker}", config.GenericAPIConfig{
+				BatchMode: config.BatchModeSingle,
+				Price:     "price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with an invalid timestamp unit",
+			config: newConfig("http://test.com/ticker/{ticker}", config.GenericAPIConfig{
+				BatchMode:     config.BatchModeSingle,
+				Price:         "$.price",
+				Timestamp:     "$.time",
+				TimestampUnit: "minutes",
+			}),
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.config.Atomic = tc.atomic
+
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenericWebSocketConfig(t *testing.T) {
+	newConfig := func(generic config.GenericWebSocketConfig) config.WebSocketConfig {
+		return config.WebSocketConfig{
+			Enabled:                  true,
+			MaxBufferSize:            1,
+			ReconnectionTimeout:      time.Second,
+			Endpoints:                []config.Endpoint{{URL: "wss://test.com"}},
+			Name:                     "test",
+			HandshakeTimeout:         time.Second,
+			ReadTimeout:              time.Second,
+			WriteTimeout:             time.Second,
+			MaxSubscriptionsPerBatch: 1,
+			Generic:                  &generic,
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		config       config.WebSocketConfig
+		pingInterval time.Duration
+		expectedErr  bool
+	}{
+		{
+			name: "good config with a message per ticker",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage: `{"op":"subscribe","symbol":"{ticker}"}`,
+				Ticker:           "$.symbol",
+				Price:            "$.price",
+			}),
+		},
+		{
+			name: "good config with a message per batch of tickers",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage:   `{"op":"subscribe","args":{tickers}}`,
+				UnsubscribeMessage: `{"op":"unsubscribe","args":{tickers}}`,
+				HeartbeatMessage:   `{"op":"ping"}`,
+				Channel:            "$.channel",
+				ChannelValue:       "tickers",
+				Results:            "$.data",
+				ResultsType:        config.ResultsTypeObject,
+				Price:              "$.price",
+				Timestamp:          "$.time",
+				TimestampUnit:      config.TimestampUnitMilliseconds,
+			}),
+			pingInterval: time.Second,
+		},
+		{
+			name: "bad config with no subscribe message",
+			config: newConfig(config.GenericWebSocketConfig{
+				Ticker: "$.symbol",
+				Price:  "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with a subscribe message without a placeholder",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage: `{"op":"subscribe"}`,
+				Ticker:           "$.symbol",
+				Price:            "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with a subscribe message with both placeholders",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage: `{"op":"subscribe","symbol":"{ticker}","args":{tickers}}`,
+				Ticker:           "$.symbol",
+				Price:            "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with an unsubscribe message without a placeholder",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage:   `{"op":"subscribe","symbol":"{ticker}"}`,
+				UnsubscribeMessage: `{"op":"unsubscribe"}`,
+				Ticker:             "$.symbol",
+				Price:              "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with a heartbeat message without a ping interval",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage: `{"op":"subscribe","symbol":"{ticker}"}`,
+				HeartbeatMessage: `{"op":"ping"}`,
+				Ticker:           "$.symbol",
+				Price:            "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with a channel path without a channel value",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage: `{"op":"subscribe","symbol":"{ticker}"}`,
+				Channel:          "$.channel",
+				Ticker:           "$.symbol",
+				Price:            "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with no results or ticker path",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage: `{"op":"subscribe","symbol":"{ticker}"}`,
+				Price:            "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "good config with an array of results",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage: `{"op":"subscribe","symbol":"{ticker}"}`,
+				Results:          "$.data",
+				ResultsType:      config.ResultsTypeArray,
+				Ticker:           "$.symbol",
+				Price:            "$.price",
+			}),
+		},
+		{
+			name: "bad config with no ticker path and results of unknown type",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage: `{"op":"subscribe","symbol":"{ticker}"}`,
+				Results:          "$.data",
+				Price:            "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with no ticker path and an array of results",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage: `{"op":"subscribe","symbol":"{ticker}"}`,
+				Results:          "$.data",
+				ResultsType:      config.ResultsTypeArray,
+				Price:            "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with a results type but no results path",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage: `{"op":"subscribe","symbol":"{ticker}"}`,
+				ResultsType:      config.ResultsTypeObject,
+				Ticker:           "$.symbol",
+				Price:            "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with an invalid results type",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage: `{"op":"subscribe","symbol":"{ticker}"}`,
+				Results:          "$.data",
+				ResultsType:      "map",
+				Ticker:           "$.symbol",
+				Price:            "$.price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with no price path",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage: `{"op":"subscribe","symbol":"{ticker}"}`,
+				Ticker:           "$.symbol",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with an invalid path",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage: `{"op":"subscribe","symbol":"{ticker}"}`,
+				Ticker:           "$.symbol",
+				Price:            "price",
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "bad config with an invalid timestamp unit",
+			config: newConfig(config.GenericWebSocketConfig{
+				SubscribeMessage: `{"op":"subscribe","symbol":"{ticker}"}`,
+				Ticker:           "$.symbol",
+				Price:            "$.price",
+				Timestamp:        "$.time",
+				TimestampUnit:    "minutes",
+			}),
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.config.PingInterval = tc.pingInterval
+
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -14,6 +14,22 @@ const (
 	// to reconnect to the websocket endpoint.
 	DefaultReconnectionTimeout = 10 * time.Second
 
+	// DefaultMaxReconnectionTimeout is the default maximum timeout for the provider to
+	// attempt to reconnect to the websocket endpoint, after backing off exponentially.
+	DefaultMaxReconnectionTimeout = 5 * time.Minute
+
+	// DefaultReconnectionBackoffMultiplier is the default factor by which the reconnection
+	// timeout increases after each consecutive failed connection.
+	DefaultReconnectionBackoffMultiplier = 2.0
+
+	// DefaultReconnectionJitter is the default fraction of the reconnection timeout that is
+	// randomly subtracted from it, so that providers do not reconnect in lockstep.
+	DefaultReconnectionJitter = 0.2
+
+	// DefaultReconnectionResetPeriod is the default duration that a connection must be
+	// healthy for before the reconnection timeout is reset.
+	DefaultReconnectionResetPeriod = 1 * time.Minute
+
 	// DefaultPostConnectionTimeout is the default timeout for the provider to wait
 	// after a connection is established before sending messages.
 	DefaultPostConnectionTimeout = 1 * time.Second
@@ -82,6 +98,25 @@ type WebSocketConfig struct {
 	// to the websocket endpoint.
 	ReconnectionTimeout time.Duration `json:"reconnectionTimeout"`
 
+	// MaxReconnectionTimeout is the maximum timeout for the provider to attempt to
+	// reconnect to the websocket endpoint. If set, the reconnection timeout increases
+	// exponentially from ReconnectionTimeout after each consecutive failed connection,
+	// up to this value. A zero value disables the backoff, i.e. the provider always
+	// waits ReconnectionTimeout before reconnecting.
+	MaxReconnectionTimeout time.Duration `json:"maxReconnectionTimeout"`
+
+	// ReconnectionBackoffMultiplier is the factor by which the reconnection timeout
+	// increases after each consecutive failed connection.
+	ReconnectionBackoffMultiplier float64 `json:"reconnectionBackoffMultiplier"`
+
+	// ReconnectionJitter is the fraction of the reconnection timeout, between 0 and 1,
+	// that is randomly subtracted from it before reconnecting.
+	ReconnectionJitter float64 `json:"reconnectionJitter"`
+
+	// ReconnectionResetPeriod is the duration that a connection must be healthy for
+	// before the reconnection timeout is reset to ReconnectionTimeout.
+	ReconnectionResetPeriod time.Duration `json:"reconnectionResetPeriod"`
+
 	// PostConnectionTimeout is the timeout for the provider to wait after a connection
 	// is established before sending messages.
 	PostConnectionTimeout time.Duration `json:"postConnectionTimeout"`
@@ -141,6 +176,11 @@ type WebSocketConfig struct {
 	// MaxSubscriptionsPerBatch is the maximum number of subscription messages that the
 	// provider will send in a single batch/write.
 	MaxSubscriptionsPerBatch int `json:"maxSubscriptionsPerBatch"`
+
+	// Generic is the declarative configuration of a generic websocket price provider. If set,
+	// the provider is created from this configuration, rather than from a provider
+	// implementation with the same name.
+	Generic *GenericWebSocketConfig `json:"generic"`
 }
 
 // ValidateBasic performs basic validation of the websocket config.
@@ -157,6 +197,28 @@ func (c *WebSocketConfig) ValidateBasic() error {
 		return fmt.Errorf("websocket reconnection timeout must be greater than 0")
 	}
 
+	if c.MaxReconnectionTimeout < 0 {
+		return fmt.Errorf("websocket max reconnection timeout cannot be negative")
+	}
+
+	if c.MaxReconnectionTimeout > 0 {
+		if c.MaxReconnectionTimeout < c.ReconnectionTimeout {
+			return fmt.Errorf("websocket max reconnection timeout must be greater than or equal to the reconnection timeout")
+		}
+
+		if c.ReconnectionBackoffMultiplier < 1 {
+			return fmt.Errorf("websocket reconnection backoff multiplier must be at least 1")
+		}
+
+		if c.ReconnectionResetPeriod <= 0 {
+			return fmt.Errorf("websocket reconnection reset period must be greater than 0")
+		}
+	}
+
+	if c.ReconnectionJitter < 0 || c.ReconnectionJitter > 1 {
+		return fmt.Errorf("websocket reconnection jitter must be between 0 and 1")
+	}
+
 	if c.PostConnectionTimeout < 0 {
 		return fmt.Errorf("websocket post connection timeout must be greater than 0")
 	}
@@ -215,5 +277,11 @@ func (c *WebSocketConfig) ValidateBasic() error {
 		return fmt.Errorf("websocket max subscriptions per batch must be greater than 0")
 	}
 
+	if c.Generic != nil {
+		if err := c.Generic.ValidateBasic(*c); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
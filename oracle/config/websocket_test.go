@@ -438,6 +438,161 @@ func TestWebSocketConfig(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "good config with reconnection backoff",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				MaxReconnectionTimeout:        config.DefaultMaxReconnectionTimeout,
+				ReconnectionBackoffMultiplier: config.DefaultReconnectionBackoffMultiplier,
+				ReconnectionJitter:            config.DefaultReconnectionJitter,
+				ReconnectionResetPeriod:       config.DefaultReconnectionResetPeriod,
+				PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
+				Name:                          "test",
+				Endpoints: []config.Endpoint{
+					{
+						URL: "wss://test.com",
+					},
+				},
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				PingInterval:                  config.DefaultPingInterval,
+				WriteInterval:                 config.DefaultWriteInterval,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				MaxSubscriptionsPerBatch:      config.DefaultMaxSubscriptionsPerBatch,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with max reconnection timeout less than reconnection timeout",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				MaxReconnectionTimeout:        time.Second,
+				ReconnectionBackoffMultiplier: config.DefaultReconnectionBackoffMultiplier,
+				ReconnectionJitter:            config.DefaultReconnectionJitter,
+				ReconnectionResetPeriod:       config.DefaultReconnectionResetPeriod,
+				PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
+				Name:                          "test",
+				Endpoints: []config.Endpoint{
+					{
+						URL: "wss://test.com",
+					},
+				},
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				PingInterval:                  config.DefaultPingInterval,
+				WriteInterval:                 config.DefaultWriteInterval,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				MaxSubscriptionsPerBatch:      config.DefaultMaxSubscriptionsPerBatch,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with reconnection backoff multiplier less than 1",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				MaxReconnectionTimeout:        config.DefaultMaxReconnectionTimeout,
+				ReconnectionBackoffMultiplier: 0.5,
+				ReconnectionJitter:            config.DefaultReconnectionJitter,
+				ReconnectionResetPeriod:       config.DefaultReconnectionResetPeriod,
+				PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
+				Name:                          "test",
+				Endpoints: []config.Endpoint{
+					{
+						URL: "wss://test.com",
+					},
+				},
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				PingInterval:                  config.DefaultPingInterval,
+				WriteInterval:                 config.DefaultWriteInterval,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				MaxSubscriptionsPerBatch:      config.DefaultMaxSubscriptionsPerBatch,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with reconnection jitter greater than 1",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				MaxReconnectionTimeout:        config.DefaultMaxReconnectionTimeout,
+				ReconnectionBackoffMultiplier: config.DefaultReconnectionBackoffMultiplier,
+				ReconnectionJitter:            1.5,
+				ReconnectionResetPeriod:       config.DefaultReconnectionResetPeriod,
+				PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
+				Name:                          "test",
+				Endpoints: []config.Endpoint{
+					{
+						URL: "wss://test.com",
+					},
+				},
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				PingInterval:                  config.DefaultPingInterval,
+				WriteInterval:                 config.DefaultWriteInterval,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				MaxSubscriptionsPerBatch:      config.DefaultMaxSubscriptionsPerBatch,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with no reconnection reset period",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				MaxReconnectionTimeout:        config.DefaultMaxReconnectionTimeout,
+				ReconnectionBackoffMultiplier: config.DefaultReconnectionBackoffMultiplier,
+				ReconnectionJitter:            config.DefaultReconnectionJitter,
+				ReconnectionResetPeriod:       0,
+				PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
+				Name:                          "test",
+				Endpoints: []config.Endpoint{
+					{
+						URL: "wss://test.com",
+					},
+				},
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				PingInterval:                  config.DefaultPingInterval,
+				WriteInterval:                 config.DefaultWriteInterval,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				MaxSubscriptionsPerBatch:      config.DefaultMaxSubscriptionsPerBatch,
+			},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
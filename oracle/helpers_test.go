@@ -225,6 +225,10 @@ func (n noOpPriceAggregator) GetPrices() oracletypes.Prices {
 	return oracletypes.Prices{}
 }
 
+func (n noOpPriceAggregator) GetDispersions() oracletypes.PriceDispersions {
+	return oracletypes.PriceDispersions{}
+}
+
 func (n noOpPriceAggregator) Reset() {
 }
 
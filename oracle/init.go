@@ -86,6 +86,7 @@ func (o *OracleImpl) createPriceProvider(ctx context.Context, cfg config.Provide
 			base.WithWebSocketConfig[types.ProviderTicker, *big.Float](cfg.WebSocket),
 			base.WithIDs[types.ProviderTicker, *big.Float](tickers),
 			base.WithMetrics[types.ProviderTicker, *big.Float](o.providerMetrics),
+			base.WithWebSocketMetrics[types.ProviderTicker, *big.Float](o.wsMetrics),
 		)
 		if err != nil {
 			return fmt.Errorf("failed to create %s's provider: %w", cfg.Name, err)
@@ -15,6 +15,7 @@ type Oracle interface {
 	IsRunning() bool
 	GetLastSyncTime() time.Time
 	GetPrices() types.Prices
+	GetDispersions() types.PriceDispersions
 	GetMarketMap() mmtypes.MarketMap
 	Start(ctx context.Context) error
 	Stop()
@@ -29,6 +30,7 @@ type PriceAggregator interface {
 	UpdateMarketMap(mmtypes.MarketMap)
 	AggregatePrices()
 	GetPrices() types.Prices
+	GetDispersions() types.PriceDispersions
 	Reset()
 }
 
@@ -24,15 +24,22 @@ const (
 	SuccessLabel = "success"
 	// Version is a label for the Connect version.
 	Version = "version"
-
-	TicksMetricName            = "health_check_system_updates_total"
-	TickerTicksMetricName      = "health_check_ticker_updates_total"
-	PricesMetricName           = "provider_price"
-	AggregatePricesMetricName  = "aggregated_price"
-	ProviderTickMetricName     = "health_check_provider_updates_total"
-	ProviderCountMetricName    = "health_check_market_providers"
-	SlinkyBuildInfoMetricName  = "slinky_build_info"
-	ConnectBuildInfoMetricName = "connect_build_info"
+	// AggregationStrategyLabel is a label for the aggregation strategy used for a market.
+	AggregationStrategyLabel = "strategy"
+	// NormalizeByLabel is a label for the currency pair whose index price was used to normalize a price.
+	NormalizeByLabel = "normalize_by"
+
+	TicksMetricName               = "health_check_system_updates_total"
+	TickerTicksMetricName         = "health_check_ticker_updates_total"
+	PricesMetricName              = "provider_price"
+	AggregatePricesMetricName     = "aggregated_price"
+	ProviderTickMetricName        = "health_check_provider_updates_total"
+	ProviderRejectionMetricName   = "health_check_provider_rejections_total"
+	ProviderCountMetricName       = "health_check_market_providers"
+	AggregationStrategyMetricName = "aggregation_strategy"
+	StaleIndexPriceMetricName     = "health_check_stale_index_price_usages_total"
+	SlinkyBuildInfoMetricName     = "slinky_build_info"
+	ConnectBuildInfoMetricName    = "connect_build_info"
 )
 
 // Metrics is an interface that defines the API for oracle metrics.
@@ -58,26 +65,42 @@ type Metrics interface {
 	// was used in the aggregation.
 	AddProviderTick(providerName, pairID string, success bool)
 
+	// AddProviderRejection increments the number of times a provider's price for a given
+	// market was rejected as an outlier before aggregation.
+	AddProviderRejection(providerName, pairID string)
+
 	// AddProviderCountForMarket increments the number of providers that were utilized
 	// to calculate the final price for a given market.
 	AddProviderCountForMarket(market string, count int)
 
+	// SetAggregationStrategy sets the aggregation strategy that was used to calculate the
+	// final price for a given market.
+	SetAggregationStrategy(market, strategy string)
+
+	// AddStaleIndexPriceUsage increments the number of times the index price of the previous
+	// tick was used to normalize a price for a given market, because the index price of the
+	// normalization pair could not be calculated in the current tick.
+	AddStaleIndexPriceUsage(market, normalizeByPair string)
+
 	// SetConnectBuildInfo sets the build information for the Slinky binary.
 	SetConnectBuildInfo()
 }
 
 // OracleMetricsImpl is a Metrics implementation that does nothing.
 type OracleMetricsImpl struct {
-	promTicks            prometheus.Counter
-	promTickerTicks      *prometheus.CounterVec
-	promPrices           *prometheus.GaugeVec
-	promAggregatePrices  *prometheus.GaugeVec
-	promProviderTick     *prometheus.CounterVec
-	promProviderCount    *prometheus.GaugeVec
-	promSlinkyBuildInfo  *prometheus.GaugeVec
-	promConnectBuildInfo *prometheus.GaugeVec
-	statsdClient         statsd.ClientInterface
-	nodeIdentifier       string
+	promTicks               prometheus.Counter
+	promTickerTicks         *prometheus.CounterVec
+	promPrices              *prometheus.GaugeVec
+	promAggregatePrices     *prometheus.GaugeVec
+	promProviderTick        *prometheus.CounterVec
+	promProviderRejection   *prometheus.CounterVec
+	promProviderCount       *prometheus.GaugeVec
+	promAggregationStrategy *prometheus.GaugeVec
+	promStaleIndexPrice     *prometheus.CounterVec
+	promSlinkyBuildInfo     *prometheus.GaugeVec
+	promConnectBuildInfo    *prometheus.GaugeVec
+	statsdClient            statsd.ClientInterface
+	nodeIdentifier          string
 }
 
 // NewMetricsFromConfig returns an oracle Metrics implementation based on the provided
@@ -141,11 +164,26 @@ func NewMetrics(statsdClient statsd.ClientInterface, nodeIdentifier string) Metr
 		Name:      ProviderTickMetricName,
 		Help:      "Number of ticks with a successful provider update.",
 	}, []string{ProviderLabel, PairIDLabel, SuccessLabel})
+	ret.promProviderRejection = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: OracleSubsystem,
+		Name:      ProviderRejectionMetricName,
+		Help:      "Number of provider prices that were rejected as outliers before aggregation.",
+	}, []string{ProviderLabel, PairIDLabel})
 	ret.promProviderCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: OracleSubsystem,
 		Name:      ProviderCountMetricName,
 		Help:      "Number of providers that were utilized to calculate the final price for a given market.",
 	}, []string{PairIDLabel})
+	ret.promAggregationStrategy = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: OracleSubsystem,
+		Name:      AggregationStrategyMetricName,
+		Help:      "Aggregation strategy that was used to calculate the final price for a given market.",
+	}, []string{PairIDLabel, AggregationStrategyLabel})
+	ret.promStaleIndexPrice = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: OracleSubsystem,
+		Name:      StaleIndexPriceMetricName,
+		Help:      "Number of times the index price of the previous tick was used to normalize a price for a given market.",
+	}, []string{PairIDLabel, NormalizeByLabel})
 	ret.promSlinkyBuildInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: OracleSubsystem,
 		Name:      SlinkyBuildInfoMetricName,
@@ -162,7 +200,10 @@ func NewMetrics(statsdClient statsd.ClientInterface, nodeIdentifier string) Metr
 	prometheus.MustRegister(ret.promPrices)
 	prometheus.MustRegister(ret.promAggregatePrices)
 	prometheus.MustRegister(ret.promProviderTick)
+	prometheus.MustRegister(ret.promProviderRejection)
 	prometheus.MustRegister(ret.promProviderCount)
+	prometheus.MustRegister(ret.promAggregationStrategy)
+	prometheus.MustRegister(ret.promStaleIndexPrice)
 	prometheus.MustRegister(ret.promSlinkyBuildInfo)
 	prometheus.MustRegister(ret.promConnectBuildInfo)
 
@@ -194,10 +235,22 @@ func (m *noOpOracleMetrics) UpdateAggregatePrice(string, uint64, float64) {}
 // was used in the aggregation.
 func (m *noOpOracleMetrics) AddProviderTick(_, _ string, _ bool) {}
 
+// AddProviderRejection increments the number of times a provider's price for a given
+// market was rejected as an outlier before aggregation.
+func (m *noOpOracleMetrics) AddProviderRejection(_, _ string) {}
+
 // AddProviderCountForMarket increments the number of providers that were utilized
 // to calculate the final price for a given market.
 func (m *noOpOracleMetrics) AddProviderCountForMarket(string, int) {}
 
+// SetAggregationStrategy sets the aggregation strategy that was used to calculate the
+// final price for a given market.
+func (m *noOpOracleMetrics) SetAggregationStrategy(string, string) {}
+
+// AddStaleIndexPriceUsage increments the number of times the index price of the previous
+// tick was used to normalize a price for a given market.
+func (m *noOpOracleMetrics) AddStaleIndexPriceUsage(string, string) {}
+
 // SetConnectBuildInfo sets the build information for the Connect binary.
 func (m *noOpOracleMetrics) SetConnectBuildInfo() {}
 
@@ -267,6 +320,19 @@ func (m *OracleMetricsImpl) AddProviderTick(providerName, pairID string, success
 	m.statsdClient.Incr(metricName, []string{fmt.Sprintf("%t", success)}, 1)
 }
 
+// AddProviderRejection increments the number of times a provider's price for a given
+// market was rejected as an outlier before aggregation.
+func (m *OracleMetricsImpl) AddProviderRejection(providerName, pairID string) {
+	m.promProviderRejection.With(prometheus.Labels{
+		ProviderLabel: strings.ToLower(providerName),
+		PairIDLabel:   strings.ToLower(pairID),
+	},
+	).Add(1)
+
+	metricName := strings.Join([]string{ProviderRejectionMetricName, m.nodeIdentifier, strings.ToLower(providerName), strings.ToLower(pairID)}, ".")
+	m.statsdClient.Incr(metricName, []string{}, 1)
+}
+
 // AddProviderCountForMarket increments the number of providers that were utilized
 // to calculate the final price for a given market.
 func (m *OracleMetricsImpl) AddProviderCountForMarket(market string, count int) {
@@ -279,6 +345,35 @@ func (m *OracleMetricsImpl) AddProviderCountForMarket(market string, count int)
 	m.statsdClient.Gauge(metricName, float64(count), []string{}, 1)
 }
 
+// SetAggregationStrategy sets the aggregation strategy that was used to calculate the
+// final price for a given market. Only the most recently used strategy is reported.
+func (m *OracleMetricsImpl) SetAggregationStrategy(market, strategy string) {
+	m.promAggregationStrategy.DeletePartialMatch(prometheus.Labels{
+		PairIDLabel: strings.ToLower(market),
+	})
+	m.promAggregationStrategy.With(prometheus.Labels{
+		PairIDLabel:              strings.ToLower(market),
+		AggregationStrategyLabel: strategy,
+	},
+	).Set(1)
+
+	metricName := strings.Join([]string{AggregationStrategyMetricName, m.nodeIdentifier, strings.ToLower(market)}, ".")
+	m.statsdClient.Gauge(metricName, float64(1), []string{AggregationStrategyLabel + ":" + strategy}, 1)
+}
+
+// AddStaleIndexPriceUsage increments the number of times the index price of the previous
+// tick was used to normalize a price for a given market.
+func (m *OracleMetricsImpl) AddStaleIndexPriceUsage(market, normalizeByPair string) {
+	m.promStaleIndexPrice.With(prometheus.Labels{
+		PairIDLabel:      strings.ToLower(market),
+		NormalizeByLabel: strings.ToLower(normalizeByPair),
+	},
+	).Add(1)
+
+	metricName := strings.Join([]string{StaleIndexPriceMetricName, m.nodeIdentifier, strings.ToLower(market), strings.ToLower(normalizeByPair)}, ".")
+	m.statsdClient.Incr(metricName, []string{}, 1)
+}
+
 // SetConnectBuildInfo sets the build information for the Connect binary. The version exported
 // is determined by the build time version in accordance with the build pkg.
 func (m *OracleMetricsImpl) SetConnectBuildInfo() {
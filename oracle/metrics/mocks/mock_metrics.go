@@ -51,6 +51,40 @@ func (_c *Metrics_AddProviderCountForMarket_Call) RunAndReturn(run func(string,
 	return _c
 }
 
+// AddProviderRejection provides a mock function with given fields: providerName, pairID
+func (_m *Metrics) AddProviderRejection(providerName string, pairID string) {
+	_m.Called(providerName, pairID)
+}
+
+// Metrics_AddProviderRejection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProviderRejection'
+type Metrics_AddProviderRejection_Call struct {
+	*mock.Call
+}
+
+// AddProviderRejection is a helper method to define mock.On call
+//   - providerName string
+//   - pairID string
+func (_e *Metrics_Expecter) AddProviderRejection(providerName interface{}, pairID interface{}) *Metrics_AddProviderRejection_Call {
+	return &Metrics_AddProviderRejection_Call{Call: _e.mock.On("AddProviderRejection", providerName, pairID)}
+}
+
+func (_c *Metrics_AddProviderRejection_Call) Run(run func(providerName string, pairID string)) *Metrics_AddProviderRejection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Metrics_AddProviderRejection_Call) Return() *Metrics_AddProviderRejection_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Metrics_AddProviderRejection_Call) RunAndReturn(run func(string, string)) *Metrics_AddProviderRejection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddProviderTick provides a mock function with given fields: providerName, pairID, success
 func (_m *Metrics) AddProviderTick(providerName string, pairID string, success bool) {
 	_m.Called(providerName, pairID, success)
@@ -86,6 +120,40 @@ func (_c *Metrics_AddProviderTick_Call) RunAndReturn(run func(string, string, bo
 	return _c
 }
 
+// AddStaleIndexPriceUsage provides a mock function with given fields: market, normalizeByPair
+func (_m *Metrics) AddStaleIndexPriceUsage(market string, normalizeByPair string) {
+	_m.Called(market, normalizeByPair)
+}
+
+// Metrics_AddStaleIndexPriceUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddStaleIndexPriceUsage'
+type Metrics_AddStaleIndexPriceUsage_Call struct {
+	*mock.Call
+}
+
+// AddStaleIndexPriceUsage is a helper method to define mock.On call
+//   - market string
+//   - normalizeByPair string
+func (_e *Metrics_Expecter) AddStaleIndexPriceUsage(market interface{}, normalizeByPair interface{}) *Metrics_AddStaleIndexPriceUsage_Call {
+	return &Metrics_AddStaleIndexPriceUsage_Call{Call: _e.mock.On("AddStaleIndexPriceUsage", market, normalizeByPair)}
+}
+
+func (_c *Metrics_AddStaleIndexPriceUsage_Call) Run(run func(market string, normalizeByPair string)) *Metrics_AddStaleIndexPriceUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Metrics_AddStaleIndexPriceUsage_Call) Return() *Metrics_AddStaleIndexPriceUsage_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Metrics_AddStaleIndexPriceUsage_Call) RunAndReturn(run func(string, string)) *Metrics_AddStaleIndexPriceUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddTick provides a mock function with given fields:
 func (_m *Metrics) AddTick() {
 	_m.Called()
@@ -151,6 +219,40 @@ func (_c *Metrics_AddTickerTick_Call) RunAndReturn(run func(string)) *Metrics_Ad
 	return _c
 }
 
+// SetAggregationStrategy provides a mock function with given fields: market, strategy
+func (_m *Metrics) SetAggregationStrategy(market string, strategy string) {
+	_m.Called(market, strategy)
+}
+
+// Metrics_SetAggregationStrategy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAggregationStrategy'
+type Metrics_SetAggregationStrategy_Call struct {
+	*mock.Call
+}
+
+// SetAggregationStrategy is a helper method to define mock.On call
+//   - market string
+//   - strategy string
+func (_e *Metrics_Expecter) SetAggregationStrategy(market interface{}, strategy interface{}) *Metrics_SetAggregationStrategy_Call {
+	return &Metrics_SetAggregationStrategy_Call{Call: _e.mock.On("SetAggregationStrategy", market, strategy)}
+}
+
+func (_c *Metrics_SetAggregationStrategy_Call) Run(run func(market string, strategy string)) *Metrics_SetAggregationStrategy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Metrics_SetAggregationStrategy_Call) Return() *Metrics_SetAggregationStrategy_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Metrics_SetAggregationStrategy_Call) RunAndReturn(run func(string, string)) *Metrics_SetAggregationStrategy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetConnectBuildInfo provides a mock function with given fields:
 func (_m *Metrics) SetConnectBuildInfo() {
 	_m.Called()
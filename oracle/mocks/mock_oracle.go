@@ -8,6 +8,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	oracletypes "github.com/skip-mev/connect/v2/oracle/types"
+
 	time "time"
 
 	types "github.com/skip-mev/connect/v2/x/marketmap/types"
@@ -26,6 +28,53 @@ func (_m *Oracle) EXPECT() *Oracle_Expecter {
 	return &Oracle_Expecter{mock: &_m.Mock}
 }
 
+// GetDispersions provides a mock function with given fields:
+func (_m *Oracle) GetDispersions() map[string]oracletypes.PriceDispersion {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDispersions")
+	}
+
+	var r0 map[string]oracletypes.PriceDispersion
+	if rf, ok := ret.Get(0).(func() map[string]oracletypes.PriceDispersion); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]oracletypes.PriceDispersion)
+		}
+	}
+
+	return r0
+}
+
+// Oracle_GetDispersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDispersions'
+type Oracle_GetDispersions_Call struct {
+	*mock.Call
+}
+
+// GetDispersions is a helper method to define mock.On call
+func (_e *Oracle_Expecter) GetDispersions() *Oracle_GetDispersions_Call {
+	return &Oracle_GetDispersions_Call{Call: _e.mock.On("GetDispersions")}
+}
+
+func (_c *Oracle_GetDispersions_Call) Run(run func()) *Oracle_GetDispersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Oracle_GetDispersions_Call) Return(_a0 map[string]oracletypes.PriceDispersion) *Oracle_GetDispersions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Oracle_GetDispersions_Call) RunAndReturn(run func() map[string]oracletypes.PriceDispersion) *Oracle_GetDispersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLastSyncTime provides a mock function with given fields:
 func (_m *Oracle) GetLastSyncTime() time.Time {
 	ret := _m.Called()
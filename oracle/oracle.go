@@ -149,3 +149,7 @@ func (o *OracleImpl) GetLastSyncTime() time.Time {
 func (o *OracleImpl) GetPrices() types.Prices {
 	return o.aggregator.GetPrices()
 }
+
+func (o *OracleImpl) GetDispersions() types.PriceDispersions {
+	return o.aggregator.GetDispersions()
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"math/big"
+)
+
+type (
+	// PriceDispersion describes how much the provider prices that contributed to an aggregated
+	// price agree with each other. A price that is agreed upon by many providers within a small
+	// range can be trusted more than a price where only a few providers disagree significantly.
+	PriceDispersion struct {
+		// StdDev is the (population) standard deviation of the contributing prices.
+		StdDev *big.Float
+		// Min is the lowest contributing price.
+		Min *big.Float
+		// Max is the highest contributing price.
+		Max *big.Float
+		// NumProviders is the number of providers that contributed to the aggregated price.
+		NumProviders uint64
+	}
+
+	// PriceDispersions is a type alias for a map of ticker to a price dispersion.
+	PriceDispersions = map[string]PriceDispersion
+)
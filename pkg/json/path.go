@@ -0,0 +1,183 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Path is a parsed JSONPath-style expression that selects a single value from a JSON document.
+// The supported syntax is a subset of JSONPath:
+//
+//   - $ selects the root of the document, and must be the first character of the expression.
+//   - .name selects the field with the given name of an object.
+//   - ['name'] or ["name"] selects the field with the given name of an object, which may contain
+//     any character other than the quote.
+//   - [index] selects the element with the given index of an array. Negative indices select
+//     elements from the end of the array.
+//
+// For example, $.data[0].last selects the last field of the first element of the data array.
+type Path struct {
+	expr     string
+	segments []segment
+}
+
+// segment is a single step of a Path, i.e. an object field or an array index.
+type segment struct {
+	field   string
+	index   int
+	isIndex bool
+}
+
+// ParsePath parses the given JSONPath-style expression.
+func ParsePath(expr string) (Path, error) {
+	if !strings.HasPrefix(expr, "$") {
+		return Path{}, fmt.Errorf("path %q must start with $", expr)
+	}
+
+	var (
+		segments []segment
+		rest     = expr[1:]
+	)
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end == -1 {
+				end = len(rest) - 1
+			}
+
+			field := rest[1 : end+1]
+			if len(field) == 0 {
+				return Path{}, fmt.Errorf("path %q contains an empty field name", expr)
+			}
+
+			segments = append(segments, segment{field: field})
+			rest = rest[end+1:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return Path{}, fmt.Errorf("path %q contains an unterminated bracket", expr)
+			}
+
+			seg, err := parseBracket(rest[1:end])
+			if err != nil {
+				return Path{}, fmt.Errorf("path %q: %w", expr, err)
+			}
+
+			segments = append(segments, seg)
+			rest = rest[end+1:]
+		default:
+			return Path{}, fmt.Errorf("path %q contains an unexpected character %q", expr, rest[0])
+		}
+	}
+
+	return Path{
+		expr:     expr,
+		segments: segments,
+	}, nil
+}
+
+// parseBracket parses the contents of a bracket, i.e. a quoted field name or an array index.
+func parseBracket(contents string) (segment, error) {
+	if len(contents) >= 2 {
+		quote := contents[0]
+		if (quote == '\'' || quote == '"') && contents[len(contents)-1] == quote {
+			field := contents[1 : len(contents)-1]
+			if strings.IndexByte(field, quote) != -1 {
+				return segment{}, fmt.Errorf("field name %s contains a quote", contents)
+			}
+
+			return segment{field: field}, nil
+		}
+	}
+
+	index, err := strconv.Atoi(contents)
+	if err != nil {
+		return segment{}, fmt.Errorf("invalid array index %q", contents)
+	}
+
+	return segment{index: index, isIndex: true}, nil
+}
+
+// String returns the expression that the path was parsed from.
+func (p Path) String() string {
+	return p.expr
+}
+
+// IsRoot returns true if the path selects the root of the document.
+func (p Path) IsRoot() bool {
+	return len(p.segments) == 0
+}
+
+// Get returns the value that the path selects from the given value, which must be decoded with
+// Decode. This returns false if the value does not exist.
+func (p Path) Get(value interface{}) (interface{}, bool) {
+	for _, seg := range p.segments {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if seg.isIndex {
+				return nil, false
+			}
+
+			var ok bool
+			if value, ok = v[seg.field]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			if !seg.isIndex {
+				return nil, false
+			}
+
+			index := seg.index
+			if index < 0 {
+				index += len(v)
+			}
+
+			if index < 0 || index >= len(v) {
+				return nil, false
+			}
+
+			value = v[index]
+		default:
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// GetString returns the value that the path selects from the given value as a string. Numbers
+// are returned in their original representation. This returns an error if the value does not
+// exist, or is not a string or number.
+func (p Path) GetString(value interface{}) (string, error) {
+	v, ok := p.Get(value)
+	if !ok {
+		return "", fmt.Errorf("no value at path %s", p)
+	}
+
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("value at path %s is not a string or number: %v", p, v)
+	}
+}
+
+// Decode decodes the given JSON document into a value that paths can be evaluated against.
+// Numbers are decoded as json.Number to preserve their precision.
+func Decode(bz []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("unable to decode json: %w", err)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,147 @@
+package json_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/pkg/json"
+)
+
+func TestParsePath(t *testing.T) {
+	testCases := []struct {
+		name      string
+		expr      string
+		expectErr bool
+	}{
+		{
+			name: "root",
+			expr: "$",
+		},
+		{
+			name: "fields",
+			expr: "$.data.price",
+		},
+		{
+			name: "quoted fields",
+			expr: `$['data']["last.price"]`,
+		},
+		{
+			name: "indices",
+			expr: "$.data[0].c[-1]",
+		},
+		{
+			name:      "no root",
+			expr:      "data.price",
+			expectErr: true,
+		},
+		{
+			name:      "empty field",
+			expr:      "$..price",
+			expectErr: true,
+		},
+		{
+			name:      "unterminated bracket",
+			expr:      "$.data[0",
+			expectErr: true,
+		},
+		{
+			name:      "invalid index",
+			expr:      "$.data[*]",
+			expectErr: true,
+		},
+		{
+			name:      "unexpected character",
+			expr:      "$data",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := json.ParsePath(tc.expr)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expr, path.String())
+		})
+	}
+}
+
+func TestPathGet(t *testing.T) {
+	value, err := json.Decode([]byte(`{
+		"data": [
+			{"symbol": "BTC/USD", "price": 64587.40000000001, "c": ["1", "2", "3"]},
+			{"symbol": "ETH/USD", "price": "3338.08"}
+		],
+		"last.price": "1"
+	}`))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		expr      string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "string field",
+			expr:     "$.data[1].symbol",
+			expected: "ETH/USD",
+		},
+		{
+			name:     "number field keeps its precision",
+			expr:     "$.data[0].price",
+			expected: "64587.40000000001",
+		},
+		{
+			name:     "negative index",
+			expr:     "$.data[0].c[-1]",
+			expected: "3",
+		},
+		{
+			name:     "quoted field",
+			expr:     "$['last.price']",
+			expected: "1",
+		},
+		{
+			name:      "missing field",
+			expr:      "$.data[0].volume",
+			expectErr: true,
+		},
+		{
+			name:      "index out of range",
+			expr:      "$.data[2].symbol",
+			expectErr: true,
+		},
+		{
+			name:      "index into object",
+			expr:      "$[0]",
+			expectErr: true,
+		},
+		{
+			name:      "not a string or number",
+			expr:      "$.data[0]",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := json.ParsePath(tc.expr)
+			require.NoError(t, err)
+
+			actual, err := path.GetString(value)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -149,6 +149,99 @@ func CalculateMedian(values []*big.Float) *big.Float {
 	return median
 }
 
+// CalculateMean calculates the arithmetic mean from a list of big.Float.
+func CalculateMean(values []*big.Float) *big.Float {
+	if len(values) == 0 {
+		return nil
+	}
+
+	sum := new(big.Float)
+	for _, value := range values {
+		sum.Add(sum, value)
+	}
+
+	return sum.Quo(sum, new(big.Float).SetInt64(int64(len(values))))
+}
+
+// CalculateTrimmedMean calculates the mean from a list of big.Float after discarding
+// trimPercentage percent of the values from each end of the sorted list. The number of
+// values discarded from each end is rounded down, and at least one value is always kept.
+func CalculateTrimmedMean(values []*big.Float, trimPercentage uint64) *big.Float {
+	if len(values) == 0 {
+		return nil
+	}
+	SortBigFloats(values)
+
+	if trimPercentage > 100 {
+		trimPercentage = 100
+	}
+
+	//nolint:gosec // trimPercentage is bounded above
+	trim := len(values) * int(trimPercentage) / 100
+	if 2*trim >= len(values) {
+		trim = (len(values) - 1) / 2
+	}
+
+	return CalculateMean(values[trim : len(values)-trim])
+}
+
+// CalculateWeightedMean calculates the weighted mean from a list of big.Float and their
+// respective weights. Returns nil if the lengths do not match or the total weight is zero.
+func CalculateWeightedMean(values, weights []*big.Float) *big.Float {
+	if len(values) == 0 || len(values) != len(weights) {
+		return nil
+	}
+
+	sum := new(big.Float)
+	totalWeight := new(big.Float)
+	for i, value := range values {
+		sum.Add(sum, new(big.Float).Mul(value, weights[i]))
+		totalWeight.Add(totalWeight, weights[i])
+	}
+
+	if totalWeight.Sign() == 0 {
+		return nil
+	}
+
+	return sum.Quo(sum, totalWeight)
+}
+
+// CalculateWeightedMedian calculates the weighted median from a list of big.Float and their
+// respective weights. The weighted median is the first value (in sorted order) at which the
+// cumulative weight reaches half of the total weight. Returns nil if the lengths do not match
+// or the total weight is zero.
+func CalculateWeightedMedian(values, weights []*big.Float) *big.Float {
+	if len(values) == 0 || len(values) != len(weights) {
+		return nil
+	}
+
+	indices := make([]int, len(values))
+	totalWeight := new(big.Float)
+	for i := range values {
+		indices[i] = i
+		totalWeight.Add(totalWeight, weights[i])
+	}
+
+	if totalWeight.Sign() == 0 {
+		return nil
+	}
+
+	sort.SliceStable(indices, func(i, j int) bool {
+		return values[indices[i]].Cmp(values[indices[j]]) < 0
+	})
+
+	middle := new(big.Float).Quo(totalWeight, new(big.Float).SetUint64(2))
+	sum := new(big.Float)
+	for _, index := range indices {
+		sum.Add(sum, weights[index])
+		if sum.Cmp(middle) >= 0 {
+			return values[index]
+		}
+	}
+
+	return values[indices[len(indices)-1]]
+}
+
 // GetScalingFactor returns the scaling factor for the price based on the difference between
 // the token decimals in the erc20 token contracts or similar.
 func GetScalingFactor(
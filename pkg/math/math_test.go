@@ -406,6 +406,135 @@ func TestCalculateMedian(t *testing.T) {
 	}
 }
 
+func TestCalculateTrimmedMean(t *testing.T) {
+	testCases := []struct {
+		name           string
+		values         []*big.Float
+		trimPercentage uint64
+		expected       *big.Float
+	}{
+		{
+			name:     "do nothing for nil slice",
+			values:   nil,
+			expected: nil,
+		},
+		{
+			name: "no trimming is the mean",
+			values: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(2),
+				big.NewFloat(6),
+			},
+			trimPercentage: 0,
+			expected:       big.NewFloat(3),
+		},
+		{
+			name: "trims values from each end",
+			values: []*big.Float{
+				big.NewFloat(100),
+				big.NewFloat(-100),
+				big.NewFloat(1),
+				big.NewFloat(2),
+				big.NewFloat(3),
+			},
+			trimPercentage: 20,
+			expected:       big.NewFloat(2),
+		},
+		{
+			name: "always keeps at least one value",
+			values: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(2),
+				big.NewFloat(3),
+			},
+			trimPercentage: 100,
+			expected:       big.NewFloat(2),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, math.CalculateTrimmedMean(tc.values, tc.trimPercentage))
+		})
+	}
+}
+
+func TestCalculateWeightedMean(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		weights  []*big.Float
+		expected *big.Float
+	}{
+		{
+			name:     "do nothing for nil slice",
+			expected: nil,
+		},
+		{
+			name:     "mismatched lengths",
+			values:   []*big.Float{big.NewFloat(1)},
+			weights:  []*big.Float{},
+			expected: nil,
+		},
+		{
+			name:     "zero total weight",
+			values:   []*big.Float{big.NewFloat(1), big.NewFloat(2)},
+			weights:  []*big.Float{big.NewFloat(0), big.NewFloat(0)},
+			expected: nil,
+		},
+		{
+			name:     "calculates the weighted mean",
+			values:   []*big.Float{big.NewFloat(1), big.NewFloat(2)},
+			weights:  []*big.Float{big.NewFloat(1), big.NewFloat(3)},
+			expected: big.NewFloat(1.75),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, math.CalculateWeightedMean(tc.values, tc.weights))
+		})
+	}
+}
+
+func TestCalculateWeightedMedian(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		weights  []*big.Float
+		expected *big.Float
+	}{
+		{
+			name:     "do nothing for nil slice",
+			expected: nil,
+		},
+		{
+			name:     "zero total weight",
+			values:   []*big.Float{big.NewFloat(1), big.NewFloat(2)},
+			weights:  []*big.Float{big.NewFloat(0), big.NewFloat(0)},
+			expected: nil,
+		},
+		{
+			name:     "equal weights is the lower median",
+			values:   []*big.Float{big.NewFloat(3), big.NewFloat(1), big.NewFloat(2)},
+			weights:  []*big.Float{big.NewFloat(1), big.NewFloat(1), big.NewFloat(1)},
+			expected: big.NewFloat(2),
+		},
+		{
+			name:     "heavy weight dominates",
+			values:   []*big.Float{big.NewFloat(3), big.NewFloat(1), big.NewFloat(2)},
+			weights:  []*big.Float{big.NewFloat(10), big.NewFloat(1), big.NewFloat(1)},
+			expected: big.NewFloat(3),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, math.CalculateWeightedMedian(tc.values, tc.weights))
+		})
+	}
+}
+
 func TestSortBigInts(t *testing.T) {
 	testCases := []struct {
 		name     string
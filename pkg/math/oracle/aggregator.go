@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -11,6 +12,7 @@ import (
 	oraclemetrics "github.com/skip-mev/connect/v2/oracle/metrics"
 	"github.com/skip-mev/connect/v2/oracle/types"
 	"github.com/skip-mev/connect/v2/pkg/math"
+	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
 	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 )
 
@@ -28,12 +30,24 @@ type IndexPriceAggregator struct {
 
 	// indexPrices cache the median prices for each ticker. These are unscaled prices.
 	indexPrices types.Prices
+	// previousIndexPrices cache the index prices of the previous tick while the index prices
+	// are being calculated. These are only used to normalize prices if the index price of the
+	// current tick could not be calculated.
+	previousIndexPrices types.Prices
 	// scaledPrices cache the scaled prices for each ticker. These are the prices that can be
 	// consumed by consumers.
 	scaledPrices types.Prices
+	// dispersions cache the scaled dispersion of the converted prices that contributed to the
+	// scaled price of each ticker.
+	dispersions types.PriceDispersions
 	// providerPrices cache the unscaled prices for each provider. These are indexed by
 	// provider -> offChainTicker -> price.
 	providerPrices map[string]types.Prices
+	// history caches the recent index prices of each ticker that is the source of a derived
+	// market (i.e. a TWAP or EMA). These are indexed by ticker -> history.
+	history map[string]*PriceHistory
+	// now returns the current time. This is used to timestamp the index price history.
+	now func() time.Time
 }
 
 // NewIndexPriceAggregator returns a new Index Price Aggregator.
@@ -57,31 +71,59 @@ func NewIndexPriceAggregator(
 		metrics:        metrics,
 		indexPrices:    make(types.Prices),
 		scaledPrices:   make(types.Prices),
+		dispersions:    make(types.PriceDispersions),
 		providerPrices: make(map[string]types.Prices),
+		history:        make(map[string]*PriceHistory),
+		now:            time.Now,
 	}, nil
 }
 
-// AggregatePrices implements the aggregate function for the median price calculation. Specifically, this
+// AggregatePrices implements the aggregate function for the index price calculation. Specifically, this
 // aggregation function aggregates the prices seen by each provider by first converting each price to a
-// common ticker and then calculating the median of the converted prices. Prices are converted either
+// common ticker and then combining the converted prices using the market's aggregation strategy (the
+// median by default). Prices are converted either
 //
 //  1. Directly from the base ticker to the target ticker. i.e. I have BTC/USD and I want BTC/USD.
 //  2. Using the index price of an asset. i.e. I have BTC/USDT and I want BTC/USD. I can convert
 //     BTC/USDT to BTC/USD using the index price of USDT/USD.
+//  3. Using the index prices of several assets. i.e. I have TOKEN/WETH and I want TOKEN/USD. I can
+//     convert TOKEN/WETH to TOKEN/USD using the index prices of WETH/USDT and USDT/USD.
 //
-// The index price cache contains the previously calculated median prices.
+// Markets are calculated in dependency order (see ResolutionOrder), so the index prices used to
+// convert a price are the ones calculated in the same tick. If an index price could not be calculated
+// in the current tick (e.g. due to insufficient providers or a cycle), the index price of the previous
+// tick is used instead and reported to the metrics.
+//
+// Derived markets (i.e. a TWAP or EMA of another market) are calculated after all other markets, from
+// the index price history of their source market (including the index price of the current tick).
+//
+// Alongside each aggregated price, the dispersion of the converted prices that contributed to it (i.e.
+// the standard deviation, minimum, maximum and number of providers) is recorded. Derived markets do not
+// have contributing providers, so no dispersion is recorded for them.
 func (m *IndexPriceAggregator) AggregatePrices() {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
-	indexPrices := make(types.Prices)
+	// The index prices of the current tick are populated as each market is calculated.
+	m.previousIndexPrices = m.indexPrices
+	m.indexPrices = make(types.Prices)
+	defer func() {
+		m.previousIndexPrices = nil
+	}()
+
+	indexPrices := m.indexPrices
 	scaledPrices := make(types.Prices)
+	dispersions := make(types.PriceDispersions)
 
-	var missingPrices []string
+	var (
+		missingPrices  []string
+		derivedMarkets []mmtypes.Market
+	)
 
-	for ticker, market := range m.cfg.Markets {
-		if !market.Ticker.Enabled {
-			m.logger.Debug("skipping disabled market", zap.Any("market", market))
+	for _, ticker := range ResolutionOrder(m.cfg) {
+		market := m.cfg.Markets[ticker]
+		if market.Ticker.IsDerived() {
+			derivedMarkets = append(derivedMarkets, market)
 			continue
 		}
 
@@ -90,9 +132,14 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 		//     BTC/USDC * Index USDC/USD = BTC/USD
 		target := market.Ticker
 		convertedPrices := m.CalculateConvertedPrices(market)
+
+		// Reject any converted prices that deviate too far from the other providers before they are
+		// aggregated (if configured for the market).
+		convertedPrices = m.FilterOutliers(market, convertedPrices)
 		m.metrics.AddProviderCountForMarket(target.String(), len(convertedPrices))
 
-		// We need to have at least the minimum number of providers to calculate the median.
+		// We need to have at least the minimum number of providers (after outliers are rejected) to
+		// calculate the aggregated price.
 		if len(convertedPrices) < int(target.MinProviderCount) { //nolint:gosec
 			missingPrices = append(missingPrices, ticker)
 			m.logger.Debug(
@@ -106,17 +153,21 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 			continue
 		}
 
-		// Take the median of the converted prices. This takes the average of the middle two
-		// prices if the number of prices is even.
-		price := math.CalculateMedian(convertedPrices)
+		// Aggregate the converted prices using the market's aggregation strategy. By default this
+		// takes the median of the converted prices, which is the average of the middle two prices
+		// if the number of prices is even.
+		price, strategy := m.AggregateConvertedPrices(market, convertedPrices)
+		m.metrics.SetAggregationStrategy(target.String(), string(strategy))
 		indexPrices[target.String()] = new(big.Float).Copy(price)
 
 		// Scale the price to the target ticker's decimals.
 		scaledPrices[target.String()] = math.ScaleBigFloat(new(big.Float).Copy(price), target.Decimals)
+		dispersions[target.String()] = ScaleDispersion(CalculateDispersion(convertedPrices), target.Decimals)
 
 		m.logger.Debug(
-			"calculated median price",
+			"calculated aggregated price",
 			zap.String("target_ticker", ticker),
+			zap.String("strategy", string(strategy)),
 
 			zap.String("unscaled_price", indexPrices[target.String()].String()),
 			zap.String("scaled_price", scaledPrices[target.String()].String()),
@@ -127,14 +178,19 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 		m.metrics.UpdateAggregatePrice(target.String(), target.GetDecimals(), floatPrice)
 	}
 
-	// Update the aggregated data. These prices are going to be used as the index prices the
-	// next time we calculate prices.
+	// Record the index prices of the current tick and calculate the derived prices.
+	now := m.now()
+	m.UpdatePriceHistory(now, indexPrices)
+	missingPrices = append(missingPrices, m.aggregateDerivedPrices(now, derivedMarkets, indexPrices, scaledPrices)...)
+
+	// Update the aggregated data. The index prices are going to be used as the fallback index prices
+	// the next time we calculate prices.
 	m.logger.Debug("calculated median prices for price feeds", zap.Int("num_prices", len(indexPrices)))
 	if len(missingPrices) > 0 {
 		m.logger.Info("failed to calculate prices for price feeds", zap.Strings("missing_prices", missingPrices))
 	}
-	m.indexPrices = indexPrices
 	m.scaledPrices = scaledPrices
+	m.dispersions = dispersions
 }
 
 // CalculateConvertedPrices calculates the converted prices for a given set of paths and target ticker.
@@ -142,7 +198,7 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 // MaxPriceAge window so is safe to use.
 func (m *IndexPriceAggregator) CalculateConvertedPrices(
 	market mmtypes.Market,
-) []*big.Float {
+) []ConvertedPrice {
 	m.logger.Debug("calculating converted prices", zap.String("ticker", market.Ticker.String()))
 	if len(market.ProviderConfigs) == 0 {
 		m.logger.Error(
@@ -153,10 +209,21 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 		return nil
 	}
 
-	convertedPrices := make([]*big.Float, 0, len(market.ProviderConfigs))
+	convertedPrices := make([]ConvertedPrice, 0, len(market.ProviderConfigs))
 	for _, cfg := range market.ProviderConfigs {
 		// Calculate the converted price.
-		adjustedPrice, err := m.CalculateAdjustedPrice(cfg)
+		adjustedPrice, stalePairs, err := m.calculateAdjustedPrice(cfg)
+		for _, stalePair := range stalePairs {
+			m.logger.Debug(
+				"using index price from the previous tick",
+				zap.String("target_ticker", market.Ticker.String()),
+				zap.String("normalize_by", stalePair.String()),
+				zap.Any("provider", cfg.Name),
+			)
+
+			m.metrics.AddStaleIndexPriceUsage(market.Ticker.String(), stalePair.String())
+		}
+
 		if err != nil {
 			m.logger.Debug(
 				"failed to calculate converted price",
@@ -169,7 +236,10 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 			continue
 		}
 
-		convertedPrices = append(convertedPrices, adjustedPrice)
+		convertedPrices = append(convertedPrices, ConvertedPrice{
+			Provider: cfg,
+			Price:    adjustedPrice,
+		})
 		m.logger.Debug(
 			"calculated converted price",
 			zap.String("target_ticker", market.Ticker.String()),
@@ -191,27 +261,60 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 //  1. A direct conversion from the base ticker to the target ticker i.e. we want BTC/USD and
 //     we have BTC/USD from a provider (e.g. Coinbase).
 //  2. We need to convert the price of a given asset against the index price of an asset.
+//  3. We need to convert the price of a given asset against the index prices of several assets,
+//     one hop after the other i.e. we want TOKEN/USD and we have TOKEN/WETH, which is converted
+//     using the index prices of WETH/USDT and USDT/USD (in that order).
 //
-// In the first case, we can simply return the price of the provider. In the second case, we need
-// to adjust the price by the index price of the asset. If the index price is not available, we
-// return an error.
+// In the first case, we can simply return the price of the provider. In the other cases, we need
+// to adjust the price by the index price of each hop, inverting the index price if the hop is
+// inverted. If any of the index prices is not available, we return an error.
 func (m *IndexPriceAggregator) CalculateAdjustedPrice(
 	cfg mmtypes.ProviderConfig,
 ) (*big.Float, error) {
+	price, _, err := m.calculateAdjustedPrice(cfg)
+	return price, err
+}
+
+// calculateAdjustedPrice calculates the adjusted price for the given provider config (see
+// CalculateAdjustedPrice). It additionally returns the normalization pairs for which the index
+// price of the previous tick was used.
+func (m *IndexPriceAggregator) calculateAdjustedPrice(
+	cfg mmtypes.ProviderConfig,
+) (*big.Float, []pkgtypes.CurrencyPair, error) {
 	price, err := m.GetProviderPrice(cfg)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if cfg.NormalizeByPair == nil {
-		return price, nil
+	path := cfg.NormalizationPath()
+	if len(path) == 0 {
+		return price, nil, nil
 	}
 
-	normalizeByIndexPrice, err := m.GetIndexPrice(*cfg.NormalizeByPair)
-	if err != nil {
-		return nil, err
+	var stalePairs []pkgtypes.CurrencyPair
+	adjustedPrice := new(big.Float).Copy(price)
+	for _, hop := range path {
+		normalizeByIndexPrice, stale, err := m.getIndexPrice(hop.CurrencyPair)
+		if err != nil {
+			return nil, stalePairs, err
+		}
+
+		if stale {
+			stalePairs = append(stalePairs, hop.CurrencyPair)
+		}
+
+		// Make sure that the price is adjusted by the market price.
+		if hop.Invert {
+			if normalizeByIndexPrice.Sign() == 0 {
+				return nil, stalePairs, fmt.Errorf("cannot invert zero index price for ticker: %s", hop.CurrencyPair)
+			}
+
+			adjustedPrice.Quo(adjustedPrice, normalizeByIndexPrice)
+			continue
+		}
+
+		adjustedPrice.Mul(adjustedPrice, normalizeByIndexPrice)
 	}
 
-	// Make sure that the price is adjusted by the market price.
-	return new(big.Float).Mul(price, normalizeByIndexPrice), nil
+	return adjustedPrice, stalePairs, nil
 }
@@ -4,9 +4,11 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/metrics/mocks"
 	"github.com/skip-mev/connect/v2/oracle/types"
 	"github.com/skip-mev/connect/v2/pkg/math/oracle"
 	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -139,6 +141,33 @@ func TestAggregateData(t *testing.T) {
 				USDT_USD.String(): big.NewFloat(1.05), // average of 1.1, 1.0
 			},
 		},
+		{
+			name: "BTC/USD is normalized by the USDT/USD index price calculated in the same tick - success",
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				prices := types.Prices{
+					"BTC-USD":  big.NewFloat(70_000),
+					"BTC-USDT": big.NewFloat(70_000),
+					"USDT-USD": big.NewFloat(1.1),
+				}
+				aggregator.SetProviderPrices(coinbase.Name, prices)
+
+				prices = types.Prices{
+					"BTCUSDT": big.NewFloat(69_000),
+					"USDTUSD": big.NewFloat(1.1),
+				}
+				aggregator.SetProviderPrices(binance.Name, prices)
+
+				// The index price of the previous tick is not used.
+				indexPrices := types.Prices{
+					usdtusdCP.String(): big.NewFloat(2),
+				}
+				aggregator.SetIndexPrices(indexPrices)
+			},
+			expectedPrices: types.Prices{
+				USDT_USD.String(): big.NewFloat(1.1),    // average of 1.1, 1.1
+				BTC_USD.String():  big.NewFloat(75_900), // median of 70_000, 75_900, 77_000
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -164,6 +193,45 @@ func TestAggregateData(t *testing.T) {
 	}
 }
 
+func TestAggregatePricesWithStaleIndexPrices(t *testing.T) {
+	metricsMock := mocks.NewMetrics(t)
+	metricsMock.On("AddProviderTick", mock.Anything, mock.Anything, mock.Anything).Return()
+	metricsMock.On("UpdatePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	metricsMock.On("AddProviderCountForMarket", mock.Anything, mock.Anything).Return()
+	metricsMock.On("SetAggregationStrategy", mock.Anything, mock.Anything).Return()
+	metricsMock.On("AddTickerTick", mock.Anything).Return()
+	metricsMock.On("UpdateAggregatePrice", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// Both BTC/USD providers that are normalized by USDT/USD use the index price of the previous
+	// tick, as USDT/USD cannot be calculated in the current tick.
+	metricsMock.On("AddStaleIndexPriceUsage", BTC_USD.String(), USDT_USD.String()).Return().Twice()
+
+	m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metricsMock)
+	require.NoError(t, err)
+
+	m.SetProviderPrices(coinbase.Name, types.Prices{
+		"BTC-USD":  big.NewFloat(70_000),
+		"BTC-USDT": big.NewFloat(70_000),
+	})
+	m.SetProviderPrices(binance.Name, types.Prices{
+		"BTCUSDT": big.NewFloat(69_000),
+	})
+	m.SetIndexPrices(types.Prices{
+		usdtusdCP.String(): big.NewFloat(1.1),
+	})
+
+	m.AggregatePrices()
+
+	// The previous index price of USDT/USD is not carried over to the current tick.
+	result := m.GetIndexPrices()
+	require.Len(t, result, 1)
+	require.Equal(t, big.NewFloat(75_900).SetPrec(36), result[BTC_USD.String()].SetPrec(36))
+
+	// In the next tick, there is no index price for USDT/USD to fall back to.
+	m.AggregatePrices()
+	require.Empty(t, m.GetIndexPrices())
+}
+
 func TestCalculateConvertedPrices(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -362,7 +430,8 @@ func TestCalculateConvertedPrices(t *testing.T) {
 
 			// Ensure that the prices are as expected.
 			for i, price := range prices {
-				require.Equal(t, tc.expectedPrices[i].SetPrec(36), price.SetPrec(36))
+				require.Equal(t, tc.expectedPrices[i].SetPrec(36), price.Price.SetPrec(36))
+				require.Equal(t, tc.cfgs[i], price.Provider)
 			}
 		})
 	}
@@ -617,6 +686,83 @@ func TestCalculateAdjustedPrice(t *testing.T) {
 			expectedPrice: big.NewFloat(0.1e-18),
 			expectedErr:   false,
 		},
+		{
+			name:   "price is adjusted over multiple hops (PEPE/ETH * ETH/USDT * USDT/USD = PEPE/USD)",
+			target: PEPE_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           binance.Name,
+				OffChainTicker: "PEPEETH",
+				NormalizeByPairs: []mmtypes.NormalizationPair{
+					{CurrencyPair: pkgtypes.NewCurrencyPair("ETH", "USDT")},
+					{CurrencyPair: usdtusdCP},
+				},
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				prices := types.Prices{
+					"PEPEETH": big.NewFloat(0.000000002),
+				}
+				aggregator.SetProviderPrices(binance.Name, prices)
+
+				indexPrices := types.Prices{
+					"ETH/USDT":         big.NewFloat(4_000),
+					usdtusdCP.String(): big.NewFloat(1.1),
+				}
+				aggregator.SetIndexPrices(indexPrices)
+			},
+			expectedPrice: big.NewFloat(0.0000088),
+			expectedErr:   false,
+		},
+		{
+			name:   "price is adjusted over multiple hops with an inverted hop (PEPE/ETH * ETH/USDT * (USD/USDT ^ -1) = PEPE/USD)",
+			target: PEPE_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           binance.Name,
+				OffChainTicker: "PEPEETH",
+				NormalizeByPairs: []mmtypes.NormalizationPair{
+					{CurrencyPair: pkgtypes.NewCurrencyPair("ETH", "USDT")},
+					{CurrencyPair: pkgtypes.NewCurrencyPair("USD", "USDT"), Invert: true},
+				},
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				prices := types.Prices{
+					"PEPEETH": big.NewFloat(0.000000002),
+				}
+				aggregator.SetProviderPrices(binance.Name, prices)
+
+				indexPrices := types.Prices{
+					"ETH/USDT": big.NewFloat(4_000),
+					"USD/USDT": big.NewFloat(0.8),
+				}
+				aggregator.SetIndexPrices(indexPrices)
+			},
+			expectedPrice: big.NewFloat(0.00001),
+			expectedErr:   false,
+		},
+		{
+			name:   "price cannot be adjusted over multiple hops if an intermediate index price does not exist",
+			target: PEPE_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           binance.Name,
+				OffChainTicker: "PEPEETH",
+				NormalizeByPairs: []mmtypes.NormalizationPair{
+					{CurrencyPair: pkgtypes.NewCurrencyPair("ETH", "USDT")},
+					{CurrencyPair: usdtusdCP},
+				},
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				prices := types.Prices{
+					"PEPEETH": big.NewFloat(0.000000002),
+				}
+				aggregator.SetProviderPrices(binance.Name, prices)
+
+				indexPrices := types.Prices{
+					usdtusdCP.String(): big.NewFloat(1.1),
+				}
+				aggregator.SetIndexPrices(indexPrices)
+			},
+			expectedPrice: nil,
+			expectedErr:   true,
+		},
 	}
 
 	for _, tc := range testCases {
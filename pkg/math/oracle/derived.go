@@ -0,0 +1,143 @@
+package oracle
+
+import (
+	"math/big"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+// historyRequirement is the amount of index price history that is required for a source ticker
+// by the derived markets in the market map.
+type historyRequirement struct {
+	window  time.Duration
+	periods int
+}
+
+// UpdatePriceHistory records the index prices of the current tick in the history of each ticker that
+// is the source of a derived market, and prunes the samples that are no longer needed. The history of
+// tickers that are no longer the source of any derived market is dropped.
+func (m *IndexPriceAggregator) UpdatePriceHistory(now time.Time, indexPrices types.Prices) {
+	requirements := make(map[string]historyRequirement)
+	for _, market := range m.cfg.Markets {
+		derived, err := market.Ticker.DerivedTicker()
+		if err != nil || derived == nil || !market.Ticker.Enabled {
+			continue
+		}
+
+		requirement := requirements[derived.Source]
+		switch derived.Method {
+		case tickermetadata.DerivedMethodTWAP:
+			requirement.window = max(requirement.window, time.Duration(derived.WindowSeconds)*time.Second) //nolint:gosec
+		case tickermetadata.DerivedMethodEMA:
+			requirement.periods = max(requirement.periods, int(derived.Periods)) //nolint:gosec
+		}
+		requirements[derived.Source] = requirement
+	}
+
+	for source := range m.history {
+		if _, ok := requirements[source]; !ok {
+			delete(m.history, source)
+		}
+	}
+
+	for source, requirement := range requirements {
+		history, ok := m.history[source]
+		if !ok {
+			history = NewPriceHistory(MaxPriceHistorySize)
+			m.history[source] = history
+		}
+
+		if price, ok := indexPrices[source]; ok && price != nil {
+			history.Add(now, price)
+		}
+
+		history.Prune(now.Add(-requirement.window), requirement.periods)
+	}
+}
+
+// CalculateDerivedPrice calculates the price of a derived market from the index price history of
+// its source market.
+func (m *IndexPriceAggregator) CalculateDerivedPrice(
+	now time.Time,
+	derived tickermetadata.DerivedTicker,
+) (*big.Float, error) {
+	history, ok := m.history[derived.Source]
+	if !ok {
+		history = NewPriceHistory(MaxPriceHistorySize)
+	}
+
+	switch derived.Method {
+	case tickermetadata.DerivedMethodTWAP:
+		return history.TWAP(now, time.Duration(derived.WindowSeconds)*time.Second) //nolint:gosec
+	default:
+		return history.EMA(derived.Periods)
+	}
+}
+
+// aggregateDerivedPrices calculates the prices of the given derived markets and adds them to the
+// index and scaled prices. A derived price is only calculated if the index price of its source was
+// calculated in the current tick. The names of the markets that could not be calculated are returned.
+func (m *IndexPriceAggregator) aggregateDerivedPrices(
+	now time.Time,
+	derivedMarkets []mmtypes.Market,
+	indexPrices types.Prices,
+	scaledPrices types.Prices,
+) []string {
+	var missingPrices []string
+	for _, market := range derivedMarkets {
+		target := market.Ticker
+		derived, err := target.DerivedTicker()
+		if err != nil || derived == nil {
+			missingPrices = append(missingPrices, target.String())
+			continue
+		}
+
+		if _, ok := indexPrices[derived.Source]; !ok {
+			m.logger.Debug(
+				"missing source index price for derived market",
+				zap.String("target_ticker", target.String()),
+				zap.String("source", derived.Source),
+			)
+
+			missingPrices = append(missingPrices, target.String())
+			continue
+		}
+
+		price, err := m.CalculateDerivedPrice(now, *derived)
+		if err != nil {
+			m.logger.Debug(
+				"failed to calculate derived price",
+				zap.String("target_ticker", target.String()),
+				zap.String("source", derived.Source),
+				zap.String("method", string(derived.Method)),
+				zap.Error(err),
+			)
+
+			missingPrices = append(missingPrices, target.String())
+			continue
+		}
+
+		indexPrices[target.String()] = new(big.Float).Copy(price)
+		scaledPrices[target.String()] = math.ScaleBigFloat(new(big.Float).Copy(price), target.Decimals)
+
+		m.logger.Debug(
+			"calculated derived price",
+			zap.String("target_ticker", target.String()),
+			zap.String("source", derived.Source),
+			zap.String("method", string(derived.Method)),
+			zap.String("unscaled_price", indexPrices[target.String()].String()),
+			zap.String("scaled_price", scaledPrices[target.String()].String()),
+		)
+		floatPrice, _ := price.Float64()
+		m.metrics.AddTickerTick(target.String())
+		m.metrics.UpdateAggregatePrice(target.String(), target.GetDecimals(), floatPrice)
+	}
+
+	return missingPrices
+}
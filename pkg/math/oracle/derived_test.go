@@ -0,0 +1,124 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+func derivedTicker(t *testing.T, base string, derived tickermetadata.DerivedTicker) mmtypes.Ticker {
+	t.Helper()
+
+	bz, err := tickermetadata.MarshalDerivedTicker(derived)
+	require.NoError(t, err)
+
+	return mmtypes.Ticker{
+		CurrencyPair:     pkgtypes.NewCurrencyPair(base, "USD"),
+		Decimals:         8,
+		MinProviderCount: 1,
+		Enabled:          true,
+		Metadata_JSON:    string(bz),
+	}
+}
+
+func TestAggregatePricesWithDerivedMarkets(t *testing.T) {
+	ticker := BTC_USD
+	ticker.MinProviderCount = 1
+
+	ema := derivedTicker(t, "BTCEMA", tickermetadata.NewEMADerivedTicker(ticker.String(), 3))
+	twap := derivedTicker(t, "BTCTWAP", tickermetadata.NewTWAPDerivedTicker(ticker.String(), 300))
+
+	mm := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			ticker.String(): {
+				Ticker: ticker,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{
+						Name:           coinbase.Name,
+						OffChainTicker: "BTC-USD",
+					},
+				},
+			},
+			ema.String(): {
+				Ticker: ema,
+			},
+			twap.String(): {
+				Ticker: twap,
+			},
+		},
+	}
+	require.NoError(t, mm.ValidateBasic())
+
+	m, err := oracle.NewIndexPriceAggregator(logger, mm, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	// The EMA is published once the history holds enough index prices. The TWAP is not published
+	// until the history covers its window.
+	for i, price := range []float64{100, 200, 300} {
+		m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(price)})
+		m.AggregatePrices()
+
+		prices := m.GetIndexPrices()
+		require.Equal(t, big.NewFloat(price).SetPrec(36), prices[ticker.String()].SetPrec(36))
+		require.NotContains(t, prices, twap.String())
+		if i < 2 {
+			require.NotContains(t, prices, ema.String())
+		}
+	}
+
+	prices := m.GetIndexPrices()
+	require.Equal(t, big.NewFloat(225).SetPrec(36), prices[ema.String()].SetPrec(36)) // alpha = 0.5: 100 -> 150 -> 225
+
+	scaledPrices := m.GetPrices()
+	require.Equal(t, big.NewFloat(22_500_000_000).SetPrec(36), scaledPrices[ema.String()].SetPrec(36))
+
+	// Derived prices are not published if the source price is missing in the current tick.
+	m.SetProviderPrices(coinbase.Name, types.Prices{})
+	m.AggregatePrices()
+	require.Empty(t, m.GetIndexPrices())
+}
+
+func TestCalculateDerivedPrice(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	twap := tickermetadata.NewTWAPDerivedTicker(BTC_USD.String(), 60)
+
+	mm := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			BTC_USD.String(): {
+				Ticker: BTC_USD,
+			},
+			"BTCTWAP/USD": {
+				Ticker: derivedTicker(t, "BTCTWAP", twap),
+			},
+		},
+	}
+
+	m, err := oracle.NewIndexPriceAggregator(logger, mm, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	// No history has been recorded.
+	_, err = m.CalculateDerivedPrice(start, twap)
+	require.Error(t, err)
+
+	// Record a price every 30 seconds.
+	for i, price := range []float64{100, 200, 300, 400} {
+		m.UpdatePriceHistory(start.Add(time.Duration(i)*30*time.Second), types.Prices{
+			BTC_USD.String(): big.NewFloat(price),
+		})
+	}
+
+	// The TWAP over the last 60 seconds is 300 * 30s + 400 * 30s.
+	price, err := m.CalculateDerivedPrice(start.Add(120*time.Second), twap)
+	require.NoError(t, err)
+	require.Equal(t, big.NewFloat(350).SetPrec(36), price.SetPrec(36))
+}
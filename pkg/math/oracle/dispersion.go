@@ -0,0 +1,66 @@
+package oracle
+
+import (
+	"math/big"
+
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math"
+)
+
+// CalculateDispersion calculates the dispersion of the given converted prices i.e. the (population)
+// standard deviation, the minimum and maximum price and the number of contributing providers. Provider
+// weights are not taken into account. The returned values are unscaled.
+func CalculateDispersion(convertedPrices []ConvertedPrice) types.PriceDispersion {
+	if len(convertedPrices) == 0 {
+		return types.PriceDispersion{
+			StdDev: new(big.Float),
+			Min:    new(big.Float),
+			Max:    new(big.Float),
+		}
+	}
+
+	var (
+		count = new(big.Float).SetInt64(int64(len(convertedPrices)))
+		sum   = new(big.Float)
+		minP  = convertedPrices[0].Price
+		maxP  = convertedPrices[0].Price
+	)
+
+	for _, convertedPrice := range convertedPrices {
+		sum.Add(sum, convertedPrice.Price)
+
+		if convertedPrice.Price.Cmp(minP) < 0 {
+			minP = convertedPrice.Price
+		}
+		if convertedPrice.Price.Cmp(maxP) > 0 {
+			maxP = convertedPrice.Price
+		}
+	}
+
+	mean := new(big.Float).Quo(sum, count)
+
+	variance := new(big.Float)
+	for _, convertedPrice := range convertedPrices {
+		diff := new(big.Float).Sub(convertedPrice.Price, mean)
+		variance.Add(variance, diff.Mul(diff, diff))
+	}
+	variance.Quo(variance, count)
+
+	return types.PriceDispersion{
+		StdDev:       new(big.Float).Sqrt(variance),
+		Min:          new(big.Float).Copy(minP),
+		Max:          new(big.Float).Copy(maxP),
+		NumProviders: uint64(len(convertedPrices)),
+	}
+}
+
+// ScaleDispersion scales the standard deviation, minimum and maximum of the given dispersion to the
+// given number of decimals, so that they are comparable to the scaled price of the market.
+func ScaleDispersion(dispersion types.PriceDispersion, decimals uint64) types.PriceDispersion {
+	return types.PriceDispersion{
+		StdDev:       math.ScaleBigFloat(new(big.Float).Copy(dispersion.StdDev), decimals),
+		Min:          math.ScaleBigFloat(new(big.Float).Copy(dispersion.Min), decimals),
+		Max:          math.ScaleBigFloat(new(big.Float).Copy(dispersion.Max), decimals),
+		NumProviders: dispersion.NumProviders,
+	}
+}
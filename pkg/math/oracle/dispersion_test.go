@@ -0,0 +1,133 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	"github.com/skip-mev/connect/v2/providers/apis/binance"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+)
+
+func TestCalculateDispersion(t *testing.T) {
+	testCases := []struct {
+		name           string
+		prices         []*big.Float
+		expectedStdDev float64
+		expectedMin    float64
+		expectedMax    float64
+	}{
+		{
+			name:           "no prices",
+			prices:         nil,
+			expectedStdDev: 0,
+			expectedMin:    0,
+			expectedMax:    0,
+		},
+		{
+			name:           "single price",
+			prices:         []*big.Float{big.NewFloat(100)},
+			expectedStdDev: 0,
+			expectedMin:    100,
+			expectedMax:    100,
+		},
+		{
+			name:           "identical prices",
+			prices:         []*big.Float{big.NewFloat(100), big.NewFloat(100), big.NewFloat(100)},
+			expectedStdDev: 0,
+			expectedMin:    100,
+			expectedMax:    100,
+		},
+		{
+			name:           "dispersed prices",
+			prices:         []*big.Float{big.NewFloat(2), big.NewFloat(4), big.NewFloat(4), big.NewFloat(4), big.NewFloat(5), big.NewFloat(5), big.NewFloat(7), big.NewFloat(9)},
+			expectedStdDev: 2,
+			expectedMin:    2,
+			expectedMax:    9,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			convertedPrices := make([]oracle.ConvertedPrice, len(tc.prices))
+			for i, price := range tc.prices {
+				convertedPrices[i] = oracle.ConvertedPrice{Price: price}
+			}
+
+			dispersion := oracle.CalculateDispersion(convertedPrices)
+			require.Equal(t, uint64(len(tc.prices)), dispersion.NumProviders)
+
+			stdDev, _ := dispersion.StdDev.Float64()
+			require.InDelta(t, tc.expectedStdDev, stdDev, 1e-9)
+
+			minPrice, _ := dispersion.Min.Float64()
+			require.Equal(t, tc.expectedMin, minPrice)
+
+			maxPrice, _ := dispersion.Max.Float64()
+			require.Equal(t, tc.expectedMax, maxPrice)
+		})
+	}
+}
+
+func TestScaleDispersion(t *testing.T) {
+	dispersion := types.PriceDispersion{
+		StdDev:       big.NewFloat(1.5),
+		Min:          big.NewFloat(98),
+		Max:          big.NewFloat(101),
+		NumProviders: 3,
+	}
+
+	scaled := oracle.ScaleDispersion(dispersion, 2)
+	require.Equal(t, uint64(3), scaled.NumProviders)
+	require.Equal(t, 0, scaled.StdDev.Cmp(big.NewFloat(150)))
+	require.Equal(t, 0, scaled.Min.Cmp(big.NewFloat(9_800)))
+	require.Equal(t, 0, scaled.Max.Cmp(big.NewFloat(10_100)))
+
+	// The original dispersion must not be modified.
+	require.Equal(t, 0, dispersion.StdDev.Cmp(big.NewFloat(1.5)))
+}
+
+func TestAggregatePricesDispersion(t *testing.T) {
+	m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	m.SetProviderPrices(coinbase.Name, types.Prices{
+		"BTC-USD":  big.NewFloat(70_000),
+		"BTC-USDT": big.NewFloat(70_000),
+	})
+	m.SetProviderPrices(binance.Name, types.Prices{
+		"BTCUSDT": big.NewFloat(69_000),
+	})
+	m.SetIndexPrices(types.Prices{
+		usdtusdCP.String(): big.NewFloat(1.1),
+	})
+
+	m.AggregatePrices()
+
+	// The converted prices are 70_000, 77_000 and 75_900.
+	dispersions := m.GetDispersions()
+	require.Len(t, dispersions, 1)
+
+	dispersion, ok := dispersions[BTC_USD.String()]
+	require.True(t, ok)
+	require.Equal(t, uint64(3), dispersion.NumProviders)
+
+	// The dispersion is scaled by the ticker's decimals, the same as the price.
+	minPrice, _ := dispersion.Min.Float64()
+	require.InDelta(t, 70_000e8, minPrice, 1)
+
+	maxPrice, _ := dispersion.Max.Float64()
+	require.InDelta(t, 77_000e8, maxPrice, 1e3)
+
+	stdDev, _ := dispersion.StdDev.Float64()
+	require.InDelta(t, 3_073.5430e8, stdDev, 1e4)
+
+	// A market without a price does not have a dispersion.
+	m.Reset()
+	m.AggregatePrices()
+	require.Empty(t, m.GetDispersions())
+}
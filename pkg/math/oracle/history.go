@@ -0,0 +1,146 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// MaxPriceHistorySize is the maximum number of index prices that are kept in the history of a ticker.
+const MaxPriceHistorySize = 16384
+
+// PriceSample is an index price of a ticker at a given time.
+type PriceSample struct {
+	Timestamp time.Time
+	Price     *big.Float
+}
+
+// PriceHistory is a bounded, in-memory time series of the index prices of a ticker. Samples are
+// expected to be added in chronological order.
+type PriceHistory struct {
+	samples []PriceSample
+	maxSize int
+}
+
+// NewPriceHistory returns a new PriceHistory that holds at most maxSize samples.
+func NewPriceHistory(maxSize int) *PriceHistory {
+	if maxSize <= 0 || maxSize > MaxPriceHistorySize {
+		maxSize = MaxPriceHistorySize
+	}
+
+	return &PriceHistory{
+		maxSize: maxSize,
+	}
+}
+
+// Add adds a sample to the history, dropping the oldest sample if the history is full.
+func (h *PriceHistory) Add(timestamp time.Time, price *big.Float) {
+	if len(h.samples) == h.maxSize {
+		h.samples = h.samples[1:]
+	}
+
+	h.samples = append(h.samples, PriceSample{
+		Timestamp: timestamp,
+		Price:     new(big.Float).Copy(price),
+	})
+}
+
+// Prune drops the samples that are no longer needed to cover the history since the given time, while
+// keeping at least the minSamples most recent samples. The latest sample at or before the given time is
+// kept, as it is the price at the start of the window.
+func (h *PriceHistory) Prune(since time.Time, minSamples int) {
+	keep := 0
+	for i, sample := range h.samples {
+		if sample.Timestamp.After(since) {
+			break
+		}
+
+		keep = i
+	}
+
+	if len(h.samples)-keep < minSamples {
+		keep = max(len(h.samples)-minSamples, 0)
+	}
+
+	h.samples = h.samples[keep:]
+}
+
+// Len returns the number of samples in the history.
+func (h *PriceHistory) Len() int {
+	return len(h.samples)
+}
+
+// Full returns true iff the history holds its maximum number of samples.
+func (h *PriceHistory) Full() bool {
+	return len(h.samples) == h.maxSize
+}
+
+// TWAP returns the time weighted average price over the window ending at now. Each sample is weighted
+// by the time until the next sample (or now). An error is returned if the history does not cover the
+// full window, unless the history is full.
+func (h *PriceHistory) TWAP(now time.Time, window time.Duration) (*big.Float, error) {
+	if len(h.samples) == 0 {
+		return nil, fmt.Errorf("no price history")
+	}
+
+	start := now.Add(-window)
+	if h.samples[0].Timestamp.After(start) && !h.Full() {
+		return nil, fmt.Errorf("price history does not cover the window of %s", window)
+	}
+
+	var (
+		weightedSum = new(big.Float)
+		totalWeight = new(big.Float)
+	)
+	for i, sample := range h.samples {
+		from := sample.Timestamp
+		if from.Before(start) {
+			from = start
+		}
+
+		to := now
+		if i+1 < len(h.samples) {
+			to = h.samples[i+1].Timestamp
+		}
+
+		if !to.After(from) {
+			continue
+		}
+
+		weight := new(big.Float).SetInt64(int64(to.Sub(from)))
+		weightedSum.Add(weightedSum, new(big.Float).Mul(sample.Price, weight))
+		totalWeight.Add(totalWeight, weight)
+	}
+
+	// All of the samples were taken at now, so the latest price is the average.
+	if totalWeight.Sign() == 0 {
+		return new(big.Float).Copy(h.samples[len(h.samples)-1].Price), nil
+	}
+
+	return weightedSum.Quo(weightedSum, totalWeight), nil
+}
+
+// EMA returns the exponential moving average of the last periods samples, with a smoothing factor
+// of 2 / (periods + 1). The average is seeded with the oldest of those samples. An error is returned
+// if the history holds fewer than periods samples.
+func (h *PriceHistory) EMA(periods uint64) (*big.Float, error) {
+	if periods == 0 {
+		return nil, fmt.Errorf("ema periods must be positive")
+	}
+
+	if uint64(len(h.samples)) < periods {
+		return nil, fmt.Errorf("price history has %d samples; expected at least %d", len(h.samples), periods)
+	}
+
+	alpha := new(big.Float).Quo(big.NewFloat(2), new(big.Float).SetUint64(periods+1))
+	oneMinusAlpha := new(big.Float).Sub(big.NewFloat(1), alpha)
+
+	samples := h.samples[uint64(len(h.samples))-periods:]
+	ema := new(big.Float).Copy(samples[0].Price)
+	for _, sample := range samples[1:] {
+		ema.Mul(ema, oneMinusAlpha)
+		ema.Add(ema, new(big.Float).Mul(sample.Price, alpha))
+	}
+
+	return ema, nil
+}
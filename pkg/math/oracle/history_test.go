@@ -0,0 +1,175 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+)
+
+func TestPriceHistory(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+
+	t.Run("history is bounded", func(t *testing.T) {
+		history := oracle.NewPriceHistory(3)
+		for i := 0; i < 5; i++ {
+			history.Add(start.Add(time.Duration(i)*time.Second), big.NewFloat(float64(i)))
+		}
+
+		require.Equal(t, 3, history.Len())
+		require.True(t, history.Full())
+
+		// The oldest samples are dropped.
+		price, err := history.EMA(1)
+		require.NoError(t, err)
+		require.Equal(t, big.NewFloat(4).SetPrec(36), price.SetPrec(36))
+	})
+
+	t.Run("prune keeps the sample at the start of the window", func(t *testing.T) {
+		history := oracle.NewPriceHistory(10)
+		for i := 0; i < 5; i++ {
+			history.Add(start.Add(time.Duration(i)*time.Second), big.NewFloat(float64(i)))
+		}
+
+		history.Prune(start.Add(2500*time.Millisecond), 0)
+		require.Equal(t, 3, history.Len())
+	})
+
+	t.Run("prune keeps the minimum number of samples", func(t *testing.T) {
+		history := oracle.NewPriceHistory(10)
+		for i := 0; i < 5; i++ {
+			history.Add(start.Add(time.Duration(i)*time.Second), big.NewFloat(float64(i)))
+		}
+
+		history.Prune(start.Add(10*time.Second), 4)
+		require.Equal(t, 4, history.Len())
+	})
+}
+
+func TestPriceHistoryTWAP(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+
+	testCases := []struct {
+		name     string
+		samples  []float64
+		interval time.Duration
+		now      time.Duration
+		window   time.Duration
+		expected *big.Float
+		expErr   bool
+	}{
+		{
+			name:   "no samples",
+			window: time.Minute,
+			expErr: true,
+		},
+		{
+			name:     "history does not cover the window",
+			samples:  []float64{100, 200},
+			interval: time.Second,
+			now:      2 * time.Second,
+			window:   time.Minute,
+			expErr:   true,
+		},
+		{
+			name:     "samples are weighted by the time until the next sample",
+			samples:  []float64{100, 200},
+			interval: time.Second,
+			now:      4 * time.Second,
+			window:   4 * time.Second,
+			expected: big.NewFloat(175), // 100 * 1s + 200 * 3s
+		},
+		{
+			name:     "the first sample is clipped to the start of the window",
+			samples:  []float64{100, 200, 300},
+			interval: 10 * time.Second,
+			now:      30 * time.Second,
+			window:   20 * time.Second,
+			expected: big.NewFloat(250), // 200 * 10s + 300 * 10s
+		},
+		{
+			name:     "a single sample covering the window",
+			samples:  []float64{100},
+			interval: time.Second,
+			now:      time.Minute,
+			window:   time.Second,
+			expected: big.NewFloat(100),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			history := oracle.NewPriceHistory(oracle.MaxPriceHistorySize)
+			for i, price := range tc.samples {
+				history.Add(start.Add(time.Duration(i)*tc.interval), big.NewFloat(price))
+			}
+
+			price, err := history.TWAP(start.Add(tc.now), tc.window)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.SetPrec(36), price.SetPrec(36))
+		})
+	}
+}
+
+func TestPriceHistoryEMA(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+
+	testCases := []struct {
+		name     string
+		samples  []float64
+		periods  uint64
+		expected *big.Float
+		expErr   bool
+	}{
+		{
+			name:    "zero periods",
+			samples: []float64{100},
+			periods: 0,
+			expErr:  true,
+		},
+		{
+			name:    "not enough samples",
+			samples: []float64{100, 200},
+			periods: 3,
+			expErr:  true,
+		},
+		{
+			name:     "single period is the latest price",
+			samples:  []float64{100, 200},
+			periods:  1,
+			expected: big.NewFloat(200),
+		},
+		{
+			name:     "ema over the last samples",
+			samples:  []float64{1_000, 100, 200, 300},
+			periods:  3,
+			expected: big.NewFloat(225), // alpha = 0.5: 100 -> 150 -> 225
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			history := oracle.NewPriceHistory(oracle.MaxPriceHistorySize)
+			for i, price := range tc.samples {
+				history.Add(start.Add(time.Duration(i)*time.Second), big.NewFloat(price))
+			}
+
+			price, err := history.EMA(tc.periods)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.SetPrec(36), price.SetPrec(36))
+		})
+	}
+}
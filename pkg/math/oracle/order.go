@@ -0,0 +1,111 @@
+package oracle
+
+import (
+	"slices"
+
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// ResolutionOrder returns the tickers of the enabled markets in the market map in the order their
+// index prices should be calculated. A market is always ordered after the markets it is normalized
+// by, so that every normalization can use the index price calculated in the same tick. Markets that
+// are otherwise unordered are sorted by ticker so that the order is deterministic.
+//
+// Markets that depend on each other (e.g. USDT/USD normalized by BTC/USD, and BTC/USD normalized by
+// USDT/USD) cannot be fully ordered. In that case, the market with the most provider configs that can
+// already be resolved is calculated first, and its unresolved dependencies are normalized using the
+// index prices of the previous tick.
+func ResolutionOrder(marketMap mmtypes.MarketMap) []string {
+	// Build the dependency graph for all enabled markets. Dependencies on markets that are not in
+	// the market map (or are disabled) are ignored, as they will never have an index price.
+	dependents := make(map[string][]string)
+	inDegree := make(map[string]int)
+	for ticker, market := range marketMap.Markets {
+		if !market.Ticker.Enabled {
+			continue
+		}
+
+		inDegree[ticker] += 0
+		for _, dependency := range market.NormalizationDependencies() {
+			if dependencyMarket, ok := marketMap.Markets[dependency]; !ok || !dependencyMarket.Ticker.Enabled {
+				continue
+			}
+
+			dependents[dependency] = append(dependents[dependency], ticker)
+			inDegree[ticker]++
+		}
+	}
+
+	var ready []string
+	for ticker, degree := range inDegree {
+		if degree == 0 {
+			ready = append(ready, ticker)
+		}
+	}
+
+	order := make([]string, 0, len(inDegree))
+	resolved := make(map[string]struct{}, len(inDegree))
+	for len(inDegree) > 0 {
+		var next string
+		if len(ready) > 0 {
+			slices.Sort(ready)
+			next, ready = ready[0], ready[1:]
+		} else {
+			next = breakCycle(marketMap, inDegree, resolved)
+		}
+
+		order = append(order, next)
+		resolved[next] = struct{}{}
+		delete(inDegree, next)
+
+		for _, dependent := range dependents[next] {
+			if _, ok := inDegree[dependent]; !ok {
+				continue
+			}
+
+			inDegree[dependent]--
+			if inDegree[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	return order
+}
+
+// breakCycle selects the unresolved market that should be calculated next when every unresolved
+// market depends on another unresolved market. The market with the most provider configs that only
+// depend on resolved markets is selected, preferring the smallest ticker.
+func breakCycle(
+	marketMap mmtypes.MarketMap,
+	unresolved map[string]int,
+	resolved map[string]struct{},
+) string {
+	var (
+		next      string
+		nextScore = -1
+	)
+
+	for ticker := range unresolved {
+		score := 0
+		for _, providerConfig := range marketMap.Markets[ticker].ProviderConfigs {
+			resolvable := true
+			for _, hop := range providerConfig.NormalizationPath() {
+				if _, ok := resolved[hop.CurrencyPair.String()]; !ok {
+					resolvable = false
+					break
+				}
+			}
+
+			if resolvable {
+				score++
+			}
+		}
+
+		if score > nextScore || (score == nextScore && ticker < next) {
+			next, nextScore = ticker, score
+		}
+	}
+
+	return next
+}
@@ -0,0 +1,103 @@
+package oracle_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestResolutionOrder(t *testing.T) {
+	market := func(ticker mmtypes.Ticker, normalizeBy ...pkgtypes.CurrencyPair) mmtypes.Market {
+		m := mmtypes.Market{
+			Ticker: ticker,
+		}
+
+		for _, cp := range normalizeBy {
+			m.ProviderConfigs = append(m.ProviderConfigs, mmtypes.ProviderConfig{
+				Name:            coinbase.Name,
+				OffChainTicker:  ticker.String(),
+				NormalizeByPair: &cp,
+			})
+		}
+
+		return m
+	}
+
+	direct := func(m mmtypes.Market) mmtypes.Market {
+		m.ProviderConfigs = append(m.ProviderConfigs, mmtypes.ProviderConfig{
+			Name:           coinbase.Name,
+			OffChainTicker: m.Ticker.String(),
+		})
+
+		return m
+	}
+
+	disabled := USDT_USD
+	disabled.Enabled = false
+
+	testCases := []struct {
+		name      string
+		marketMap mmtypes.MarketMap
+		expected  []string
+	}{
+		{
+			name:      "empty market map",
+			marketMap: mmtypes.MarketMap{},
+			expected:  []string{},
+		},
+		{
+			name: "direct markets are sorted by ticker",
+			marketMap: mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					USDT_USD.String(): direct(market(USDT_USD)),
+					BTC_USD.String():  direct(market(BTC_USD)),
+					ETH_USD.String():  direct(market(ETH_USD)),
+				},
+			},
+			expected: []string{BTC_USD.String(), ETH_USD.String(), USDT_USD.String()},
+		},
+		{
+			name: "markets are ordered after their normalization markets",
+			marketMap: mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					USDT_USD.String(): direct(market(USDT_USD)),
+					BTC_USD.String():  market(BTC_USD, usdtusdCP),
+					PEPE_USD.String(): market(PEPE_USD, btcusdCP),
+				},
+			},
+			expected: []string{USDT_USD.String(), BTC_USD.String(), PEPE_USD.String()},
+		},
+		{
+			name: "disabled markets are skipped",
+			marketMap: mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					USDT_USD.String(): direct(market(disabled)),
+					BTC_USD.String():  market(BTC_USD, usdtusdCP),
+				},
+			},
+			expected: []string{BTC_USD.String()},
+		},
+		{
+			name: "cycles are broken at the market with the most resolvable providers",
+			marketMap: mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					USDT_USD.String(): direct(direct(market(USDT_USD, btcusdCP))),
+					BTC_USD.String():  direct(market(BTC_USD, usdtusdCP)),
+					ETH_USD.String():  market(ETH_USD, usdtusdCP),
+				},
+			},
+			expected: []string{USDT_USD.String(), BTC_USD.String(), ETH_USD.String()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, oracle.ResolutionOrder(tc.marketMap))
+		})
+	}
+}
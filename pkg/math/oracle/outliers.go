@@ -0,0 +1,106 @@
+package oracle
+
+import (
+	"math/big"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/pkg/math"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+// minMADRatio is the minimum median absolute deviation used by the MAD outlier filter, relative to
+// the median. Without it, every price that differs from the median would be rejected whenever
+// most of the providers report the same price, i.e. when the median absolute deviation is zero.
+const minMADRatio = 0.005
+
+// FilterOutliers removes the converted prices that deviate too far from the median of the converted
+// prices, as configured by the outlier filter in the market's ticker metadata. Each rejected price is
+// reported to the metrics. If no outlier filter is configured, the converted prices are returned as is.
+func (m *IndexPriceAggregator) FilterOutliers(
+	market mmtypes.Market,
+	convertedPrices []ConvertedPrice,
+) []ConvertedPrice {
+	if len(convertedPrices) == 0 {
+		return convertedPrices
+	}
+
+	aggregation, err := tickermetadata.AggregationFromJSONString(market.Ticker.Metadata_JSON)
+	if err != nil || aggregation.OutlierFilter == nil {
+		return convertedPrices
+	}
+
+	filter := *aggregation.OutlierFilter
+	if err := filter.ValidateBasic(); err != nil {
+		m.logger.Debug(
+			"invalid outlier filter; skipping outlier rejection",
+			zap.String("target_ticker", market.Ticker.String()),
+			zap.Error(err),
+		)
+
+		return convertedPrices
+	}
+
+	// Determine the maximum deviation from the median that is allowed for the market.
+	median := math.CalculateMedian(pricesOf(convertedPrices))
+	threshold := new(big.Float).SetFloat64(filter.Threshold)
+
+	var maxDeviation *big.Float
+	switch filter.Method {
+	case tickermetadata.OutlierFilterMethodMAD:
+		deviations := make([]*big.Float, len(convertedPrices))
+		for i, convertedPrice := range convertedPrices {
+			deviations[i] = absDeviation(convertedPrice.Price, median)
+		}
+
+		mad := math.CalculateMedian(deviations)
+		minMAD := new(big.Float).Mul(new(big.Float).Abs(median), big.NewFloat(minMADRatio))
+		if mad.Cmp(minMAD) < 0 {
+			mad = minMAD
+		}
+
+		maxDeviation = new(big.Float).Mul(threshold, mad)
+	case tickermetadata.OutlierFilterMethodPercentage:
+		maxDeviation = new(big.Float).Mul(new(big.Float).Abs(median), threshold)
+		maxDeviation.Quo(maxDeviation, big.NewFloat(100))
+	}
+
+	filtered := make([]ConvertedPrice, 0, len(convertedPrices))
+	for _, convertedPrice := range convertedPrices {
+		if absDeviation(convertedPrice.Price, median).Cmp(maxDeviation) > 0 {
+			m.logger.Debug(
+				"rejected outlier provider price",
+				zap.String("target_ticker", market.Ticker.String()),
+				zap.String("provider", convertedPrice.Provider.Name),
+				zap.String("price", convertedPrice.Price.String()),
+				zap.String("median", median.String()),
+				zap.String("max_deviation", maxDeviation.String()),
+			)
+
+			m.metrics.AddProviderRejection(convertedPrice.Provider.Name, market.Ticker.String())
+			continue
+		}
+
+		filtered = append(filtered, convertedPrice)
+	}
+
+	return filtered
+}
+
+// pricesOf returns the prices of the given converted prices in a new slice, so that they can
+// be sorted without reordering the converted prices.
+func pricesOf(convertedPrices []ConvertedPrice) []*big.Float {
+	prices := make([]*big.Float, len(convertedPrices))
+	for i, convertedPrice := range convertedPrices {
+		prices[i] = convertedPrice.Price
+	}
+
+	return prices
+}
+
+// absDeviation returns the absolute difference between the price and the median.
+func absDeviation(price, median *big.Float) *big.Float {
+	deviation := new(big.Float).Sub(price, median)
+	return deviation.Abs(deviation)
+}
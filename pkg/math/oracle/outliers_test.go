@@ -0,0 +1,170 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/metrics/mocks"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	"github.com/skip-mev/connect/v2/providers/apis/binance"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	"github.com/skip-mev/connect/v2/providers/websockets/kucoin"
+	"github.com/skip-mev/connect/v2/providers/websockets/okx"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestFilterOutliers(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata string
+		prices   []*big.Float
+		expected []*big.Float
+	}{
+		{
+			name:     "no outlier filter",
+			metadata: "",
+			prices:   []*big.Float{big.NewFloat(100), big.NewFloat(101), big.NewFloat(0)},
+			expected: []*big.Float{big.NewFloat(100), big.NewFloat(101), big.NewFloat(0)},
+		},
+		{
+			name:     "percentage filter rejects a zero price",
+			metadata: `{"outlier_filter":{"method":"percentage","threshold":5}}`,
+			prices:   []*big.Float{big.NewFloat(100), big.NewFloat(101), big.NewFloat(0)},
+			expected: []*big.Float{big.NewFloat(100), big.NewFloat(101)},
+		},
+		{
+			name:     "percentage filter keeps prices within the band",
+			metadata: `{"outlier_filter":{"method":"percentage","threshold":5}}`,
+			prices:   []*big.Float{big.NewFloat(100), big.NewFloat(104), big.NewFloat(96)},
+			expected: []*big.Float{big.NewFloat(100), big.NewFloat(104), big.NewFloat(96)},
+		},
+		{
+			name:     "percentage filter rejects both prices of a diverging pair",
+			metadata: `{"outlier_filter":{"method":"percentage","threshold":1}}`,
+			prices:   []*big.Float{big.NewFloat(100), big.NewFloat(110)},
+			expected: []*big.Float{},
+		},
+		{
+			name:     "mad filter rejects a spike",
+			metadata: `{"outlier_filter":{"method":"mad","threshold":3}}`,
+			prices:   []*big.Float{big.NewFloat(100), big.NewFloat(101), big.NewFloat(99), big.NewFloat(1000)},
+			expected: []*big.Float{big.NewFloat(100), big.NewFloat(101), big.NewFloat(99)},
+		},
+		{
+			name:     "mad filter keeps prices within the band",
+			metadata: `{"outlier_filter":{"method":"mad","threshold":3}}`,
+			prices:   []*big.Float{big.NewFloat(100), big.NewFloat(101), big.NewFloat(99), big.NewFloat(102)},
+			expected: []*big.Float{big.NewFloat(100), big.NewFloat(101), big.NewFloat(99), big.NewFloat(102)},
+		},
+		{
+			name:     "mad filter keeps a small deviation when most prices are equal",
+			metadata: `{"outlier_filter":{"method":"mad","threshold":3}}`,
+			prices:   []*big.Float{big.NewFloat(100), big.NewFloat(100), big.NewFloat(100), big.NewFloat(101)},
+			expected: []*big.Float{big.NewFloat(100), big.NewFloat(100), big.NewFloat(100), big.NewFloat(101)},
+		},
+		{
+			name:     "mad filter rejects a spike when most prices are equal",
+			metadata: `{"outlier_filter":{"method":"mad","threshold":3}}`,
+			prices:   []*big.Float{big.NewFloat(100), big.NewFloat(100), big.NewFloat(100), big.NewFloat(110)},
+			expected: []*big.Float{big.NewFloat(100), big.NewFloat(100), big.NewFloat(100)},
+		},
+		{
+			name:     "invalid outlier filter is ignored",
+			metadata: `{"outlier_filter":{"method":"zscore","threshold":3}}`,
+			prices:   []*big.Float{big.NewFloat(100), big.NewFloat(0)},
+			expected: []*big.Float{big.NewFloat(100), big.NewFloat(0)},
+		},
+	}
+
+	providers := []string{coinbase.Name, binance.Name, kucoin.Name, okx.Name}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+			require.NoError(t, err)
+
+			ticker := BTC_USD
+			ticker.Metadata_JSON = tc.metadata
+
+			convertedPrices := make([]oracle.ConvertedPrice, len(tc.prices))
+			for i, price := range tc.prices {
+				convertedPrices[i] = oracle.ConvertedPrice{
+					Provider: mmtypes.ProviderConfig{Name: providers[i]},
+					Price:    price,
+				}
+			}
+
+			filtered := m.FilterOutliers(mmtypes.Market{Ticker: ticker}, convertedPrices)
+			require.Len(t, filtered, len(tc.expected))
+			for i, price := range filtered {
+				require.Equal(t, tc.expected[i].SetPrec(36), price.Price.SetPrec(36))
+			}
+		})
+	}
+}
+
+func TestAggregatePricesWithOutlierFilter(t *testing.T) {
+	ticker := BTC_USD
+	ticker.Metadata_JSON = `{"outlier_filter":{"method":"percentage","threshold":5}}`
+	market := mmtypes.Market{
+		Ticker: ticker,
+		ProviderConfigs: []mmtypes.ProviderConfig{
+			{
+				Name:           coinbase.Name,
+				OffChainTicker: "BTC-USD",
+			},
+			{
+				Name:           binance.Name,
+				OffChainTicker: "BTCUSD",
+			},
+			{
+				Name:           kucoin.Name,
+				OffChainTicker: "BTC-USD",
+			},
+		},
+	}
+	mm := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			ticker.String(): market,
+		},
+	}
+
+	t.Run("rejected prices count towards the min provider count", func(t *testing.T) {
+		metricsMock := mocks.NewMetrics(t)
+		metricsMock.On("AddProviderTick", mock.Anything, ticker.String(), true).Return()
+		metricsMock.On("UpdatePrice", mock.Anything, ticker.String(), ticker.Decimals, mock.Anything).Return()
+		metricsMock.On("AddProviderRejection", kucoin.Name, ticker.String()).Return().Once()
+		metricsMock.On("AddProviderCountForMarket", ticker.String(), 2).Return().Once()
+
+		m, err := oracle.NewIndexPriceAggregator(logger, mm, metricsMock)
+		require.NoError(t, err)
+
+		m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(70_000)})
+		m.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(70_100)})
+		m.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(700_000)})
+
+		m.AggregatePrices()
+		require.Empty(t, m.GetPrices())
+	})
+
+	t.Run("prices are aggregated without the outliers", func(t *testing.T) {
+		market.Ticker.MinProviderCount = 2
+		mm.Markets[ticker.String()] = market
+
+		m, err := oracle.NewIndexPriceAggregator(logger, mm, metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(70_000)})
+		m.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(70_100)})
+		m.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(0)})
+
+		m.AggregatePrices()
+		prices := m.GetIndexPrices()
+		require.Len(t, prices, 1)
+		require.Equal(t, big.NewFloat(70_050).SetPrec(36), prices[ticker.String()].SetPrec(36))
+	})
+}
@@ -0,0 +1,104 @@
+package oracle
+
+import (
+	"math/big"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/pkg/math"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+// ConvertedPrice is a provider price that has been converted to the target ticker, along with
+// the provider configuration that was used to derive it.
+type ConvertedPrice struct {
+	// Provider is the provider configuration used to derive the price.
+	Provider mmtypes.ProviderConfig
+	// Price is the converted (unscaled) price.
+	Price *big.Float
+}
+
+// AggregateConvertedPrices combines the converted prices for a market into a single price using the
+// aggregation strategy configured in the market's ticker metadata. The median is used if no strategy
+// is configured, if the metadata cannot be parsed, or if the configured strategy cannot be applied
+// (e.g. no provider has a non-zero weight). The median is weighted by the weight of each provider
+// config if the weights differ. The strategy that was applied is returned alongside the price.
+func (m *IndexPriceAggregator) AggregateConvertedPrices(
+	market mmtypes.Market,
+	convertedPrices []ConvertedPrice,
+) (*big.Float, tickermetadata.AggregationStrategy) {
+	prices := pricesOf(convertedPrices)
+
+	aggregation, err := tickermetadata.AggregationFromJSONString(market.Ticker.Metadata_JSON)
+	if err != nil {
+		m.logger.Debug(
+			"failed to parse aggregation metadata; defaulting to median",
+			zap.String("target_ticker", market.Ticker.String()),
+			zap.Error(err),
+		)
+
+		return math.CalculateMedian(prices), tickermetadata.AggregationStrategyMedian
+	}
+
+	var price *big.Float
+	strategy := aggregation.GetStrategy()
+	switch strategy {
+	case tickermetadata.AggregationStrategyMedian:
+		// Providers are weighted as configured in the market map. If every provider has the same
+		// weight, the plain median is used instead so that the middle two prices are averaged
+		// when the number of prices is even.
+		if weights, ok := configuredWeights(convertedPrices); ok {
+			price = math.CalculateWeightedMedian(prices, weights)
+		}
+	case tickermetadata.AggregationStrategyTrimmedMean:
+		price = math.CalculateTrimmedMean(prices, aggregation.TrimPercentage)
+	case tickermetadata.AggregationStrategyVolumeWeightedMean:
+		price = math.CalculateWeightedMean(prices, providerWeights(convertedPrices, aggregation.ProviderVolumes))
+	case tickermetadata.AggregationStrategyLiquidityWeightedMedian:
+		price = math.CalculateWeightedMedian(prices, providerWeights(convertedPrices, aggregation.ProviderLiquidity))
+	}
+
+	if price == nil {
+		if strategy != tickermetadata.AggregationStrategyMedian {
+			m.logger.Debug(
+				"failed to apply aggregation strategy; defaulting to median",
+				zap.String("target_ticker", market.Ticker.String()),
+				zap.String("strategy", string(strategy)),
+			)
+		}
+
+		return math.CalculateMedian(prices), tickermetadata.AggregationStrategyMedian
+	}
+
+	return price, strategy
+}
+
+// providerWeights returns the weight of each converted price as configured by the given
+// provider name -> weight mapping. Providers without a configured weight are given a
+// weight of zero.
+func providerWeights(convertedPrices []ConvertedPrice, weights map[string]uint64) []*big.Float {
+	out := make([]*big.Float, len(convertedPrices))
+	for i, convertedPrice := range convertedPrices {
+		out[i] = new(big.Float).SetUint64(weights[convertedPrice.Provider.Name])
+	}
+
+	return out
+}
+
+// configuredWeights returns the effective weight of each converted price as configured on its
+// provider config. It returns false if every provider has the same weight.
+func configuredWeights(convertedPrices []ConvertedPrice) ([]*big.Float, bool) {
+	weighted := false
+	out := make([]*big.Float, len(convertedPrices))
+	for i, convertedPrice := range convertedPrices {
+		weight := convertedPrice.Provider.EffectiveWeight()
+		if weight != convertedPrices[0].Provider.EffectiveWeight() {
+			weighted = true
+		}
+
+		out[i] = new(big.Float).SetUint64(weight)
+	}
+
+	return out, weighted
+}
@@ -0,0 +1,171 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	"github.com/skip-mev/connect/v2/providers/apis/binance"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	"github.com/skip-mev/connect/v2/providers/websockets/kucoin"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+func TestAggregateConvertedPrices(t *testing.T) {
+	convertedPrices := []oracle.ConvertedPrice{
+		{
+			Provider: mmtypes.ProviderConfig{Name: coinbase.Name},
+			Price:    big.NewFloat(100),
+		},
+		{
+			Provider: mmtypes.ProviderConfig{Name: binance.Name},
+			Price:    big.NewFloat(110),
+		},
+		{
+			Provider: mmtypes.ProviderConfig{Name: kucoin.Name},
+			Price:    big.NewFloat(200),
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		metadata         string
+		expectedPrice    *big.Float
+		expectedStrategy tickermetadata.AggregationStrategy
+	}{
+		{
+			name:             "no metadata defaults to the median",
+			metadata:         "",
+			expectedPrice:    big.NewFloat(110),
+			expectedStrategy: tickermetadata.AggregationStrategyMedian,
+		},
+		{
+			name:             "unrelated metadata defaults to the median",
+			metadata:         `{"aggregate_ids":[{"venue":"coingecko","ID":"id"}]}`,
+			expectedPrice:    big.NewFloat(110),
+			expectedStrategy: tickermetadata.AggregationStrategyMedian,
+		},
+		{
+			name:             "trimmed mean without trimming",
+			metadata:         `{"aggregation_strategy":"trimmed_mean"}`,
+			expectedPrice:    big.NewFloat(136.66666666666666),
+			expectedStrategy: tickermetadata.AggregationStrategyTrimmedMean,
+		},
+		{
+			name:             "trimmed mean trimming one price from each end",
+			metadata:         `{"aggregation_strategy":"trimmed_mean","trim_percentage":34}`,
+			expectedPrice:    big.NewFloat(110),
+			expectedStrategy: tickermetadata.AggregationStrategyTrimmedMean,
+		},
+		{
+			name:             "volume weighted mean",
+			metadata:         `{"aggregation_strategy":"volume_weighted_mean","provider_volumes":{"coinbase_api":3,"binance_api":1}}`,
+			expectedPrice:    big.NewFloat(102.5),
+			expectedStrategy: tickermetadata.AggregationStrategyVolumeWeightedMean,
+		},
+		{
+			name:             "volume weighted mean without volumes defaults to the median",
+			metadata:         `{"aggregation_strategy":"volume_weighted_mean"}`,
+			expectedPrice:    big.NewFloat(110),
+			expectedStrategy: tickermetadata.AggregationStrategyMedian,
+		},
+		{
+			name:             "liquidity weighted median",
+			metadata:         `{"aggregation_strategy":"liquidity_weighted_median","provider_liquidity":{"coinbase_api":1,"binance_api":1,"kucoin_ws":5}}`,
+			expectedPrice:    big.NewFloat(200),
+			expectedStrategy: tickermetadata.AggregationStrategyLiquidityWeightedMedian,
+		},
+		{
+			name:             "invalid metadata defaults to the median",
+			metadata:         `{"aggregation_strategy":1}`,
+			expectedPrice:    big.NewFloat(110),
+			expectedStrategy: tickermetadata.AggregationStrategyMedian,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+			require.NoError(t, err)
+
+			ticker := BTC_USD
+			ticker.Metadata_JSON = tc.metadata
+			market := mmtypes.Market{
+				Ticker: ticker,
+			}
+
+			prices := make([]oracle.ConvertedPrice, len(convertedPrices))
+			copy(prices, convertedPrices)
+
+			price, strategy := m.AggregateConvertedPrices(market, prices)
+			require.Equal(t, tc.expectedStrategy, strategy)
+			require.Equal(t, tc.expectedPrice.SetPrec(36), price.SetPrec(36))
+		})
+	}
+}
+
+func TestAggregateConvertedPricesWithProviderWeights(t *testing.T) {
+	testCases := []struct {
+		name          string
+		weights       []uint64
+		expectedPrice *big.Float
+	}{
+		{
+			name:          "no weights is the median",
+			weights:       []uint64{0, 0, 0, 0},
+			expectedPrice: big.NewFloat(105),
+		},
+		{
+			name:          "equal weights is the median",
+			weights:       []uint64{5, 5, 5, 5},
+			expectedPrice: big.NewFloat(105),
+		},
+		{
+			name:          "default weight is one",
+			weights:       []uint64{0, 1, 0, 1},
+			expectedPrice: big.NewFloat(105),
+		},
+		{
+			name:          "heavier provider pulls the median",
+			weights:       []uint64{0, 0, 0, 4},
+			expectedPrice: big.NewFloat(200),
+		},
+		{
+			name:          "lighter providers are outweighed",
+			weights:       []uint64{3, 0, 0, 0},
+			expectedPrice: big.NewFloat(100),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+			require.NoError(t, err)
+
+			prices := []*big.Float{
+				big.NewFloat(100),
+				big.NewFloat(110),
+				big.NewFloat(100),
+				big.NewFloat(200),
+			}
+			convertedPrices := make([]oracle.ConvertedPrice, len(prices))
+			for i, price := range prices {
+				convertedPrices[i] = oracle.ConvertedPrice{
+					Provider: mmtypes.ProviderConfig{
+						Name:   coinbase.Name,
+						Weight: tc.weights[i],
+					},
+					Price: price,
+				}
+			}
+
+			price, strategy := m.AggregateConvertedPrices(mmtypes.Market{Ticker: BTC_USD}, convertedPrices)
+			require.Equal(t, tickermetadata.AggregationStrategyMedian, strategy)
+			require.Equal(t, tc.expectedPrice.SetPrec(36), price.SetPrec(36))
+		})
+	}
+}
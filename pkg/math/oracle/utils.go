@@ -53,6 +53,24 @@ func (m *IndexPriceAggregator) GetIndexPrice(
 	return price, nil
 }
 
+// getIndexPrice returns the index price of the current tick for the given currency pair. If the index
+// price has not been calculated in the current tick, the index price of the previous tick is returned
+// (if available) and marked as stale.
+func (m *IndexPriceAggregator) getIndexPrice(
+	cp pkgtypes.CurrencyPair,
+) (*big.Float, bool, error) {
+	price, err := m.GetIndexPrice(cp)
+	if err == nil {
+		return price, false, nil
+	}
+
+	if previous, ok := m.previousIndexPrices[cp.String()]; ok && previous != nil {
+		return previous, true, nil
+	}
+
+	return nil, false, err
+}
+
 // SetIndexPrice sets the index price for the given currency pair.
 func (m *IndexPriceAggregator) SetIndexPrices(
 	prices types.Prices,
@@ -122,3 +140,15 @@ func (m *IndexPriceAggregator) GetPrices() types.Prices {
 
 	return cpy
 }
+
+// GetDispersions returns the dispersion of the converted prices that contributed to each aggregated
+// price. The dispersions are scaled by the respective ticker's decimals, similar to the prices.
+func (m *IndexPriceAggregator) GetDispersions() types.PriceDispersions {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cpy := make(types.PriceDispersions)
+	maps.Copy(cpy, m.dispersions)
+
+	return cpy
+}
@@ -85,6 +85,12 @@ func (m *MedianAggregator) GetPrices() types.Prices {
 	return m.finalPrices
 }
 
+// GetDispersions returns the dispersions of the aggregated prices. The median aggregator
+// does not calculate dispersions, so this always returns an empty map.
+func (m *MedianAggregator) GetDispersions() types.PriceDispersions {
+	return make(types.PriceDispersions)
+}
+
 // Reset resets the data aggregator for all providers.
 func (m *MedianAggregator) Reset() {
 	m.mtx.Lock()
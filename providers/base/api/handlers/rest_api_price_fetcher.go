@@ -11,17 +11,26 @@ import (
 	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/providers/base/api/errors"
 	"github.com/skip-mev/connect/v2/providers/base/api/metrics"
+	"github.com/skip-mev/connect/v2/providers/base/endpoints"
 	providertypes "github.com/skip-mev/connect/v2/providers/types"
 )
 
 // RestAPIFetcher handles the logic of fetching prices from a REST API. This implementation
 // depends on an APIDataHandler to handle the creation of URLs / parsing the API response.
+// If the fetcher is created with a handler per endpoint, requests fail over to the next
+// healthy endpoint when an endpoint cannot be reached or returns a server error.
 type RestAPIFetcher[K providertypes.ResponseKey, V providertypes.ResponseValue] struct {
-	// requestHandler is responsible for making outgoing HTTP requests with a given URL.
-	requestHandler RequestHandler
+	// requestHandlers are responsible for making outgoing HTTP requests with a given URL,
+	// one per endpoint.
+	requestHandlers []RequestHandler
 
-	// apiDataHandler is responsible for creating URLs and parsing the API response.
-	apiDataHandler APIDataHandler[K, V]
+	// apiDataHandlers are responsible for creating URLs and parsing the API response, one
+	// per endpoint.
+	apiDataHandlers []APIDataHandler[K, V]
+
+	// selector selects the endpoint that each request is sent to. It is nil if the fetcher
+	// only has handlers for a single endpoint.
+	selector *endpoints.Selector
 
 	// metrics is responsible for tracking metrics related to the API.
 	metrics metrics.APIMetrics
@@ -63,16 +72,73 @@ func NewRestAPIFetcher[K providertypes.ResponseKey, V providertypes.ResponseValu
 	}
 
 	return &RestAPIFetcher[K, V]{
-		requestHandler: requestHandler,
-		apiDataHandler: apiDataHandler,
-		metrics:        metrics,
-		config:         config,
-		logger:         logger.With(zap.String("fetcher", config.Name)),
+		requestHandlers: []RequestHandler{requestHandler},
+		apiDataHandlers: []APIDataHandler[K, V]{apiDataHandler},
+		metrics:         metrics,
+		config:          config,
+		logger:          logger.With(zap.String("fetcher", config.Name)),
+	}, nil
+}
+
+// NewRestAPIFetcherWithEndpoints creates a new RestAPIFetcher that fails over between the
+// endpoints of the given config. The request handler and API data handler at each index
+// must send requests to, and create URLs for, the endpoint at the same index.
+func NewRestAPIFetcherWithEndpoints[K providertypes.ResponseKey, V providertypes.ResponseValue](
+	requestHandlers []RequestHandler,
+	apiDataHandlers []APIDataHandler[K, V],
+	metrics metrics.APIMetrics,
+	cfg config.APIConfig,
+	logger *zap.Logger,
+	opts ...endpoints.Option,
+) (*RestAPIFetcher[K, V], error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if !cfg.Enabled {
+		return nil, fmt.Errorf("api is disabled")
+	}
+
+	if len(cfg.Endpoints) == 0 {
+		return nil, fmt.Errorf("no endpoints configured")
+	}
+
+	if len(requestHandlers) != len(cfg.Endpoints) || len(apiDataHandlers) != len(cfg.Endpoints) {
+		return nil, fmt.Errorf(
+			"expected a request handler and api data handler per endpoint (%d); got %d and %d",
+			len(cfg.Endpoints),
+			len(requestHandlers),
+			len(apiDataHandlers),
+		)
+	}
+
+	for i := range cfg.Endpoints {
+		if requestHandlers[i] == nil {
+			return nil, fmt.Errorf("request handler for endpoint %d is nil", i)
+		}
+
+		if apiDataHandlers[i] == nil {
+			return nil, fmt.Errorf("api data handler for endpoint %d is nil", i)
+		}
+	}
+
+	if metrics == nil {
+		return nil, fmt.Errorf("metrics is nil")
+	}
+
+	return &RestAPIFetcher[K, V]{
+		requestHandlers: requestHandlers,
+		apiDataHandlers: apiDataHandlers,
+		selector:        endpoints.NewSelector(cfg.Endpoints, opts...),
+		metrics:         metrics,
+		config:          cfg,
+		logger:          logger.With(zap.String("fetcher", cfg.Name)),
 	}, nil
 }
 
 // Fetch is used to fetch the corresponding IDs from the API. This method blocks until the
-// response is received from the API, parsed, and returned.
+// response is received from the API, parsed, and returned. If the request to an endpoint
+// fails, it is retried on the next healthy endpoint, if any.
 func (pf *RestAPIFetcher[K, V]) Fetch(
 	ctx context.Context,
 	ids []K,
@@ -83,8 +149,51 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 		pf.metrics.ObserveProviderResponseLatency(pf.config.Name, metrics.RedactedURL, time.Since(start))
 	}()
 
+	// Without a selector, the data handler creates the URLs for a single endpoint.
+	if pf.selector == nil {
+		response, _ := pf.fetch(ctx, 0, ids)
+		return response
+	}
+
+	var response providertypes.GetResponse[K, V]
+	for attempt := 0; attempt < pf.selector.Len(); attempt++ {
+		index, _, err := pf.selector.Select()
+		if err != nil {
+			return providertypes.NewGetResponseWithErr[K, V](
+				ids,
+				providertypes.NewErrorWithCode(
+					errors.ErrCreateURLWithErr(err),
+					providertypes.ErrorUnableToCreateURL,
+				),
+			)
+		}
+
+		var failed bool
+		response, failed = pf.fetch(ctx, index, ids)
+		if !failed || ctx.Err() != nil {
+			break
+		}
+
+		if attempt < pf.selector.Len()-1 {
+			pf.logger.Debug("request to endpoint failed; failing over to next endpoint", zap.Int("endpoint", index))
+		}
+	}
+
+	return response
+}
+
+// fetch is used to fetch the corresponding IDs from the endpoint with the given index. This
+// returns true if the endpoint failed, i.e. the request could not be made or the endpoint
+// returned a server error, in which case the request may be retried on another endpoint.
+func (pf *RestAPIFetcher[K, V]) fetch(
+	ctx context.Context,
+	index int,
+	ids []K,
+) (providertypes.GetResponse[K, V], bool) {
+	start := time.Now()
+
 	// Create the URL for the request.
-	url, err := pf.apiDataHandler.CreateURL(ids)
+	url, err := pf.apiDataHandlers[index].CreateURL(ids)
 	if err != nil {
 		return providertypes.NewGetResponseWithErr[K, V](
 			ids,
@@ -92,7 +201,7 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 				errors.ErrCreateURLWithErr(err),
 				providertypes.ErrorUnableToCreateURL,
 			),
-		)
+		), false
 	}
 
 	pf.logger.Debug("created url", zap.String("url", url))
@@ -104,7 +213,7 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 	pf.logger.Debug("making request", zap.String("url", url))
 
 	// Record the status code in the metrics.
-	resp, err := pf.requestHandler.Do(apiCtx, url)
+	resp, err := pf.requestHandlers[index].Do(apiCtx, url)
 	pf.metrics.AddHTTPStatusCode(pf.config.Name, resp)
 	if err != nil {
 		status := providertypes.ErrorUnknown
@@ -118,22 +227,27 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 			zap.String("url", url),
 		)
 
+		pf.reportFailure(index)
 		return providertypes.NewGetResponseWithErr[K, V](
 			ids,
 			providertypes.NewErrorWithCode( // TODO(nikhil): coordinate api-errors w/ correct metric codes
 				errors.ErrDoRequestWithErr(err),
 				status,
 			),
-		)
+		), true
 	}
 	defer resp.Body.Close()
 
 	pf.logger.Debug("received response", zap.Int("status_code", resp.StatusCode))
 	// TODO: add more error handling here.
 	// TODO(nikhil): move this logic to a shared HTTPClient
-	var response providertypes.GetResponse[K, V]
+	var (
+		response providertypes.GetResponse[K, V]
+		failed   bool
+	)
 	switch {
 	case resp.StatusCode == http.StatusTooManyRequests:
+		failed = true
 		response = providertypes.NewGetResponseWithErr[K, V](
 			ids,
 			providertypes.NewErrorWithCode(
@@ -142,6 +256,7 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 			),
 		)
 	case resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices:
+		failed = resp.StatusCode >= http.StatusInternalServerError
 		response = providertypes.NewGetResponseWithErr[K, V](
 			ids,
 			providertypes.NewErrorWithCode(
@@ -150,7 +265,7 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 			),
 		)
 	default:
-		response = pf.apiDataHandler.ParseResponse(ids, resp)
+		response = pf.apiDataHandlers[index].ParseResponse(ids, resp)
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
@@ -161,5 +276,26 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 		)
 	}
 
-	return response
+	if failed {
+		pf.reportFailure(index)
+	} else if pf.selector != nil {
+		pf.selector.ReportSuccess(index, time.Since(start))
+	}
+
+	return response, failed
+}
+
+// reportFailure records that the endpoint with the given index failed, if the fetcher fails
+// over between endpoints.
+func (pf *RestAPIFetcher[K, V]) reportFailure(index int) {
+	if pf.selector == nil {
+		return
+	}
+
+	pf.selector.ReportFailure(index)
+	pf.logger.Debug(
+		"endpoint failed",
+		zap.Int("endpoint", index),
+		zap.Float64("error_rate", pf.selector.Health()[index].ErrorRate),
+	)
 }
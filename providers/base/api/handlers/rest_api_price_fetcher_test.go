@@ -0,0 +1,170 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/providers/base/api/handlers"
+	"github.com/skip-mev/connect/v2/providers/base/api/handlers/mocks"
+	"github.com/skip-mev/connect/v2/providers/base/api/metrics"
+	mockmetrics "github.com/skip-mev/connect/v2/providers/base/api/metrics/mocks"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
+)
+
+const mirrorURL = "http://mirror.fetchdata.org:8080"
+
+func TestRestAPIFetcherWithEndpoints(t *testing.T) {
+	endpointsCfg := cfg
+	endpointsCfg.Endpoints = []config.Endpoint{{URL: constantURL}, {URL: mirrorURL}}
+
+	newMetrics := func() metrics.APIMetrics {
+		m := mockmetrics.NewAPIMetrics(t)
+		m.On("ObserveProviderResponseLatency", "handler1", metrics.RedactedURL, mock.Anything).Maybe()
+		m.On("AddHTTPStatusCode", "handler1", mock.Anything).Maybe()
+		return m
+	}
+
+	newAPIHandler := func(url string, parse bool) *mocks.APIDataHandler[slinkytypes.CurrencyPair, *big.Int] {
+		h := mocks.NewAPIDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+		h.On("CreateURL", mock.Anything).Return(url, nil).Maybe()
+		if parse {
+			h.On("ParseResponse", mock.Anything, mock.Anything).Return(
+				providertypes.NewGetResponse(
+					map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+						btcusd: {Value: big.NewInt(100)},
+					},
+					nil,
+				),
+			).Maybe()
+		}
+		return h
+	}
+
+	t.Run("requires a handler per endpoint", func(t *testing.T) {
+		_, err := handlers.NewRestAPIFetcherWithEndpoints[slinkytypes.CurrencyPair, *big.Int](
+			[]handlers.RequestHandler{mocks.NewRequestHandler(t)},
+			[]handlers.APIDataHandler[slinkytypes.CurrencyPair, *big.Int]{newAPIHandler(constantURL, false)},
+			newMetrics(),
+			endpointsCfg,
+			logger,
+		)
+		require.Error(t, err)
+	})
+
+	t.Run("fails over to the next endpoint on request errors", func(t *testing.T) {
+		primary := mocks.NewRequestHandler(t)
+		primary.On("Do", mock.Anything, constantURL).Return(nil, fmt.Errorf("connection refused")).Once()
+
+		mirror := mocks.NewRequestHandler(t)
+		mirror.On("Do", mock.Anything, mirrorURL).Return(
+			&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))},
+			nil,
+		).Twice()
+
+		fetcher, err := handlers.NewRestAPIFetcherWithEndpoints[slinkytypes.CurrencyPair, *big.Int](
+			[]handlers.RequestHandler{primary, mirror},
+			[]handlers.APIDataHandler[slinkytypes.CurrencyPair, *big.Int]{
+				newAPIHandler(constantURL, false),
+				newAPIHandler(mirrorURL, true),
+			},
+			newMetrics(),
+			endpointsCfg,
+			logger,
+		)
+		require.NoError(t, err)
+
+		// The request fails over to the mirror within the same fetch.
+		response := fetcher.Fetch(context.Background(), []slinkytypes.CurrencyPair{btcusd})
+		require.Len(t, response.Resolved, 1)
+		require.Equal(t, big.NewInt(100), response.Resolved[btcusd].Value)
+
+		// Subsequent requests are sent to the mirror until the primary is probed again.
+		response = fetcher.Fetch(context.Background(), []slinkytypes.CurrencyPair{btcusd})
+		require.Len(t, response.Resolved, 1)
+	})
+
+	t.Run("fails over to the next endpoint on server errors", func(t *testing.T) {
+		primary := mocks.NewRequestHandler(t)
+		primary.On("Do", mock.Anything, constantURL).Return(
+			&http.Response{StatusCode: http.StatusBadGateway, Body: io.NopCloser(strings.NewReader(""))},
+			nil,
+		).Once()
+
+		mirror := mocks.NewRequestHandler(t)
+		mirror.On("Do", mock.Anything, mirrorURL).Return(
+			&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))},
+			nil,
+		).Once()
+
+		fetcher, err := handlers.NewRestAPIFetcherWithEndpoints[slinkytypes.CurrencyPair, *big.Int](
+			[]handlers.RequestHandler{primary, mirror},
+			[]handlers.APIDataHandler[slinkytypes.CurrencyPair, *big.Int]{
+				newAPIHandler(constantURL, false),
+				newAPIHandler(mirrorURL, true),
+			},
+			newMetrics(),
+			endpointsCfg,
+			logger,
+		)
+		require.NoError(t, err)
+
+		response := fetcher.Fetch(context.Background(), []slinkytypes.CurrencyPair{btcusd})
+		require.Len(t, response.Resolved, 1)
+	})
+
+	t.Run("does not fail over on client errors", func(t *testing.T) {
+		primary := mocks.NewRequestHandler(t)
+		primary.On("Do", mock.Anything, constantURL).Return(
+			&http.Response{StatusCode: http.StatusBadRequest, Body: io.NopCloser(strings.NewReader(""))},
+			nil,
+		).Once()
+
+		fetcher, err := handlers.NewRestAPIFetcherWithEndpoints[slinkytypes.CurrencyPair, *big.Int](
+			[]handlers.RequestHandler{primary, mocks.NewRequestHandler(t)},
+			[]handlers.APIDataHandler[slinkytypes.CurrencyPair, *big.Int]{
+				newAPIHandler(constantURL, false),
+				newAPIHandler(mirrorURL, false),
+			},
+			newMetrics(),
+			endpointsCfg,
+			logger,
+		)
+		require.NoError(t, err)
+
+		response := fetcher.Fetch(context.Background(), []slinkytypes.CurrencyPair{btcusd})
+		require.Len(t, response.UnResolved, 1)
+	})
+
+	t.Run("returns the last error if all endpoints fail", func(t *testing.T) {
+		primary := mocks.NewRequestHandler(t)
+		primary.On("Do", mock.Anything, constantURL).Return(nil, fmt.Errorf("connection refused")).Once()
+
+		mirror := mocks.NewRequestHandler(t)
+		mirror.On("Do", mock.Anything, mirrorURL).Return(nil, fmt.Errorf("connection refused")).Once()
+
+		fetcher, err := handlers.NewRestAPIFetcherWithEndpoints[slinkytypes.CurrencyPair, *big.Int](
+			[]handlers.RequestHandler{primary, mirror},
+			[]handlers.APIDataHandler[slinkytypes.CurrencyPair, *big.Int]{
+				newAPIHandler(constantURL, false),
+				newAPIHandler(mirrorURL, false),
+			},
+			newMetrics(),
+			endpointsCfg,
+			logger,
+		)
+		require.NoError(t, err)
+
+		response := fetcher.Fetch(context.Background(), []slinkytypes.CurrencyPair{btcusd})
+		require.Len(t, response.UnResolved, 1)
+	})
+}
@@ -0,0 +1,61 @@
+package base
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+)
+
+// reconnectionBackoff determines how long a websocket connection waits for before reconnecting.
+// The timeout increases exponentially with the number of consecutive failed connections, up to
+// the configured maximum, and is reset once a connection has been healthy for the configured
+// reset period. A random jitter is subtracted from the timeout so that providers that fail at
+// the same time do not reconnect in lockstep.
+type reconnectionBackoff struct {
+	cfg config.WebSocketConfig
+
+	// attempts is the number of consecutive failed connections.
+	attempts int
+}
+
+// newReconnectionBackoff returns a new reconnection backoff for the given websocket config.
+func newReconnectionBackoff(cfg config.WebSocketConfig) *reconnectionBackoff {
+	return &reconnectionBackoff{
+		cfg: cfg,
+	}
+}
+
+// next records that the connection was closed after having been up for the given duration, and
+// returns the timeout to wait for before reconnecting.
+func (b *reconnectionBackoff) next(uptime time.Duration) time.Duration {
+	if b.cfg.ReconnectionResetPeriod > 0 && uptime >= b.cfg.ReconnectionResetPeriod {
+		b.attempts = 0
+	}
+
+	timeout := b.timeout()
+	b.attempts++
+
+	if b.cfg.ReconnectionJitter > 0 {
+		timeout -= time.Duration(b.cfg.ReconnectionJitter * rand.Float64() * float64(timeout))
+	}
+
+	return timeout
+}
+
+// timeout returns the timeout for the current number of consecutive failed connections, before
+// the jitter is applied.
+func (b *reconnectionBackoff) timeout() time.Duration {
+	// The backoff is disabled if no maximum timeout is configured.
+	if b.cfg.MaxReconnectionTimeout <= 0 {
+		return b.cfg.ReconnectionTimeout
+	}
+
+	timeout := float64(b.cfg.ReconnectionTimeout) * math.Pow(b.cfg.ReconnectionBackoffMultiplier, float64(b.attempts))
+	if timeout >= float64(b.cfg.MaxReconnectionTimeout) {
+		return b.cfg.MaxReconnectionTimeout
+	}
+
+	return time.Duration(timeout)
+}
@@ -37,14 +37,22 @@ func WithNewWebSocketHandler[K providertypes.ResponseKey, V providertypes.Respon
 	}
 }
 
-// Update updates the provider with the given options.
+// Update updates the provider with the given options. If only the IDs of a running websocket provider
+// are updated, its connections are subscribed to the new IDs and unsubscribed from the removed IDs in
+// place. Otherwise, the provider is restarted with the new configuration.
 func (p *Provider[K, V]) Update(opts ...UpdateOption[K, V]) {
 	p.logger.Debug("updating provider")
+	wsHandler := p.getWebSocketHandler()
 	for _, opt := range opts {
 		opt(p)
 	}
 	p.logger.Debug("provider updated")
 
+	if p.Type() == providertypes.WebSockets && wsHandler == p.getWebSocketHandler() && p.updateWebSocketIDs(p.GetIDs()) {
+		p.logger.Debug("updated ids of websocket connections; provider is not restarted")
+		return
+	}
+
 	if _, cancel := p.getFetchCtx(); cancel != nil {
 		p.logger.Debug("canceling fetch context; restarting provider")
 		cancel()
@@ -125,3 +133,11 @@ func (p *Provider[K, V]) GetWebSocketHandler() wshandlers.WebSocketQueryHandler[
 func (p *Provider[K, V]) GetAPIConfig() config.APIConfig {
 	return p.apiCfg
 }
+
+// getWebSocketHandler returns the WebSocket handler of the provider, which is nil for API providers.
+func (p *Provider[K, V]) getWebSocketHandler() wshandlers.WebSocketQueryHandler[K, V] {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.ws
+}
@@ -3,14 +3,17 @@ package base_test
 import (
 	"context"
 	"math/big"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
 	"github.com/skip-mev/connect/v2/providers/base"
 	"github.com/skip-mev/connect/v2/providers/base/testutils"
+	wshandlermocks "github.com/skip-mev/connect/v2/providers/base/websocket/handlers/mocks"
 	providertypes "github.com/skip-mev/connect/v2/providers/types"
 )
 
@@ -239,4 +242,84 @@ func TestConfigUpdater(t *testing.T) {
 		provider.Stop()
 		require.Eventually(t, func() bool { return !provider.IsRunning() }, 2*time.Second, 100*time.Millisecond)
 	})
+
+	t.Run("update IDs in place with a websocket provider", func(t *testing.T) {
+		pairs := []slinkytypes.CurrencyPair{btcusd}
+		updated := []slinkytypes.CurrencyPair{ethusd, solusd, btcusd}
+
+		var starts atomic.Int32
+		wsHandler := wshandlermocks.NewWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](t)
+		wsHandler.On("Copy").Return(wsHandler).Maybe()
+		wsHandler.On("Start", mock.Anything, pairs, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			starts.Add(1)
+			<-args.Get(0).(context.Context).Done()
+		}).Once()
+		wsHandler.On("UpdateIDs", []slinkytypes.CurrencyPair{btcusd, ethusd, solusd}).Return(nil).Once()
+
+		provider, err := base.NewProvider[slinkytypes.CurrencyPair, *big.Int](
+			base.WithName[slinkytypes.CurrencyPair, *big.Int](wsCfg.Name),
+			base.WithWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](wsHandler),
+			base.WithWebSocketConfig[slinkytypes.CurrencyPair, *big.Int](wsCfg),
+			base.WithLogger[slinkytypes.CurrencyPair, *big.Int](logger),
+			base.WithIDs[slinkytypes.CurrencyPair, *big.Int](pairs),
+		)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 8*time.Second)
+		defer cancel()
+
+		go func() {
+			provider.Start(ctx)
+		}()
+
+		// Wait for the connection to start and update the IDs.
+		require.Eventually(t, func() bool { return starts.Load() == 1 }, 2*time.Second, 100*time.Millisecond)
+		provider.Update(base.WithNewIDs[slinkytypes.CurrencyPair, *big.Int](updated))
+
+		// The IDs should be updated without restarting the connection.
+		time.Sleep(2 * time.Second)
+		require.Equal(t, updated, provider.GetIDs())
+		require.Equal(t, int32(1), starts.Load())
+
+		provider.Stop()
+		require.Eventually(t, func() bool { return !provider.IsRunning() }, 2*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("restart on IDs update that requires rebalancing connections", func(t *testing.T) {
+		pairs := []slinkytypes.CurrencyPair{btcusd}
+
+		var starts atomic.Int32
+		wsHandler := wshandlermocks.NewWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](t)
+		wsHandler.On("Copy").Return(wsHandler).Maybe()
+		wsHandler.On("Start", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			starts.Add(1)
+			<-args.Get(0).(context.Context).Done()
+		})
+
+		// The multiplex config allows a single subscription per connection, so new IDs require new connections.
+		provider, err := base.NewProvider[slinkytypes.CurrencyPair, *big.Int](
+			base.WithName[slinkytypes.CurrencyPair, *big.Int](wsCfgMultiplex.Name),
+			base.WithWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](wsHandler),
+			base.WithWebSocketConfig[slinkytypes.CurrencyPair, *big.Int](wsCfgMultiplex),
+			base.WithLogger[slinkytypes.CurrencyPair, *big.Int](logger),
+			base.WithIDs[slinkytypes.CurrencyPair, *big.Int](pairs),
+		)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 8*time.Second)
+		defer cancel()
+
+		go func() {
+			provider.Start(ctx)
+		}()
+
+		require.Eventually(t, func() bool { return starts.Load() == 1 }, 2*time.Second, 100*time.Millisecond)
+		provider.Update(base.WithNewIDs[slinkytypes.CurrencyPair, *big.Int]([]slinkytypes.CurrencyPair{btcusd, ethusd}))
+
+		// The provider should restart its connections instead of updating them in place.
+		require.Eventually(t, func() bool { return starts.Load() == 2 }, 4*time.Second, 100*time.Millisecond)
+
+		provider.Stop()
+		require.Eventually(t, func() bool { return !provider.IsRunning() }, 2*time.Second, 100*time.Millisecond)
+	})
 }
@@ -0,0 +1,253 @@
+package endpoints
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+)
+
+const (
+	// DefaultProbeInterval is the default amount of time that a failed endpoint is skipped for,
+	// before it is selected again to probe whether it has recovered.
+	DefaultProbeInterval = 30 * time.Second
+
+	// smoothingFactor is the weight of the latest observation in the moving averages of the
+	// error rate and latency of an endpoint.
+	smoothingFactor = 0.2
+)
+
+// Health is a snapshot of the health of an endpoint.
+type Health struct {
+	// Endpoint is the endpoint that the health is tracked for.
+	Endpoint config.Endpoint
+
+	// Healthy is false if the last request to the endpoint failed.
+	Healthy bool
+
+	// ErrorRate is the exponential moving average of the rate of requests to the endpoint
+	// that failed, between 0 and 1.
+	ErrorRate float64
+
+	// Latency is the exponential moving average of the latency of successful requests to
+	// the endpoint. It is zero if no request to the endpoint has succeeded yet.
+	Latency time.Duration
+}
+
+// endpointHealth tracks the health of a single endpoint.
+type endpointHealth struct {
+	Health
+
+	// failedAt is the time at which the endpoint last failed, or was last selected to be
+	// probed while failed.
+	failedAt time.Time
+
+	// measured is true once a request to the endpoint has succeeded, i.e. its latency is known.
+	measured bool
+}
+
+// Selector selects the endpoint that a provider sends its requests to, out of the endpoints that
+// are configured for it. Endpoints that fail are skipped in favour of the other endpoints, and are
+// periodically probed to determine whether they have recovered. Out of the healthy endpoints, the
+// one with the lowest latency, weighted by its error rate, is selected. Endpoints that have not
+// been used yet are only selected, in the configured order, if no endpoint with a known latency
+// is healthy. The Selector is safe for concurrent use.
+type Selector struct {
+	mu sync.Mutex
+
+	// probeInterval is the amount of time that a failed endpoint is skipped for.
+	probeInterval time.Duration
+
+	// endpoints is the health of each of the configured endpoints.
+	endpoints []*endpointHealth
+}
+
+// Option is a function that is used to configure the Selector.
+type Option func(*Selector)
+
+// WithProbeInterval sets the amount of time that a failed endpoint is skipped for, before it is
+// selected again to probe whether it has recovered.
+func WithProbeInterval(probeInterval time.Duration) Option {
+	return func(s *Selector) {
+		s.probeInterval = probeInterval
+	}
+}
+
+// NewSelector returns a new Selector for the given endpoints. All endpoints are considered to be
+// healthy initially.
+func NewSelector(endpoints []config.Endpoint, opts ...Option) *Selector {
+	s := &Selector{
+		probeInterval: DefaultProbeInterval,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.setEndpoints(endpoints)
+	return s
+}
+
+// Select returns the index of the endpoint that the next request should be sent to, along with
+// the endpoint itself. An error is returned if no endpoints are configured.
+func (s *Selector) Select() (int, config.Endpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.endpoints) == 0 {
+		return 0, config.Endpoint{}, fmt.Errorf("no endpoints configured")
+	}
+
+	var (
+		now      = time.Now()
+		selected = -1
+		fallback = -1
+		oldest   = -1
+	)
+	for i, e := range s.endpoints {
+		if !e.Healthy {
+			// Probe failed endpoints once the probe interval has elapsed since they last
+			// failed, or were last probed.
+			if now.Sub(e.failedAt) >= s.probeInterval {
+				e.failedAt = now
+				return i, e.Endpoint, nil
+			}
+
+			if oldest == -1 || e.failedAt.Before(s.endpoints[oldest].failedAt) {
+				oldest = i
+			}
+
+			continue
+		}
+
+		// Endpoints without a known latency are only used if no other endpoint is healthy.
+		if !e.measured {
+			if fallback == -1 {
+				fallback = i
+			}
+
+			continue
+		}
+
+		if selected == -1 || e.score() < s.endpoints[selected].score() {
+			selected = i
+		}
+	}
+
+	switch {
+	case selected != -1:
+	case fallback != -1:
+		selected = fallback
+	default:
+		// All endpoints failed, so the endpoint that failed the longest ago is retried.
+		selected = oldest
+	}
+
+	return selected, s.endpoints[selected].Endpoint, nil
+}
+
+// ReportSuccess records that a request to the endpoint with the given index succeeded with the
+// given latency. The endpoint is considered to be healthy again if it failed before.
+func (s *Selector) ReportSuccess(index int, latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if index < 0 || index >= len(s.endpoints) {
+		return
+	}
+
+	e := s.endpoints[index]
+	e.Healthy = true
+	e.ErrorRate = movingAverage(e.ErrorRate, 0)
+	if !e.measured {
+		e.Latency = latency
+		e.measured = true
+	} else {
+		e.Latency = time.Duration(movingAverage(float64(e.Latency), float64(latency)))
+	}
+}
+
+// ReportFailure records that a request to the endpoint with the given index failed. The endpoint
+// is skipped until it is probed again.
+func (s *Selector) ReportFailure(index int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if index < 0 || index >= len(s.endpoints) {
+		return
+	}
+
+	e := s.endpoints[index]
+	e.Healthy = false
+	e.ErrorRate = movingAverage(e.ErrorRate, 1)
+	e.failedAt = time.Now()
+}
+
+// SetEndpoints updates the endpoints that are selected from. The health of the endpoints is reset
+// if the endpoints changed.
+func (s *Selector) SetEndpoints(endpoints []config.Endpoint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(endpoints) == len(s.endpoints) {
+		changed := false
+		for i, e := range s.endpoints {
+			if e.Endpoint != endpoints[i] {
+				changed = true
+				break
+			}
+		}
+
+		if !changed {
+			return
+		}
+	}
+
+	s.setEndpoints(endpoints)
+}
+
+// Health returns a snapshot of the health of each of the endpoints.
+func (s *Selector) Health() []Health {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	health := make([]Health, len(s.endpoints))
+	for i, e := range s.endpoints {
+		health[i] = e.Health
+	}
+
+	return health
+}
+
+// Len returns the number of endpoints that are selected from.
+func (s *Selector) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.endpoints)
+}
+
+// setEndpoints resets the health of the selector to the given, healthy endpoints.
+func (s *Selector) setEndpoints(endpoints []config.Endpoint) {
+	s.endpoints = make([]*endpointHealth, len(endpoints))
+	for i, endpoint := range endpoints {
+		s.endpoints[i] = &endpointHealth{
+			Health: Health{
+				Endpoint: endpoint,
+				Healthy:  true,
+			},
+		}
+	}
+}
+
+// score returns the score of a healthy endpoint, where lower is better. This is the latency of the
+// endpoint, increased in proportion to its error rate.
+func (e *endpointHealth) score() float64 {
+	return float64(e.Latency) * (1 + e.ErrorRate)
+}
+
+// movingAverage returns the exponential moving average with the given observation.
+func movingAverage(average, observation float64) float64 {
+	return smoothingFactor*observation + (1-smoothingFactor)*average
+}
@@ -0,0 +1,156 @@
+package endpoints_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/providers/base/endpoints"
+)
+
+var (
+	primary = config.Endpoint{URL: "https://primary.com"}
+	mirror  = config.Endpoint{URL: "https://mirror.com"}
+	backup  = config.Endpoint{URL: "https://backup.com"}
+)
+
+func TestSelector(t *testing.T) {
+	t.Run("no endpoints", func(t *testing.T) {
+		selector := endpoints.NewSelector(nil)
+
+		_, _, err := selector.Select()
+		require.Error(t, err)
+	})
+
+	t.Run("selects the first endpoint initially", func(t *testing.T) {
+		selector := endpoints.NewSelector([]config.Endpoint{primary, mirror})
+
+		index, endpoint, err := selector.Select()
+		require.NoError(t, err)
+		require.Equal(t, 0, index)
+		require.Equal(t, primary, endpoint)
+	})
+
+	t.Run("fails over to the next endpoint", func(t *testing.T) {
+		selector := endpoints.NewSelector([]config.Endpoint{primary, mirror, backup})
+		selector.ReportFailure(0)
+
+		index, endpoint, err := selector.Select()
+		require.NoError(t, err)
+		require.Equal(t, 1, index)
+		require.Equal(t, mirror, endpoint)
+
+		selector.ReportFailure(1)
+
+		index, _, err = selector.Select()
+		require.NoError(t, err)
+		require.Equal(t, 2, index)
+	})
+
+	t.Run("keeps using a healthy endpoint over unused endpoints", func(t *testing.T) {
+		selector := endpoints.NewSelector([]config.Endpoint{primary, mirror})
+		selector.ReportFailure(0)
+		selector.ReportSuccess(1, 100*time.Millisecond)
+
+		index, _, err := selector.Select()
+		require.NoError(t, err)
+		require.Equal(t, 1, index)
+	})
+
+	t.Run("selects the endpoint with the lowest latency", func(t *testing.T) {
+		selector := endpoints.NewSelector([]config.Endpoint{primary, mirror})
+		selector.ReportSuccess(0, 200*time.Millisecond)
+		selector.ReportSuccess(1, 100*time.Millisecond)
+
+		index, _, err := selector.Select()
+		require.NoError(t, err)
+		require.Equal(t, 1, index)
+	})
+
+	t.Run("weighs the latency by the error rate", func(t *testing.T) {
+		selector := endpoints.NewSelector([]config.Endpoint{primary, mirror})
+		selector.ReportSuccess(0, 100*time.Millisecond)
+		selector.ReportFailure(1)
+		selector.ReportFailure(1)
+		selector.ReportSuccess(1, 90*time.Millisecond)
+
+		index, _, err := selector.Select()
+		require.NoError(t, err)
+		require.Equal(t, 0, index)
+	})
+
+	t.Run("probes failed endpoints after the probe interval", func(t *testing.T) {
+		selector := endpoints.NewSelector(
+			[]config.Endpoint{primary, mirror},
+			endpoints.WithProbeInterval(100*time.Millisecond),
+		)
+		selector.ReportFailure(0)
+		selector.ReportSuccess(1, 100*time.Millisecond)
+
+		index, _, err := selector.Select()
+		require.NoError(t, err)
+		require.Equal(t, 1, index)
+
+		time.Sleep(150 * time.Millisecond)
+
+		// The failed endpoint is probed once per interval.
+		index, _, err = selector.Select()
+		require.NoError(t, err)
+		require.Equal(t, 0, index)
+
+		index, _, err = selector.Select()
+		require.NoError(t, err)
+		require.Equal(t, 1, index)
+
+		// The endpoint recovers once the probe succeeds.
+		selector.ReportSuccess(0, 50*time.Millisecond)
+
+		index, _, err = selector.Select()
+		require.NoError(t, err)
+		require.Equal(t, 0, index)
+		require.True(t, selector.Health()[0].Healthy)
+	})
+
+	t.Run("retries the endpoint that failed the longest ago if all endpoints failed", func(t *testing.T) {
+		selector := endpoints.NewSelector([]config.Endpoint{primary, mirror})
+		selector.ReportFailure(1)
+		selector.ReportFailure(0)
+
+		index, _, err := selector.Select()
+		require.NoError(t, err)
+		require.Equal(t, 1, index)
+	})
+
+	t.Run("tracks the error rate and latency of endpoints", func(t *testing.T) {
+		selector := endpoints.NewSelector([]config.Endpoint{primary})
+		selector.ReportSuccess(0, 100*time.Millisecond)
+		selector.ReportSuccess(0, 200*time.Millisecond)
+		selector.ReportFailure(0)
+
+		health := selector.Health()
+		require.Len(t, health, 1)
+		require.Equal(t, primary, health[0].Endpoint)
+		require.False(t, health[0].Healthy)
+		require.InDelta(t, 0.2, health[0].ErrorRate, 1e-9)
+		require.Equal(t, 120*time.Millisecond, health[0].Latency)
+	})
+
+	t.Run("resets the health when the endpoints change", func(t *testing.T) {
+		selector := endpoints.NewSelector([]config.Endpoint{primary, mirror})
+		selector.ReportFailure(0)
+
+		// The health is kept if the endpoints are unchanged.
+		selector.SetEndpoints([]config.Endpoint{primary, mirror})
+		require.False(t, selector.Health()[0].Healthy)
+
+		selector.SetEndpoints([]config.Endpoint{backup})
+		require.Equal(t, 1, selector.Len())
+
+		index, endpoint, err := selector.Select()
+		require.NoError(t, err)
+		require.Equal(t, 0, index)
+		require.Equal(t, backup, endpoint)
+	})
+}
@@ -108,8 +108,20 @@ func (p *Provider[K, V]) startMultiplexWebsocket(ctx context.Context) error {
 		wg.SetLimit(1)
 	}
 
-	for _, subIDs := range subTasks {
-		wg.Go(p.startWebSocket(ctx, subIDs))
+	// Track the connections, so that their IDs can be updated without restarting them.
+	conns := make([]*wsConnection[K, V], 0, len(subTasks))
+	for i, subIDs := range subTasks {
+		conns = append(conns, &wsConnection[K, V]{
+			index:   i,
+			handler: p.GetWebSocketHandler().Copy(),
+			ids:     subIDs,
+		})
+	}
+	p.setWebSocketConnections(conns)
+	defer p.setWebSocketConnections(nil)
+
+	for _, conn := range conns {
+		wg.Go(p.startWebSocket(ctx, conn))
 
 		select {
 		case <-time.After(p.wsCfg.HandshakeTimeout):
@@ -125,32 +137,47 @@ func (p *Provider[K, V]) startMultiplexWebsocket(ctx context.Context) error {
 }
 
 // startWebSocket starts a connection to the websocket and handles the incoming messages.
-func (p *Provider[K, V]) startWebSocket(ctx context.Context, subIDs []K) func() error {
+func (p *Provider[K, V]) startWebSocket(ctx context.Context, conn *wsConnection[K, V]) func() error {
 	return func() error {
 		// Start the websocket query handler. If the connection fails to start, then the query handler
-		// will be restarted after a timeout.
+		// will be restarted after a timeout that backs off exponentially with consecutive failures.
 		restarts := 0
-		handler := p.GetWebSocketHandler()
-		handler = handler.Copy()
+		handler := conn.handler
+		backoff := newReconnectionBackoff(p.wsCfg)
 		for {
 			select {
 			case <-ctx.Done():
 				p.logger.Debug("web socket stopped via context")
 				return ctx.Err()
 			default:
-				if restarts > 0 {
-					p.logger.Debug("restarting websocket query handler", zap.Int("num_restarts", restarts))
-
-					// If the websocket query handler returns, then the connection was closed. Wait for
-					// a bit before trying to reconnect.
-					time.Sleep(p.wsCfg.ReconnectionTimeout)
-				}
-
+				// The IDs of the connection may have been updated since it was last started.
+				subIDs := p.getWebSocketConnectionIDs(conn)
 				p.logger.Debug("starting websocket query handler", zap.Int("num_ids", len(subIDs)), zap.Any("ids", subIDs))
+
+				start := time.Now()
 				if err := handler.Start(ctx, subIDs, p.responseCh); err != nil {
 					p.logger.Error("websocket query handler returned error", zap.Error(err))
 				}
 				restarts++
+
+				// If the websocket query handler returns, then the connection was closed. Wait for
+				// a bit before trying to reconnect.
+				timeout := backoff.next(time.Since(start))
+				p.wsMetrics.SetWebSocketReconnectionAttempts(p.name, conn.index, backoff.attempts)
+				p.wsMetrics.SetWebSocketReconnectionBackoff(p.name, conn.index, timeout)
+				p.logger.Debug(
+					"restarting websocket query handler",
+					zap.Int("num_restarts", restarts),
+					zap.Int("consecutive_failures", backoff.attempts),
+					zap.Duration("timeout", timeout),
+				)
+
+				select {
+				case <-ctx.Done():
+					p.logger.Debug("web socket stopped via context")
+					return ctx.Err()
+				case <-time.After(timeout):
+				}
 			}
 		}
 	}
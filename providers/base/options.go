@@ -7,6 +7,7 @@ import (
 	apihandlers "github.com/skip-mev/connect/v2/providers/base/api/handlers"
 	providermetrics "github.com/skip-mev/connect/v2/providers/base/metrics"
 	wshandlers "github.com/skip-mev/connect/v2/providers/base/websocket/handlers"
+	wsmetrics "github.com/skip-mev/connect/v2/providers/base/websocket/metrics"
 	providertypes "github.com/skip-mev/connect/v2/providers/types"
 )
 
@@ -110,3 +111,14 @@ func WithMetrics[K providertypes.ResponseKey, V providertypes.ResponseValue](met
 		p.metrics = metrics
 	}
 }
+
+// WithWebSocketMetrics sets the websocket metrics implementation for the provider.
+func WithWebSocketMetrics[K providertypes.ResponseKey, V providertypes.ResponseValue](metrics wsmetrics.WebSocketMetrics) ProviderOption[K, V] {
+	return func(p *Provider[K, V]) {
+		if metrics == nil {
+			panic("cannot set nil websocket metrics")
+		}
+
+		p.wsMetrics = metrics
+	}
+}
@@ -12,6 +12,7 @@ import (
 	apihandlers "github.com/skip-mev/connect/v2/providers/base/api/handlers"
 	providermetrics "github.com/skip-mev/connect/v2/providers/base/metrics"
 	wshandlers "github.com/skip-mev/connect/v2/providers/base/websocket/handlers"
+	wsmetrics "github.com/skip-mev/connect/v2/providers/base/websocket/metrics"
 	providertypes "github.com/skip-mev/connect/v2/providers/types"
 )
 
@@ -41,6 +42,10 @@ type Provider[K providertypes.ResponseKey, V providertypes.ResponseValue] struct
 	// wsCfg is the websocket configuration for the provider.
 	wsCfg config.WebSocketConfig
 
+	// wsConns is the set of websocket connections that are currently started by the provider,
+	// along with the IDs each connection is subscribed to.
+	wsConns []*wsConnection[K, V]
+
 	// data is the latest set of key -> value pairs for the provider i.e. the latest prices
 	// for a given set of currency pairs.
 	data map[K]providertypes.ResolvedResult[V]
@@ -51,6 +56,10 @@ type Provider[K providertypes.ResponseKey, V providertypes.ResponseValue] struct
 	// metrics is the metrics implementation for the provider.
 	metrics providermetrics.ProviderMetrics
 
+	// wsMetrics is the websocket metrics implementation for the provider. This is used to
+	// track the reconnection backoff of the websocket connections.
+	wsMetrics wsmetrics.WebSocketMetrics
+
 	// fetchCtx is the context for the fetch function.
 	fetchCtx context.Context
 
@@ -94,6 +103,10 @@ func NewProvider[K providertypes.ResponseKey, V providertypes.ResponseValue](opt
 		p.metrics = providermetrics.NewNopProviderMetrics()
 	}
 
+	if p.wsMetrics == nil {
+		p.wsMetrics = wsmetrics.NewNopWebSocketMetrics()
+	}
+
 	return p, nil
 }
 
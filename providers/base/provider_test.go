@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	wserrors "github.com/skip-mev/connect/v2/providers/base/websocket/errors"
 	wshandlers "github.com/skip-mev/connect/v2/providers/base/websocket/handlers"
 	wshandlermocks "github.com/skip-mev/connect/v2/providers/base/websocket/handlers/mocks"
+	wsmetricmocks "github.com/skip-mev/connect/v2/providers/base/websocket/metrics/mocks"
 	providertypes "github.com/skip-mev/connect/v2/providers/types"
 )
 
@@ -327,6 +329,110 @@ func TestStop(t *testing.T) {
 	})
 }
 
+func TestWebSocketReconnectionBackoff(t *testing.T) {
+	cfg := wsCfg
+	cfg.ReconnectionTimeout = 20 * time.Millisecond
+	cfg.MaxReconnectionTimeout = 80 * time.Millisecond
+	cfg.ReconnectionBackoffMultiplier = 2
+	cfg.ReconnectionResetPeriod = time.Minute
+
+	// runProvider runs a websocket provider whose connections are up for the given duration, and
+	// returns the reconnection timeouts that are reported to the metrics.
+	runProvider := func(t *testing.T, cfg config.WebSocketConfig, uptime time.Duration) []time.Duration {
+		t.Helper()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+
+		handler := wshandlermocks.NewWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](t)
+		handler.On("Copy").Return(handler).Maybe()
+		handler.On("Start", mock.Anything, mock.Anything, mock.Anything).Run(func(_ mock.Arguments) {
+			time.Sleep(uptime)
+		}).Return(fmt.Errorf("connection closed")).Maybe()
+
+		var (
+			mu       sync.Mutex
+			backoffs []time.Duration
+		)
+		metrics := wsmetricmocks.NewWebSocketMetrics(t)
+		metrics.On("SetWebSocketReconnectionAttempts", cfg.Name, 0, mock.Anything).Maybe()
+		metrics.On("SetWebSocketReconnectionBackoff", cfg.Name, 0, mock.Anything).Run(func(args mock.Arguments) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			backoffs = append(backoffs, args.Get(2).(time.Duration))
+		}).Maybe()
+
+		provider, err := base.NewProvider(
+			base.WithName[slinkytypes.CurrencyPair, *big.Int](cfg.Name),
+			base.WithWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](handler),
+			base.WithWebSocketConfig[slinkytypes.CurrencyPair, *big.Int](cfg),
+			base.WithWebSocketMetrics[slinkytypes.CurrencyPair, *big.Int](metrics),
+			base.WithLogger[slinkytypes.CurrencyPair, *big.Int](logger),
+			base.WithIDs[slinkytypes.CurrencyPair, *big.Int](pairs),
+		)
+		require.NoError(t, err)
+
+		err = provider.Start(ctx)
+		require.Equal(t, context.DeadlineExceeded, err)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		return backoffs
+	}
+
+	t.Run("backs off exponentially up to the max timeout", func(t *testing.T) {
+		backoffs := runProvider(t, cfg, 0)
+		require.GreaterOrEqual(t, len(backoffs), 4)
+		require.Equal(t, []time.Duration{
+			20 * time.Millisecond,
+			40 * time.Millisecond,
+			80 * time.Millisecond,
+			80 * time.Millisecond,
+		}, backoffs[:4])
+	})
+
+	t.Run("uses a fixed timeout without a max timeout", func(t *testing.T) {
+		fixedCfg := cfg
+		fixedCfg.MaxReconnectionTimeout = 0
+
+		backoffs := runProvider(t, fixedCfg, 0)
+		require.GreaterOrEqual(t, len(backoffs), 4)
+		for _, backoff := range backoffs {
+			require.Equal(t, 20*time.Millisecond, backoff)
+		}
+	})
+
+	t.Run("resets the timeout after a healthy connection", func(t *testing.T) {
+		resetCfg := cfg
+		resetCfg.ReconnectionResetPeriod = 30 * time.Millisecond
+
+		backoffs := runProvider(t, resetCfg, 40*time.Millisecond)
+		require.GreaterOrEqual(t, len(backoffs), 2)
+		for _, backoff := range backoffs {
+			require.Equal(t, 20*time.Millisecond, backoff)
+		}
+	})
+
+	t.Run("subtracts a random jitter from the timeout", func(t *testing.T) {
+		jitterCfg := cfg
+		jitterCfg.ReconnectionJitter = 0.5
+
+		backoffs := runProvider(t, jitterCfg, 0)
+		require.GreaterOrEqual(t, len(backoffs), 4)
+		for i, expected := range []time.Duration{
+			20 * time.Millisecond,
+			40 * time.Millisecond,
+			80 * time.Millisecond,
+			80 * time.Millisecond,
+		} {
+			require.LessOrEqual(t, backoffs[i], expected)
+			require.GreaterOrEqual(t, backoffs[i], expected/2)
+		}
+	})
+}
+
 func TestWebSocketProvider(t *testing.T) {
 	testCases := []struct {
 		name           string
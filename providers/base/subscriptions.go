@@ -0,0 +1,165 @@
+package base
+
+import (
+	"go.uber.org/zap"
+
+	wshandlers "github.com/skip-mev/connect/v2/providers/base/websocket/handlers"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
+)
+
+// wsConnection is a websocket connection that is started by the provider, along with the IDs that
+// it is subscribed to.
+type wsConnection[K providertypes.ResponseKey, V providertypes.ResponseValue] struct {
+	// index is the index of the connection amongst the connections of the provider.
+	index int
+
+	// handler is the query handler that manages the connection.
+	handler wshandlers.WebSocketQueryHandler[K, V]
+
+	// ids is the set of IDs that the connection is subscribed to. The connection is restarted
+	// with these IDs.
+	ids []K
+}
+
+// updateWebSocketIDs updates the IDs of the running websocket connections of the provider in place,
+// without restarting them. IDs that are removed are unsubscribed from the connection they are assigned
+// to, and IDs that are added are subscribed to on the connection with the fewest IDs. This returns
+// false if the connections must be restarted instead, i.e. if no connections are running, the IDs
+// must be rebalanced across the connections to respect the maximum number of subscriptions per
+// connection, or a connection cannot be updated.
+func (p *Provider[K, V]) updateWebSocketIDs(ids []K) bool {
+	conns := p.getWebSocketConnections()
+	if len(conns) == 0 || len(ids) == 0 {
+		return false
+	}
+
+	assignments, ok := p.assignWebSocketIDs(conns, ids)
+	if !ok {
+		p.logger.Debug("ids must be rebalanced across websocket connections")
+		return false
+	}
+
+	for i, conn := range conns {
+		if idsEqual(p.getWebSocketConnectionIDs(conn), assignments[i]) {
+			continue
+		}
+
+		// The IDs are set before the connection is updated, so that the connection is restarted with
+		// the new IDs if it is closed in the meantime.
+		p.setWebSocketConnectionIDs(conn, assignments[i])
+		if err := conn.handler.UpdateIDs(assignments[i]); err != nil {
+			p.logger.Debug("failed to update ids of websocket connection", zap.Error(err))
+			return false
+		}
+	}
+
+	return true
+}
+
+// assignWebSocketIDs assigns the given IDs to the given connections. IDs keep the connection they are
+// assigned to, and new IDs are assigned to the connection with the fewest IDs. This returns false if
+// a new ID cannot be assigned without exceeding the maximum number of subscriptions per connection, or
+// if a connection would be left without IDs.
+func (p *Provider[K, V]) assignWebSocketIDs(conns []*wsConnection[K, V], ids []K) ([][]K, bool) {
+	updated := make(map[K]struct{}, len(ids))
+	for _, id := range ids {
+		updated[id] = struct{}{}
+	}
+
+	// Keep the IDs that are still assigned to the provider on their current connection.
+	assigned := make(map[K]struct{}, len(ids))
+	assignments := make([][]K, len(conns))
+	for i, conn := range conns {
+		for _, id := range p.getWebSocketConnectionIDs(conn) {
+			if _, ok := updated[id]; ok {
+				assignments[i] = append(assignments[i], id)
+				assigned[id] = struct{}{}
+			}
+		}
+	}
+
+	// Assign the new IDs to the connection with the fewest IDs.
+	maxSubsPerConn := p.wsCfg.MaxSubscriptionsPerConnection
+	for _, id := range ids {
+		if _, ok := assigned[id]; ok {
+			continue
+		}
+
+		index := 0
+		for i := range assignments {
+			if len(assignments[i]) < len(assignments[index]) {
+				index = i
+			}
+		}
+
+		if maxSubsPerConn > 0 && len(assignments[index]) >= maxSubsPerConn {
+			return nil, false
+		}
+
+		assignments[index] = append(assignments[index], id)
+		assigned[id] = struct{}{}
+	}
+
+	for _, assignment := range assignments {
+		if len(assignment) == 0 {
+			return nil, false
+		}
+	}
+
+	return assignments, true
+}
+
+// setWebSocketConnections sets the websocket connections that are started by the provider.
+func (p *Provider[K, V]) setWebSocketConnections(conns []*wsConnection[K, V]) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.wsConns = conns
+}
+
+// getWebSocketConnections returns the websocket connections that are started by the provider.
+func (p *Provider[K, V]) getWebSocketConnections() []*wsConnection[K, V] {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.wsConns
+}
+
+// setWebSocketConnectionIDs sets the IDs that the given websocket connection is subscribed to.
+func (p *Provider[K, V]) setWebSocketConnectionIDs(conn *wsConnection[K, V], ids []K) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	conn.ids = ids
+}
+
+// getWebSocketConnectionIDs returns the IDs that the given websocket connection is subscribed to.
+func (p *Provider[K, V]) getWebSocketConnectionIDs(conn *wsConnection[K, V]) []K {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ids := make([]K, len(conn.ids))
+	copy(ids, conn.ids)
+
+	return ids
+}
+
+// idsEqual returns true if the given sets of IDs are equal, regardless of their order.
+func idsEqual[K providertypes.ResponseKey](a, b []K) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	set := make(map[K]struct{}, len(a))
+	for _, id := range a {
+		set[id] = struct{}{}
+	}
+
+	for _, id := range b {
+		if _, ok := set[id]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
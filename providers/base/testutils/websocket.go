@@ -11,6 +11,7 @@ import (
 
 	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/providers/base"
+	wserrors "github.com/skip-mev/connect/v2/providers/base/websocket/errors"
 	"github.com/skip-mev/connect/v2/providers/base/websocket/handlers"
 	handlermocks "github.com/skip-mev/connect/v2/providers/base/websocket/handlers/mocks"
 	providertypes "github.com/skip-mev/connect/v2/providers/types"
@@ -29,6 +30,7 @@ func CreateWebSocketQueryHandlerWithGetResponses[K providertypes.ResponseKey, V
 	handler := handlermocks.NewWebSocketQueryHandler[K, V](t)
 
 	handler.On("Copy").Return(handler).Maybe()
+	handler.On("UpdateIDs", mock.Anything).Return(wserrors.ErrNotConnected).Maybe()
 	handler.On("Start", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
 		ctx := args.Get(0).(context.Context)
 		responseCh := args.Get(2).(chan<- providertypes.GetResponse[K, V])
@@ -58,6 +60,7 @@ func CreateWebSocketQueryHandlerWithResponseFn[K providertypes.ResponseKey, V pr
 	handler := handlermocks.NewWebSocketQueryHandler[K, V](t)
 
 	handler.On("Copy").Return(handler).Maybe()
+	handler.On("UpdateIDs", mock.Anything).Return(wserrors.ErrNotConnected).Maybe()
 	handler.On("Start", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
 		ctx := args.Get(0).(context.Context)
 		responseCh := args.Get(2).(chan<- providertypes.GetResponse[K, V])
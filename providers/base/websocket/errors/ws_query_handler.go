@@ -24,6 +24,15 @@ var (
 
 	// ErrDial is returned when the WebSocketConnHandler cannot create a connection.
 	ErrDial = errors.New("websocket connection handler failed to create connection")
+
+	// ErrUnsubscribeNotSupported is returned when the WebSocketDataHandler cannot create
+	// messages to unsubscribe from events. The connection must be restarted to stop
+	// receiving the events instead.
+	ErrUnsubscribeNotSupported = errors.New("websocket data handler does not support unsubscribing")
+
+	// ErrNotConnected is returned when the WebSocketQueryHandler cannot update the
+	// subscriptions of a connection, because the connection is not started.
+	ErrNotConnected = errors.New("websocket query handler is not connected")
 )
 
 // ErrHandleMessageWithErr is used to create a new ErrHandleMessage with the given error.
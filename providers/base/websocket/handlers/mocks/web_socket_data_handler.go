@@ -250,6 +250,64 @@ func (_c *WebSocketDataHandler_HeartBeatMessages_Call[K, V]) RunAndReturn(run fu
 	return _c
 }
 
+// UnsubscribeMessages provides a mock function with given fields: ids
+func (_m *WebSocketDataHandler[K, V]) UnsubscribeMessages(ids []K) ([]handlers.WebsocketEncodedMessage, error) {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsubscribeMessages")
+	}
+
+	var r0 []handlers.WebsocketEncodedMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]K) ([]handlers.WebsocketEncodedMessage, error)); ok {
+		return rf(ids)
+	}
+	if rf, ok := ret.Get(0).(func([]K) []handlers.WebsocketEncodedMessage); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]handlers.WebsocketEncodedMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]K) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebSocketDataHandler_UnsubscribeMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribeMessages'
+type WebSocketDataHandler_UnsubscribeMessages_Call[K types.ResponseKey, V types.ResponseValue] struct {
+	*mock.Call
+}
+
+// UnsubscribeMessages is a helper method to define mock.On call
+//   - ids []K
+func (_e *WebSocketDataHandler_Expecter[K, V]) UnsubscribeMessages(ids interface{}) *WebSocketDataHandler_UnsubscribeMessages_Call[K, V] {
+	return &WebSocketDataHandler_UnsubscribeMessages_Call[K, V]{Call: _e.mock.On("UnsubscribeMessages", ids)}
+}
+
+func (_c *WebSocketDataHandler_UnsubscribeMessages_Call[K, V]) Run(run func(ids []K)) *WebSocketDataHandler_UnsubscribeMessages_Call[K, V] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]K))
+	})
+	return _c
+}
+
+func (_c *WebSocketDataHandler_UnsubscribeMessages_Call[K, V]) Return(_a0 []handlers.WebsocketEncodedMessage, _a1 error) *WebSocketDataHandler_UnsubscribeMessages_Call[K, V] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebSocketDataHandler_UnsubscribeMessages_Call[K, V]) RunAndReturn(run func([]K) ([]handlers.WebsocketEncodedMessage, error)) *WebSocketDataHandler_UnsubscribeMessages_Call[K, V] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewWebSocketDataHandler creates a new instance of WebSocketDataHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWebSocketDataHandler[K types.ResponseKey, V types.ResponseValue](t interface {
@@ -120,6 +120,52 @@ func (_c *WebSocketQueryHandler_Start_Call[K, V]) RunAndReturn(run func(context.
 	return _c
 }
 
+// UpdateIDs provides a mock function with given fields: ids
+func (_m *WebSocketQueryHandler[K, V]) UpdateIDs(ids []K) error {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateIDs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]K) error); ok {
+		r0 = rf(ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebSocketQueryHandler_UpdateIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateIDs'
+type WebSocketQueryHandler_UpdateIDs_Call[K types.ResponseKey, V types.ResponseValue] struct {
+	*mock.Call
+}
+
+// UpdateIDs is a helper method to define mock.On call
+//   - ids []K
+func (_e *WebSocketQueryHandler_Expecter[K, V]) UpdateIDs(ids interface{}) *WebSocketQueryHandler_UpdateIDs_Call[K, V] {
+	return &WebSocketQueryHandler_UpdateIDs_Call[K, V]{Call: _e.mock.On("UpdateIDs", ids)}
+}
+
+func (_c *WebSocketQueryHandler_UpdateIDs_Call[K, V]) Run(run func(ids []K)) *WebSocketQueryHandler_UpdateIDs_Call[K, V] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]K))
+	})
+	return _c
+}
+
+func (_c *WebSocketQueryHandler_UpdateIDs_Call[K, V]) Return(_a0 error) *WebSocketQueryHandler_UpdateIDs_Call[K, V] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebSocketQueryHandler_UpdateIDs_Call[K, V]) RunAndReturn(run func([]K) error) *WebSocketQueryHandler_UpdateIDs_Call[K, V] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewWebSocketQueryHandler creates a new instance of WebSocketQueryHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWebSocketQueryHandler[K types.ResponseKey, V types.ResponseValue](t interface {
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"sync"
@@ -9,6 +10,7 @@ import (
 	"github.com/gorilla/websocket"
 
 	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/providers/base/endpoints"
 )
 
 type (
@@ -58,6 +60,10 @@ type WebSocketConnHandlerImpl struct {
 
 	// preDialHook is a function that is called before the connection is established.
 	preDialHook PreDialHook
+
+	// endpoints selects the endpoint that the connection is made to. It is shared with the
+	// copies of the handler, so that all connections to the data provider avoid failed endpoints.
+	endpoints *endpoints.Selector
 }
 
 // NewWebSocketHandlerImpl returns a new WebSocketConnHandlerImpl.
@@ -67,7 +73,8 @@ func NewWebSocketHandlerImpl(cfg config.WebSocketConfig, opts ...Option) (*WebSo
 	}
 
 	h := &WebSocketConnHandlerImpl{
-		cfg: cfg,
+		cfg:       cfg,
+		endpoints: endpoints.NewSelector(cfg.Endpoints),
 	}
 
 	for _, opt := range opts {
@@ -88,7 +95,8 @@ func (h *WebSocketConnHandlerImpl) CreateDialer() *websocket.Dialer {
 	}
 }
 
-// Dial is used to create a new connection to the data provider with the given URL.
+// Dial is used to create a new connection to the data provider. The connection is made to the
+// healthiest endpoint, failing over to the other endpoints if the connection cannot be made.
 func (h *WebSocketConnHandlerImpl) Dial() error {
 	if h.preDialHook != nil {
 		if err := h.preDialHook(h); err != nil {
@@ -100,9 +108,28 @@ func (h *WebSocketConnHandlerImpl) Dial() error {
 		return fmt.Errorf("no endpoints provided")
 	}
 
-	var err error
-	h.conn, _, err = h.CreateDialer().Dial(h.cfg.Endpoints[0].URL, nil)
-	return err
+	// The endpoints may have been updated by the pre-dial hook.
+	h.endpoints.SetEndpoints(h.cfg.Endpoints)
+
+	var errs []error
+	for attempt := 0; attempt < h.endpoints.Len(); attempt++ {
+		index, endpoint, err := h.endpoints.Select()
+		if err != nil {
+			return err
+		}
+
+		start := time.Now()
+		h.conn, _, err = h.CreateDialer().Dial(endpoint.URL, nil)
+		if err == nil {
+			h.endpoints.ReportSuccess(index, time.Since(start))
+			return nil
+		}
+
+		h.endpoints.ReportFailure(index)
+		errs = append(errs, fmt.Errorf("failed to dial endpoint %d: %w", index, err))
+	}
+
+	return errors.Join(errs...)
 }
 
 // Read is used to read data from the data provider. Each websocket data handler is responsible
@@ -175,6 +202,7 @@ func (h *WebSocketConnHandlerImpl) Copy() WebSocketConnHandler {
 	return &WebSocketConnHandlerImpl{
 		cfg:         h.cfg,
 		preDialHook: h.preDialHook,
+		endpoints:   h.endpoints,
 	}
 }
 
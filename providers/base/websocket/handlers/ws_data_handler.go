@@ -21,6 +21,11 @@ type WebSocketDataHandler[K providertypes.ResponseKey, V providertypes.ResponseV
 	// to new events or unsubscribe from events.
 	CreateMessages(ids []K) ([]WebsocketEncodedMessage, error)
 
+	// UnsubscribeMessages is used to create the messages that unsubscribe the connection from the events
+	// of the given ids, without closing the connection. Handlers that cannot unsubscribe from events should
+	// return ErrUnsubscribeNotSupported, in which case the connection is restarted instead.
+	UnsubscribeMessages(ids []K) ([]WebsocketEncodedMessage, error)
+
 	// HeartBeatMessages is used to construct heartbeat messages to be sent to the data provider. Note that
 	// the handler must maintain the necessary state information to construct the heartbeat messages. This
 	// can be done on the fly as messages as handled by the handler.
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -25,6 +26,11 @@ type WebSocketQueryHandler[K providertypes.ResponseKey, V providertypes.Response
 	// channel.
 	Start(ctx context.Context, ids []K, responseCh chan<- providertypes.GetResponse[K, V]) error
 
+	// UpdateIDs should update the set of IDs of a started connection, without restarting the
+	// connection. If the connection cannot be updated, an error should be returned, in which
+	// case the connection must be restarted with the new set of IDs instead.
+	UpdateIDs(ids []K) error
+
 	// Copy is used to create a copy of the query handler. This is useful for creating
 	// multiple connections to the same data provider.
 	Copy() WebSocketQueryHandler[K, V]
@@ -35,6 +41,12 @@ type WebSocketQueryHandler[K providertypes.ResponseKey, V providertypes.Response
 // provider and subscribe to events for a given set of IDs. It runs in a separate go
 // routine and will send all responses to the response channel as they are received.
 type WebSocketQueryHandlerImpl[K providertypes.ResponseKey, V providertypes.ResponseValue] struct {
+	// mu guards the data handler, the IDs and the connection status.
+	mu sync.Mutex
+
+	// updateMu serializes the updates of the IDs of the connection.
+	updateMu sync.Mutex
+
 	logger  *zap.Logger
 	metrics metrics.WebSocketMetrics
 	config  config.WebSocketConfig
@@ -49,6 +61,10 @@ type WebSocketQueryHandlerImpl[K providertypes.ResponseKey, V providertypes.Resp
 
 	// ids is the set of IDs that the provider will fetch data for.
 	ids []K
+
+	// connected is true once the connection is started, until it is closed. The IDs of the
+	// connection can only be updated while it is connected.
+	connected bool
 }
 
 // NewWebSocketQueryHandler creates a new websocket query handler.
@@ -110,6 +126,7 @@ func (h *WebSocketQueryHandlerImpl[K, V]) Start(
 		if err := recover(); err != nil {
 			h.logger.Error("panic occurred", zap.Any("err", err))
 		}
+		h.setConnected(false)
 		h.metrics.AddWebSocketConnectionStatus(h.config.Name, metrics.Unhealthy)
 	}()
 
@@ -140,6 +157,7 @@ func (h *WebSocketQueryHandlerImpl[K, V]) Start(
 		return fmt.Errorf("failed to start connection: %w", err)
 	}
 
+	h.setConnected(true)
 	if h.config.PingInterval > 0 {
 		go h.heartBeat(ctx)
 	}
@@ -295,8 +313,11 @@ func (h *WebSocketQueryHandlerImpl[K, V]) recv(ctx context.Context, responseCh c
 			h.logger.Debug("message received; attempting to handle message", zap.String("message", string(message)))
 			h.metrics.AddWebSocketConnectionStatus(h.config.Name, metrics.ReadSuccess)
 
-			// Handle the message.
+			// Handle the message. The data handler is not used concurrently with updates
+			// of the IDs of the connection.
+			h.mu.Lock()
 			response, updateMessage, err := h.dataHandler.HandleMessage(message)
+			h.mu.Unlock()
 			if err != nil {
 				h.logger.Debug("failed to handle websocket message", zap.Error(err))
 				h.metrics.AddWebSocketDataHandlerStatus(h.config.Name, metrics.HandleMessageErr)
@@ -342,6 +363,127 @@ func (h *WebSocketQueryHandlerImpl[K, V]) recv(ctx context.Context, responseCh c
 	}
 }
 
+// UpdateIDs is used to update the set of IDs of a started connection, without restarting the
+// connection. The connection is unsubscribed from the events of the IDs that are removed, and
+// subscribed to the events of the IDs that are added. An error is returned if the connection is
+// not started, or the data handler cannot unsubscribe from events, in which case the connection
+// must be restarted with the new set of IDs instead.
+func (h *WebSocketQueryHandlerImpl[K, V]) UpdateIDs(ids []K) error {
+	// Updates are serialized, so that the messages of concurrent updates are not interleaved.
+	h.updateMu.Lock()
+	defer h.updateMu.Unlock()
+
+	messages, err := h.updateMessages(ids)
+	if err != nil {
+		return err
+	}
+
+	// The messages are written without holding the lock of the data handler, so that messages
+	// received from the data provider continue to be handled in between writes.
+	return h.writeMessages(messages)
+}
+
+// updateMessages is used to create the messages that update the subscriptions of the connection
+// to the given set of IDs, and to update the IDs of the connection.
+func (h *WebSocketQueryHandlerImpl[K, V]) updateMessages(ids []K) ([]WebsocketEncodedMessage, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.connected {
+		return nil, errors.ErrNotConnected
+	}
+
+	added, removed := diffIDs(h.ids, ids)
+	h.logger.Debug(
+		"updating ids of connection",
+		zap.Int("num_added", len(added)),
+		zap.Int("num_removed", len(removed)),
+	)
+
+	var messages []WebsocketEncodedMessage
+	if len(removed) > 0 {
+		unsubscribeMessages, err := h.dataHandler.UnsubscribeMessages(removed)
+		if err != nil {
+			h.logger.Debug("failed to create unsubscribe messages", zap.Error(err))
+			h.metrics.AddWebSocketDataHandlerStatus(h.config.Name, metrics.CreateMessageErr)
+			return nil, errors.ErrCreateMessageWithErr(err)
+		}
+
+		h.metrics.AddWebSocketDataHandlerStatus(h.config.Name, metrics.CreateMessageSuccess)
+		messages = append(messages, unsubscribeMessages...)
+	}
+
+	if len(added) > 0 {
+		subscribeMessages, err := h.dataHandler.CreateMessages(added)
+		if err != nil {
+			h.logger.Debug("failed to create subscription messages", zap.Error(err))
+			h.metrics.AddWebSocketDataHandlerStatus(h.config.Name, metrics.CreateMessageErr)
+			return nil, errors.ErrCreateMessageWithErr(err)
+		}
+
+		h.metrics.AddWebSocketDataHandlerStatus(h.config.Name, metrics.CreateMessageSuccess)
+		messages = append(messages, subscribeMessages...)
+	}
+
+	// If the messages cannot be written, the connection is restarted with the new set of IDs.
+	h.ids = ids
+	return messages, nil
+}
+
+// writeMessages is used to write the given messages to the data provider, waiting for the write
+// interval between messages.
+func (h *WebSocketQueryHandlerImpl[K, V]) writeMessages(messages []WebsocketEncodedMessage) error {
+	for index, message := range messages {
+		h.logger.Debug("sending payload", zap.String("payload", string(message)))
+
+		if err := h.connHandler.Write(message); err != nil {
+			h.logger.Debug("failed to write message to websocket connection handler", zap.Error(err))
+			h.metrics.AddWebSocketConnectionStatus(h.config.Name, metrics.WriteErr)
+			return errors.ErrWriteWithErr(err)
+		}
+		h.metrics.AddWebSocketConnectionStatus(h.config.Name, metrics.WriteSuccess)
+
+		if index != len(messages)-1 {
+			time.Sleep(h.config.WriteInterval)
+		}
+	}
+
+	return nil
+}
+
+// setConnected is used to set whether the connection is started.
+func (h *WebSocketQueryHandlerImpl[K, V]) setConnected(connected bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.connected = connected
+}
+
+// diffIDs returns the IDs that are in updated but not in current, and the IDs that are in
+// current but not in updated.
+func diffIDs[K providertypes.ResponseKey](current, updated []K) (added, removed []K) {
+	currentSet := make(map[K]struct{}, len(current))
+	for _, id := range current {
+		currentSet[id] = struct{}{}
+	}
+
+	updatedSet := make(map[K]struct{}, len(updated))
+	for _, id := range updated {
+		updatedSet[id] = struct{}{}
+		if _, ok := currentSet[id]; !ok {
+			added = append(added, id)
+		}
+	}
+
+	for _, id := range current {
+		if _, ok := updatedSet[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+
+	return added, removed
+}
+
 // close is used to close the connection to the data provider.
 func (h *WebSocketQueryHandlerImpl[K, V]) close() error {
 	h.logger.Debug("closing connection to websocket handler")
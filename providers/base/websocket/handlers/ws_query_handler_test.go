@@ -2,9 +2,11 @@ package handlers_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -843,3 +845,198 @@ func TestWebSocketQueryHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestWebSocketQueryHandlerUpdateIDs(t *testing.T) {
+	t.Run("fails to update the ids of a connection that is not started", func(t *testing.T) {
+		handler, err := handlers.NewWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](
+			logger,
+			cfg,
+			handlermocks.NewWebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int](t),
+			handlermocks.NewWebSocketConnHandler(t),
+			mockmetrics.NewWebSocketMetrics(t),
+		)
+		require.NoError(t, err)
+
+		err = handler.UpdateIDs([]slinkytypes.CurrencyPair{btcusd})
+		require.ErrorIs(t, err, wserrors.ErrNotConnected)
+	})
+
+	testCases := []struct {
+		name        string
+		connHandler func() handlers.WebSocketConnHandler
+		dataHandler func() handlers.WebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int]
+		ids         []slinkytypes.CurrencyPair
+		expectedErr error
+	}{
+		{
+			name: "unsubscribes from removed ids and subscribes to added ids",
+			connHandler: func() handlers.WebSocketConnHandler {
+				connHandler := handlermocks.NewWebSocketConnHandler(t)
+
+				connHandler.On("Dial").Return(nil).Once()
+				connHandler.On("Write", testMessage).Return(nil).Once()
+				connHandler.On("Write", []byte("unsubscribe")).Return(nil).Once()
+				connHandler.On("Write", []byte("subscribe")).Return(nil).Once()
+				connHandler.On("Read").Return(heartbeat, nil).Maybe().After(100 * time.Millisecond)
+				connHandler.On("Close").Return(nil).Once()
+
+				return connHandler
+			},
+			dataHandler: func() handlers.WebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int] {
+				dataHandler := handlermocks.NewWebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+
+				dataHandler.On("CreateMessages", []slinkytypes.CurrencyPair{btcusd, ethusd}).Return(
+					[]handlers.WebsocketEncodedMessage{testMessage}, nil,
+				).Once()
+				dataHandler.On("UnsubscribeMessages", []slinkytypes.CurrencyPair{btcusd}).Return(
+					[]handlers.WebsocketEncodedMessage{[]byte("unsubscribe")}, nil,
+				).Once()
+				dataHandler.On("CreateMessages", []slinkytypes.CurrencyPair{atomusd}).Return(
+					[]handlers.WebsocketEncodedMessage{[]byte("subscribe")}, nil,
+				).Once()
+				dataHandler.On("HandleMessage", mock.Anything).Return(
+					providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{}, nil, nil,
+				).Maybe()
+
+				return dataHandler
+			},
+			ids: []slinkytypes.CurrencyPair{ethusd, atomusd},
+		},
+		{
+			name: "fails to update the ids if the data handler cannot unsubscribe",
+			connHandler: func() handlers.WebSocketConnHandler {
+				connHandler := handlermocks.NewWebSocketConnHandler(t)
+
+				connHandler.On("Dial").Return(nil).Once()
+				connHandler.On("Write", testMessage).Return(nil).Once()
+				connHandler.On("Read").Return(heartbeat, nil).Maybe().After(100 * time.Millisecond)
+				connHandler.On("Close").Return(nil).Once()
+
+				return connHandler
+			},
+			dataHandler: func() handlers.WebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int] {
+				dataHandler := handlermocks.NewWebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+
+				dataHandler.On("CreateMessages", []slinkytypes.CurrencyPair{btcusd, ethusd}).Return(
+					[]handlers.WebsocketEncodedMessage{testMessage}, nil,
+				).Once()
+				dataHandler.On("UnsubscribeMessages", []slinkytypes.CurrencyPair{btcusd}).Return(
+					nil, wserrors.ErrUnsubscribeNotSupported,
+				).Once()
+				dataHandler.On("HandleMessage", mock.Anything).Return(
+					providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{}, nil, nil,
+				).Maybe()
+
+				return dataHandler
+			},
+			ids:         []slinkytypes.CurrencyPair{ethusd},
+			expectedErr: wserrors.ErrUnsubscribeNotSupported,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := mockmetrics.NewWebSocketMetrics(t)
+			m.On("AddWebSocketConnectionStatus", name, mock.Anything).Return().Maybe()
+			m.On("AddWebSocketDataHandlerStatus", name, mock.Anything).Return().Maybe()
+			m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+
+			handler, err := handlers.NewWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](
+				logger,
+				cfg,
+				tc.dataHandler(),
+				tc.connHandler(),
+				m,
+			)
+			require.NoError(t, err)
+
+			responseCh := make(chan providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int], 100)
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				handler.Start(ctx, []slinkytypes.CurrencyPair{btcusd, ethusd}, responseCh)
+			}()
+
+			// Wait for the connection to be started before updating its ids.
+			require.Eventually(t, func() bool {
+				err = handler.UpdateIDs(tc.ids)
+				return !errors.Is(err, wserrors.ErrNotConnected)
+			}, 5*time.Second, 50*time.Millisecond)
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			cancel()
+			<-done
+		})
+	}
+
+	t.Run("handles messages while writing update messages", func(t *testing.T) {
+		var (
+			handled     = make(chan struct{})
+			handledOnce sync.Once
+		)
+
+		connHandler := handlermocks.NewWebSocketConnHandler(t)
+		connHandler.On("Dial").Return(nil).Once()
+		connHandler.On("Write", testMessage).Return(nil).Once()
+		connHandler.On("Write", []byte("subscribe")).Return(nil).Run(func(_ mock.Arguments) {
+			// Messages must still be handled while the update is being written.
+			select {
+			case <-handled:
+			case <-time.After(5 * time.Second):
+				t.Error("message was not handled while writing update messages")
+			}
+		}).Once()
+		connHandler.On("Read").Return(heartbeat, nil).Maybe().After(100 * time.Millisecond)
+		connHandler.On("Close").Return(nil).Once()
+
+		dataHandler := handlermocks.NewWebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+		dataHandler.On("CreateMessages", []slinkytypes.CurrencyPair{btcusd}).Return(
+			[]handlers.WebsocketEncodedMessage{testMessage}, nil,
+		).Once()
+		dataHandler.On("CreateMessages", []slinkytypes.CurrencyPair{ethusd}).Return(
+			[]handlers.WebsocketEncodedMessage{[]byte("subscribe")}, nil,
+		).Once()
+		dataHandler.On("HandleMessage", mock.Anything).Return(
+			providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{}, nil, nil,
+		).Run(func(_ mock.Arguments) {
+			handledOnce.Do(func() { close(handled) })
+		}).Maybe()
+
+		m := mockmetrics.NewWebSocketMetrics(t)
+		m.On("AddWebSocketConnectionStatus", name, mock.Anything).Return().Maybe()
+		m.On("AddWebSocketDataHandlerStatus", name, mock.Anything).Return().Maybe()
+		m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+
+		handler, err := handlers.NewWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](
+			logger,
+			cfg,
+			dataHandler,
+			connHandler,
+			m,
+		)
+		require.NoError(t, err)
+
+		responseCh := make(chan providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int], 100)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			handler.Start(ctx, []slinkytypes.CurrencyPair{btcusd}, responseCh)
+		}()
+
+		require.Eventually(t, func() bool {
+			err = handler.UpdateIDs([]slinkytypes.CurrencyPair{btcusd, ethusd})
+			return !errors.Is(err, wserrors.ErrNotConnected)
+		}, 5*time.Second, 50*time.Millisecond)
+		require.NoError(t, err)
+
+		cancel()
+		<-done
+	})
+}
@@ -125,6 +125,76 @@ func (_c *WebSocketMetrics_ObserveWebSocketLatency_Call) RunAndReturn(run func(s
 	return _c
 }
 
+// SetWebSocketReconnectionAttempts provides a mock function with given fields: provider, connection, attempts
+func (_m *WebSocketMetrics) SetWebSocketReconnectionAttempts(provider string, connection int, attempts int) {
+	_m.Called(provider, connection, attempts)
+}
+
+// WebSocketMetrics_SetWebSocketReconnectionAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWebSocketReconnectionAttempts'
+type WebSocketMetrics_SetWebSocketReconnectionAttempts_Call struct {
+	*mock.Call
+}
+
+// SetWebSocketReconnectionAttempts is a helper method to define mock.On call
+//   - provider string
+//   - connection int
+//   - attempts int
+func (_e *WebSocketMetrics_Expecter) SetWebSocketReconnectionAttempts(provider interface{}, connection interface{}, attempts interface{}) *WebSocketMetrics_SetWebSocketReconnectionAttempts_Call {
+	return &WebSocketMetrics_SetWebSocketReconnectionAttempts_Call{Call: _e.mock.On("SetWebSocketReconnectionAttempts", provider, connection, attempts)}
+}
+
+func (_c *WebSocketMetrics_SetWebSocketReconnectionAttempts_Call) Run(run func(provider string, connection int, attempts int)) *WebSocketMetrics_SetWebSocketReconnectionAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *WebSocketMetrics_SetWebSocketReconnectionAttempts_Call) Return() *WebSocketMetrics_SetWebSocketReconnectionAttempts_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *WebSocketMetrics_SetWebSocketReconnectionAttempts_Call) RunAndReturn(run func(string, int, int)) *WebSocketMetrics_SetWebSocketReconnectionAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetWebSocketReconnectionBackoff provides a mock function with given fields: provider, connection, backoff
+func (_m *WebSocketMetrics) SetWebSocketReconnectionBackoff(provider string, connection int, backoff time.Duration) {
+	_m.Called(provider, connection, backoff)
+}
+
+// WebSocketMetrics_SetWebSocketReconnectionBackoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWebSocketReconnectionBackoff'
+type WebSocketMetrics_SetWebSocketReconnectionBackoff_Call struct {
+	*mock.Call
+}
+
+// SetWebSocketReconnectionBackoff is a helper method to define mock.On call
+//   - provider string
+//   - connection int
+//   - backoff time.Duration
+func (_e *WebSocketMetrics_Expecter) SetWebSocketReconnectionBackoff(provider interface{}, connection interface{}, backoff interface{}) *WebSocketMetrics_SetWebSocketReconnectionBackoff_Call {
+	return &WebSocketMetrics_SetWebSocketReconnectionBackoff_Call{Call: _e.mock.On("SetWebSocketReconnectionBackoff", provider, connection, backoff)}
+}
+
+func (_c *WebSocketMetrics_SetWebSocketReconnectionBackoff_Call) Run(run func(provider string, connection int, backoff time.Duration)) *WebSocketMetrics_SetWebSocketReconnectionBackoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *WebSocketMetrics_SetWebSocketReconnectionBackoff_Call) Return() *WebSocketMetrics_SetWebSocketReconnectionBackoff_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *WebSocketMetrics_SetWebSocketReconnectionBackoff_Call) RunAndReturn(run func(string, int, time.Duration)) *WebSocketMetrics_SetWebSocketReconnectionBackoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewWebSocketMetrics creates a new instance of WebSocketMetrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWebSocketMetrics(t interface {
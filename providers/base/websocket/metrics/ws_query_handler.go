@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -13,6 +14,9 @@ import (
 const (
 	// StatusLabel is the label used for the status of a provider response.
 	StatusLabel = "status"
+
+	// ConnectionLabel is the label used for the index of a websocket connection of a provider.
+	ConnectionLabel = "connection"
 )
 
 // WebSocketMetrics is an interface that defines the API for metrics collection for providers
@@ -31,6 +35,14 @@ type WebSocketMetrics interface {
 	// ObserveWebSocketLatency adds a latency observation to the metrics collector for the
 	// given provider.
 	ObserveWebSocketLatency(provider string, duration time.Duration)
+
+	// SetWebSocketReconnectionAttempts sets the number of consecutive failed connections of the
+	// given connection of the provider.
+	SetWebSocketReconnectionAttempts(provider string, connection int, attempts int)
+
+	// SetWebSocketReconnectionBackoff sets the timeout that the given connection of the provider
+	// waits for before reconnecting.
+	SetWebSocketReconnectionBackoff(provider string, connection int, backoff time.Duration)
 }
 
 // WebSocketMetricsImpl contains metrics exposed by this package.
@@ -43,6 +55,12 @@ type WebSocketMetricsImpl struct {
 
 	// Histogram paginated by provider, measuring the latency between invocation and collection.
 	responseTimePerProvider *prometheus.HistogramVec
+
+	// Number of consecutive failed connections per provider connection.
+	reconnectionAttemptsPerProvider *prometheus.GaugeVec
+
+	// Reconnection timeout per provider connection.
+	reconnectionBackoffPerProvider *prometheus.GaugeVec
 }
 
 // NewWebSocketMetricsFromConfig returns a new Metrics struct given the main oracle metrics config.
@@ -72,12 +90,24 @@ func NewWebSocketMetrics() WebSocketMetrics {
 			Help:      "Response time per web socket provider.",
 			Buckets:   []float64{50, 100, 250, 500, 1000, 2000},
 		}, []string{providermetrics.ProviderLabel}),
+		reconnectionAttemptsPerProvider: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "web_socket_reconnection_attempts",
+			Help:      "Number of consecutive failed connections per web socket provider connection.",
+		}, []string{providermetrics.ProviderLabel, ConnectionLabel}),
+		reconnectionBackoffPerProvider: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "web_socket_reconnection_backoff",
+			Help:      "Time in milliseconds that a web socket provider connection waits for before reconnecting.",
+		}, []string{providermetrics.ProviderLabel, ConnectionLabel}),
 	}
 
 	// register the above metrics
 	prometheus.MustRegister(m.connectionStatusPerProvider)
 	prometheus.MustRegister(m.dataHandlerStatusPerProvider)
 	prometheus.MustRegister(m.responseTimePerProvider)
+	prometheus.MustRegister(m.reconnectionAttemptsPerProvider)
+	prometheus.MustRegister(m.reconnectionBackoffPerProvider)
 
 	return m
 }
@@ -98,6 +128,12 @@ func (m *noOpWebSocketMetricsImpl) AddWebSocketDataHandlerStatus(_ string, _ Han
 func (m *noOpWebSocketMetricsImpl) ObserveWebSocketLatency(_ string, _ time.Duration) {
 }
 
+func (m *noOpWebSocketMetricsImpl) SetWebSocketReconnectionAttempts(_ string, _ int, _ int) {
+}
+
+func (m *noOpWebSocketMetricsImpl) SetWebSocketReconnectionBackoff(_ string, _ int, _ time.Duration) {
+}
+
 // AddWebSocketConnectionStatus adds a method / status response to the metrics collector for the
 // given provider. Specifically, this tracks various connection related errors.
 func (m *WebSocketMetricsImpl) AddWebSocketConnectionStatus(provider string, status ConnectionStatus) {
@@ -125,3 +161,23 @@ func (m *WebSocketMetricsImpl) ObserveWebSocketLatency(provider string, duration
 	},
 	).Observe(float64(duration.Milliseconds()))
 }
+
+// SetWebSocketReconnectionAttempts sets the number of consecutive failed connections of the given
+// connection of the provider.
+func (m *WebSocketMetricsImpl) SetWebSocketReconnectionAttempts(provider string, connection int, attempts int) {
+	m.reconnectionAttemptsPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+		ConnectionLabel:               strconv.Itoa(connection),
+	},
+	).Set(float64(attempts))
+}
+
+// SetWebSocketReconnectionBackoff sets the timeout that the given connection of the provider waits
+// for before reconnecting.
+func (m *WebSocketMetricsImpl) SetWebSocketReconnectionBackoff(provider string, connection int, backoff time.Duration) {
+	m.reconnectionBackoffPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+		ConnectionLabel:               strconv.Itoa(connection),
+	},
+	).Set(float64(backoff.Milliseconds()))
+}
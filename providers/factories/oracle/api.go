@@ -23,6 +23,7 @@ import (
 	"github.com/skip-mev/connect/v2/providers/apis/polymarket"
 	apihandlers "github.com/skip-mev/connect/v2/providers/base/api/handlers"
 	"github.com/skip-mev/connect/v2/providers/base/api/metrics"
+	"github.com/skip-mev/connect/v2/providers/generic"
 	"github.com/skip-mev/connect/v2/providers/static"
 	"github.com/skip-mev/connect/v2/providers/volatile"
 )
@@ -54,14 +55,15 @@ func APIQueryHandlerFactory(
 	}
 
 	var (
-		apiPriceFetcher types.PriceAPIFetcher
-		apiDataHandler  types.PriceAPIDataHandler
-		headers         = make(map[string]string)
+		apiPriceFetcher   types.PriceAPIFetcher
+		apiDataHandler    types.PriceAPIDataHandler
+		newAPIDataHandler func(config.APIConfig) (types.PriceAPIDataHandler, error)
+		headers           = make(map[string]string)
 	)
 
 	// If the provider has an API key, add it to the headers.
-	if len(cfg.API.Endpoints) == 1 && cfg.API.Endpoints[0].Authentication.Enabled() {
-		headers[cfg.API.Endpoints[0].Authentication.APIKeyHeader] = cfg.API.Endpoints[0].Authentication.APIKey
+	if len(cfg.API.Endpoints) == 1 {
+		headers = authenticationHeaders(cfg.API.Endpoints[0])
 	}
 
 	requestHandler, err := apihandlers.NewRequestHandlerImpl(client, apihandlers.WithHTTPHeaders(headers))
@@ -70,20 +72,22 @@ func APIQueryHandlerFactory(
 	}
 
 	switch providerName := cfg.Name; {
+	case cfg.API.Generic != nil:
+		newAPIDataHandler = generic.NewAPIHandler
 	case providerName == binance.Name:
-		apiDataHandler, err = binance.NewAPIHandler(cfg.API)
+		newAPIDataHandler = binance.NewAPIHandler
 	case providerName == bitstamp.Name:
-		apiDataHandler, err = bitstamp.NewAPIHandler(cfg.API)
+		newAPIDataHandler = bitstamp.NewAPIHandler
 	case providerName == coinbaseapi.Name:
-		apiDataHandler, err = coinbaseapi.NewAPIHandler(cfg.API)
+		newAPIDataHandler = coinbaseapi.NewAPIHandler
 	case providerName == coingecko.Name:
-		apiDataHandler, err = coingecko.NewAPIHandler(cfg.API)
+		newAPIDataHandler = coingecko.NewAPIHandler
 	case providerName == coinmarketcap.Name:
-		apiDataHandler, err = coinmarketcap.NewAPIHandler(cfg.API)
+		newAPIDataHandler = coinmarketcap.NewAPIHandler
 	case providerName == geckoterminal.Name:
-		apiDataHandler, err = geckoterminal.NewAPIHandler(cfg.API)
+		newAPIDataHandler = geckoterminal.NewAPIHandler
 	case providerName == kraken.Name:
-		apiDataHandler, err = kraken.NewAPIHandler(cfg.API)
+		newAPIDataHandler = kraken.NewAPIHandler
 	case strings.HasPrefix(providerName, uniswapv3.BaseName):
 		apiPriceFetcher, err = uniswapv3.NewPriceFetcher(ctx, logger, metrics, cfg.API)
 	case providerName == static.Name:
@@ -97,7 +101,7 @@ func APIQueryHandlerFactory(
 	case providerName == osmosis.Name:
 		apiPriceFetcher, err = osmosis.NewAPIPriceFetcher(logger, cfg.API, metrics)
 	case providerName == polymarket.Name:
-		apiDataHandler, err = polymarket.NewAPIHandler(cfg.API)
+		newAPIDataHandler = polymarket.NewAPIHandler
 	default:
 		return nil, fmt.Errorf("unknown provider: %s", cfg.Name)
 	}
@@ -105,6 +109,18 @@ func APIQueryHandlerFactory(
 		return nil, err
 	}
 
+	// If the provider has multiple endpoints, create a REST API price fetcher that fails over
+	// between them. Otherwise, create the data handler for the single endpoint.
+	switch {
+	case newAPIDataHandler != nil && len(cfg.API.Endpoints) > 1:
+		apiPriceFetcher, err = newRestAPIFetcherWithEndpoints(logger, cfg.API, client, newAPIDataHandler, metrics)
+	case newAPIDataHandler != nil:
+		apiDataHandler, err = newAPIDataHandler(cfg.API)
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	// if no apiPriceFetcher has been created yet, create a default REST API price fetcher.
 	if apiPriceFetcher == nil {
 		apiPriceFetcher, err = apihandlers.NewRestAPIFetcher(
@@ -127,3 +143,48 @@ func APIQueryHandlerFactory(
 		metrics,
 	)
 }
+
+// newRestAPIFetcherWithEndpoints returns a REST API price fetcher that fails over between the endpoints
+// of the given config. Each endpoint has its own data handler, created with a copy of the config that
+// only contains the endpoint, and its own request handler, which sets the endpoint's API key.
+func newRestAPIFetcherWithEndpoints(
+	logger *zap.Logger,
+	cfg config.APIConfig,
+	client *http.Client,
+	newAPIDataHandler func(config.APIConfig) (types.PriceAPIDataHandler, error),
+	metrics metrics.APIMetrics,
+) (types.PriceAPIFetcher, error) {
+	requestHandlers := make([]apihandlers.RequestHandler, len(cfg.Endpoints))
+	apiDataHandlers := make([]types.PriceAPIDataHandler, len(cfg.Endpoints))
+	for i, endpoint := range cfg.Endpoints {
+		// Pin the endpoint directly into a copy of the config.
+		endpointCfg := cfg
+		endpointCfg.Endpoints = []config.Endpoint{endpoint}
+
+		var err error
+		apiDataHandlers[i], err = newAPIDataHandler(endpointCfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create api data handler for endpoint %d: %w", i, err)
+		}
+
+		requestHandlers[i], err = apihandlers.NewRequestHandlerImpl(
+			client,
+			apihandlers.WithHTTPHeaders(authenticationHeaders(endpoint)),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return apihandlers.NewRestAPIFetcherWithEndpoints(requestHandlers, apiDataHandlers, metrics, cfg, logger)
+}
+
+// authenticationHeaders returns the HTTP headers that authenticate requests to the given endpoint.
+func authenticationHeaders(endpoint config.Endpoint) map[string]string {
+	headers := make(map[string]string)
+	if endpoint.Authentication.Enabled() {
+		headers[endpoint.Authentication.APIKeyHeader] = endpoint.Authentication.APIKey
+	}
+
+	return headers
+}
@@ -12,6 +12,7 @@ import (
 	apihandlers "github.com/skip-mev/connect/v2/providers/base/api/handlers"
 	wshandlers "github.com/skip-mev/connect/v2/providers/base/websocket/handlers"
 	wsmetrics "github.com/skip-mev/connect/v2/providers/base/websocket/metrics"
+	"github.com/skip-mev/connect/v2/providers/generic"
 	"github.com/skip-mev/connect/v2/providers/websockets/binance"
 	"github.com/skip-mev/connect/v2/providers/websockets/bitfinex"
 	"github.com/skip-mev/connect/v2/providers/websockets/bitstamp"
@@ -56,26 +57,28 @@ func WebSocketQueryHandlerFactory(
 		connHandler    wshandlers.WebSocketConnHandler
 	)
 
-	switch cfg.Name {
-	case binance.Name:
+	switch providerName := cfg.Name; {
+	case cfg.WebSocket.Generic != nil:
+		wsDataHandler, err = generic.NewWebSocketDataHandler(logger, cfg.WebSocket)
+	case providerName == binance.Name:
 		wsDataHandler, err = binance.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case bitfinex.Name:
+	case providerName == bitfinex.Name:
 		wsDataHandler, err = bitfinex.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case bitstamp.Name:
+	case providerName == bitstamp.Name:
 		wsDataHandler, err = bitstamp.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case bybit.Name:
+	case providerName == bybit.Name:
 		wsDataHandler, err = bybit.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case coinbasews.Name:
+	case providerName == coinbasews.Name:
 		wsDataHandler, err = coinbasews.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case cryptodotcom.Name:
+	case providerName == cryptodotcom.Name:
 		wsDataHandler, err = cryptodotcom.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case gate.Name:
+	case providerName == gate.Name:
 		wsDataHandler, err = gate.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case huobi.Name:
+	case providerName == huobi.Name:
 		wsDataHandler, err = huobi.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case kraken.Name:
+	case providerName == kraken.Name:
 		wsDataHandler, err = kraken.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case kucoin.Name:
+	case providerName == kucoin.Name:
 		// Create the KuCoin websocket data handler.
 		wsDataHandler, err = kucoin.NewWebSocketDataHandler(logger, cfg.WebSocket)
 		if err != nil {
@@ -96,9 +99,9 @@ func WebSocketQueryHandlerFactory(
 			cfg.WebSocket,
 			wshandlers.WithPreDialHook(kucoin.PreDialHook(cfg.API, requestHandler)),
 		)
-	case mexc.Name:
+	case providerName == mexc.Name:
 		wsDataHandler, err = mexc.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case okx.Name:
+	case providerName == okx.Name:
 		wsDataHandler, err = okx.NewWebSocketDataHandler(logger, cfg.WebSocket)
 	default:
 		return nil, fmt.Errorf("unknown provider: %s", cfg.Name)
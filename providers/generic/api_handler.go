@@ -0,0 +1,197 @@
+package generic
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/json"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
+)
+
+var _ types.PriceAPIDataHandler = (*APIHandler)(nil)
+
+// APIHandler implements the PriceAPIDataHandler interface for generic REST APIs. The URLs of the
+// requests and the parsing of the responses are entirely determined by the generic API config of
+// the provider, so that an API can be supported without implementing a provider for it.
+type APIHandler struct {
+	// api is the config for the API.
+	api config.APIConfig
+
+	// parser extracts the prices from the responses of the API.
+	parser resultParser
+
+	// cache maintains the latest set of tickers seen by the handler.
+	cache types.ProviderTickers
+}
+
+// NewAPIHandler returns a new generic PriceAPIDataHandler. The API config must contain a generic
+// API config.
+func NewAPIHandler(
+	api config.APIConfig,
+) (types.PriceAPIDataHandler, error) {
+	if api.Generic == nil {
+		return nil, fmt.Errorf("api config for %s is not a generic api config", api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config for %s: %w", api.Name, err)
+	}
+
+	parser, err := newResultParser(
+		api.Generic.Results,
+		"",
+		api.Generic.Ticker,
+		api.Generic.Price,
+		api.Generic.Timestamp,
+		api.Generic.TimestampUnit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid generic api config for %s: %w", api.Name, err)
+	}
+
+	return &APIHandler{
+		api:    api,
+		parser: parser,
+		cache:  types.NewProviderTickers(),
+	}, nil
+}
+
+// CreateURL returns the URL that is used to fetch data from the API for the given tickers. The
+// off-chain tickers replace the placeholder in the URL template, according to the batch mode.
+func (h *APIHandler) CreateURL(
+	tickers []types.ProviderTicker,
+) (string, error) {
+	if len(tickers) == 0 {
+		return "", fmt.Errorf("empty url created. invalid or no ticker were provided")
+	}
+
+	template := h.api.Endpoints[0].URL
+	switch h.api.Generic.BatchMode {
+	case config.BatchModeSingle:
+		if len(tickers) != 1 {
+			return "", fmt.Errorf("expected 1 ticker, got %d", len(tickers))
+		}
+
+		h.cache.Add(tickers[0])
+		return strings.ReplaceAll(template, config.TickerPlaceholder, url.QueryEscape(tickers[0].GetOffChainTicker())), nil
+	case config.BatchModeList:
+		separator := h.api.Generic.Separator
+		if len(separator) == 0 {
+			separator = config.DefaultTickersSeparator
+		}
+
+		offChainTickers := make([]string, len(tickers))
+		for i, ticker := range tickers {
+			offChainTickers[i] = url.QueryEscape(ticker.GetOffChainTicker())
+			h.cache.Add(ticker)
+		}
+
+		return strings.ReplaceAll(template, config.TickersPlaceholder, strings.Join(offChainTickers, separator)), nil
+	default:
+		for _, ticker := range tickers {
+			h.cache.Add(ticker)
+		}
+
+		return template, nil
+	}
+}
+
+// ParseResponse parses the response from the API and returns a GetResponse. Each of the tickers
+// supplied will get a response or an error.
+func (h *APIHandler) ParseResponse(
+	tickers []types.ProviderTicker,
+	resp *http.Response,
+) types.PriceResponse {
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToDecode),
+		)
+	}
+
+	value, err := json.Decode(bz)
+	if err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToDecode),
+		)
+	}
+
+	// The requested ticker can only be used as the ticker of a result if a single ticker was
+	// requested.
+	var requested string
+	if len(tickers) == 1 {
+		requested = tickers[0].GetOffChainTicker()
+	}
+
+	results, err := h.parser.parseResults(value, requested)
+	if err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorInvalidResponse),
+		)
+	}
+
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unresolved = make(types.UnResolvedPrices)
+	)
+
+	for _, result := range results {
+		ticker, ok := h.cache.FromOffChainTicker(result.ticker)
+		if !ok {
+			continue
+		}
+
+		price, err := h.parser.parsePrice(result)
+		if err != nil {
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to parse price: %w", err),
+					providertypes.ErrorFailedToParsePrice,
+				),
+			}
+			continue
+		}
+
+		timestamp, err := h.parser.parseTimestamp(result)
+		if err != nil {
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to parse timestamp: %w", err),
+					providertypes.ErrorInvalidResponse,
+				),
+			}
+			continue
+		}
+
+		resolved[ticker] = types.NewPriceResult(price, timestamp)
+	}
+
+	// Add currency pairs that received no response to the unresolved map.
+	for _, ticker := range tickers {
+		_, resolvedOk := resolved[ticker]
+		_, unresolvedOk := unresolved[ticker]
+
+		if !resolvedOk && !unresolvedOk {
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					fmt.Errorf("no response"),
+					providertypes.ErrorNoResponse,
+				),
+			}
+		}
+	}
+
+	return types.NewPriceResponse(resolved, unresolved)
+}
@@ -0,0 +1,341 @@
+package generic_test
+
+import (
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/providers/base/testutils"
+	"github.com/skip-mev/connect/v2/providers/generic"
+)
+
+var (
+	btcusd = types.DefaultProviderTicker{
+		OffChainTicker: "BTC/USD",
+	}
+	ethusd = types.DefaultProviderTicker{
+		OffChainTicker: "ETH/USD",
+	}
+
+	singleCfg = config.APIConfig{
+		Name:             "regional_api",
+		Enabled:          true,
+		Timeout:          time.Second,
+		Interval:         time.Second,
+		ReconnectTimeout: time.Second,
+		MaxQueries:       1,
+		Endpoints:        []config.Endpoint{{URL: "https://api.regional.com/ticker?symbol={ticker}"}},
+		Generic: &config.GenericAPIConfig{
+			BatchMode: config.BatchModeSingle,
+			Price:     "$.result.price",
+			Timestamp: "$.result.time",
+		},
+	}
+
+	listCfg = config.APIConfig{
+		Name:             "regional_api",
+		Enabled:          true,
+		Timeout:          time.Second,
+		Interval:         time.Second,
+		ReconnectTimeout: time.Second,
+		MaxQueries:       1,
+		Atomic:           true,
+		Endpoints:        []config.Endpoint{{URL: "https://api.regional.com/tickers?symbols={tickers}"}},
+		Generic: &config.GenericAPIConfig{
+			BatchMode:     config.BatchModeList,
+			Separator:     ";",
+			Results:       "$.data",
+			Ticker:        "$.symbol",
+			Price:         "$.last",
+			Timestamp:     "$.ts",
+			TimestampUnit: config.TimestampUnitMilliseconds,
+		},
+	}
+
+	allCfg = config.APIConfig{
+		Name:             "regional_api",
+		Enabled:          true,
+		Timeout:          time.Second,
+		Interval:         time.Second,
+		ReconnectTimeout: time.Second,
+		MaxQueries:       1,
+		Atomic:           true,
+		Endpoints:        []config.Endpoint{{URL: "https://api.regional.com/tickers"}},
+		Generic: &config.GenericAPIConfig{
+			BatchMode: config.BatchModeAll,
+			Results:   "$.result",
+			Price:     "$.c[0]",
+		},
+	}
+)
+
+func TestNewAPIHandler(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		_, err := generic.NewAPIHandler(listCfg)
+		require.NoError(t, err)
+	})
+
+	t.Run("not a generic config", func(t *testing.T) {
+		cfg := listCfg
+		cfg.Generic = nil
+
+		_, err := generic.NewAPIHandler(cfg)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid path", func(t *testing.T) {
+		genericCfg := *listCfg.Generic
+		genericCfg.Price = "last"
+
+		cfg := listCfg
+		cfg.Generic = &genericCfg
+
+		_, err := generic.NewAPIHandler(cfg)
+		require.Error(t, err)
+	})
+}
+
+func TestCreateURL(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cfg         config.APIConfig
+		cps         []types.ProviderTicker
+		url         string
+		expectedErr bool
+	}{
+		{
+			name:        "empty",
+			cfg:         listCfg,
+			cps:         []types.ProviderTicker{},
+			expectedErr: true,
+		},
+		{
+			name: "single ticker",
+			cfg:  singleCfg,
+			cps: []types.ProviderTicker{
+				btcusd,
+			},
+			url: "https://api.regional.com/ticker?symbol=BTC%2FUSD",
+		},
+		{
+			name: "multiple tickers with a single ticker per request",
+			cfg:  singleCfg,
+			cps: []types.ProviderTicker{
+				btcusd,
+				ethusd,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "list of tickers",
+			cfg:  listCfg,
+			cps: []types.ProviderTicker{
+				btcusd,
+				ethusd,
+			},
+			url: "https://api.regional.com/tickers?symbols=BTC%2FUSD;ETH%2FUSD",
+		},
+		{
+			name: "all tickers",
+			cfg:  allCfg,
+			cps: []types.ProviderTicker{
+				btcusd,
+				ethusd,
+			},
+			url: "https://api.regional.com/tickers",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := generic.NewAPIHandler(tc.cfg)
+			require.NoError(t, err)
+
+			url, err := h.CreateURL(tc.cps)
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.url, url)
+			}
+		})
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        config.APIConfig
+		cps        []types.ProviderTicker
+		response   *http.Response
+		resolved   map[types.ProviderTicker]*big.Float
+		timestamps map[types.ProviderTicker]time.Time
+		unresolved []types.ProviderTicker
+	}{
+		{
+			name: "single ticker",
+			cfg:  singleCfg,
+			cps: []types.ProviderTicker{
+				btcusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`{"result":{"price":"64587.4","time":"2024-05-01T12:00:00Z"}}`,
+			),
+			resolved: map[types.ProviderTicker]*big.Float{
+				btcusd: big.NewFloat(64587.4),
+			},
+			timestamps: map[types.ProviderTicker]time.Time{
+				btcusd: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "list of tickers",
+			cfg:  listCfg,
+			cps: []types.ProviderTicker{
+				btcusd,
+				ethusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`{"data":[{"symbol":"BTC/USD","last":64587.4,"ts":1714564800000},{"symbol":"ETH/USD","last":"3338.08","ts":1714564801000}]}`,
+			),
+			resolved: map[types.ProviderTicker]*big.Float{
+				btcusd: big.NewFloat(64587.4),
+				ethusd: big.NewFloat(3338.08),
+			},
+			timestamps: map[types.ProviderTicker]time.Time{
+				btcusd: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+				ethusd: time.Date(2024, 5, 1, 12, 0, 1, 0, time.UTC),
+			},
+		},
+		{
+			name: "all tickers keyed by ticker",
+			cfg:  allCfg,
+			cps: []types.ProviderTicker{
+				btcusd,
+				ethusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`{"result":{"BTC/USD":{"c":["64587.4","0.01"]},"ETH/USD":{"c":["3338.08","0.02"]},"SOL/USD":{"c":["150.1","0.03"]}}}`,
+			),
+			resolved: map[types.ProviderTicker]*big.Float{
+				btcusd: big.NewFloat(64587.4),
+				ethusd: big.NewFloat(3338.08),
+			},
+		},
+		{
+			name: "missing ticker",
+			cfg:  listCfg,
+			cps: []types.ProviderTicker{
+				btcusd,
+				ethusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`{"data":[{"symbol":"BTC/USD","last":64587.4,"ts":1714564800000}]}`,
+			),
+			resolved: map[types.ProviderTicker]*big.Float{
+				btcusd: big.NewFloat(64587.4),
+			},
+			timestamps: map[types.ProviderTicker]time.Time{
+				btcusd: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+			},
+			unresolved: []types.ProviderTicker{
+				ethusd,
+			},
+		},
+		{
+			name: "bad price",
+			cfg:  listCfg,
+			cps: []types.ProviderTicker{
+				btcusd,
+				ethusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`{"data":[{"symbol":"BTC/USD","last":"$64587.4","ts":1714564800000},{"symbol":"ETH/USD","ts":1714564800000}]}`,
+			),
+			unresolved: []types.ProviderTicker{
+				btcusd,
+				ethusd,
+			},
+		},
+		{
+			name: "bad timestamp",
+			cfg:  singleCfg,
+			cps: []types.ProviderTicker{
+				btcusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`{"result":{"price":"64587.4","time":"yesterday"}}`,
+			),
+			unresolved: []types.ProviderTicker{
+				btcusd,
+			},
+		},
+		{
+			name: "no results",
+			cfg:  listCfg,
+			cps: []types.ProviderTicker{
+				btcusd,
+				ethusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`{"error":"unknown symbol"}`,
+			),
+			unresolved: []types.ProviderTicker{
+				btcusd,
+				ethusd,
+			},
+		},
+		{
+			name: "bad response",
+			cfg:  listCfg,
+			cps: []types.ProviderTicker{
+				btcusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`shout out my label that's me`,
+			),
+			unresolved: []types.ProviderTicker{
+				btcusd,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := generic.NewAPIHandler(tc.cfg)
+			require.NoError(t, err)
+
+			// Update the cache since it is assumed that createURL is executed before ParseResponse.
+			_, err = h.CreateURL(tc.cps)
+			require.NoError(t, err)
+
+			now := time.Now()
+			resp := h.ParseResponse(tc.cps, tc.response)
+
+			require.Len(t, resp.Resolved, len(tc.resolved))
+			require.Len(t, resp.UnResolved, len(tc.unresolved))
+
+			for cp, price := range tc.resolved {
+				require.Contains(t, resp.Resolved, cp)
+				r := resp.Resolved[cp]
+				require.Equal(t, price.SetPrec(18), r.Value.SetPrec(18))
+
+				if timestamp, ok := tc.timestamps[cp]; ok {
+					require.Equal(t, timestamp, r.Timestamp)
+				} else {
+					require.True(t, r.Timestamp.After(now))
+				}
+			}
+
+			for _, cp := range tc.unresolved {
+				require.Contains(t, resp.UnResolved, cp)
+				require.Error(t, resp.UnResolved[cp])
+			}
+		})
+	}
+}
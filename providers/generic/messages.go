@@ -0,0 +1,72 @@
+package generic
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	slinkymath "github.com/skip-mev/connect/v2/pkg/math"
+	"github.com/skip-mev/connect/v2/providers/base/websocket/handlers"
+)
+
+// newMessages creates the messages for the given tickers from the given message template. If the
+// template contains the {ticker} placeholder, a message is created per ticker, with the
+// placeholder replaced by the JSON-escaped off-chain ticker. Otherwise, a message is created per
+// batch of up to MaxSubscriptionsPerBatch tickers, with the {tickers} placeholder replaced by a
+// JSON array of the off-chain tickers.
+func newMessages(
+	template string,
+	tickers []types.ProviderTicker,
+	maxSubscriptionsPerBatch int,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	numTickers := len(tickers)
+	if numTickers == 0 {
+		return nil, fmt.Errorf("tickers cannot be empty")
+	}
+
+	offChainTickers := make([]string, numTickers)
+	for i, ticker := range tickers {
+		offChainTickers[i] = ticker.GetOffChainTicker()
+	}
+
+	if strings.Contains(template, config.TickerPlaceholder) {
+		msgs := make([]handlers.WebsocketEncodedMessage, numTickers)
+		for i, ticker := range offChainTickers {
+			bz, err := json.Marshal(ticker)
+			if err != nil {
+				return nil, fmt.Errorf("unable to marshal ticker: %w", err)
+			}
+
+			// Strip the quotes of the marshalled ticker, since the placeholder may be quoted in
+			// the template, or be a part of a larger string.
+			escaped := string(bz[1 : len(bz)-1])
+			msgs[i] = []byte(strings.ReplaceAll(template, config.TickerPlaceholder, escaped))
+		}
+
+		return msgs, nil
+	}
+
+	if maxSubscriptionsPerBatch < 1 {
+		return nil, fmt.Errorf("max subscriptions per batch must be greater than 0")
+	}
+
+	numBatches := int(math.Ceil(float64(numTickers) / float64(maxSubscriptionsPerBatch)))
+	msgs := make([]handlers.WebsocketEncodedMessage, numBatches)
+	for i := 0; i < numBatches; i++ {
+		// Get the tickers for the batch.
+		start := i * maxSubscriptionsPerBatch
+		end := slinkymath.Min((i+1)*maxSubscriptionsPerBatch, numTickers)
+
+		bz, err := json.Marshal(offChainTickers[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal tickers: %w", err)
+		}
+
+		msgs[i] = []byte(strings.ReplaceAll(template, config.TickersPlaceholder, string(bz)))
+	}
+
+	return msgs, nil
+}
@@ -0,0 +1,213 @@
+package generic
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/pkg/json"
+	"github.com/skip-mev/connect/v2/pkg/math"
+)
+
+// result is a single result of a response, along with the off-chain ticker it is for.
+type result struct {
+	// ticker is the off-chain ticker of the result.
+	ticker string
+
+	// value is the decoded result.
+	value interface{}
+}
+
+// resultParser extracts the results, i.e. the prices of tickers, from decoded responses using the
+// paths configured for a generic provider.
+type resultParser struct {
+	// results is the path of the results in a response. It is nil if the response is a single
+	// result.
+	results *json.Path
+
+	// resultsType is the type of the results, if the results must be of a single type.
+	resultsType config.ResultsType
+
+	// ticker is the path of the off-chain ticker in a result. It is nil if the ticker is
+	// determined by the key of the result, or the requested ticker.
+	ticker *json.Path
+
+	// price is the path of the price in a result.
+	price json.Path
+
+	// timestamp is the path of the timestamp in a result. It is nil if results are timestamped
+	// with the time at which they are parsed.
+	timestamp *json.Path
+
+	// unit is the unit of numeric timestamps.
+	unit config.TimestampUnit
+}
+
+// newResultParser returns a new result parser for the given paths. Empty paths are optional,
+// except for the price path. An empty results type accepts both arrays and objects of results.
+func newResultParser(
+	results string,
+	resultsType config.ResultsType,
+	ticker, price, timestamp string,
+	unit config.TimestampUnit,
+) (resultParser, error) {
+	var (
+		parser = resultParser{resultsType: resultsType, unit: unit}
+		err    error
+	)
+
+	if parser.results, err = parseOptionalPath(results); err != nil {
+		return resultParser{}, err
+	}
+
+	if parser.ticker, err = parseOptionalPath(ticker); err != nil {
+		return resultParser{}, err
+	}
+
+	if parser.price, err = json.ParsePath(price); err != nil {
+		return resultParser{}, err
+	}
+
+	if parser.timestamp, err = parseOptionalPath(timestamp); err != nil {
+		return resultParser{}, err
+	}
+
+	return parser, nil
+}
+
+// parseOptionalPath parses the given path, returning nil if it is empty.
+func parseOptionalPath(expr string) (*json.Path, error) {
+	if len(expr) == 0 {
+		return nil, nil
+	}
+
+	path, err := json.ParsePath(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &path, nil
+}
+
+// parseResults returns the results of the given decoded response. The requested ticker is used as
+// the ticker of a result whose ticker cannot otherwise be determined, and must be empty if
+// multiple tickers were requested. Results whose ticker cannot be determined are skipped.
+func (p resultParser) parseResults(value interface{}, requested string) ([]result, error) {
+	if p.results == nil {
+		ticker, err := p.parseTicker(value, "", requested)
+		if err != nil {
+			return nil, err
+		}
+
+		return []result{{ticker: ticker, value: value}}, nil
+	}
+
+	values, ok := p.results.Get(value)
+	if !ok {
+		return nil, fmt.Errorf("no results at path %s", p.results)
+	}
+
+	var results []result
+	switch values := values.(type) {
+	case []interface{}:
+		if p.resultsType == config.ResultsTypeObject {
+			return nil, fmt.Errorf("results at path %s are not an object", p.results)
+		}
+
+		// The requested ticker is only used if the array contains a single result.
+		if len(values) != 1 {
+			requested = ""
+		}
+
+		for _, v := range values {
+			if ticker, err := p.parseTicker(v, "", requested); err == nil {
+				results = append(results, result{ticker: ticker, value: v})
+			}
+		}
+	case map[string]interface{}:
+		if p.resultsType == config.ResultsTypeArray {
+			return nil, fmt.Errorf("results at path %s are not an array", p.results)
+		}
+
+		for key, v := range values {
+			if ticker, err := p.parseTicker(v, key, requested); err == nil {
+				results = append(results, result{ticker: ticker, value: v})
+			}
+		}
+	default:
+		return nil, fmt.Errorf("results at path %s are not an array or object", p.results)
+	}
+
+	return results, nil
+}
+
+// parseTicker returns the off-chain ticker of the given result. If no ticker path is configured,
+// the key of the result is used if it is set, and the requested ticker otherwise.
+func (p resultParser) parseTicker(value interface{}, key, requested string) (string, error) {
+	switch {
+	case p.ticker != nil:
+		return p.ticker.GetString(value)
+	case len(key) > 0:
+		return key, nil
+	case len(requested) > 0:
+		return requested, nil
+	default:
+		return "", fmt.Errorf("unable to determine the ticker of result")
+	}
+}
+
+// parsePrice returns the price of the given result.
+func (p resultParser) parsePrice(r result) (*big.Float, error) {
+	price, err := p.price.GetString(r.value)
+	if err != nil {
+		return nil, err
+	}
+
+	return math.Float64StringToBigFloat(price)
+}
+
+// parseTimestamp returns the timestamp of the given result. If no timestamp path is configured,
+// the current time is returned.
+func (p resultParser) parseTimestamp(r result) (time.Time, error) {
+	if p.timestamp == nil {
+		return time.Now().UTC(), nil
+	}
+
+	timestamp, err := p.timestamp.GetString(r.value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Numeric timestamps are relative to the unix epoch, in the configured unit. Integers are
+	// parsed exactly, so that nanosecond timestamps do not lose precision.
+	if n, err := strconv.ParseInt(timestamp, 10, 64); err == nil {
+		switch p.unit {
+		case config.TimestampUnitMilliseconds:
+			return time.UnixMilli(n).UTC(), nil
+		case config.TimestampUnitNanoseconds:
+			return time.Unix(0, n).UTC(), nil
+		default:
+			return time.Unix(n, 0).UTC(), nil
+		}
+	}
+
+	if f, err := strconv.ParseFloat(timestamp, 64); err == nil {
+		switch p.unit {
+		case config.TimestampUnitMilliseconds:
+			return time.Unix(0, int64(f*float64(time.Millisecond))).UTC(), nil
+		case config.TimestampUnitNanoseconds:
+			return time.Unix(0, int64(f)).UTC(), nil
+		default:
+			return time.Unix(0, int64(f*float64(time.Second))).UTC(), nil
+		}
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %s at path %s: %w", timestamp, p.timestamp, err)
+	}
+
+	return t.UTC(), nil
+}
@@ -0,0 +1,207 @@
+package generic
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/json"
+	wserrors "github.com/skip-mev/connect/v2/providers/base/websocket/errors"
+	"github.com/skip-mev/connect/v2/providers/base/websocket/handlers"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
+)
+
+var _ types.PriceWebSocketDataHandler = (*WebSocketHandler)(nil)
+
+// WebSocketHandler implements the WebSocketDataHandler interface for generic websocket APIs. The
+// subscription messages and the parsing of the received messages are entirely determined by the
+// generic websocket config of the provider, so that a websocket API can be supported without
+// implementing a provider for it.
+type WebSocketHandler struct {
+	logger *zap.Logger
+
+	// ws is the config for the websocket.
+	ws config.WebSocketConfig
+
+	// channel is the path of the channel in a message. It is nil if all messages are parsed
+	// for prices.
+	channel *json.Path
+
+	// parser extracts the prices from the messages received from the websocket.
+	parser resultParser
+
+	// cache maintains the latest set of tickers seen by the handler.
+	cache types.ProviderTickers
+}
+
+// NewWebSocketDataHandler returns a new generic PriceWebSocketDataHandler. The websocket config
+// must contain a generic websocket config.
+func NewWebSocketDataHandler(
+	logger *zap.Logger,
+	ws config.WebSocketConfig,
+) (types.PriceWebSocketDataHandler, error) {
+	if ws.Generic == nil {
+		return nil, fmt.Errorf("websocket config for %s is not a generic websocket config", ws.Name)
+	}
+
+	if !ws.Enabled {
+		return nil, fmt.Errorf("websocket config for %s is not enabled", ws.Name)
+	}
+
+	if err := ws.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid websocket config for %s: %w", ws.Name, err)
+	}
+
+	channel, err := parseOptionalPath(ws.Generic.Channel)
+	if err != nil {
+		return nil, fmt.Errorf("invalid generic websocket config for %s: %w", ws.Name, err)
+	}
+
+	parser, err := newResultParser(
+		ws.Generic.Results,
+		ws.Generic.ResultsType,
+		ws.Generic.Ticker,
+		ws.Generic.Price,
+		ws.Generic.Timestamp,
+		ws.Generic.TimestampUnit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid generic websocket config for %s: %w", ws.Name, err)
+	}
+
+	return &WebSocketHandler{
+		logger:  logger,
+		ws:      ws,
+		channel: channel,
+		parser:  parser,
+		cache:   types.NewProviderTickers(),
+	}, nil
+}
+
+// HandleMessage is used to handle a message received from the data provider. If a channel path
+// is configured, messages on any other channel (e.g. subscription responses and heartbeat
+// responses) are ignored. Otherwise, every message is expected to contain prices.
+func (h *WebSocketHandler) HandleMessage(
+	message []byte,
+) (types.PriceResponse, []handlers.WebsocketEncodedMessage, error) {
+	var resp types.PriceResponse
+
+	value, err := json.Decode(message)
+	if err != nil {
+		return resp, nil, fmt.Errorf("failed to decode message: %w", err)
+	}
+
+	if h.channel != nil {
+		channel, err := h.channel.GetString(value)
+		if err != nil || channel != h.ws.Generic.ChannelValue {
+			h.logger.Debug("ignoring message that is not on the price channel", zap.Binary("message", message))
+			return resp, nil, nil
+		}
+	}
+
+	results, err := h.parser.parseResults(value, "")
+	if err != nil {
+		return resp, nil, fmt.Errorf("failed to parse message: %w", err)
+	}
+
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unresolved = make(types.UnResolvedPrices)
+	)
+
+	for _, result := range results {
+		ticker, ok := h.cache.FromOffChainTicker(result.ticker)
+		if !ok {
+			h.logger.Debug("received price for unknown ticker", zap.String("ticker", result.ticker))
+			continue
+		}
+
+		price, err := h.parser.parsePrice(result)
+		if err != nil {
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to parse price: %w", err),
+					providertypes.ErrorFailedToParsePrice,
+				),
+			}
+			continue
+		}
+
+		timestamp, err := h.parser.parseTimestamp(result)
+		if err != nil {
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to parse timestamp: %w", err),
+					providertypes.ErrorInvalidResponse,
+				),
+			}
+			continue
+		}
+
+		resolved[ticker] = types.NewPriceResult(price, timestamp)
+	}
+
+	return types.NewPriceResponse(resolved, unresolved), nil, nil
+}
+
+// CreateMessages is used to create the messages that subscribe to the given tickers, from the
+// subscribe message template.
+func (h *WebSocketHandler) CreateMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	msgs, err := newMessages(h.ws.Generic.SubscribeMessage, tickers, h.ws.MaxSubscriptionsPerBatch)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ticker := range tickers {
+		h.cache.Add(ticker)
+	}
+
+	return msgs, nil
+}
+
+// UnsubscribeMessages is used to create the messages that unsubscribe from the given tickers, from
+// the unsubscribe message template. If no unsubscribe message template is configured, the
+// connection must be restarted instead.
+func (h *WebSocketHandler) UnsubscribeMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	if len(h.ws.Generic.UnsubscribeMessage) == 0 {
+		return nil, wserrors.ErrUnsubscribeNotSupported
+	}
+
+	msgs, err := newMessages(h.ws.Generic.UnsubscribeMessage, tickers, h.ws.MaxSubscriptionsPerBatch)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ticker := range tickers {
+		h.cache.Remove(ticker)
+	}
+
+	return msgs, nil
+}
+
+// HeartBeatMessages is used to construct the heartbeat messages to be sent to the data provider.
+// This is the configured heartbeat message, if any.
+func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
+	if len(h.ws.Generic.HeartbeatMessage) == 0 {
+		return nil, nil
+	}
+
+	return []handlers.WebsocketEncodedMessage{[]byte(h.ws.Generic.HeartbeatMessage)}, nil
+}
+
+// Copy is used to create a copy of the WebSocketHandler.
+func (h *WebSocketHandler) Copy() types.PriceWebSocketDataHandler {
+	return &WebSocketHandler{
+		logger:  h.logger,
+		ws:      h.ws,
+		channel: h.channel,
+		parser:  h.parser,
+		cache:   types.NewProviderTickers(),
+	}
+}
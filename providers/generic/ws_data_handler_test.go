@@ -0,0 +1,382 @@
+package generic_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	wserrors "github.com/skip-mev/connect/v2/providers/base/websocket/errors"
+	"github.com/skip-mev/connect/v2/providers/base/websocket/handlers"
+	"github.com/skip-mev/connect/v2/providers/generic"
+)
+
+var (
+	solusd = types.DefaultProviderTicker{
+		OffChainTicker: "SOL/USD",
+	}
+
+	logger = zap.NewExample()
+
+	wsCfg = config.WebSocketConfig{
+		Name:                     "regional_ws",
+		Enabled:                  true,
+		MaxBufferSize:            1024,
+		ReconnectionTimeout:      time.Second,
+		Endpoints:                []config.Endpoint{{URL: "wss://ws.regional.com"}},
+		HandshakeTimeout:         time.Second,
+		ReadTimeout:              time.Second,
+		WriteTimeout:             time.Second,
+		PingInterval:             15 * time.Second,
+		MaxSubscriptionsPerBatch: 2,
+		Generic: &config.GenericWebSocketConfig{
+			SubscribeMessage:   `{"op":"subscribe","args":{tickers}}`,
+			UnsubscribeMessage: `{"op":"unsubscribe","args":{tickers}}`,
+			HeartbeatMessage:   `{"op":"ping"}`,
+			Channel:            "$.channel",
+			ChannelValue:       "tickers",
+			Results:            "$.data",
+			Ticker:             "$.symbol",
+			Price:              "$.last",
+			Timestamp:          "$.ts",
+			TimestampUnit:      config.TimestampUnitMilliseconds,
+		},
+	}
+
+	singleWsCfg = config.WebSocketConfig{
+		Name:                     "regional_ws",
+		Enabled:                  true,
+		MaxBufferSize:            1024,
+		ReconnectionTimeout:      time.Second,
+		Endpoints:                []config.Endpoint{{URL: "wss://ws.regional.com"}},
+		HandshakeTimeout:         time.Second,
+		ReadTimeout:              time.Second,
+		WriteTimeout:             time.Second,
+		MaxSubscriptionsPerBatch: 2,
+		Generic: &config.GenericWebSocketConfig{
+			SubscribeMessage: `{"method":"sub","params":{"channel":"ticker.{ticker}"}}`,
+			Ticker:           "$.s",
+			Price:            "$.p",
+		},
+	}
+
+	objectWsCfg = config.WebSocketConfig{
+		Name:                     "regional_ws",
+		Enabled:                  true,
+		MaxBufferSize:            1024,
+		ReconnectionTimeout:      time.Second,
+		Endpoints:                []config.Endpoint{{URL: "wss://ws.regional.com"}},
+		HandshakeTimeout:         time.Second,
+		ReadTimeout:              time.Second,
+		WriteTimeout:             time.Second,
+		MaxSubscriptionsPerBatch: 2,
+		Generic: &config.GenericWebSocketConfig{
+			SubscribeMessage: `{"op":"subscribe","args":{tickers}}`,
+			Results:          "$.prices",
+			ResultsType:      config.ResultsTypeObject,
+			Price:            "$.last",
+		},
+	}
+)
+
+func TestNewWebSocketDataHandler(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		_, err := generic.NewWebSocketDataHandler(logger, wsCfg)
+		require.NoError(t, err)
+	})
+
+	t.Run("not a generic config", func(t *testing.T) {
+		cfg := wsCfg
+		cfg.Generic = nil
+
+		_, err := generic.NewWebSocketDataHandler(logger, cfg)
+		require.Error(t, err)
+	})
+
+	t.Run("heartbeat message without a ping interval", func(t *testing.T) {
+		cfg := wsCfg
+		cfg.PingInterval = 0
+
+		_, err := generic.NewWebSocketDataHandler(logger, cfg)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid subscribe message", func(t *testing.T) {
+		genericCfg := *wsCfg.Generic
+		genericCfg.SubscribeMessage = `{"op":"subscribe"}`
+
+		cfg := wsCfg
+		cfg.Generic = &genericCfg
+
+		_, err := generic.NewWebSocketDataHandler(logger, cfg)
+		require.Error(t, err)
+	})
+}
+
+func TestHandleMessage(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        config.WebSocketConfig
+		msg        string
+		resolved   map[types.ProviderTicker]*big.Float
+		timestamps map[types.ProviderTicker]time.Time
+		unresolved []types.ProviderTicker
+		expErr     bool
+	}{
+		{
+			name: "price update",
+			cfg:  wsCfg,
+			msg:  `{"channel":"tickers","data":[{"symbol":"BTC/USD","last":"64587.4","ts":1714564800000},{"symbol":"ETH/USD","last":3338.08,"ts":1714564801000}]}`,
+			resolved: map[types.ProviderTicker]*big.Float{
+				btcusd: big.NewFloat(64587.4),
+				ethusd: big.NewFloat(3338.08),
+			},
+			timestamps: map[types.ProviderTicker]time.Time{
+				btcusd: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+				ethusd: time.Date(2024, 5, 1, 12, 0, 1, 0, time.UTC),
+			},
+		},
+		{
+			name: "price update with an unknown ticker",
+			cfg:  wsCfg,
+			msg:  `{"channel":"tickers","data":[{"symbol":"BTC/USD","last":"64587.4","ts":1714564800000},{"symbol":"DOGE/USD","last":"0.15","ts":1714564800000}]}`,
+			resolved: map[types.ProviderTicker]*big.Float{
+				btcusd: big.NewFloat(64587.4),
+			},
+			timestamps: map[types.ProviderTicker]time.Time{
+				btcusd: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "price update with a bad price",
+			cfg:  wsCfg,
+			msg:  `{"channel":"tickers","data":[{"symbol":"BTC/USD","last":"$64587.4","ts":1714564800000}]}`,
+			unresolved: []types.ProviderTicker{
+				btcusd,
+			},
+		},
+		{
+			name: "message on another channel",
+			cfg:  wsCfg,
+			msg:  `{"channel":"subscribe","success":true}`,
+		},
+		{
+			name: "message without a channel",
+			cfg:  wsCfg,
+			msg:  `{"op":"pong"}`,
+		},
+		{
+			name: "single result without a channel",
+			cfg:  singleWsCfg,
+			msg:  `{"s":"ETH/USD","p":"3338.08"}`,
+			resolved: map[types.ProviderTicker]*big.Float{
+				ethusd: big.NewFloat(3338.08),
+			},
+		},
+		{
+			name: "results keyed by ticker",
+			cfg:  objectWsCfg,
+			msg:  `{"prices":{"BTC/USD":{"last":"64587.4"},"ETH/USD":{"last":"3338.08"}}}`,
+			resolved: map[types.ProviderTicker]*big.Float{
+				btcusd: big.NewFloat(64587.4),
+				ethusd: big.NewFloat(3338.08),
+			},
+		},
+		{
+			name:   "results that are not of the configured type",
+			cfg:    objectWsCfg,
+			msg:    `{"prices":[{"last":"64587.4"}]}`,
+			expErr: true,
+		},
+		{
+			name:   "message without a result",
+			cfg:    singleWsCfg,
+			msg:    `{"result":"subscribed"}`,
+			expErr: true,
+		},
+		{
+			name:   "invalid message",
+			cfg:    wsCfg,
+			msg:    `pong`,
+			expErr: true,
+		},
+		{
+			name:   "price update without results",
+			cfg:    wsCfg,
+			msg:    `{"channel":"tickers"}`,
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := generic.NewWebSocketDataHandler(logger, tc.cfg)
+			require.NoError(t, err)
+
+			// Update the cache since it is assumed that CreateMessages is executed before HandleMessage.
+			_, err = h.CreateMessages([]types.ProviderTicker{btcusd, ethusd})
+			require.NoError(t, err)
+
+			now := time.Now()
+			resp, updateMsgs, err := h.HandleMessage([]byte(tc.msg))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Empty(t, updateMsgs)
+
+			require.Len(t, resp.Resolved, len(tc.resolved))
+			require.Len(t, resp.UnResolved, len(tc.unresolved))
+
+			for cp, price := range tc.resolved {
+				require.Contains(t, resp.Resolved, cp)
+				r := resp.Resolved[cp]
+				require.Equal(t, price.SetPrec(18), r.Value.SetPrec(18))
+
+				if timestamp, ok := tc.timestamps[cp]; ok {
+					require.Equal(t, timestamp, r.Timestamp)
+				} else {
+					require.True(t, r.Timestamp.After(now))
+				}
+			}
+
+			for _, cp := range tc.unresolved {
+				require.Contains(t, resp.UnResolved, cp)
+				require.Error(t, resp.UnResolved[cp])
+			}
+		})
+	}
+}
+
+func TestCreateMessages(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cfg      config.WebSocketConfig
+		cps      []types.ProviderTicker
+		expected []string
+		expErr   bool
+	}{
+		{
+			name:   "no tickers",
+			cfg:    wsCfg,
+			cps:    []types.ProviderTicker{},
+			expErr: true,
+		},
+		{
+			name: "one batch",
+			cfg:  wsCfg,
+			cps: []types.ProviderTicker{
+				btcusd,
+				ethusd,
+			},
+			expected: []string{
+				`{"op":"subscribe","args":["BTC/USD","ETH/USD"]}`,
+			},
+		},
+		{
+			name: "multiple batches",
+			cfg:  wsCfg,
+			cps: []types.ProviderTicker{
+				btcusd,
+				ethusd,
+				solusd,
+			},
+			expected: []string{
+				`{"op":"subscribe","args":["BTC/USD","ETH/USD"]}`,
+				`{"op":"subscribe","args":["SOL/USD"]}`,
+			},
+		},
+		{
+			name: "message per ticker",
+			cfg:  singleWsCfg,
+			cps: []types.ProviderTicker{
+				btcusd,
+				ethusd,
+				solusd,
+			},
+			expected: []string{
+				`{"method":"sub","params":{"channel":"ticker.BTC/USD"}}`,
+				`{"method":"sub","params":{"channel":"ticker.ETH/USD"}}`,
+				`{"method":"sub","params":{"channel":"ticker.SOL/USD"}}`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := generic.NewWebSocketDataHandler(logger, tc.cfg)
+			require.NoError(t, err)
+
+			msgs, err := h.CreateMessages(tc.cps)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, toMessages(tc.expected), msgs)
+		})
+	}
+}
+
+func TestUnsubscribeMessages(t *testing.T) {
+	t.Run("unsubscribes and stops handling prices", func(t *testing.T) {
+		h, err := generic.NewWebSocketDataHandler(logger, wsCfg)
+		require.NoError(t, err)
+
+		_, err = h.CreateMessages([]types.ProviderTicker{btcusd, ethusd, solusd})
+		require.NoError(t, err)
+
+		msgs, err := h.UnsubscribeMessages([]types.ProviderTicker{ethusd, solusd})
+		require.NoError(t, err)
+		require.Equal(t, toMessages([]string{`{"op":"unsubscribe","args":["ETH/USD","SOL/USD"]}`}), msgs)
+
+		resp, _, err := h.HandleMessage([]byte(`{"channel":"tickers","data":[{"symbol":"BTC/USD","last":"64587.4","ts":1714564800000},{"symbol":"ETH/USD","last":"3338.08","ts":1714564800000}]}`))
+		require.NoError(t, err)
+		require.Len(t, resp.Resolved, 1)
+		require.Contains(t, resp.Resolved, btcusd)
+	})
+
+	t.Run("not supported without an unsubscribe message", func(t *testing.T) {
+		h, err := generic.NewWebSocketDataHandler(logger, singleWsCfg)
+		require.NoError(t, err)
+
+		_, err = h.UnsubscribeMessages([]types.ProviderTicker{btcusd})
+		require.ErrorIs(t, err, wserrors.ErrUnsubscribeNotSupported)
+	})
+}
+
+func TestHeartBeatMessages(t *testing.T) {
+	t.Run("heartbeat message", func(t *testing.T) {
+		h, err := generic.NewWebSocketDataHandler(logger, wsCfg)
+		require.NoError(t, err)
+
+		msgs, err := h.HeartBeatMessages()
+		require.NoError(t, err)
+		require.Equal(t, toMessages([]string{`{"op":"ping"}`}), msgs)
+	})
+
+	t.Run("no heartbeat message", func(t *testing.T) {
+		h, err := generic.NewWebSocketDataHandler(logger, singleWsCfg)
+		require.NoError(t, err)
+
+		msgs, err := h.HeartBeatMessages()
+		require.NoError(t, err)
+		require.Empty(t, msgs)
+	})
+}
+
+func toMessages(msgs []string) []handlers.WebsocketEncodedMessage {
+	encoded := make([]handlers.WebsocketEncodedMessage, len(msgs))
+	for i, msg := range msgs {
+		encoded[i] = []byte(msg)
+	}
+
+	return encoded
+}
@@ -26,6 +26,12 @@ const (
 	// ref: https://developers.binance.com/docs/binance-spot-api-docs/web-socket-streams#subscribe-to-a-stream
 	SubscribeMethod MethodType = "SUBSCRIBE"
 
+	// UnsubscribeMethod represents an unsubscribe method. This is used to unsubscribe from streams
+	// without closing the connection.
+	//
+	// ref: https://developers.binance.com/docs/binance-spot-api-docs/web-socket-streams#unsubscribe-to-a-stream
+	UnsubscribeMethod MethodType = "UNSUBSCRIBE"
+
 	// AggregateTradeStream represents the aggregate trade stream. This stream provides
 	// trade information that is aggregated for a single taker order.
 	//
@@ -43,7 +49,7 @@ const (
 )
 
 // SubscribeMessageRequest represents a subscribe message request. This is used to subscribe
-// to Binance websocket streams.
+// to (or unsubscribe from) Binance websocket streams.
 //
 // Request
 //
@@ -211,9 +217,22 @@ type TickerMessageResponse struct {
 // NewSubscribeRequestMessage returns a set of messages to subscribe to the Binance websocket. This will
 // subscribe each instrument to the aggregate trade and ticker streams.
 func (h *WebSocketHandler) NewSubscribeRequestMessage(instruments []string) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(SubscribeMethod, instruments)
+}
+
+// NewUnsubscribeRequestMessage returns a set of messages to unsubscribe the given instruments from the
+// aggregate trade and ticker streams of the Binance websocket.
+func (h *WebSocketHandler) NewUnsubscribeRequestMessage(instruments []string) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(UnsubscribeMethod, instruments)
+}
+
+// newRequestMessages returns a set of messages with the given method for the aggregate trade and ticker
+// streams of each instrument. The IDs of subscribe messages are recorded, so that failed subscriptions
+// can be retried.
+func (h *WebSocketHandler) newRequestMessages(method MethodType, instruments []string) ([]handlers.WebsocketEncodedMessage, error) {
 	numInstruments := len(instruments)
 	if numInstruments == 0 {
-		return nil, fmt.Errorf("no instruments to subscribe to")
+		return nil, fmt.Errorf("no instruments to %s", strings.ToLower(string(method)))
 	}
 
 	numBatches := int(math.Ceil(float64(numInstruments) / float64(h.ws.MaxSubscriptionsPerBatch)))
@@ -234,16 +253,18 @@ func (h *WebSocketHandler) NewSubscribeRequestMessage(instruments []string) ([]h
 		// Generate a random ID.
 		id := h.GenerateID()
 		msg, err := json.Marshal(SubscribeMessageRequest{
-			Method: string(SubscribeMethod),
+			Method: string(method),
 			Params: params,
 			ID:     id,
 		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal subscribe message: %w", err)
+			return nil, fmt.Errorf("failed to marshal %s message: %w", strings.ToLower(string(method)), err)
 		}
 
 		// Set the IDs
-		h.SetIDForInstruments(id, batch)
+		if method == SubscribeMethod {
+			h.SetIDForInstruments(id, batch)
+		}
 		msgs[i] = msg
 	}
 
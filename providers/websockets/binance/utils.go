@@ -32,6 +32,10 @@ var DefaultWebSocketConfig = config.WebSocketConfig{
 	Enabled:                       true,
 	MaxBufferSize:                 config.DefaultMaxBufferSize,
 	ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+	MaxReconnectionTimeout:        config.DefaultMaxReconnectionTimeout,
+	ReconnectionBackoffMultiplier: config.DefaultReconnectionBackoffMultiplier,
+	ReconnectionJitter:            config.DefaultReconnectionJitter,
+	ReconnectionResetPeriod:       config.DefaultReconnectionResetPeriod,
 	PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
 	HandshakeTimeout:              DefaultHandshakeTimeout,
 	Endpoints:                     []config.Endpoint{{URL: WSS}},
@@ -147,6 +147,21 @@ func (h *WebSocketHandler) CreateMessages(
 	return h.NewSubscribeRequestMessage(instruments)
 }
 
+// UnsubscribeMessages is used to create the messages to send to Binance to unsubscribe from the
+// given tickers, without closing the connection.
+func (h *WebSocketHandler) UnsubscribeMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	instruments := make([]string, 0)
+
+	for _, ticker := range tickers {
+		instruments = append(instruments, ticker.GetOffChainTicker())
+		h.cache.Remove(ticker)
+	}
+
+	return h.NewUnsubscribeRequestMessage(instruments)
+}
+
 // HeartBeatMessages is not used for Binance. Heartbeats are handled on an ad-hoc basis when
 // messages are received from the Binance websocket API.
 //
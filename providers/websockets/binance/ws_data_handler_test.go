@@ -457,3 +457,31 @@ func TestCreateMessages(t *testing.T) {
 		})
 	}
 }
+
+func TestUnsubscribeMessages(t *testing.T) {
+	t.Run("no tickers", func(t *testing.T) {
+		handler, err := binance.NewWebSocketDataHandler(logger, binance.DefaultWebSocketConfig)
+		require.NoError(t, err)
+
+		_, err = handler.UnsubscribeMessages([]types.ProviderTicker{})
+		require.Error(t, err)
+	})
+
+	t.Run("unsubscribes from a subscribed ticker", func(t *testing.T) {
+		handler, err := binance.NewWebSocketDataHandler(logger, binance.DefaultWebSocketConfig)
+		require.NoError(t, err)
+
+		_, err = handler.CreateMessages([]types.ProviderTicker{btcusdt, ethusdt})
+		require.NoError(t, err)
+
+		actual, err := handler.UnsubscribeMessages([]types.ProviderTicker{btcusdt})
+		require.NoError(t, err)
+		require.Len(t, actual, 1)
+
+		var msg binance.SubscribeMessageRequest
+		require.NoError(t, json.Unmarshal(actual[0], &msg))
+		require.Equal(t, string(binance.UnsubscribeMethod), msg.Method)
+		require.Equal(t, []string{"btcusdt@aggTrade", "btcusdt@ticker"}, msg.Params)
+		require.NotZero(t, msg.ID)
+	})
+}
@@ -24,6 +24,10 @@ const (
 	EventSubscribe Event = "subscribe"
 	// EventSubscribed indicates that a subscription was successful.
 	EventSubscribed Event = "subscribed"
+	// EventUnsubscribe indicates an unsubscribe action.
+	EventUnsubscribe Event = "unsubscribe"
+	// EventUnsubscribed indicates that an unsubscription was successful.
+	EventUnsubscribed Event = "unsubscribed"
 	// EventError indicates that an error occurred.
 	EventError Event = "error"
 	// ChannelTicker is the channel name for the ticker channel.
@@ -120,6 +124,31 @@ func NewSubscribeMessage(symbol string) (handlers.WebsocketEncodedMessage, error
 	})
 }
 
+// UnsubscribeMessage is a message used to make an unsubscription request for a channel.
+//
+// Ex:
+//
+//	{
+//	 event: "unsubscribe",
+//	 chanId: CHANNEL_ID
+//	}
+//
+// ref: https://docs.bitfinex.com/docs/ws-general#unsubscribe-from-channels
+type UnsubscribeMessage struct {
+	BaseMessage
+	ChannelID int `json:"chanId" validate:"required"`
+}
+
+// NewUnsubscribeMessage creates a new unsubscribe message given the channel ID.
+func NewUnsubscribeMessage(channelID int) (handlers.WebsocketEncodedMessage, error) {
+	return json.Marshal(UnsubscribeMessage{
+		BaseMessage: BaseMessage{
+			Event: string(EventUnsubscribe),
+		},
+		ChannelID: channelID,
+	})
+}
+
 // SubscribedMessage is message indicating the status of a subscription request.
 //
 // Ex:
@@ -23,6 +23,10 @@ var DefaultWebSocketConfig = config.WebSocketConfig{
 	Enabled:                       true,
 	MaxBufferSize:                 1000,
 	ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+	MaxReconnectionTimeout:        config.DefaultMaxReconnectionTimeout,
+	ReconnectionBackoffMultiplier: config.DefaultReconnectionBackoffMultiplier,
+	ReconnectionJitter:            config.DefaultReconnectionJitter,
+	ReconnectionResetPeriod:       config.DefaultReconnectionResetPeriod,
 	PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
 	Endpoints:                     []config.Endpoint{{URL: URLProd}},
 	ReadBufferSize:                config.DefaultReadBufferSize,
@@ -8,6 +8,7 @@ import (
 
 	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/oracle/types"
+	wserrors "github.com/skip-mev/connect/v2/providers/base/websocket/errors"
 	"github.com/skip-mev/connect/v2/providers/base/websocket/handlers"
 )
 
@@ -97,6 +98,10 @@ func (h *WebSocketHandler) HandleMessage(
 
 		return resp, nil, nil
 
+	case EventUnsubscribed:
+		h.logger.Debug("received unsubscribed response message")
+		return resp, nil, nil
+
 	case EventError:
 		h.logger.Debug("received error message")
 
@@ -140,6 +145,40 @@ func (h *WebSocketHandler) CreateMessages(
 	return msgs, nil
 }
 
+// UnsubscribeMessages is used to create the unsubscription messages to send to the data provider
+// for the given tickers, without closing the connection. BitFinex unsubscribes by channel ID, so
+// ErrUnsubscribeNotSupported is returned if the subscription to a ticker has not been confirmed yet.
+func (h *WebSocketHandler) UnsubscribeMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	channelIDs := make(map[string]int, len(h.channelMap))
+	for channelID, ticker := range h.channelMap {
+		channelIDs[ticker.GetOffChainTicker()] = channelID
+	}
+
+	msgs := make([]handlers.WebsocketEncodedMessage, len(tickers))
+	for i, ticker := range tickers {
+		channelID, ok := channelIDs[ticker.GetOffChainTicker()]
+		if !ok {
+			return nil, fmt.Errorf("%w: no channel for ticker %s", wserrors.ErrUnsubscribeNotSupported, ticker.GetOffChainTicker())
+		}
+
+		msg, err := NewUnsubscribeMessage(channelID)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling unsubscription message: %w", err)
+		}
+
+		msgs[i] = msg
+	}
+
+	for _, ticker := range tickers {
+		delete(h.channelMap, channelIDs[ticker.GetOffChainTicker()])
+		h.cache.Remove(ticker)
+	}
+
+	return msgs, nil
+}
+
 // HeartBeatMessages is not used for BitFinex.
 func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
 	return nil, nil
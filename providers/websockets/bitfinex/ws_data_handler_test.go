@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/connect/v2/oracle/types"
+	wserrors "github.com/skip-mev/connect/v2/providers/base/websocket/errors"
 	"github.com/skip-mev/connect/v2/providers/base/websocket/handlers"
 	"github.com/skip-mev/connect/v2/providers/websockets/bitfinex"
 )
@@ -269,3 +270,40 @@ func TestCreateMessages(t *testing.T) {
 		})
 	}
 }
+
+func TestUnsubscribeMessages(t *testing.T) {
+	wsHandler, err := bitfinex.NewWebSocketDataHandler(logger, bitfinex.DefaultWebSocketConfig)
+	require.NoError(t, err)
+
+	_, err = wsHandler.CreateMessages([]types.ProviderTicker{btcusd, ethusd})
+	require.NoError(t, err)
+
+	// The subscription to ETHUSD is not confirmed, so there is no channel to unsubscribe from.
+	_, err = wsHandler.UnsubscribeMessages([]types.ProviderTicker{ethusd})
+	require.ErrorIs(t, err, wserrors.ErrUnsubscribeNotSupported)
+
+	subscribed, err := json.Marshal(bitfinex.SubscribedMessage{
+		BaseMessage: bitfinex.BaseMessage{Event: string(bitfinex.EventSubscribed)},
+		Channel:     string(bitfinex.ChannelTicker),
+		ChannelID:   channelBTC,
+		Pair:        "BTCUSD",
+	})
+	require.NoError(t, err)
+
+	_, _, err = wsHandler.HandleMessage(subscribed)
+	require.NoError(t, err)
+
+	msgs, err := wsHandler.UnsubscribeMessages([]types.ProviderTicker{btcusd})
+	require.NoError(t, err)
+
+	expected, err := json.Marshal(bitfinex.UnsubscribeMessage{
+		BaseMessage: bitfinex.BaseMessage{Event: string(bitfinex.EventUnsubscribe)},
+		ChannelID:   channelBTC,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []handlers.WebsocketEncodedMessage{expected}, msgs)
+
+	// Updates for the channel are no longer handled.
+	_, _, err = wsHandler.HandleMessage(rawStringToBz(`[111,[14957,68.17328796,14958,55.29588132,-659,-0.0422,14971,53723.08813995,16494,14454]]`))
+	require.Error(t, err)
+}
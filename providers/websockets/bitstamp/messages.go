@@ -41,6 +41,19 @@ const (
 	// ref: https://www.bitstamp.net/websocket/v2/
 	SubscriptionSucceededEvent EventType = "bts:subscription_succeeded"
 
+	// UnsubscriptionEvent is the unsubscription event. This event is sent to the
+	// server to unsubscribe from a channel.
+	//
+	// ref: https://www.bitstamp.net/websocket/v2/
+	UnsubscriptionEvent EventType = "bts:unsubscribe"
+
+	// UnsubscriptionSucceededEvent is the unsubscription succeeded event. This
+	// event is received after an unsubscription request is made to the ticker
+	// channel.
+	//
+	// ref: https://www.bitstamp.net/websocket/v2/
+	UnsubscriptionSucceededEvent EventType = "bts:unsubscription_succeeded"
+
 	// ReconnectEvent is the reconnect event. After you receive this request,
 	// you will have a few seconds to reconnect. Without doing so, you will
 	// automatically be disconnected. If you send reconnection request, you
@@ -93,13 +106,26 @@ type SubscriptionRequestMessageData struct {
 // NewSubscriptionRequestMessages returns a new subscription request message
 // for a given set of channels.
 func NewSubscriptionRequestMessages(channels []string) ([]handlers.WebsocketEncodedMessage, error) {
+	return newRequestMessages(SubscriptionEvent, channels)
+}
+
+// NewUnsubscriptionRequestMessages returns a new unsubscription request message,
+// which has the same format as the subscription request message, for a given set
+// of channels.
+func NewUnsubscriptionRequestMessages(channels []string) ([]handlers.WebsocketEncodedMessage, error) {
+	return newRequestMessages(UnsubscriptionEvent, channels)
+}
+
+// newRequestMessages returns a request message with the given event for each of
+// the given channels.
+func newRequestMessages(event EventType, channels []string) ([]handlers.WebsocketEncodedMessage, error) {
 	if len(channels) == 0 {
 		return nil, fmt.Errorf("no instruments provided")
 	}
 
 	msgs := make([]handlers.WebsocketEncodedMessage, len(channels))
 	for i, channel := range channels {
-		msg, err := NewSubscriptionRequestMessage(channel)
+		msg, err := newRequestMessage(event, channel)
 		if err != nil {
 			return nil, err
 		}
@@ -112,9 +138,14 @@ func NewSubscriptionRequestMessages(channels []string) ([]handlers.WebsocketEnco
 
 // NewSubscriptionRequestMessage returns a new subscription request message.
 func NewSubscriptionRequestMessage(channel string) (handlers.WebsocketEncodedMessage, error) {
+	return newRequestMessage(SubscriptionEvent, channel)
+}
+
+// newRequestMessage returns a new request message with the given event.
+func newRequestMessage(event EventType, channel string) (handlers.WebsocketEncodedMessage, error) {
 	bz, err := json.Marshal(SubscriptionRequestMessage{
 		BaseMessage: BaseMessage{
-			Event: string(SubscriptionEvent),
+			Event: string(event),
 		},
 		Data: SubscriptionRequestMessageData{
 			Channel: channel,
@@ -23,6 +23,10 @@ var DefaultWebSocketConfig = config.WebSocketConfig{
 	Name:                          Name,
 	MaxBufferSize:                 config.DefaultMaxBufferSize,
 	ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+	MaxReconnectionTimeout:        config.DefaultMaxReconnectionTimeout,
+	ReconnectionBackoffMultiplier: config.DefaultReconnectionBackoffMultiplier,
+	ReconnectionJitter:            config.DefaultReconnectionJitter,
+	ReconnectionResetPeriod:       config.DefaultReconnectionResetPeriod,
 	PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
 	Endpoints:                     []config.Endpoint{{URL: WSS}},
 	ReadBufferSize:                config.DefaultReadBufferSize,
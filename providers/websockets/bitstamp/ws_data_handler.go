@@ -91,6 +91,9 @@ func (h *WebSocketHandler) HandleMessage(
 
 		h.logger.Debug("successfully subscribed to channel", zap.String("channel", subscriptionMsg.Channel))
 		return resp, nil, nil
+	case UnsubscriptionSucceededEvent:
+		h.logger.Debug("received unsubscription succeeded event")
+		return resp, nil, nil
 	case TradeEvent:
 		h.logger.Debug("received ticker event")
 
@@ -122,6 +125,21 @@ func (h *WebSocketHandler) CreateMessages(
 	return NewSubscriptionRequestMessages(instruments)
 }
 
+// UnsubscribeMessages creates the messages to send to the Bitstamp websocket API to
+// unsubscribe from the live trades channel for the specified tickers.
+func (h *WebSocketHandler) UnsubscribeMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	instruments := make([]string, 0)
+
+	for _, ticker := range tickers {
+		instruments = append(instruments, fmt.Sprintf("%s%s", TickerChannel, ticker.GetOffChainTicker()))
+		h.cache.Remove(ticker)
+	}
+
+	return NewUnsubscriptionRequestMessages(instruments)
+}
+
 // HeartBeatMessages is used to create the heartbeat messages to send to the Bitstamp
 // websocket API.
 func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
@@ -23,8 +23,10 @@ type (
 const (
 	// OperationSubscribe is the operation to subscribe to a channel.
 	OperationSubscribe Operation = "subscribe"
-	OperationPing      Operation = "ping"
-	OperationPong      Operation = "pong"
+	// OperationUnsubscribe is the operation to unsubscribe from a channel.
+	OperationUnsubscribe Operation = "unsubscribe"
+	OperationPing        Operation = "ping"
+	OperationPong        Operation = "pong"
 
 	// TickerChannel is the channel for spot price updates.
 	TickerChannel Channel = "tickers"
@@ -56,6 +58,17 @@ type SubscriptionRequest struct {
 // NewSubscriptionRequestMessage creates subscription messages corresponding to the provided tickers.
 // If the number of tickers is greater than 10, the requests will be broken into 10-ticker messages.
 func (h *WebSocketHandler) NewSubscriptionRequestMessage(tickers []string) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(OperationSubscribe, tickers)
+}
+
+// NewUnsubscriptionRequestMessage creates unsubscription messages corresponding to the provided tickers,
+// broken into batches in the same way as subscription messages.
+func (h *WebSocketHandler) NewUnsubscriptionRequestMessage(tickers []string) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(OperationUnsubscribe, tickers)
+}
+
+// newRequestMessages creates request messages with the given operation for the provided tickers.
+func (h *WebSocketHandler) newRequestMessages(operation Operation, tickers []string) ([]handlers.WebsocketEncodedMessage, error) {
 	numTickers := len(tickers)
 	if numTickers == 0 {
 		return nil, fmt.Errorf("tickers cannot be empty")
@@ -72,7 +85,7 @@ func (h *WebSocketHandler) NewSubscriptionRequestMessage(tickers []string) ([]ha
 		bz, err := json.Marshal(
 			SubscriptionRequest{
 				BaseRequest: BaseRequest{
-					Op: string(OperationSubscribe),
+					Op: string(operation),
 				},
 				Args: tickers[start:end],
 			},
@@ -88,6 +88,10 @@ func (h *WebSocketHandler) HandleMessage(
 		}
 
 		return resp, updateMessage, nil
+	case OperationUnsubscribe:
+		h.logger.Debug("received unsubscribe response message", zap.Bool("success", baseResponse.Success))
+
+		return resp, nil, nil
 	case OperationPing:
 		h.logger.Debug("received pong response message")
 
@@ -125,6 +129,21 @@ func (h *WebSocketHandler) CreateMessages(
 	return h.NewSubscriptionRequestMessage(pairs)
 }
 
+// UnsubscribeMessages is used to create the messages to send to the data provider to unsubscribe
+// from the given tickers, without closing the connection.
+func (h *WebSocketHandler) UnsubscribeMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	pairs := make([]string, 0)
+
+	for _, ticker := range tickers {
+		pairs = append(pairs, string(TickerChannel)+"."+ticker.GetOffChainTicker())
+		h.cache.Remove(ticker)
+	}
+
+	return h.NewUnsubscriptionRequestMessage(pairs)
+}
+
 // HeartBeatMessages is used to construct heartbeat messages to be sent to the data provider. Note that
 // the handler must maintain the necessary state information to construct the heartbeat messages. This
 // can be done on the fly as messages as handled by the handler.
@@ -28,6 +28,12 @@ const (
 	// ref: https://docs.cloud.coinbase.com/exchange/docs/websocket-overview#subscribe
 	SubscribeMessage MessageType = "subscribe"
 
+	// UnsubscribeMessage represents an unsubscribe message. This is used to unsubscribe from
+	// channels without closing the connection.
+	//
+	// ref: https://docs.cloud.coinbase.com/exchange/docs/websocket-overview#unsubscribe
+	UnsubscribeMessage MessageType = "unsubscribe"
+
 	// SubscriptionsMessage represents a subscriptions message. This is sent by the
 	// websocket feed after a subscribe message is sent.
 	//
@@ -122,6 +128,17 @@ type SubscribeRequestMessage struct {
 
 // NewSubscribeRequestMessage returns a new subscribe request message.
 func (h *WebSocketHandler) NewSubscribeRequestMessage(instruments []string) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(SubscribeMessage, instruments)
+}
+
+// NewUnsubscribeRequestMessage returns a new unsubscribe request message.
+func (h *WebSocketHandler) NewUnsubscribeRequestMessage(instruments []string) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(UnsubscribeMessage, instruments)
+}
+
+// newRequestMessages returns a set of request messages of the given type for the ticker and heartbeat
+// channels of the given instruments.
+func (h *WebSocketHandler) newRequestMessages(messageType MessageType, instruments []string) ([]handlers.WebsocketEncodedMessage, error) {
 	numInstruments := len(instruments)
 	if numInstruments == 0 {
 		return nil, fmt.Errorf("no instruments provided")
@@ -135,12 +152,12 @@ func (h *WebSocketHandler) NewSubscribeRequestMessage(instruments []string) ([]h
 		end := slinkymath.Min((i+1)*h.ws.MaxSubscriptionsPerBatch, numInstruments)
 
 		bz, err := json.Marshal(SubscribeRequestMessage{
-			Type:       string(SubscribeMessage),
+			Type:       string(messageType),
 			ProductIDs: instruments[start:end],
 			Channels:   []string{string(TickerChannel), string(HeartbeatChannel)},
 		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal subscribe request message %w", err)
+			return nil, fmt.Errorf("failed to marshal %s request message %w", messageType, err)
 		}
 		msgs[i] = bz
 	}
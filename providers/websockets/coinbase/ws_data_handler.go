@@ -133,6 +133,23 @@ func (h *WebSocketHandler) CreateMessages(
 	return h.NewSubscribeRequestMessage(instruments)
 }
 
+// UnsubscribeMessages is used to create the messages to send to the data provider to unsubscribe
+// from the given tickers, without closing the connection.
+func (h *WebSocketHandler) UnsubscribeMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	instruments := make([]string, 0)
+
+	for _, ticker := range tickers {
+		instruments = append(instruments, ticker.GetOffChainTicker())
+		h.cache.Remove(ticker)
+		delete(h.sequence, ticker)
+		delete(h.tradeIDs, ticker)
+	}
+
+	return h.NewUnsubscribeRequestMessage(instruments)
+}
+
 // HeartBeatMessages is not used for Coinbase.
 func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
 	return nil, nil
@@ -22,6 +22,10 @@ const (
 	// be called when the initial connection is established.
 	InstrumentMethod Method = "subscribe"
 
+	// UnsubscribeMethod is the method used to unsubscribe from an instrument. This is
+	// called when the instrument is no longer needed but the connection is kept open.
+	UnsubscribeMethod Method = "unsubscribe"
+
 	// HeartBeatRequestMethod is the method used to send a heartbeat message to the
 	// Crypto.com websocket API. The heartbeat message is sent to the Crypto.com websocket
 	// API every 30 seconds.
@@ -97,6 +101,18 @@ type InstrumentParams struct {
 // NewInstrumentMessage returns a new InstrumentRequestMessage that can be sent to
 // the Crypto.com websocket API.
 func (h *WebSocketHandler) NewInstrumentMessage(instruments []string) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(InstrumentMethod, instruments)
+}
+
+// NewUnsubscribeMessage returns a new unsubscribe message, which has the same format as
+// the InstrumentRequestMessage, that can be sent to the Crypto.com websocket API.
+func (h *WebSocketHandler) NewUnsubscribeMessage(instruments []string) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(UnsubscribeMethod, instruments)
+}
+
+// newRequestMessages returns a set of request messages with the given method for the given
+// instruments, batched by the maximum number of subscriptions per batch.
+func (h *WebSocketHandler) newRequestMessages(method Method, instruments []string) ([]handlers.WebsocketEncodedMessage, error) {
 	numInstruments := len(instruments)
 	if numInstruments == 0 {
 		return nil, fmt.Errorf("no instruments specified")
@@ -110,7 +126,7 @@ func (h *WebSocketHandler) NewInstrumentMessage(instruments []string) ([]handler
 		end := slinkymath.Min((i+1)*h.ws.MaxSubscriptionsPerBatch, numInstruments)
 
 		bz, err := json.Marshal(InstrumentRequestMessage{
-			Method: string(InstrumentMethod),
+			Method: string(method),
 			Params: InstrumentParams{
 				Channels: instruments[start:end],
 			},
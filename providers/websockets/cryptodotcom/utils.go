@@ -40,6 +40,10 @@ var DefaultWebSocketConfig = config.WebSocketConfig{
 	Enabled:                       true,
 	MaxBufferSize:                 config.DefaultMaxBufferSize,
 	ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+	MaxReconnectionTimeout:        config.DefaultMaxReconnectionTimeout,
+	ReconnectionBackoffMultiplier: config.DefaultReconnectionBackoffMultiplier,
+	ReconnectionJitter:            config.DefaultReconnectionJitter,
+	ReconnectionResetPeriod:       config.DefaultReconnectionResetPeriod,
 	PostConnectionTimeout:         DefaultPostConnectionTimeout,
 	Endpoints:                     []config.Endpoint{{URL: URL_PROD}},
 	ReadBufferSize:                config.DefaultReadBufferSize,
@@ -93,6 +93,9 @@ func (h *WebSocketHandler) HandleMessage(
 		}
 
 		return subscribeResp, nil, nil
+	case UnsubscribeMethod:
+		h.logger.Debug("received unsubscribe message")
+		return resp, nil, nil
 	default:
 		return resp, nil, fmt.Errorf("unknown method %s", msg.Method)
 	}
@@ -114,6 +117,21 @@ func (h *WebSocketHandler) CreateMessages(
 	return h.NewInstrumentMessage(instruments)
 }
 
+// UnsubscribeMessages is used to create the messages to send to the data provider to
+// unsubscribe from the given tickers, without closing the connection.
+func (h *WebSocketHandler) UnsubscribeMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	instruments := make([]string, 0)
+
+	for _, ticker := range tickers {
+		instruments = append(instruments, fmt.Sprintf(TickerChannel, ticker.GetOffChainTicker()))
+		h.cache.Remove(ticker)
+	}
+
+	return h.NewUnsubscribeMessage(instruments)
+}
+
 // HeartBeatMessages is not used for Crypto.com.
 func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
 	return nil, nil
@@ -29,6 +29,9 @@ const (
 	// EventSubscribe is the event for subscribing to a topic.
 	EventSubscribe Event = "subscribe"
 
+	// EventUnsubscribe is the event for unsubscribing from a topic.
+	EventUnsubscribe Event = "unsubscribe"
+
 	// EventUpdate is the event indicating an update.
 	EventUpdate Event = "update"
 
@@ -102,6 +105,16 @@ type SubscribeRequest struct {
 
 // NewSubscribeRequest returns a new SubscribeRequest encoded message for the given symbols.
 func (h *WebSocketHandler) NewSubscribeRequest(symbols []string) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequests(EventSubscribe, symbols)
+}
+
+// NewUnsubscribeRequest returns a new unsubscribe request encoded message for the given symbols.
+func (h *WebSocketHandler) NewUnsubscribeRequest(symbols []string) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequests(EventUnsubscribe, symbols)
+}
+
+// newRequests returns new request encoded messages with the given event for the given symbols.
+func (h *WebSocketHandler) newRequests(event Event, symbols []string) ([]handlers.WebsocketEncodedMessage, error) {
 	numSymbols := len(symbols)
 	if numSymbols == 0 {
 		return nil, fmt.Errorf("cannot attach payload of 0 length")
@@ -118,7 +131,7 @@ func (h *WebSocketHandler) NewSubscribeRequest(symbols []string) ([]handlers.Web
 			BaseMessage: BaseMessage{
 				Time:    time.Now().UTC().Second(),
 				Channel: string(ChannelTickers),
-				Event:   string(EventSubscribe),
+				Event:   string(event),
 			},
 			ID:      time.Now().UTC().Second(),
 			Payload: symbols[start:end],
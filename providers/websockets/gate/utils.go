@@ -19,6 +19,10 @@ var DefaultWebSocketConfig = config.WebSocketConfig{
 	Enabled:                       true,
 	MaxBufferSize:                 1000,
 	ReconnectionTimeout:           10 * time.Second,
+	MaxReconnectionTimeout:        config.DefaultMaxReconnectionTimeout,
+	ReconnectionBackoffMultiplier: config.DefaultReconnectionBackoffMultiplier,
+	ReconnectionJitter:            config.DefaultReconnectionJitter,
+	ReconnectionResetPeriod:       config.DefaultReconnectionResetPeriod,
 	PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
 	Endpoints:                     []config.Endpoint{{URL: URL}},
 	ReadBufferSize:                config.DefaultReadBufferSize,
@@ -81,6 +81,10 @@ func (h *WebSocketHandler) HandleMessage(
 		updateMsg, err := h.parseSubscribeResponse(subResponse)
 		return resp, updateMsg, err
 
+	case EventUnsubscribe:
+		h.logger.Debug("received unsubscribe response message")
+		return resp, nil, nil
+
 	case EventUpdate:
 		if err := json.Unmarshal(message, &tickerStream); err != nil {
 			return resp, nil, err
@@ -111,6 +115,21 @@ func (h *WebSocketHandler) CreateMessages(
 	return h.NewSubscribeRequest(instruments)
 }
 
+// UnsubscribeMessages is used to create the messages to send to the data provider to unsubscribe
+// from the given tickers, without closing the connection.
+func (h *WebSocketHandler) UnsubscribeMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	instruments := make([]string, 0)
+
+	for _, ticker := range tickers {
+		instruments = append(instruments, ticker.GetOffChainTicker())
+		h.cache.Remove(ticker)
+	}
+
+	return h.NewUnsubscribeRequest(instruments)
+}
+
 // HeartBeatMessages is not used for Gate.io.
 func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
 	return nil, nil
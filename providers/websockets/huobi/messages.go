@@ -68,11 +68,27 @@ func NewSubscriptionRequest(symbol string) (handlers.WebsocketEncodedMessage, er
 	})
 }
 
-// SubscriptionResponse is the response message sent from the server after a subscription request.
+// UnsubscriptionRequest is the request message sent to the server to unsubscribe from a topic.
+type UnsubscriptionRequest struct {
+	Unsub string `json:"unsub"`
+	ID    string `json:"id"`
+}
+
+// NewUnsubscriptionRequest creates a new encoded UnsubscriptionRequest from the given symbol.
+func NewUnsubscriptionRequest(symbol string) (handlers.WebsocketEncodedMessage, error) {
+	return json.Marshal(UnsubscriptionRequest{
+		Unsub: subFromSymbol(symbol),
+		ID:    symbol,
+	})
+}
+
+// SubscriptionResponse is the response message sent from the server after a subscription or
+// unsubscription request.
 type SubscriptionResponse struct {
-	ID     string `json:"id"`
-	Status string `json:"status"`
-	Subbed string `json:"subbed"`
+	ID       string `json:"id"`
+	Status   string `json:"status"`
+	Subbed   string `json:"subbed"`
+	Unsubbed string `json:"unsubbed"`
 }
 
 // TickerStream is the stream for a given ticker sent every 100ms by the Huobi API.
@@ -14,6 +14,12 @@ import (
 // parseSubscriptionResponse attempts to parse a subscription message. It returns an error if the message
 // cannot be properly parsed.
 func (h *WebSocketHandler) parseSubscriptionResponse(resp SubscriptionResponse) ([]handlers.WebsocketEncodedMessage, error) {
+	// Unsubscription responses are not retried, as the ticker is no longer needed.
+	if resp.Unsubbed != "" {
+		h.logger.Debug("unsubscribed", zap.String("ticker", resp.Unsubbed), zap.String("status", resp.Status))
+		return nil, nil
+	}
+
 	if Status(resp.Status) != StatusOk {
 		msg, err := NewSubscriptionRequest(symbolFromSub(resp.Subbed))
 		return []handlers.WebsocketEncodedMessage{msg}, err
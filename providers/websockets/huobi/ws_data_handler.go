@@ -147,6 +147,29 @@ func (h *WebSocketHandler) CreateMessages(
 	return msgs, nil
 }
 
+// UnsubscribeMessages is used to create the unsubscription messages to send to the data provider
+// for the given tickers, without closing the connection.
+func (h *WebSocketHandler) UnsubscribeMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	if len(tickers) == 0 {
+		return nil, fmt.Errorf("no tickers to unsubscribe from")
+	}
+
+	msgs := make([]handlers.WebsocketEncodedMessage, len(tickers))
+	for i, ticker := range tickers {
+		msg, err := NewUnsubscriptionRequest(ticker.GetOffChainTicker())
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling unsubscription message: %w", err)
+		}
+
+		msgs[i] = msg
+		h.cache.Remove(ticker)
+	}
+
+	return msgs, nil
+}
+
 // HeartBeatMessages is not used for Huobi.
 func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
 	return nil, nil
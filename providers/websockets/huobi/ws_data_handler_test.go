@@ -70,6 +70,31 @@ func TestHandleMessage(t *testing.T) {
 			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
 			expErr:        true,
 		},
+		{
+			name: "unsubscription response",
+			msg: func() []byte {
+				msg := huobi.SubscriptionResponse{
+					ID:       "btcusdt",
+					Status:   "ok",
+					Unsubbed: "market.btcusdt.ticker",
+				}
+
+				bz, err := json.Marshal(msg)
+				require.NoError(t, err)
+
+				var buf bytes.Buffer
+				zw := gzip.NewWriter(&buf)
+
+				_, err = zw.Write(bz)
+				require.NoError(t, err)
+				require.NoError(t, zw.Close())
+
+				return buf.Bytes()
+			},
+			resp:          types.NewPriceResponse(nil, nil),
+			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
+			expErr:        false,
+		},
 		{
 			name: "ticker price update",
 			msg: func() []byte {
@@ -350,3 +375,22 @@ func TestCreateMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestUnsubscribeMessages(t *testing.T) {
+	wsHandler, err := huobi.NewWebSocketDataHandler(logger, huobi.DefaultWebSocketConfig)
+	require.NoError(t, err)
+
+	_, err = wsHandler.UnsubscribeMessages([]types.ProviderTicker{})
+	require.Error(t, err)
+
+	_, err = wsHandler.CreateMessages([]types.ProviderTicker{btcusdt, ethusdt})
+	require.NoError(t, err)
+
+	msgs, err := wsHandler.UnsubscribeMessages([]types.ProviderTicker{btcusdt})
+	require.NoError(t, err)
+
+	expected, err := huobi.NewUnsubscriptionRequest("btcusdt")
+	require.NoError(t, err)
+	require.Equal(t, []handlers.WebsocketEncodedMessage{expected}, msgs)
+	require.JSONEq(t, `{"unsub":"market.btcusdt.ticker","id":"btcusdt"}`, string(msgs[0]))
+}
@@ -46,6 +46,12 @@ const (
 	//
 	// https://docs.kraken.com/websockets/#message-subscribe
 	SubscribeEvent Event = "subscribe"
+
+	// UnsubscribeEvent is the event name that is used to send an unsubscribe
+	// message to the server.
+	//
+	// https://docs.kraken.com/websockets/#message-unsubscribe
+	UnsubscribeEvent Event = "unsubscribe"
 )
 
 const (
@@ -63,6 +69,10 @@ const (
 	// has received the subscription request.
 	SubscribedStatus Status = "subscribed"
 
+	// UnsubscribedStatus is the status that is sent to the client when the
+	// server has received the unsubscription request.
+	UnsubscribedStatus Status = "unsubscribed"
+
 	// ErrorStatus is the status that is sent to the client when the server has
 	// received the subscription request.
 	ErrorStatus Status = "error"
@@ -126,7 +136,7 @@ type HeartbeatResponseMessage struct {
 }
 
 // SubscribeRequestMessage is the message that is sent to the server to subscribe
-// to a channel.
+// to (or unsubscribe from) a channel.
 //
 //	{
 //			"event": "subscribe",
@@ -162,6 +172,23 @@ type Subscription struct {
 // given asset pairs.
 func (h *WebSocketHandler) NewSubscribeRequestMessage(
 	instruments []string,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(SubscribeEvent, instruments)
+}
+
+// NewUnsubscribeRequestMessage returns a new unsubscribe request message with
+// the given asset pairs.
+func (h *WebSocketHandler) NewUnsubscribeRequestMessage(
+	instruments []string,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(UnsubscribeEvent, instruments)
+}
+
+// newRequestMessages returns a set of request messages with the given event for
+// the ticker channel of the given asset pairs.
+func (h *WebSocketHandler) newRequestMessages(
+	event Event,
+	instruments []string,
 ) ([]handlers.WebsocketEncodedMessage, error) {
 	numInstruments := len(instruments)
 	if numInstruments == 0 {
@@ -177,7 +204,7 @@ func (h *WebSocketHandler) NewSubscribeRequestMessage(
 
 		bz, err := json.Marshal(
 			SubscribeRequestMessage{
-				Event: string(SubscribeEvent),
+				Event: string(event),
 				Pair:  instruments[start:end],
 				Subscription: Subscription{
 					Name: string(TickerChannel),
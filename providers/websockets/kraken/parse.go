@@ -58,7 +58,16 @@ func (h *WebSocketHandler) parseBaseMessage(message []byte, event Event) ([]hand
 		case SubscribedStatus:
 			h.logger.Debug("received successful subscription status response message", zap.String("ticker", resp.Pair))
 			return nil, nil
+		case UnsubscribedStatus:
+			h.logger.Debug("received successful unsubscription status response message", zap.String("ticker", resp.Pair))
+			return nil, nil
 		case ErrorStatus:
+			// Markets that were unsubscribed from are not resubscribed to.
+			if _, ok := h.cache.FromOffChainTicker(resp.Pair); !ok {
+				h.logger.Debug("received error status response message for unsubscribed ticker", zap.String("ticker", resp.Pair))
+				return nil, nil
+			}
+
 			h.logger.Debug(
 				"could not successfully subscribe to ticker; attempting to resubscribe",
 				zap.String("ticker", resp.Pair),
@@ -49,6 +49,12 @@ const (
 	// ref: https://www.kucoin.com/docs/websocket/basic-info/subscribe/introduction
 	SubscribeMessage MessageType = "subscribe"
 
+	// UnsubscribeMessage represents the unsubscribe message that is sent to the
+	// websocket server to unsubscribe from a channel.
+	//
+	// ref: https://www.kucoin.com/docs/websocket/basic-info/unsubscribe
+	UnsubscribeMessage MessageType = "unsubscribe"
+
 	// AckMessage represents the response message received from the websocket server
 	// after sending a subscribe message.
 	//
@@ -146,6 +152,23 @@ type SubscribeRequestMessage struct {
 // NewSubscribeRequestMessage returns a new SubscribeRequestMessage.
 func (h *WebSocketHandler) NewSubscribeRequestMessage(
 	instruments []string,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(SubscribeMessage, instruments)
+}
+
+// NewUnsubscribeRequestMessage returns a new unsubscribe request message, which has the
+// same format as the SubscribeRequestMessage.
+func (h *WebSocketHandler) NewUnsubscribeRequestMessage(
+	instruments []string,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(UnsubscribeMessage, instruments)
+}
+
+// newRequestMessages returns a set of request messages of the given type for the
+// ticker topic of the given instruments.
+func (h *WebSocketHandler) newRequestMessages(
+	messageType MessageType,
+	instruments []string,
 ) ([]handlers.WebsocketEncodedMessage, error) {
 	numInstruments := len(instruments)
 	if numInstruments == 0 {
@@ -163,13 +186,13 @@ func (h *WebSocketHandler) NewSubscribeRequestMessage(
 		topic := fmt.Sprintf("%s%s", TickerTopic, strings.Join(instruments[start:end], ","))
 		bz, err := json.Marshal(SubscribeRequestMessage{
 			ID:             time.Now().UTC().UnixNano(),
-			Type:           string(SubscribeMessage),
+			Type:           string(messageType),
 			Topic:          topic,
 			PrivateChannel: false,
 			Response:       false,
 		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal subscribe request message: %w", err)
+			return nil, fmt.Errorf("failed to marshal %s request message: %w", messageType, err)
 		}
 		msgs[i] = bz
 	}
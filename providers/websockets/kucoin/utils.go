@@ -52,6 +52,10 @@ var (
 		Enabled:                       true,
 		MaxBufferSize:                 config.DefaultMaxBufferSize,
 		ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+		MaxReconnectionTimeout:        config.DefaultMaxReconnectionTimeout,
+		ReconnectionBackoffMultiplier: config.DefaultReconnectionBackoffMultiplier,
+		ReconnectionJitter:            config.DefaultReconnectionJitter,
+		ReconnectionResetPeriod:       config.DefaultReconnectionResetPeriod,
 		PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
 		Endpoints:                     []config.Endpoint{{URL: WSS}},
 		Name:                          Name,
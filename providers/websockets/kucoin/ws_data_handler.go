@@ -122,6 +122,21 @@ func (h *WebSocketHandler) CreateMessages(
 	return h.NewSubscribeRequestMessage(instruments)
 }
 
+// UnsubscribeMessages is used to create the messages to send to the KuCoin websocket API to
+// unsubscribe from the given tickers, without closing the connection.
+func (h *WebSocketHandler) UnsubscribeMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	instruments := make([]string, 0)
+
+	for _, ticker := range tickers {
+		instruments = append(instruments, ticker.GetOffChainTicker())
+		h.cache.Remove(ticker)
+	}
+
+	return h.NewUnsubscribeRequestMessage(instruments)
+}
+
 // HeartBeatMessages is used to create the set of heartbeat messages to send to the KuCoin
 // websocket API. Per the KuCoin websocket documentation, the interval between heartbeats
 // should be around 10 seconds, however, this is dynamic. As such, the websocket connection
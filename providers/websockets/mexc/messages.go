@@ -24,6 +24,12 @@ const (
 	// ref: https://mexcdevelop.github.io/apidocs/spot_v3_en/#live-subscribing-unsubscribing-to-streams
 	SubscriptionMethod MethodType = "SUBSCRIPTION"
 
+	// UnsubscriptionMethod is the method that is sent to the MEXC websocket to unsubscribe from a
+	// currency pair i.e. market.
+	//
+	// ref: https://mexcdevelop.github.io/apidocs/spot_v3_en/#live-subscribing-unsubscribing-to-streams
+	UnsubscriptionMethod MethodType = "UNSUBSCRIPTION"
+
 	// PingMethod is the method that is sent to the MEXC websocket to ping the server. This should
 	// be done every 30 seconds.
 	//
@@ -86,9 +92,24 @@ type SubscriptionRequestMessage struct {
 
 // NewSubscribeRequestMessage returns a new SubscriptionRequestMessage.
 func (h *WebSocketHandler) NewSubscribeRequestMessage(instruments []string) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(SubscriptionMethod, instruments)
+}
+
+// NewUnsubscribeRequestMessage returns a new unsubscribe request message, which has the same
+// format as the SubscriptionRequestMessage.
+func (h *WebSocketHandler) NewUnsubscribeRequestMessage(instruments []string) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(UnsubscriptionMethod, instruments)
+}
+
+// newRequestMessages returns a set of request messages with the given method for the given
+// instruments, batched by the maximum number of subscriptions per batch.
+func (h *WebSocketHandler) newRequestMessages(
+	method MethodType,
+	instruments []string,
+) ([]handlers.WebsocketEncodedMessage, error) {
 	numInstruments := len(instruments)
 	if numInstruments == 0 {
-		return nil, fmt.Errorf("cannot subscribe to 0 instruments")
+		return nil, fmt.Errorf("cannot send %s request for 0 instruments", method)
 	}
 
 	numBatches := int(math.Ceil(float64(numInstruments) / float64(h.ws.MaxSubscriptionsPerBatch)))
@@ -99,7 +120,7 @@ func (h *WebSocketHandler) NewSubscribeRequestMessage(instruments []string) ([]h
 		end := slinkymath.Min((i+1)*h.ws.MaxSubscriptionsPerBatch, numInstruments)
 
 		bz, err := json.Marshal(SubscriptionRequestMessage{
-			Method: string(SubscriptionMethod),
+			Method: string(method),
 			Params: instruments[start:end],
 		})
 		if err != nil {
@@ -113,6 +113,21 @@ func (h *WebSocketHandler) CreateMessages(
 	return h.NewSubscribeRequestMessage(instruments)
 }
 
+// UnsubscribeMessages is used to create the messages to send to the data provider to
+// unsubscribe from the given tickers, without closing the connection.
+func (h *WebSocketHandler) UnsubscribeMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	instruments := make([]string, 0)
+	for _, ticker := range tickers {
+		mexcTicker := fmt.Sprintf("%s%s%s", string(MiniTickerChannel), strings.ToUpper(ticker.GetOffChainTicker()), "@UTC+8")
+		instruments = append(instruments, mexcTicker)
+		h.cache.Remove(ticker)
+	}
+
+	return h.NewUnsubscribeRequestMessage(instruments)
+}
+
 // HeartBeatMessages is used by the MEXC handler to send heart beat messages to the data provider.
 // This is used to keep the connection alive when no messages are being sent from the data provider.
 func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
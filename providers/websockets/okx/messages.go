@@ -26,6 +26,8 @@ type (
 const (
 	// OperationSubscribe is the operation to subscribe to a channel.
 	OperationSubscribe Operation = "subscribe"
+	// OperationUnsubscribe is the operation to unsubscribe from a channel.
+	OperationUnsubscribe Operation = "unsubscribe"
 )
 
 const (
@@ -38,6 +40,8 @@ const (
 const (
 	// EventSubscribe is the event denoting that we have successfully subscribed to a channel.
 	EventSubscribe EventType = "subscribe"
+	// EventUnsubscribe is the event denoting that we have successfully unsubscribed from a channel.
+	EventUnsubscribe EventType = "unsubscribe"
 	// EventTickers is the event for tickers. By default, this field will not be populated
 	// in a properly formatted message. So we set the default value to an empty string.
 	EventTickers EventType = ""
@@ -97,6 +101,23 @@ type SubscriptionTopic struct {
 // to the tickers channel.
 func (h *WebSocketHandler) NewSubscribeToTickersRequestMessage(
 	instruments []SubscriptionTopic,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(OperationSubscribe, instruments)
+}
+
+// NewUnsubscribeFromTickersRequestMessage returns a new request message for unsubscribing
+// from the tickers channel.
+func (h *WebSocketHandler) NewUnsubscribeFromTickersRequestMessage(
+	instruments []SubscriptionTopic,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newRequestMessages(OperationUnsubscribe, instruments)
+}
+
+// newRequestMessages returns a set of request messages with the given operation for the
+// given subscription topics.
+func (h *WebSocketHandler) newRequestMessages(
+	operation Operation,
+	instruments []SubscriptionTopic,
 ) ([]handlers.WebsocketEncodedMessage, error) {
 	numInstruments := len(instruments)
 	if numInstruments == 0 {
@@ -112,7 +133,7 @@ func (h *WebSocketHandler) NewSubscribeToTickersRequestMessage(
 
 		bz, err := json.Marshal(
 			SubscribeRequestMessage{
-				Operation: string(OperationSubscribe),
+				Operation: string(operation),
 				Arguments: instruments[start:end],
 			},
 		)
@@ -28,8 +28,9 @@ const (
 // parseSubscribeResponseMessage parses a subscribe response message. The format of the message
 // is defined in the messages.go file. There are two cases that are handled:
 //
-// 1. Successfully subscribed to the channel. In this case, no further action is required.
-// 2. Error message. In this case, we attempt to re-subscribe to the channel.
+// 1. Successfully subscribed to (or unsubscribed from) the channel. In this case, no further
+// action is required.
+// 2. Error message. In this case, we attempt to re-send the request.
 func (h *WebSocketHandler) parseSubscribeResponseMessage(resp SubscribeResponseMessage) ([]handlers.WebsocketEncodedMessage, error) {
 	// A response with an event type of subscribe means that we have successfully subscribed to the channel.
 	if t := EventType(resp.Event); t == EventSubscribe {
@@ -37,6 +38,11 @@ func (h *WebSocketHandler) parseSubscribeResponseMessage(resp SubscribeResponseM
 		return nil, nil
 	}
 
+	if t := EventType(resp.Event); t == EventUnsubscribe {
+		h.logger.Debug("successfully unsubscribed from channel", zap.String("instrument", resp.Arguments.InstrumentID))
+		return nil, nil
+	}
+
 	// Attempt to re-subscribe to the channel.
 	// Format of the message is:
 	//  ...
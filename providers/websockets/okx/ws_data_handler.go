@@ -76,7 +76,7 @@ func (h *WebSocketHandler) HandleMessage(
 
 	eventType := EventType(baseMessage.Event)
 	switch {
-	case eventType == EventSubscribe || eventType == EventError:
+	case eventType == EventSubscribe || eventType == EventUnsubscribe || eventType == EventError:
 		h.logger.Debug("received subscribe response message")
 
 		var subscribeMessage SubscribeResponseMessage
@@ -127,6 +127,23 @@ func (h *WebSocketHandler) CreateMessages(
 	return h.NewSubscribeToTickersRequestMessage(instruments)
 }
 
+// UnsubscribeMessages is used to create the messages to send to the data provider to unsubscribe
+// from the given tickers, without closing the connection.
+func (h *WebSocketHandler) UnsubscribeMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	instruments := make([]SubscriptionTopic, 0)
+	for _, ticker := range tickers {
+		instruments = append(instruments, SubscriptionTopic{
+			Channel:      string(TickersChannel),
+			InstrumentID: ticker.GetOffChainTicker(),
+		})
+		h.cache.Remove(ticker)
+	}
+
+	return h.NewUnsubscribeFromTickersRequestMessage(instruments)
+}
+
 // HeartBeatMessages is not used for okx.
 func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
 	return nil, nil
@@ -1,7 +1,10 @@
 package oracle
 
 import (
+	"math/big"
+
 	"github.com/skip-mev/connect/v2/oracle/types"
+	servertypes "github.com/skip-mev/connect/v2/service/servers/oracle/types"
 )
 
 func ToReqPrices(prices types.Prices) map[string]string {
@@ -14,3 +17,27 @@ func ToReqPrices(prices types.Prices) map[string]string {
 
 	return reqPrices
 }
+
+func ToReqDispersions(dispersions types.PriceDispersions) map[string]servertypes.PriceDispersion {
+	reqDispersions := make(map[string]servertypes.PriceDispersion, len(dispersions))
+
+	for cp, dispersion := range dispersions {
+		reqDispersions[cp] = servertypes.PriceDispersion{
+			StdDev:       toIntString(dispersion.StdDev),
+			Min:          toIntString(dispersion.Min),
+			Max:          toIntString(dispersion.Max),
+			NumProviders: dispersion.NumProviders,
+		}
+	}
+
+	return reqDispersions
+}
+
+func toIntString(f *big.Float) string {
+	if f == nil {
+		return "0"
+	}
+
+	i, _ := f.Int(nil)
+	return i.String()
+}
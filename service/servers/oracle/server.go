@@ -169,13 +169,17 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 		// get the prices
 		prices := os.o.GetPrices()
 
+		// get the dispersion of each price
+		dispersions := os.o.GetDispersions()
+
 		// get the latest timestamp of the latest update from the oracle
 		timestamp := os.o.GetLastSyncTime()
 
 		resCh <- &types.QueryPricesResponse{
-			Prices:    ToReqPrices(prices),
-			Timestamp: timestamp,
-			Version:   build.Build,
+			Prices:      ToReqPrices(prices),
+			Timestamp:   timestamp,
+			Version:     build.Build,
+			Dispersions: ToReqDispersions(dispersions),
 		}
 	}()
 
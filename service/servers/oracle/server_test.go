@@ -142,6 +142,14 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 		cp1.String(): big.NewFloat(100.1),
 		cp2.String(): big.NewFloat(200.1),
 	})
+	s.mockOracle.On("GetDispersions").Return(types.PriceDispersions{
+		cp1.String(): {
+			StdDev:       big.NewFloat(1.5),
+			Min:          big.NewFloat(98.2),
+			Max:          big.NewFloat(101.9),
+			NumProviders: 3,
+		},
+	})
 	ts := time.Now()
 	s.mockOracle.On("GetLastSyncTime").Return(ts)
 
@@ -152,6 +160,16 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 	// check response
 	s.Require().Equal(resp.Prices[cp1.String()], big.NewInt(100).String())
 	s.Require().Equal(resp.Prices[cp2.String()], big.NewInt(200).String())
+
+	// check dispersions
+	s.Require().Len(resp.Dispersions, 1)
+	s.Require().Equal(stypes.PriceDispersion{
+		StdDev:       "1",
+		Min:          "98",
+		Max:          "101",
+		NumProviders: 3,
+	}, resp.Dispersions[cp1.String()])
+
 	// check timestamp
 
 	s.Require().Equal(resp.Timestamp, ts.UTC())
@@ -165,6 +183,7 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 	respBz, err := io.ReadAll(httpResp.Body)
 	s.Require().NoError(err)
 	s.Require().Contains(string(respBz), fmt.Sprintf(`{"prices":{"%s":"100","%s":"200"},"timestamp":`, cp1.String(), cp2.String()))
+	s.Require().Contains(string(respBz), fmt.Sprintf(`"dispersions":{"%s":{"std_dev":"1","min":"98","max":"101","num_providers":"3"}}`, cp1.String()))
 }
 
 func (s *ServerTestSuite) TestOracleMarketMap() {
@@ -80,6 +80,10 @@ type QueryPricesResponse struct {
 	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
 	// Version defines the version of the oracle service that provided the prices.
 	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Dispersions defines the dispersion of the provider prices that contributed
+	// to each price, keyed by the same tickers as the prices. Consumers can use
+	// these to determine how confident the oracle is in each price.
+	Dispersions map[string]PriceDispersion `protobuf:"bytes,4,rep,name=dispersions,proto3" json:"dispersions" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *QueryPricesResponse) Reset()         { *m = QueryPricesResponse{} }
@@ -136,6 +140,89 @@ func (m *QueryPricesResponse) GetVersion() string {
 	return ""
 }
 
+func (m *QueryPricesResponse) GetDispersions() map[string]PriceDispersion {
+	if m != nil {
+		return m.Dispersions
+	}
+	return nil
+}
+
+// PriceDispersion defines the dispersion of the provider prices that
+// contributed to an aggregated price. The standard deviation, minimum and
+// maximum are scaled by the ticker's decimals, the same as the price.
+type PriceDispersion struct {
+	// StdDev defines the standard deviation of the contributing prices.
+	StdDev string `protobuf:"bytes,1,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	// Min defines the lowest contributing price.
+	Min string `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	// Max defines the highest contributing price.
+	Max string `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	// NumProviders defines the number of providers that contributed to the
+	// price.
+	NumProviders uint64 `protobuf:"varint,4,opt,name=num_providers,json=numProviders,proto3" json:"num_providers,omitempty"`
+}
+
+func (m *PriceDispersion) Reset()         { *m = PriceDispersion{} }
+func (m *PriceDispersion) String() string { return proto.CompactTextString(m) }
+func (*PriceDispersion) ProtoMessage()    {}
+func (*PriceDispersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{2}
+}
+func (m *PriceDispersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceDispersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceDispersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceDispersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceDispersion.Merge(m, src)
+}
+func (m *PriceDispersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceDispersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceDispersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceDispersion proto.InternalMessageInfo
+
+func (m *PriceDispersion) GetStdDev() string {
+	if m != nil {
+		return m.StdDev
+	}
+	return ""
+}
+
+func (m *PriceDispersion) GetMin() string {
+	if m != nil {
+		return m.Min
+	}
+	return ""
+}
+
+func (m *PriceDispersion) GetMax() string {
+	if m != nil {
+		return m.Max
+	}
+	return ""
+}
+
+func (m *PriceDispersion) GetNumProviders() uint64 {
+	if m != nil {
+		return m.NumProviders
+	}
+	return 0
+}
+
 // QueryMarketMapRequest defines the request type for the MarketMap method.
 type QueryMarketMapRequest struct {
 }
@@ -144,7 +231,7 @@ func (m *QueryMarketMapRequest) Reset()         { *m = QueryMarketMapRequest{} }
 func (m *QueryMarketMapRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketMapRequest) ProtoMessage()    {}
 func (*QueryMarketMapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e88883d464f0f25b, []int{2}
+	return fileDescriptor_e88883d464f0f25b, []int{3}
 }
 func (m *QueryMarketMapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,7 +270,7 @@ func (m *QueryMarketMapResponse) Reset()         { *m = QueryMarketMapResponse{}
 func (m *QueryMarketMapResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketMapResponse) ProtoMessage()    {}
 func (*QueryMarketMapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e88883d464f0f25b, []int{3}
+	return fileDescriptor_e88883d464f0f25b, []int{4}
 }
 func (m *QueryMarketMapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,7 +314,7 @@ func (m *QueryVersionRequest) Reset()         { *m = QueryVersionRequest{} }
 func (m *QueryVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionRequest) ProtoMessage()    {}
 func (*QueryVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e88883d464f0f25b, []int{4}
+	return fileDescriptor_e88883d464f0f25b, []int{5}
 }
 func (m *QueryVersionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +353,7 @@ func (m *QueryVersionResponse) Reset()         { *m = QueryVersionResponse{} }
 func (m *QueryVersionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionResponse) ProtoMessage()    {}
 func (*QueryVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e88883d464f0f25b, []int{5}
+	return fileDescriptor_e88883d464f0f25b, []int{6}
 }
 func (m *QueryVersionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +392,9 @@ func (m *QueryVersionResponse) GetVersion() string {
 func init() {
 	proto.RegisterType((*QueryPricesRequest)(nil), "slinky.service.v1.QueryPricesRequest")
 	proto.RegisterType((*QueryPricesResponse)(nil), "slinky.service.v1.QueryPricesResponse")
+	proto.RegisterMapType((map[string]PriceDispersion)(nil), "slinky.service.v1.QueryPricesResponse.DispersionsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "slinky.service.v1.QueryPricesResponse.PricesEntry")
+	proto.RegisterType((*PriceDispersion)(nil), "slinky.service.v1.PriceDispersion")
 	proto.RegisterType((*QueryMarketMapRequest)(nil), "slinky.service.v1.QueryMarketMapRequest")
 	proto.RegisterType((*QueryMarketMapResponse)(nil), "slinky.service.v1.QueryMarketMapResponse")
 	proto.RegisterType((*QueryVersionRequest)(nil), "slinky.service.v1.QueryVersionRequest")
@@ -315,44 +404,50 @@ func init() {
 func init() { proto.RegisterFile("slinky/service/v1/oracle.proto", fileDescriptor_e88883d464f0f25b) }
 
 var fileDescriptor_e88883d464f0f25b = []byte{
-	// 582 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xae, 0x5b, 0xe8, 0xa8, 0x7b, 0x01, 0xd3, 0x41, 0x9a, 0x8d, 0xb4, 0xaa, 0x04, 0x94, 0x03,
-	0x31, 0x0b, 0x07, 0x18, 0xa8, 0x42, 0x2a, 0xe2, 0x38, 0x31, 0x2a, 0x7e, 0x48, 0xbd, 0x4c, 0x6e,
-	0x64, 0x4a, 0xd4, 0x26, 0x36, 0xb1, 0x1b, 0xa9, 0x37, 0xc4, 0x5f, 0x30, 0x09, 0x89, 0x0b, 0x67,
-	0xfe, 0x97, 0x1d, 0x27, 0x71, 0xe1, 0x04, 0xa8, 0x45, 0xe2, 0xdf, 0x40, 0xb1, 0x9d, 0x74, 0x5d,
-	0x3b, 0x6d, 0xa7, 0xfa, 0xf9, 0x7b, 0x7e, 0xef, 0x7b, 0xdf, 0xf7, 0x1a, 0xe8, 0x88, 0x71, 0x10,
-	0x8d, 0xa6, 0x58, 0xd0, 0x38, 0x09, 0x7c, 0x8a, 0x93, 0x1d, 0xcc, 0x62, 0xe2, 0x8f, 0xa9, 0xcb,
-	0x63, 0x26, 0x19, 0xba, 0xa6, 0x71, 0xd7, 0xe0, 0x6e, 0xb2, 0x63, 0xd7, 0x86, 0x6c, 0xc8, 0x14,
-	0x8a, 0xd3, 0x93, 0x4e, 0xb4, 0xb7, 0x87, 0x8c, 0x0d, 0xc7, 0x14, 0x13, 0x1e, 0x60, 0x12, 0x45,
-	0x4c, 0x12, 0x19, 0xb0, 0x48, 0x18, 0xb4, 0x61, 0x50, 0x15, 0x0d, 0x26, 0xef, 0xb1, 0x0c, 0x42,
-	0x2a, 0x24, 0x09, 0xb9, 0x49, 0xa8, 0xfb, 0x4c, 0x84, 0x4c, 0x1c, 0xe8, 0xba, 0x3a, 0x30, 0x50,
-	0xd3, 0x50, 0x0c, 0x49, 0x3c, 0xa2, 0x32, 0x24, 0x3c, 0x25, 0xa9, 0x03, 0x9d, 0xd1, 0xaa, 0x41,
-	0xf4, 0x6a, 0x42, 0xe3, 0xe9, 0x7e, 0x1c, 0xf8, 0x54, 0xf4, 0xe8, 0xc7, 0x09, 0x15, 0xb2, 0xf5,
-	0xa9, 0x08, 0xaf, 0x2f, 0x5d, 0x0b, 0xce, 0x22, 0x41, 0xd1, 0x3e, 0x2c, 0x73, 0x75, 0x63, 0x81,
-	0x66, 0xa9, 0x5d, 0xf5, 0x3c, 0x77, 0x65, 0x46, 0x77, 0xcd, 0x3b, 0x57, 0x87, 0x2f, 0x22, 0x19,
-	0x4f, 0xbb, 0x97, 0x8e, 0x7e, 0x35, 0x0a, 0x3d, 0x53, 0x07, 0x75, 0x61, 0x25, 0x9f, 0xc7, 0x2a,
-	0x36, 0x41, 0xbb, 0xea, 0xd9, 0xae, 0x9e, 0xd8, 0xcd, 0x26, 0x76, 0x5f, 0x67, 0x19, 0xdd, 0x2b,
-	0xe9, 0xe3, 0xc3, 0xdf, 0x0d, 0xd0, 0x5b, 0x3c, 0x43, 0x16, 0xdc, 0x48, 0x68, 0x2c, 0x02, 0x16,
-	0x59, 0xa5, 0x26, 0x68, 0x57, 0x7a, 0x59, 0x68, 0xef, 0xc2, 0xea, 0x89, 0xd6, 0xe8, 0x2a, 0x2c,
-	0x8d, 0xe8, 0xd4, 0x02, 0x2a, 0x29, 0x3d, 0xa2, 0x1a, 0xbc, 0x9c, 0x90, 0xf1, 0x84, 0xaa, 0xd6,
-	0x95, 0x9e, 0x0e, 0x9e, 0x14, 0x1f, 0x83, 0xd6, 0x4d, 0xb8, 0xa9, 0x26, 0xd9, 0x53, 0x6a, 0xed,
-	0x11, 0x9e, 0x69, 0xf3, 0x0e, 0xde, 0x38, 0x0d, 0x18, 0x75, 0x3a, 0x10, 0x6a, 0x6d, 0x0f, 0x42,
-	0xc2, 0x55, 0x97, 0xaa, 0xe7, 0x64, 0x0a, 0xe5, 0x16, 0xa4, 0x1a, 0x2d, 0xde, 0x56, 0xc2, 0xec,
-	0xd8, 0xda, 0x34, 0x9a, 0xbf, 0xd5, 0xe4, 0xb3, 0x7e, 0x0f, 0x60, 0x6d, 0xf9, 0xda, 0x74, 0x3b,
-	0x31, 0x35, 0x58, 0x9a, 0xda, 0xfb, 0x57, 0x82, 0xe5, 0x97, 0x6a, 0x13, 0xd1, 0x57, 0x00, 0xcb,
-	0x5a, 0x01, 0x74, 0xfb, 0x3c, 0xaf, 0x54, 0x3b, 0xfb, 0xce, 0xc5, 0x2c, 0x6d, 0x75, 0x3e, 0xff,
-	0xf8, 0xfb, 0xa5, 0xf8, 0xa8, 0x6f, 0x23, 0x0b, 0x9b, 0x2d, 0xd3, 0xdb, 0x9f, 0xae, 0x98, 0x31,
-	0xb7, 0x8e, 0x7d, 0x16, 0x45, 0xd4, 0x97, 0xab, 0xd0, 0x77, 0x00, 0x2b, 0xb9, 0x0a, 0xa8, 0x7d,
-	0x56, 0xd3, 0xd3, 0xea, 0xdb, 0xf7, 0x2e, 0x90, 0x69, 0x18, 0x3e, 0x57, 0x0c, 0x3b, 0xfd, 0x5b,
-	0x68, 0x6b, 0x95, 0x61, 0x6e, 0x07, 0xda, 0x5e, 0x43, 0x72, 0x81, 0x7e, 0x03, 0x70, 0xc3, 0x28,
-	0x8f, 0xce, 0x94, 0x66, 0xd9, 0x31, 0xfb, 0xee, 0xb9, 0x79, 0x86, 0xe1, 0x33, 0xc5, 0x70, 0xb7,
-	0xbf, 0x85, 0xea, 0xab, 0x0c, 0x8d, 0x9b, 0xc8, 0x5e, 0xc3, 0xcf, 0x60, 0xdd, 0x37, 0x47, 0x33,
-	0x07, 0x1c, 0xcf, 0x1c, 0xf0, 0x67, 0xe6, 0x80, 0xc3, 0xb9, 0x53, 0x38, 0x9e, 0x3b, 0x85, 0x9f,
-	0x73, 0xa7, 0xd0, 0x7f, 0x3a, 0x0c, 0xe4, 0x87, 0xc9, 0xc0, 0xf5, 0x59, 0x88, 0xc5, 0x28, 0xe0,
-	0xf7, 0x43, 0x9a, 0xe4, 0x85, 0x12, 0x2f, 0xff, 0x68, 0xa5, 0xbf, 0x34, 0x16, 0x59, 0x6d, 0x39,
-	0xe5, 0x54, 0x0c, 0xca, 0xea, 0x9f, 0xf7, 0xf0, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1f, 0xbe,
-	0x99, 0x8d, 0xe2, 0x04, 0x00, 0x00,
+	// 687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xcd, 0x34, 0x25, 0x25, 0x13, 0x10, 0x65, 0x48, 0xa9, 0xeb, 0x16, 0x37, 0x32, 0x02, 0xc2,
+	0x02, 0x9b, 0x9a, 0x45, 0x5b, 0x50, 0x85, 0x14, 0xca, 0xb2, 0xa2, 0x44, 0x3c, 0xa4, 0x6c, 0x22,
+	0xc7, 0x19, 0x82, 0x95, 0xd8, 0x63, 0x3c, 0x63, 0x8b, 0x6c, 0xf9, 0x82, 0x4a, 0x48, 0x6c, 0x58,
+	0xf3, 0x29, 0x48, 0x5d, 0x56, 0x62, 0xc3, 0x0a, 0x50, 0x8b, 0xc4, 0x6f, 0xa0, 0x79, 0xd8, 0x79,
+	0x34, 0x55, 0xbb, 0xf2, 0xdc, 0x7b, 0xee, 0xe3, 0xdc, 0x3b, 0x67, 0x0c, 0x0d, 0x3a, 0xf0, 0xc3,
+	0xfe, 0xd0, 0xa6, 0x38, 0x4e, 0x7d, 0x0f, 0xdb, 0xe9, 0x86, 0x4d, 0x62, 0xd7, 0x1b, 0x60, 0x2b,
+	0x8a, 0x09, 0x23, 0xe8, 0xba, 0xc4, 0x2d, 0x85, 0x5b, 0xe9, 0x86, 0x5e, 0xed, 0x91, 0x1e, 0x11,
+	0xa8, 0xcd, 0x4f, 0x32, 0x50, 0x5f, 0xeb, 0x11, 0xd2, 0x1b, 0x60, 0xdb, 0x8d, 0x7c, 0xdb, 0x0d,
+	0x43, 0xc2, 0x5c, 0xe6, 0x93, 0x90, 0x2a, 0x74, 0x5d, 0xa1, 0xc2, 0xea, 0x24, 0xef, 0x6c, 0xe6,
+	0x07, 0x98, 0x32, 0x37, 0x88, 0x54, 0xc0, 0x8a, 0x47, 0x68, 0x40, 0x68, 0x5b, 0xd6, 0x95, 0x86,
+	0x82, 0x6a, 0x8a, 0x62, 0xe0, 0xc6, 0x7d, 0xcc, 0x02, 0x37, 0xe2, 0x24, 0xa5, 0x21, 0x23, 0xcc,
+	0x2a, 0x44, 0x2f, 0x13, 0x1c, 0x0f, 0xf7, 0x63, 0xdf, 0xc3, 0xb4, 0x89, 0x3f, 0x24, 0x98, 0x32,
+	0xf3, 0x7b, 0x11, 0xde, 0x98, 0x70, 0xd3, 0x88, 0x84, 0x14, 0xa3, 0x7d, 0x58, 0x8a, 0x84, 0x47,
+	0x03, 0xb5, 0x62, 0xbd, 0xe2, 0x38, 0xd6, 0xa9, 0x19, 0xad, 0x19, 0x79, 0x96, 0x34, 0x9f, 0x87,
+	0x2c, 0x1e, 0x36, 0xe6, 0x0f, 0x7f, 0xad, 0x17, 0x9a, 0xaa, 0x0e, 0x6a, 0xc0, 0x72, 0x3e, 0x8f,
+	0x36, 0x57, 0x03, 0xf5, 0x8a, 0xa3, 0x5b, 0x72, 0x62, 0x2b, 0x9b, 0xd8, 0x7a, 0x95, 0x45, 0x34,
+	0x2e, 0xf3, 0xe4, 0x83, 0xdf, 0xeb, 0xa0, 0x39, 0x4a, 0x43, 0x1a, 0x5c, 0x48, 0x71, 0x4c, 0x7d,
+	0x12, 0x6a, 0xc5, 0x1a, 0xa8, 0x97, 0x9b, 0x99, 0x89, 0xda, 0xb0, 0xd2, 0xf5, 0x69, 0x24, 0x2d,
+	0xaa, 0xcd, 0x0b, 0xd2, 0x9b, 0x17, 0x24, 0xbd, 0x3b, 0xca, 0x1c, 0x67, 0x3e, 0x5e, 0x51, 0xdf,
+	0x86, 0x95, 0xb1, 0xd9, 0xd0, 0x22, 0x2c, 0xf6, 0xf1, 0x50, 0x03, 0x82, 0x05, 0x3f, 0xa2, 0x2a,
+	0xbc, 0x94, 0xba, 0x83, 0x04, 0x8b, 0xd9, 0xca, 0x4d, 0x69, 0x3c, 0x9e, 0xdb, 0x02, 0x7a, 0x07,
+	0x2e, 0x4e, 0x77, 0x98, 0x91, 0xbf, 0x35, 0x9e, 0x5f, 0x71, 0xcc, 0x19, 0xdc, 0x05, 0x81, 0x51,
+	0xa9, 0xb1, 0x1e, 0x26, 0x85, 0xd7, 0xa6, 0x50, 0xb4, 0x0c, 0x17, 0x28, 0xeb, 0xb6, 0xbb, 0x38,
+	0x55, 0x6d, 0x4a, 0x94, 0x75, 0x77, 0x71, 0xca, 0x7b, 0x07, 0x7e, 0xa8, 0x78, 0xf2, 0xa3, 0xf0,
+	0xb8, 0x1f, 0xd5, 0x4e, 0xf9, 0x11, 0xdd, 0x86, 0x57, 0xc3, 0x24, 0xe0, 0x4a, 0x4b, 0xfd, 0x2e,
+	0x8e, 0xf9, 0x46, 0x41, 0x7d, 0xbe, 0x79, 0x25, 0x4c, 0x82, 0xfd, 0xcc, 0x67, 0x2e, 0xc3, 0x25,
+	0xb1, 0xce, 0x3d, 0xa1, 0xb3, 0x3d, 0x37, 0xca, 0x54, 0xf5, 0x16, 0xde, 0x9c, 0x06, 0x94, 0xae,
+	0x76, 0x20, 0x94, 0xaa, 0x6c, 0x07, 0x6e, 0x24, 0x78, 0x55, 0x1c, 0x23, 0x1b, 0x35, 0x17, 0x2f,
+	0x1f, 0x76, 0x94, 0x5b, 0x0e, 0xb2, 0xa3, 0xb9, 0xa4, 0xd4, 0xfa, 0x46, 0x6d, 0x40, 0xf5, 0x7b,
+	0x08, 0xab, 0x93, 0x6e, 0xd5, 0x6d, 0x4c, 0x2f, 0x60, 0x42, 0x2f, 0xce, 0xbf, 0x22, 0x2c, 0xbd,
+	0x10, 0x6f, 0x18, 0x7d, 0x01, 0xb0, 0x24, 0xaf, 0x16, 0xdd, 0x39, 0x4f, 0x30, 0xa2, 0x9d, 0x7e,
+	0xf7, 0x62, 0xba, 0x32, 0x77, 0x3e, 0xfd, 0xf8, 0xfb, 0x79, 0x6e, 0x13, 0xad, 0xd8, 0x1e, 0x09,
+	0x43, 0xec, 0x31, 0xf5, 0xdb, 0xe0, 0x6f, 0x53, 0xbe, 0x8a, 0x96, 0x8e, 0x34, 0x5b, 0x3d, 0xdd,
+	0x69, 0x0c, 0x7d, 0x03, 0xb0, 0x9c, 0x6f, 0x01, 0xd5, 0xcf, 0x6a, 0x3a, 0xbd, 0x7d, 0xfd, 0xfe,
+	0x05, 0x22, 0x15, 0xc3, 0x67, 0x82, 0xe1, 0x0e, 0x5a, 0x9b, 0xc1, 0x30, 0xbf, 0x8d, 0xd6, 0x2d,
+	0xb4, 0x7a, 0x9a, 0x64, 0x0e, 0xa3, 0xaf, 0x00, 0x2e, 0xa8, 0xcd, 0xa3, 0x33, 0x57, 0x33, 0x79,
+	0x63, 0xfa, 0xbd, 0x73, 0xe3, 0x14, 0xc3, 0xa7, 0x82, 0xe1, 0x36, 0xd2, 0x67, 0x30, 0x54, 0x97,
+	0xd9, 0x5a, 0x45, 0x2b, 0xa7, 0xf9, 0x29, 0xb0, 0xf1, 0xfa, 0xf0, 0xd8, 0x00, 0x47, 0xc7, 0x06,
+	0xf8, 0x73, 0x6c, 0x80, 0x83, 0x13, 0xa3, 0x70, 0x74, 0x62, 0x14, 0x7e, 0x9e, 0x18, 0x85, 0xd6,
+	0x93, 0x9e, 0xcf, 0xde, 0x27, 0x1d, 0xcb, 0x23, 0x81, 0x4d, 0xfb, 0x7e, 0xf4, 0x20, 0xc0, 0x69,
+	0xde, 0x25, 0x75, 0xf2, 0xdf, 0x3d, 0xff, 0xe2, 0x98, 0x66, 0xb5, 0xd9, 0x30, 0xc2, 0xb4, 0x53,
+	0x12, 0xff, 0xac, 0x47, 0xff, 0x07, 0x00, 0x2a, 0xee, 0x36, 0x55, 0x1c, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -560,6 +655,30 @@ func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Dispersions) > 0 {
+		for k := range m.Dispersions {
+			v := m.Dispersions[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Version) > 0 {
 		i -= len(m.Version)
 		copy(dAtA[i:], m.Version)
@@ -567,12 +686,12 @@ func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Prices) > 0 {
@@ -597,6 +716,55 @@ func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceDispersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceDispersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceDispersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumProviders != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.NumProviders))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StdDev) > 0 {
+		i -= len(m.StdDev)
+		copy(dAtA[i:], m.StdDev)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.StdDev)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryMarketMapRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -748,6 +916,39 @@ func (m *QueryPricesResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	if len(m.Dispersions) > 0 {
+		for k, v := range m.Dispersions {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + l + sovOracle(uint64(l))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *PriceDispersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StdDev)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.NumProviders != 0 {
+		n += 1 + sovOracle(uint64(m.NumProviders))
+	}
 	return n
 }
 
@@ -1072,6 +1273,300 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dispersions == nil {
+				m.Dispersions = make(map[string]PriceDispersion)
+			}
+			var mapkey string
+			mapvalue := &PriceDispersion{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PriceDispersion{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Dispersions[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceDispersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceDispersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceDispersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdDev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StdDev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Min = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumProviders", wireType)
+			}
+			m.NumProviders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumProviders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
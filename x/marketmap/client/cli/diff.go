@@ -0,0 +1,178 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// marketUpdateKind is the kind of update that is performed on the markets of a market map file.
+type marketUpdateKind int
+
+const (
+	// marketUpdateCreate creates markets that must not exist yet.
+	marketUpdateCreate marketUpdateKind = iota
+	// marketUpdateUpdate updates markets that must already exist.
+	marketUpdateUpdate
+	// marketUpdateUpsert creates markets that do not exist yet, and updates the ones that do.
+	marketUpdateUpsert
+)
+
+// sortedMarkets returns the markets of a market map, sorted by their ticker so that the resulting
+// messages are deterministic.
+func sortedMarkets(markets map[string]types.Market) []types.Market {
+	tickers := make([]string, 0, len(markets))
+	for ticker := range markets {
+		tickers = append(tickers, ticker)
+	}
+	sort.Strings(tickers)
+
+	sorted := make([]types.Market, 0, len(tickers))
+	for _, ticker := range tickers {
+		sorted = append(sorted, markets[ticker])
+	}
+
+	return sorted
+}
+
+// diffMarkets returns a human-readable diff of the given market updates against the current markets. Markets
+// that are created are prefixed with +, markets that are updated with ~, followed by the fields that change.
+// Markets that are unchanged are omitted.
+func diffMarkets(current map[string]types.Market, updates []types.Market) []string {
+	var diff []string
+	for _, market := range updates {
+		var marketDiff types.MarketDiff
+		if existing, ok := current[market.Ticker.String()]; ok {
+			marketDiff = types.NewMarketDiff(&existing, market)
+		} else {
+			marketDiff = types.NewMarketDiff(nil, market)
+		}
+
+		diff = append(diff, formatMarketDiff(marketDiff, market)...)
+	}
+
+	return diff
+}
+
+// formatMarketDiff returns the human-readable diff lines of the given market diff.
+func formatMarketDiff(marketDiff types.MarketDiff, market types.Market) []string {
+	var diff []string
+	switch marketDiff.DiffType {
+	case types.MarketDiffType_MARKET_DIFF_TYPE_CREATED:
+		diff = append(diff, fmt.Sprintf("+ %s", marketDiff.Ticker))
+		diff = append(diff, diffField("ticker", nil, market.Ticker)...)
+	case types.MarketDiffType_MARKET_DIFF_TYPE_UPDATED:
+		diff = append(diff, fmt.Sprintf("~ %s", marketDiff.Ticker))
+	default:
+		return nil
+	}
+
+	for _, field := range marketDiff.UpdatedFields {
+		diff = append(diff, diffField(field.Field, field.OldValue, field.NewValue)...)
+	}
+
+	switch marketDiff.EnabledChange {
+	case types.EnabledChange_ENABLED_CHANGE_ENABLED:
+		if marketDiff.DiffType == types.MarketDiffType_MARKET_DIFF_TYPE_UPDATED {
+			diff = append(diff, diffField("enabled", false, true)...)
+		}
+	case types.EnabledChange_ENABLED_CHANGE_DISABLED:
+		diff = append(diff, diffField("enabled", true, false)...)
+	}
+
+	for _, providerConfig := range marketDiff.RemovedProviderConfigs {
+		diff = append(diff, diffField(providerConfigKey(providerConfig), providerConfig, nil)...)
+	}
+
+	for _, providerConfig := range marketDiff.AddedProviderConfigs {
+		diff = append(diff, diffField(providerConfigKey(providerConfig), nil, providerConfig)...)
+	}
+
+	return diff
+}
+
+// diffParams returns the diff of the given params against the current params.
+func diffParams(current, updated types.Params) []string {
+	var diff []string
+	if current.Admin != updated.Admin {
+		diff = append(diff, diffField("admin", current.Admin, updated.Admin)...)
+	}
+
+	currentAuthorities := make(map[string]struct{}, len(current.MarketAuthorities))
+	for _, authority := range current.MarketAuthorities {
+		currentAuthorities[authority] = struct{}{}
+	}
+
+	updatedAuthorities := make(map[string]struct{}, len(updated.MarketAuthorities))
+	for _, authority := range updated.MarketAuthorities {
+		updatedAuthorities[authority] = struct{}{}
+		if _, ok := currentAuthorities[authority]; !ok {
+			diff = append(diff, diffField("market_authority", nil, authority)...)
+		}
+	}
+
+	for _, authority := range current.MarketAuthorities {
+		if _, ok := updatedAuthorities[authority]; !ok {
+			diff = append(diff, diffField("market_authority", authority, nil)...)
+		}
+	}
+
+	currentRoles := make(map[string]types.MarketAuthorityRole, len(current.MarketAuthorityRoles))
+	for _, role := range current.MarketAuthorityRoles {
+		currentRoles[role.Address] = role
+	}
+
+	updatedRoles := make(map[string]struct{}, len(updated.MarketAuthorityRoles))
+	for _, role := range updated.MarketAuthorityRoles {
+		updatedRoles[role.Address] = struct{}{}
+
+		name := fmt.Sprintf("market_authority_role %s", role.Address)
+		currentRole, ok := currentRoles[role.Address]
+		switch {
+		case !ok:
+			diff = append(diff, diffField(name, nil, role)...)
+		case toJSON(currentRole) != toJSON(role):
+			diff = append(diff, diffField(name, currentRole, role)...)
+		}
+	}
+
+	for _, role := range current.MarketAuthorityRoles {
+		if _, ok := updatedRoles[role.Address]; !ok {
+			diff = append(diff, diffField(fmt.Sprintf("market_authority_role %s", role.Address), role, nil)...)
+		}
+	}
+
+	return diff
+}
+
+// diffField returns the diff lines of a single field. A nil old value means the field is added, a nil new
+// value means it is removed.
+func diffField(name string, old, updated any) []string {
+	var diff []string
+	if old != nil {
+		diff = append(diff, fmt.Sprintf("    - %s: %s", name, toJSON(old)))
+	}
+	if updated != nil {
+		diff = append(diff, fmt.Sprintf("    + %s: %s", name, toJSON(updated)))
+	}
+
+	return diff
+}
+
+// providerConfigKey returns the key that identifies a provider config within a market.
+func providerConfigKey(providerConfig types.ProviderConfig) string {
+	return fmt.Sprintf("provider %s (%s)", providerConfig.Name, providerConfig.OffChainTicker)
+}
+
+// toJSON returns the compact JSON representation of the given value, falling back to its default
+// formatting if it cannot be marshalled.
+func toJSON(v any) string {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(bz)
+}
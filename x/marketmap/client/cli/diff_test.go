@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestDiffMarkets(t *testing.T) {
+	btcusd := types.Market{
+		Ticker: types.NewTicker("BTC", "USD", 8, 1, true),
+		ProviderConfigs: []types.ProviderConfig{
+			{Name: "binance", OffChainTicker: "BTCUSD"},
+		},
+	}
+
+	ethusd := types.Market{
+		Ticker: types.NewTicker("ETH", "USD", 8, 1, true),
+		ProviderConfigs: []types.ProviderConfig{
+			{Name: "binance", OffChainTicker: "ETHUSD"},
+		},
+	}
+
+	current := map[string]types.Market{
+		btcusd.Ticker.String(): btcusd,
+	}
+
+	t.Run("unchanged markets are omitted", func(t *testing.T) {
+		require.Empty(t, diffMarkets(current, []types.Market{btcusd}))
+	})
+
+	t.Run("created markets are added", func(t *testing.T) {
+		diff := diffMarkets(current, []types.Market{ethusd})
+		require.Len(t, diff, 3)
+		require.Equal(t, "+ ETH/USD", diff[0])
+		require.Contains(t, diff[2], "+ provider binance (ETHUSD)")
+	})
+
+	t.Run("updated markets show the fields that change", func(t *testing.T) {
+		updated := btcusd
+		updated.Ticker.Decimals = 9
+		updated.ProviderConfigs = []types.ProviderConfig{
+			{Name: "coinbase", OffChainTicker: "BTC-USD"},
+		}
+		updated.Ticker.Enabled = false
+
+		diff := diffMarkets(current, []types.Market{updated})
+		require.Equal(t, []string{
+			"~ BTC/USD",
+			`    - decimals: "8"`,
+			`    + decimals: "9"`,
+			"    - enabled: true",
+			"    + enabled: false",
+			`    - provider binance (BTCUSD): {"name":"binance","off_chain_ticker":"BTCUSD","normalize_by_pairs":null}`,
+			`    + provider coinbase (BTC-USD): {"name":"coinbase","off_chain_ticker":"BTC-USD","normalize_by_pairs":null}`,
+		}, diff)
+	})
+}
+
+func TestDiffParams(t *testing.T) {
+	current := types.Params{
+		MarketAuthorities: []string{"a", "b"},
+		Admin:             "admin",
+	}
+
+	require.Empty(t, diffParams(current, current))
+
+	updated := types.Params{
+		MarketAuthorities: []string{"b", "c"},
+		Admin:             "new-admin",
+	}
+
+	require.Equal(t, []string{
+		`    - admin: "admin"`,
+		`    + admin: "new-admin"`,
+		`    + market_authority: "c"`,
+		`    - market_authority: "a"`,
+	}, diffParams(current, updated))
+
+	withRole := updated
+	withRole.MarketAuthorityRoles = []types.MarketAuthorityRole{
+		{Address: "c", AllowedQuotes: []string{"USD"}},
+	}
+
+	require.Equal(t, []string{
+		`    + market_authority_role c: {"address":"c","allowed_quotes":["USD"]}`,
+	}, diffParams(updated, withRole))
+
+	require.Equal(t, []string{
+		`    - market_authority_role c: {"address":"c","allowed_quotes":["USD"]}`,
+	}, diffParams(withRole, updated))
+
+	require.Equal(t, []string{"b"}, removeAddresses(current.MarketAuthorities, []string{"a"}))
+}
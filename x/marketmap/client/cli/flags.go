@@ -0,0 +1,16 @@
+package cli
+
+const (
+	// FlagAdmin is the flag used to set the admin in the update-params command.
+	FlagAdmin = "admin"
+	// FlagMarketAuthorities is the flag used to set the market authorities in the update-params command.
+	FlagMarketAuthorities = "market-authorities"
+	// FlagMarketAuthorityRoles is the flag used to set the market authority roles in the update-params command.
+	FlagMarketAuthorityRoles = "market-authority-roles"
+	// FlagStatus is the flag used to filter markets by their enabled status in the market-map query.
+	FlagStatus = "status"
+	// FlagProvider is the flag used to filter markets by provider in the market-map query.
+	FlagProvider = "provider"
+	// FlagQuote is the flag used to filter markets by quote asset in the market-map query.
+	FlagQuote = "quote"
+)
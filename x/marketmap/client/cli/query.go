@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/spf13/cobra"
 
 	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -24,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryMarketMap(),
 		CmdQueryLastUpdated(),
 		CmdQueryMarket(),
+		CmdQuerySimulateMarketMapUpdate(),
+		CmdQueryPendingMarketUpdates(),
 	)
 
 	return cmd
@@ -59,15 +64,22 @@ func CmdQueryMarketMap() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "market-map",
 		Short: "Query the current market map",
-		Args:  cobra.NoArgs,
+		Long: `Query the current market map. The full market map is returned, unless the markets are paginated
+or filtered by their enabled status, provider or quote asset.`,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			req, err := marketMapRequestFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.MarketMap(cmd.Context(), &types.MarketMapRequest{})
+			res, err := queryClient.MarketMap(cmd.Context(), req)
 			if err != nil {
 				return err
 			}
@@ -76,10 +88,62 @@ func CmdQueryMarketMap() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagStatus, "", "only return markets with the given status (enabled or disabled)")
+	cmd.Flags().String(FlagProvider, "", "only return markets with the given provider")
+	cmd.Flags().String(FlagQuote, "", "only return markets with the given quote asset")
+
+	flags.AddPaginationFlagsToCmd(cmd, "market-map")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
+// marketMapRequestFromFlags returns the MarketMap request for the filter and pagination flags of the command. The
+// request is only paginated if one of the pagination flags is set.
+func marketMapRequestFromFlags(cmd *cobra.Command) (*types.MarketMapRequest, error) {
+	req := &types.MarketMapRequest{}
+
+	status, err := cmd.Flags().GetString(FlagStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	switch status {
+	case "":
+	case "enabled":
+		req.Enabled = types.EnabledFilter_ENABLED_FILTER_ENABLED
+	case "disabled":
+		req.Enabled = types.EnabledFilter_ENABLED_FILTER_DISABLED
+	default:
+		return nil, fmt.Errorf("invalid status %q, expected enabled or disabled", status)
+	}
+
+	if req.Provider, err = cmd.Flags().GetString(FlagProvider); err != nil {
+		return nil, err
+	}
+
+	if req.Quote, err = cmd.Flags().GetString(FlagQuote); err != nil {
+		return nil, err
+	}
+
+	if req.Pagination, err = pageRequestFromFlags(cmd); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// pageRequestFromFlags returns the page request for the pagination flags of the command, or nil if none of the
+// pagination flags is set.
+func pageRequestFromFlags(cmd *cobra.Command) (*query.PageRequest, error) {
+	for _, flag := range []string{flags.FlagPage, flags.FlagPageKey, flags.FlagOffset, flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse} {
+		if cmd.Flags().Changed(flag) {
+			return client.ReadPageRequest(cmd.Flags())
+		}
+	}
+
+	return nil, nil
+}
+
 func CmdQueryMarket() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "market [base] [quote]",
@@ -133,3 +197,71 @@ func CmdQueryLastUpdated() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdQuerySimulateMarketMapUpdate returns the command for simulating the upsert of the markets of a market map file.
+func CmdQuerySimulateMarketMapUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-update [market-map-file]",
+		Short: "Query the changes and validation errors of upserting the markets of a market map file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			marketMap, err := types.ReadMarketMapFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulateMarketMapUpdate(cmd.Context(), &types.SimulateMarketMapUpdateRequest{
+				Markets: sortedMarkets(marketMap.Markets),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryPendingMarketUpdates returns the command for querying the market updates that are scheduled for a future
+// height.
+func CmdQueryPendingMarketUpdates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-updates",
+		Short: "Query the market updates that are scheduled for a future height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := pageRequestFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingMarketUpdates(cmd.Context(), &types.PendingMarketUpdatesRequest{
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "pending-updates")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,453 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// GetTxCmd returns the parent command for all x/marketmap cli transaction commands.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Transaction commands for the marketmap module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdCreateMarkets(),
+		CmdUpdateMarkets(),
+		CmdUpsertMarkets(),
+		CmdRemoveMarketAuthorities(),
+		CmdUpdateParams(),
+		CmdScheduleMarketUpdates(),
+		CmdCancelMarketUpdates(),
+	)
+
+	return cmd
+}
+
+// CmdCreateMarkets returns the command for creating the markets of a market map file. None of the markets
+// may exist on chain.
+func CmdCreateMarkets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-markets [market-map-file]",
+		Short: "Create the markets of a market map file",
+		Long: `Create the markets of a market map file, in the same JSON format as the market maps in
+cmd/constants/marketmaps. The market map is validated against the current market map on chain, and
+the resulting diff is printed before the transaction is signed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			markets, err := readMarketUpdates(cmd, clientCtx, args[0], marketUpdateCreate)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateMarkets{
+				Authority:     clientCtx.GetFromAddress().String(),
+				CreateMarkets: markets,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdUpdateMarkets returns the command for updating the markets of a market map file. All of the markets
+// must exist on chain.
+func CmdUpdateMarkets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-markets [market-map-file]",
+		Short: "Update the markets of a market map file",
+		Long: `Update the markets of a market map file, in the same JSON format as the market maps in
+cmd/constants/marketmaps. The market map is validated against the current market map on chain, and
+the resulting diff is printed before the transaction is signed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			markets, err := readMarketUpdates(cmd, clientCtx, args[0], marketUpdateUpdate)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateMarkets{
+				Authority:     clientCtx.GetFromAddress().String(),
+				UpdateMarkets: markets,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdUpsertMarkets returns the command for creating the markets of a market map file that do not exist
+// on chain, and updating the ones that do.
+func CmdUpsertMarkets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upsert-markets [market-map-file]",
+		Short: "Create or update the markets of a market map file",
+		Long: `Create or update the markets of a market map file, in the same JSON format as the market maps
+in cmd/constants/marketmaps. The market map is validated against the current market map on chain, and
+the resulting diff is printed before the transaction is signed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			markets, err := readMarketUpdates(cmd, clientCtx, args[0], marketUpdateUpsert)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpsertMarkets{
+				Authority: clientCtx.GetFromAddress().String(),
+				Markets:   markets,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRemoveMarketAuthorities returns the command for removing addresses from the market authorities. It
+// must be signed by the admin of the module.
+func CmdRemoveMarketAuthorities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-market-authorities [address] [address...]",
+		Short: "Remove addresses from the market authorities",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveMarketAuthorities{
+				RemoveAddresses: args,
+				Admin:           clientCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if !clientCtx.Offline {
+				params, err := queryParams(cmd, clientCtx)
+				if err != nil {
+					return err
+				}
+
+				updated := params
+				updated.MarketAuthorities = removeAddresses(params.MarketAuthorities, args)
+				printDiff(cmd, diffParams(params, updated))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdUpdateParams returns the command for updating the module's parameters. Parameters that are not set
+// by flags keep their current value on chain. The resulting message must be executed by the module
+// authority, which is usually governance.
+func CmdUpdateParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params",
+		Short: "Update the marketmap module parameters",
+		Long: `Update the marketmap module parameters. Parameters that are not set by flags keep their
+current value on chain. The message must be executed by the module authority, which is usually
+governance, so it is commonly generated with --generate-only and submitted as a proposal.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if !clientCtx.Offline {
+				if params, err = queryParams(cmd, clientCtx); err != nil {
+					return err
+				}
+			}
+
+			updated := params
+			if cmd.Flags().Changed(FlagAdmin) {
+				if updated.Admin, err = cmd.Flags().GetString(FlagAdmin); err != nil {
+					return err
+				}
+			}
+
+			if cmd.Flags().Changed(FlagMarketAuthorities) {
+				if updated.MarketAuthorities, err = cmd.Flags().GetStringSlice(FlagMarketAuthorities); err != nil {
+					return err
+				}
+			}
+
+			if cmd.Flags().Changed(FlagMarketAuthorityRoles) {
+				path, err := cmd.Flags().GetString(FlagMarketAuthorityRoles)
+				if err != nil {
+					return err
+				}
+
+				if updated.MarketAuthorityRoles, err = readMarketAuthorityRoles(path); err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgParams{
+				Params:    updated,
+				Authority: clientCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if !clientCtx.Offline {
+				printDiff(cmd, diffParams(params, updated))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAdmin, "", "the address of the new admin")
+	cmd.Flags().StringSlice(FlagMarketAuthorities, nil, "the comma-separated addresses of the new market authorities")
+	cmd.Flags().String(FlagMarketAuthorityRoles, "", "the path to a JSON file with the list of new market authority roles")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdScheduleMarketUpdates returns the command for scheduling updates of the markets of a market map file at a
+// future height. All of the markets must exist on chain.
+func CmdScheduleMarketUpdates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-market-updates [height] [market-map-file]",
+		Short: "Schedule updates of the markets of a market map file at a future height",
+		Long: `Schedule updates of the markets of a market map file, in the same JSON format as the market maps in
+cmd/constants/marketmaps. The updates are applied at the beginning of the block at the given height. The
+market map is validated against the current market map on chain, and the resulting diff is printed before
+the transaction is signed.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			markets, err := readMarketUpdates(cmd, clientCtx, args[1], marketUpdateUpdate)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgScheduleMarketUpdates{
+				Authority: clientCtx.GetFromAddress().String(),
+				Height:    height,
+				Markets:   markets,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdCancelMarketUpdates returns the command for cancelling the updates of markets that are scheduled for a future
+// height. It must be signed by the admin of the module.
+func CmdCancelMarketUpdates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-market-updates [height] [ticker] [ticker...]",
+		Short: "Cancel the updates of markets that are scheduled for a future height",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			msg := &types.MsgCancelMarketUpdates{
+				Admin:   clientCtx.GetFromAddress().String(),
+				Height:  height,
+				Markets: args[1:],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// readMarketUpdates reads the markets of the market map file at the given path, and validates them. Unless
+// the client is offline, the markets are applied to the current market map on chain, the resulting market map
+// is validated and the diff is printed. Otherwise, only the market map of the file is validated.
+func readMarketUpdates(
+	cmd *cobra.Command,
+	clientCtx client.Context,
+	path string,
+	kind marketUpdateKind,
+) ([]types.Market, error) {
+	marketMap, err := types.ReadMarketMapFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(marketMap.Markets) == 0 {
+		return nil, fmt.Errorf("market map file %s does not contain any markets", path)
+	}
+
+	markets := sortedMarkets(marketMap.Markets)
+	if clientCtx.Offline {
+		return markets, nil
+	}
+
+	res, err := types.NewQueryClient(clientCtx).MarketMap(cmd.Context(), &types.MarketMapRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the current market map: %w", err)
+	}
+
+	current := res.MarketMap.Markets
+	if current == nil {
+		current = make(map[string]types.Market)
+	}
+
+	// apply the updates to a copy of the current market map, so the resulting market map can be validated
+	updated := make(map[string]types.Market, len(current)+len(markets))
+	for ticker, market := range current {
+		updated[ticker] = market
+	}
+
+	for _, market := range markets {
+		ticker := market.Ticker.String()
+
+		_, exists := current[ticker]
+		switch {
+		case kind == marketUpdateCreate && exists:
+			return nil, types.NewMarketAlreadyExistsError(types.TickerString(ticker))
+		case kind == marketUpdateUpdate && !exists:
+			return nil, types.NewMarketDoesNotExistsError(types.TickerString(ticker))
+		}
+
+		updated[ticker] = market
+	}
+
+	updatedMarketMap := types.MarketMap{Markets: updated}
+	if err := updatedMarketMap.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("the updated market map is invalid: %w", err)
+	}
+
+	printDiff(cmd, diffMarkets(current, markets))
+
+	return markets, nil
+}
+
+// readMarketAuthorityRoles reads the JSON list of market authority roles in the file at the given path.
+func readMarketAuthorityRoles(path string) ([]types.MarketAuthorityRole, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []types.MarketAuthorityRole
+	if err := json.Unmarshal(bz, &roles); err != nil {
+		return nil, fmt.Errorf("failed to read market authority roles from %s: %w", path, err)
+	}
+
+	return roles, nil
+}
+
+// queryParams queries the current parameters of the module.
+func queryParams(cmd *cobra.Command, clientCtx client.Context) (types.Params, error) {
+	res, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.ParamsRequest{})
+	if err != nil {
+		return types.Params{}, fmt.Errorf("failed to query the current params: %w", err)
+	}
+
+	return res.Params, nil
+}
+
+// removeAddresses returns the given addresses without the addresses to remove.
+func removeAddresses(addresses, remove []string) []string {
+	removals := make(map[string]struct{}, len(remove))
+	for _, address := range remove {
+		removals[address] = struct{}{}
+	}
+
+	remaining := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		if _, ok := removals[address]; !ok {
+			remaining = append(remaining, address)
+		}
+	}
+
+	return remaining
+}
+
+// printDiff prints the given diff to stderr, so that it does not interfere with the output of the command
+// (e.g. a transaction generated with --generate-only).
+func printDiff(cmd *cobra.Command, diff []string) {
+	out := cmd.ErrOrStderr()
+	if len(diff) == 0 {
+		fmt.Fprintln(out, "no changes to the current state")
+		return
+	}
+
+	fmt.Fprintf(out, "changes to the current state:\n%s\n", strings.Join(diff, "\n"))
+}
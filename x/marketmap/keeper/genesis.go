@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
@@ -30,6 +31,12 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic(err)
 	}
 
+	for _, update := range gs.PendingMarketUpdates {
+		if err := k.pendingUpdates.Set(ctx, collections.Join(update.Height, update.Market.Ticker.String()), update.Market); err != nil {
+			panic(err)
+		}
+	}
+
 	if k.hooks != nil {
 		if err := k.hooks.AfterMarketGenesis(ctx, gs.MarketMap.Markets); err != nil {
 			panic(err)
@@ -54,12 +61,18 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	pendingUpdates, err := k.GetAllPendingMarketUpdates(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		MarketMap: types.MarketMap{
 			Markets: markets,
 		},
-		LastUpdated: lastUpdated,
-		Params:      params,
+		LastUpdated:          lastUpdated,
+		Params:               params,
+		PendingMarketUpdates: pendingUpdates,
 	}
 }
 
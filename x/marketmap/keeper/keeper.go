@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -30,6 +31,14 @@ type Keeper struct {
 
 	// params is the module's parameters.
 	params collections.Item[types.Params]
+
+	// pendingUpdates is keyed by the height an update is applied at and the
+	// CurrencyPair string of the updated market, and contains the updated Markets.
+	pendingUpdates collections.Map[collections.Pair[uint64, string], types.Market]
+
+	// authorityChanges is keyed by the address of a market authority, and contains the height of the
+	// last block the authority changed markets in along with the number of markets it changed.
+	authorityChanges collections.Map[string, collections.Pair[uint64, uint64]]
 }
 
 // NewKeeper initializes the keeper and its backing stores.
@@ -50,7 +59,21 @@ func NewKeeper(ss store.KVStoreService, cdc codec.BinaryCodec, authority sdk.Acc
 		markets:     collections.NewMap(sb, types.MarketsPrefix, "markets", types.TickersCodec, codec.CollValue[types.Market](cdc)),
 		lastUpdated: collections.NewItem[uint64](sb, types.LastUpdatedPrefix, "last_updated", types.LastUpdatedCodec),
 		params:      params,
-		hooks:       &types.NoopMarketMapHooks{},
+		pendingUpdates: collections.NewMap(
+			sb,
+			types.PendingUpdatesPrefix,
+			"pending_updates",
+			types.PendingUpdatesCodec,
+			codec.CollValue[types.Market](cdc),
+		),
+		authorityChanges: collections.NewMap(
+			sb,
+			types.AuthorityChangesPrefix,
+			"authority_changes",
+			collections.StringKey,
+			types.AuthorityChangesCodec,
+		),
+		hooks: &types.NoopMarketMapHooks{},
 	}
 }
 
@@ -149,8 +172,8 @@ func (k *Keeper) UpdateMarket(ctx sdk.Context, market types.Market) error {
 }
 
 // DeleteMarket removes a Market.
-// This is currently only expected to be called in upgrade handlers, and callers will need to separately call
-// RemoveCurrencyPair on x/oracle to clean up leftover state in that module.
+// This does not run the AfterMarketRemoved hook, so callers outside of MsgRemoveMarkets (e.g. upgrade handlers)
+// will need to separately call RemoveCurrencyPair on x/oracle to clean up leftover state in that module.
 func (k *Keeper) DeleteMarket(ctx sdk.Context, tickerStr string) error {
 	// Check if Ticker exists
 	alreadyExists, err := k.markets.Has(ctx, types.TickerString(tickerStr))
@@ -163,11 +186,71 @@ func (k *Keeper) DeleteMarket(ctx sdk.Context, tickerStr string) error {
 	return k.markets.Remove(ctx, types.TickerString(tickerStr))
 }
 
+// ValidateMarketRemovals checks that all the given markets exist, and that none of the markets that remain in
+// the market map depend on them, either to normalize their prices or as the source of a derived market.
+func (k *Keeper) ValidateMarketRemovals(ctx sdk.Context, tickers []string) error {
+	removals := make(map[string]struct{}, len(tickers))
+	for _, ticker := range tickers {
+		has, err := k.HasMarket(ctx, ticker)
+		if err != nil {
+			return err
+		}
+
+		if !has {
+			return types.NewMarketDoesNotExistsError(types.TickerString(ticker))
+		}
+
+		removals[ticker] = struct{}{}
+	}
+
+	return k.markets.Walk(ctx, nil, func(key types.TickerString, market types.Market) (bool, error) {
+		if _, ok := removals[string(key)]; ok {
+			return false, nil
+		}
+
+		for _, dependency := range market.NormalizationDependencies() {
+			if _, ok := removals[dependency]; ok {
+				return true, fmt.Errorf("market %s cannot be removed, market %s is normalized by it", dependency, key)
+			}
+		}
+
+		derived, err := market.Ticker.DerivedTicker()
+		if err != nil {
+			return true, err
+		}
+
+		if derived != nil {
+			if _, ok := removals[derived.Source]; ok {
+				return true, fmt.Errorf("market %s cannot be removed, market %s is derived from it", derived.Source, key)
+			}
+		}
+
+		return false, nil
+	})
+}
+
 // HasMarket checks if a market exists in the store.
 func (k *Keeper) HasMarket(ctx sdk.Context, tickerStr string) (bool, error) {
 	return k.markets.Has(ctx, types.TickerString(tickerStr))
 }
 
+// AddAuthorityMarketChanges adds the given number of changed markets to the markets changed by the given market
+// authority in the current block, and returns the total number of markets the authority changed in the block.
+func (k *Keeper) AddAuthorityMarketChanges(ctx sdk.Context, authority string, count uint64) (uint64, error) {
+	height := uint64(ctx.BlockHeight())
+
+	changes, err := k.authorityChanges.Get(ctx, authority)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+	case err != nil:
+		return 0, err
+	case changes.K1() == height:
+		count += changes.K2()
+	}
+
+	return count, k.authorityChanges.Set(ctx, authority, collections.Join(height, count))
+}
+
 // SetParams sets the x/marketmap module's parameters.
 func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	return k.params.Set(ctx, params)
@@ -181,31 +264,164 @@ func (k *Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
 // ValidateState is called after keeper modifications have been made to the market map to verify that
 // the aggregate of all updates has led to a valid state.
 func (k *Keeper) ValidateState(ctx sdk.Context, updates []types.Market) error {
+	var (
+		hasNormalization bool
+		disabled         = make(map[string]struct{})
+	)
 	for _, market := range updates {
 		if err := k.IsMarketValid(ctx, market); err != nil {
 			return err
 		}
+
+		hasNormalization = hasNormalization || len(market.NormalizationDependencies()) > 0
+		if !market.Ticker.Enabled {
+			disabled[market.Ticker.String()] = struct{}{}
+		}
 	}
 
-	return nil
+	// Only updates that disable markets can leave enabled markets depending on disabled markets.
+	if len(disabled) > 0 {
+		if err := k.validateDisabledMarkets(ctx, disabled); err != nil {
+			return err
+		}
+	}
+
+	// Only updates that add normalization dependencies can introduce a normalization cycle.
+	if !hasNormalization {
+		return nil
+	}
+
+	markets, err := k.GetAllMarkets(ctx)
+	if err != nil {
+		return err
+	}
+
+	mm := types.MarketMap{Markets: markets}
+	return mm.ValidateNormalizationDependencies()
+}
+
+// validateDisabledMarkets checks that no enabled market depends on the given disabled markets, either to normalize
+// its prices or as the source of a derived market.
+func (k *Keeper) validateDisabledMarkets(ctx sdk.Context, disabled map[string]struct{}) error {
+	return k.markets.Walk(ctx, nil, func(key types.TickerString, market types.Market) (bool, error) {
+		if !market.Ticker.Enabled {
+			return false, nil
+		}
+
+		for _, dependency := range market.NormalizationDependencies() {
+			if _, ok := disabled[dependency]; ok {
+				return true, fmt.Errorf("market %s cannot be disabled, enabled market %s is normalized by it", dependency, key)
+			}
+		}
+
+		derived, err := market.Ticker.DerivedTicker()
+		if err != nil {
+			return true, err
+		}
+
+		if derived != nil {
+			if _, ok := disabled[derived.Source]; ok {
+				return true, fmt.Errorf("market %s cannot be disabled, enabled market %s is derived from it", derived.Source, key)
+			}
+		}
+
+		return false, nil
+	})
+}
+
+// SimulateMarketUpdates upserts the given markets on a branch of the given context that is discarded afterwards, running
+// the market map hooks like a committed update would, and returns the changes that the update makes to each market
+// along with the errors that the update fails with. An error is only returned if the market map cannot be read.
+func (k *Keeper) SimulateMarketUpdates(ctx sdk.Context, markets []types.Market) ([]types.MarketDiff, []string, error) {
+	// the cache context is never written, so the update is not committed to state
+	cacheCtx, _ := ctx.CacheContext()
+
+	var (
+		diffs   = make([]types.MarketDiff, 0, len(markets))
+		errs    []string
+		tickers = make(map[string]struct{}, len(markets))
+	)
+
+	for _, market := range markets {
+		ticker := market.Ticker.String()
+		if _, seen := tickers[ticker]; seen {
+			errs = append(errs, fmt.Sprintf("duplicate ticker: %s", ticker))
+			continue
+		}
+		tickers[ticker] = struct{}{}
+
+		if err := market.ValidateBasic(); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid market %s: %s", ticker, err))
+		}
+
+		exists, err := k.HasMarket(cacheCtx, ticker)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !exists {
+			diffs = append(diffs, types.NewMarketDiff(nil, market))
+			if err := k.CreateMarket(cacheCtx, market); err != nil {
+				errs = append(errs, err.Error())
+			} else if err := k.hooks.AfterMarketCreated(cacheCtx, market); err != nil {
+				errs = append(errs, fmt.Sprintf("unable to run create market hook for %s: %s", ticker, err))
+			}
+
+			continue
+		}
+
+		existing, err := k.GetMarket(cacheCtx, ticker)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		diffs = append(diffs, types.NewMarketDiff(&existing, market))
+		if err := k.UpdateMarket(cacheCtx, market); err != nil {
+			errs = append(errs, err.Error())
+		} else if err := k.hooks.AfterMarketUpdated(cacheCtx, market); err != nil {
+			errs = append(errs, fmt.Sprintf("unable to run update market hook for %s: %s", ticker, err))
+		}
+	}
+
+	// validate that the resulting state of the market map is valid
+	if err := k.ValidateState(cacheCtx, markets); err != nil {
+		errs = append(errs, fmt.Sprintf("invalid state resulting from update: %s", err))
+	}
+
+	return diffs, errs, nil
 }
 
 // IsMarketValid checks if a market is valid by statefully checking if each of the currency pairs
-// specified by its provider configs are valid and in state.
+// specified by its provider configs are valid and in state, and that the source of a derived market
+// is in state.
 func (k *Keeper) IsMarketValid(ctx sdk.Context, market types.Market) error {
 	// check that all markets already exist in the keeper store:
 	for _, providerConfig := range market.ProviderConfigs {
-		if providerConfig.NormalizeByPair != nil {
-			has, err := k.markets.Has(ctx, types.TickerString(providerConfig.NormalizeByPair.String()))
+		for _, hop := range providerConfig.NormalizationPath() {
+			has, err := k.markets.Has(ctx, types.TickerString(hop.CurrencyPair.String()))
 			if err != nil {
 				return err
 			}
 
 			if !has {
-				return fmt.Errorf("currency pair %s in provider config does not exist", providerConfig.NormalizeByPair.String())
+				return fmt.Errorf("currency pair %s in provider config does not exist", hop.CurrencyPair.String())
 			}
 		}
 	}
 
+	derived, err := market.Ticker.DerivedTicker()
+	if err != nil || derived == nil {
+		return err
+	}
+
+	source, err := k.GetMarket(ctx, derived.Source)
+	if err != nil {
+		return fmt.Errorf("source market %s of derived market %s does not exist", derived.Source, market.Ticker.String())
+	}
+
+	if source.Ticker.IsDerived() {
+		return fmt.Errorf("derived market %s cannot be derived from the derived market %s", market.Ticker.String(), derived.Source)
+	}
+
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 	oraclekeeper "github.com/skip-mev/connect/v2/x/oracle/keeper"
 	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -276,6 +277,109 @@ func (s *KeeperTestSuite) TestValidUpdate() {
 	s.Require().NoError(s.keeper.ValidateState(s.ctx, []types.Market{validMarket}))
 }
 
+func (s *KeeperTestSuite) TestValidUpdateWithNormalizeByPairs() {
+	// create a valid markets
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, ethusdt))
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdtusd))
+
+	// valid market with multiple normalize pairs that are in state
+	validMarket := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair: slinkytypes.CurrencyPair{
+				Base:  "TOKEN",
+				Quote: "USD",
+			},
+			Decimals:         8,
+			MinProviderCount: 1,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "raydium",
+				OffChainTicker: "token-eth",
+				NormalizeByPairs: []types.NormalizationPair{
+					{CurrencyPair: ethusdt.Ticker.CurrencyPair},
+					{CurrencyPair: usdtusd.Ticker.CurrencyPair},
+				},
+			},
+		},
+	}
+
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, validMarket))
+	s.Require().NoError(s.keeper.ValidateState(s.ctx, []types.Market{validMarket}))
+
+	// invalid market with a normalize pair that is not in state
+	invalidMarket := validMarket
+	invalidMarket.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:           "raydium",
+			OffChainTicker: "token-eth",
+			NormalizeByPairs: []types.NormalizationPair{
+				{CurrencyPair: ethusdt.Ticker.CurrencyPair},
+				{CurrencyPair: slinkytypes.CurrencyPair{Base: "USDT", Quote: "invalid"}},
+			},
+		},
+	}
+
+	s.Require().NoError(s.keeper.UpdateMarket(s.ctx, invalidMarket))
+	s.Require().Error(s.keeper.ValidateState(s.ctx, []types.Market{invalidMarket}))
+}
+
+func (s *KeeperTestSuite) TestInvalidUpdateDisablesNormalizationMarket() {
+	usdt := usdtusd
+	usdt.Ticker.Enabled = true
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdt))
+
+	btc := btcusdt
+	btc.Ticker.Enabled = true
+	btc.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:             "kucoin",
+			OffChainTicker:   "btc-usdt",
+			NormalizeByPairs: []types.NormalizationPair{{CurrencyPair: usdt.Ticker.CurrencyPair}},
+		},
+	}
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, btc))
+
+	// usdt cannot be disabled while btc is enabled
+	usdt.Ticker.Enabled = false
+	s.Require().NoError(s.keeper.UpdateMarket(s.ctx, usdt))
+	s.Require().Error(s.keeper.ValidateState(s.ctx, []types.Market{usdt}))
+
+	// both can be disabled together
+	btc.Ticker.Enabled = false
+	s.Require().NoError(s.keeper.UpdateMarket(s.ctx, btc))
+	s.Require().NoError(s.keeper.ValidateState(s.ctx, []types.Market{usdt, btc}))
+}
+
+func (s *KeeperTestSuite) TestInvalidUpdateNormalizationCycle() {
+	// create a valid markets
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdtusd))
+
+	usdcusdNormalized := usdcusd
+	usdcusdNormalized.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:            "kucoin",
+			OffChainTicker:  "usdc-usdt",
+			NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+		},
+	}
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdcusdNormalized))
+	s.Require().NoError(s.keeper.ValidateState(s.ctx, []types.Market{usdcusdNormalized}))
+
+	// updating USDT/USD to be normalized by USDC/USD introduces a cycle
+	usdtusdNormalized := usdtusd
+	usdtusdNormalized.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:            "kucoin",
+			OffChainTicker:  "usdt-usdc",
+			NormalizeByPair: &usdcusd.Ticker.CurrencyPair,
+		},
+	}
+	s.Require().NoError(s.keeper.UpdateMarket(s.ctx, usdtusdNormalized))
+	s.Require().Error(s.keeper.ValidateState(s.ctx, []types.Market{usdtusdNormalized}))
+}
+
 func (s *KeeperTestSuite) TestDeleteMarket() {
 	// create a valid markets
 	s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
@@ -309,3 +413,96 @@ func (s *KeeperTestSuite) TestEnableDisableMarket() {
 	s.Require().NoError(err)
 	s.Require().False(market.Ticker.Enabled)
 }
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	expected, err := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+
+	s.Require().NoError(keeper.NewMigrator(s.keeper).Migrate1to2(s.ctx))
+
+	params, err := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, params)
+	s.Require().Empty(params.MarketAuthorityRoles)
+}
+
+func (s *KeeperTestSuite) TestDisableMarketsForMissedPrices() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	usdt := usdtusd
+	usdt.Ticker.Enabled = true
+
+	// an enabled market that is normalized by usdt
+	btc := btcusdt
+	btc.Ticker.Enabled = true
+	btc.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:             "kucoin",
+			OffChainTicker:   "btc-usdt",
+			NormalizeByPairs: []types.NormalizationPair{{CurrencyPair: usdt.Ticker.CurrencyPair}},
+		},
+	}
+
+	_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+		Authority:     s.marketAuthorities[0],
+		CreateMarkets: []types.Market{usdt, btc},
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, oracletypes.Params{MaxMissedHeights: 2}))
+
+	enabled := func(market types.Market) bool {
+		got, err := s.keeper.GetMarket(s.ctx, market.Ticker.String())
+		s.Require().NoError(err)
+		return got.Ticker.Enabled
+	}
+
+	s.Run("a normalization market of an enabled market is not disabled", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager()).WithBlockHeight(11)
+		for i := 0; i < 3; i++ {
+			s.Require().NoError(s.oracleKeeper.RecordPriceMissed(ctx, usdt.Ticker.CurrencyPair))
+		}
+
+		s.Require().True(enabled(usdt))
+		s.Require().Empty(ctx.EventManager().Events())
+
+		lastUpdated, err := s.keeper.GetLastUpdated(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(10), lastUpdated)
+	})
+
+	s.Run("a market without enabled dependents is disabled", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager()).WithBlockHeight(12)
+		for i := 0; i < 2; i++ {
+			s.Require().NoError(s.oracleKeeper.RecordPriceMissed(ctx, btc.Ticker.CurrencyPair))
+		}
+
+		s.Require().False(enabled(btc))
+
+		var eventTypes []string
+		for _, event := range ctx.EventManager().Events() {
+			eventTypes = append(eventTypes, event.Type)
+		}
+		s.Require().Equal([]string{types.EventTypeUpdateMarket, oracletypes.EventTypeMarketDisabled}, eventTypes)
+
+		lastUpdated, err := s.keeper.GetLastUpdated(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(12), lastUpdated)
+	})
+
+	s.Run("the normalization market is not disabled again past the maximum missed heights", func() {
+		s.Require().NoError(s.oracleKeeper.RecordPriceMissed(s.ctx, usdt.Ticker.CurrencyPair))
+		s.Require().True(enabled(usdt))
+	})
+
+	s.Run("the normalization market is disabled once it reaches the maximum missed heights again", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, usdt.Ticker.CurrencyPair, oracletypes.QuotePrice{
+			Price: math.NewInt(100),
+		}))
+
+		for i := 0; i < 2; i++ {
+			s.Require().NoError(s.oracleKeeper.RecordPriceMissed(s.ctx, usdt.Ticker.CurrencyPair))
+		}
+
+		s.Require().False(enabled(usdt))
+	})
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations of the x/marketmap module.
+type Migrator struct {
+	k *Keeper
+}
+
+// NewMigrator returns a new Migrator for the x/marketmap module.
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{k: k}
+}
+
+// Migrate1to2 migrates the x/marketmap module from consensus version 1 to 2. Version 2 introduces the market
+// authority roles of the params, the scheduled market updates and the number of markets changed by each market
+// authority per block. The new state starts empty, so the existing params are only validated and re-encoded with
+// no market authority roles.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.k.GetParams(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	if err := params.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid marketmap params: %w", err)
+	}
+
+	return m.k.SetParams(ctx, params)
+}
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
@@ -30,7 +32,7 @@ func (ms msgServer) UpsertMarkets(goCtx context.Context, msg *types.MsgUpsertMar
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// perform basic msg validity checks
-	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+	if err := ms.verifyMarketAuthorities(ctx, msg, msg.GetMarkets(), marketChangeUpsert); err != nil {
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
@@ -94,7 +96,7 @@ func (ms msgServer) CreateMarkets(goCtx context.Context, msg *types.MsgCreateMar
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// perform basic msg validity checks
-	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+	if err := ms.verifyMarketAuthorities(ctx, msg, msg.GetCreateMarkets(), marketChangeUpsert); err != nil {
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
@@ -135,7 +137,7 @@ func (ms msgServer) UpdateMarkets(goCtx context.Context, msg *types.MsgUpdateMar
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// perform basic msg validity checks
-	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+	if err := ms.verifyMarketAuthorities(ctx, msg, msg.GetUpdateMarkets(), marketChangeUpsert); err != nil {
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
@@ -169,12 +171,22 @@ func (ms msgServer) UpdateMarkets(goCtx context.Context, msg *types.MsgUpdateMar
 	return &types.MsgUpdateMarketsResponse{}, ms.k.SetLastUpdated(ctx, uint64(ctx.BlockHeight()))
 }
 
-// verifyMarketAuthorities verifies that the msg-submitter is a market-authority
-// and returns the context for the msg, this method returns an error if the submitter is not a market
-// authority.
+// marketChangeKind is the kind of change that a market authority makes to markets.
+type marketChangeKind int
+
+const (
+	// marketChangeUpsert creates or updates markets.
+	marketChangeUpsert marketChangeKind = iota
+	// marketChangeRemove removes markets.
+	marketChangeRemove
+)
+
+// verifyMarketAuthorities verifies that the msg-submitter is a market-authority that is allowed to make the given
+// kind of change to the given markets, this method returns an error if the submitter is not a market authority, if
+// the changes are outside the scope of its role, or if it has exceeded the number of markets it can change per block.
 func (ms msgServer) verifyMarketAuthorities(ctx sdk.Context, msg interface {
 	GetAuthority() string
-},
+}, markets []types.Market, kind marketChangeKind,
 ) error {
 	if msg == nil {
 		return fmt.Errorf("unable to process nil msg")
@@ -190,6 +202,78 @@ func (ms msgServer) verifyMarketAuthorities(ctx sdk.Context, msg interface {
 		return fmt.Errorf("request signer %s does not match module market authorities", msg.GetAuthority())
 	}
 
+	// market authorities without a role are unrestricted
+	role, ok := params.GetMarketAuthorityRole(msg.GetAuthority())
+	if !ok {
+		return nil
+	}
+
+	for _, market := range markets {
+		// removing a market removes all of its provider configs, which is validated like creating it
+		var existing *types.Market
+		if kind != marketChangeRemove {
+			stored, err := ms.k.GetMarket(ctx, market.Ticker.String())
+			switch {
+			case err == nil:
+				existing = &stored
+			case !errors.Is(err, collections.ErrNotFound):
+				return fmt.Errorf("unable to get market %s: %w", market.Ticker.String(), err)
+			}
+		}
+
+		if err := role.ValidateMarket(existing, market); err != nil {
+			return err
+		}
+
+		if role.EnableDisableOnly {
+			if err := ms.verifyEnableDisableOnly(ctx, role, market, kind); err != nil {
+				return err
+			}
+		}
+	}
+
+	if role.MaxMarketsPerBlock == 0 {
+		return nil
+	}
+
+	changes, err := ms.k.AddAuthorityMarketChanges(ctx, role.Address, uint64(len(markets)))
+	if err != nil {
+		return err
+	}
+
+	if changes > role.MaxMarketsPerBlock {
+		return fmt.Errorf(
+			"market authority %s cannot change more than %d markets per block, %d changed",
+			role.Address,
+			role.MaxMarketsPerBlock,
+			changes,
+		)
+	}
+
+	return nil
+}
+
+// verifyEnableDisableOnly verifies that the given change of a market only enables or disables an existing market.
+func (ms msgServer) verifyEnableDisableOnly(
+	ctx sdk.Context,
+	role types.MarketAuthorityRole,
+	market types.Market,
+	kind marketChangeKind,
+) error {
+	if kind == marketChangeRemove {
+		return fmt.Errorf("market authority %s can only enable or disable markets, and cannot remove %s", role.Address, market.Ticker.String())
+	}
+
+	existing, err := ms.k.GetMarket(ctx, market.Ticker.String())
+	if err != nil {
+		return fmt.Errorf("market authority %s can only enable or disable markets, and cannot create %s", role.Address, market.Ticker.String())
+	}
+
+	diff := types.NewMarketDiff(&existing, market)
+	if len(diff.UpdatedFields) > 0 || len(diff.AddedProviderConfigs) > 0 || len(diff.RemovedProviderConfigs) > 0 {
+		return fmt.Errorf("market authority %s can only enable or disable markets, and cannot otherwise update %s", role.Address, market.Ticker.String())
+	}
+
 	return nil
 }
 
@@ -243,6 +327,12 @@ func (ms msgServer) RemoveMarketAuthorities(goCtx context.Context, msg *types.Ms
 		}
 	}
 
+	// the roles of removed market authorities are removed along with them
+	params.MarketAuthorityRoles = slices.DeleteFunc(params.MarketAuthorityRoles, func(role types.MarketAuthorityRole) bool {
+		_, found := removeAddresses[role.Address]
+		return found
+	})
+
 	if err := ms.k.SetParams(ctx, params); err != nil {
 		return nil, err
 	}
@@ -250,6 +340,65 @@ func (ms msgServer) RemoveMarketAuthorities(goCtx context.Context, msg *types.Ms
 	return &types.MsgRemoveMarketAuthoritiesResponse{}, nil
 }
 
+// RemoveMarkets removes the given markets from the marketmap, and runs the AfterMarketRemoved hook for each of
+// them. The signer must be the admin or a market authority, and none of the remaining markets may depend on the
+// removed markets.
+func (ms msgServer) RemoveMarkets(goCtx context.Context, msg *types.MsgRemoveMarkets) (*types.MsgRemoveMarketsResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	if msg.Authority != params.Admin && !checkMarketAuthority(msg.Authority, params) {
+		return nil, fmt.Errorf("request signer %s does not match module admin or market authorities", msg.Authority)
+	}
+
+	if err := ms.k.ValidateMarketRemovals(ctx, msg.Markets); err != nil {
+		return nil, fmt.Errorf("unable to remove markets: %w", err)
+	}
+
+	markets := make([]types.Market, 0, len(msg.Markets))
+	for _, ticker := range msg.Markets {
+		market, err := ms.k.GetMarket(ctx, ticker)
+		if err != nil {
+			return nil, err
+		}
+
+		markets = append(markets, market)
+	}
+
+	// the admin is not restricted by the roles of the market authorities
+	if msg.Authority != params.Admin {
+		if err := ms.verifyMarketAuthorities(ctx, msg, markets, marketChangeRemove); err != nil {
+			return nil, fmt.Errorf("unable to verify market authorities: %w", err)
+		}
+	}
+
+	for _, market := range markets {
+		if err := ms.k.DeleteMarket(ctx, market.Ticker.String()); err != nil {
+			return nil, fmt.Errorf("unable to remove market: %w", err)
+		}
+
+		if err := ms.k.hooks.AfterMarketRemoved(ctx, market); err != nil {
+			return nil, fmt.Errorf("unable to run remove market hook: %w", err)
+		}
+
+		event := sdk.NewEvent(
+			types.EventTypeRemoveMarket,
+			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
+		)
+		ctx.EventManager().EmitEvent(event)
+	}
+
+	return &types.MsgRemoveMarketsResponse{}, ms.k.SetLastUpdated(ctx, uint64(ctx.BlockHeight()))
+}
+
 // checkMarketAuthority checks if the given authority is the x/marketmap's list of MarketAuthorities.
 func checkMarketAuthority(authority string, params types.Params) bool {
 	if len(params.MarketAuthorities) == 0 {
@@ -264,3 +413,72 @@ func checkMarketAuthority(authority string, params types.Params) bool {
 
 	return false
 }
+
+// ScheduleMarketUpdates schedules updates of existing markets that are applied at the beginning of the block at the
+// given height. The signer must be a market authority, and all markets must exist.
+func (ms msgServer) ScheduleMarketUpdates(
+	goCtx context.Context,
+	msg *types.MsgScheduleMarketUpdates,
+) (*types.MsgScheduleMarketUpdatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// perform basic msg validity checks
+	if err := ms.verifyMarketAuthorities(ctx, msg, msg.GetMarkets(), marketChangeUpsert); err != nil {
+		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
+	}
+
+	for _, market := range msg.Markets {
+		if err := ms.k.SchedulePendingMarketUpdate(ctx, msg.Height, market); err != nil {
+			return nil, fmt.Errorf("unable to schedule market update: %w", err)
+		}
+
+		event := sdk.NewEvent(
+			types.EventTypeScheduleMarketUpdate,
+			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(msg.Height, 10)),
+			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(market.Ticker.Decimals, 10)),
+			sdk.NewAttribute(types.AttributeKeyMinProviderCount, strconv.FormatUint(market.Ticker.MinProviderCount, 10)),
+			sdk.NewAttribute(types.AttributeKeyMetadata, market.Ticker.Metadata_JSON),
+		)
+		ctx.EventManager().EmitEvent(event)
+	}
+
+	return &types.MsgScheduleMarketUpdatesResponse{}, nil
+}
+
+// CancelMarketUpdates cancels the updates of the given markets that are scheduled for the given height. The signer
+// must be the admin.
+func (ms msgServer) CancelMarketUpdates(
+	goCtx context.Context,
+	msg *types.MsgCancelMarketUpdates,
+) (*types.MsgCancelMarketUpdatesResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	if msg.Admin != params.Admin {
+		return nil, fmt.Errorf("request admin %s does not match module admin %s", msg.Admin, params.Admin)
+	}
+
+	for _, ticker := range msg.Markets {
+		if err := ms.k.CancelPendingMarketUpdate(ctx, msg.Height, ticker); err != nil {
+			return nil, fmt.Errorf("unable to cancel market update: %w", err)
+		}
+
+		event := sdk.NewEvent(
+			types.EventTypeCancelMarketUpdate,
+			sdk.NewAttribute(types.AttributeKeyCurrencyPair, ticker),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(msg.Height, 10)),
+		)
+		ctx.EventManager().EmitEvent(event)
+	}
+
+	return &types.MsgCancelMarketUpdatesResponse{}, nil
+}
@@ -519,3 +519,335 @@ func (s *KeeperTestSuite) TestMsgServerUpsertMarkets() {
 		s.Require().Nil(resp)
 	})
 }
+
+func (s *KeeperTestSuite) TestMsgServerRemoveMarkets() {
+	hooks := mmmocks.NewMarketMapHooks(s.T())
+
+	// init keeper w/ mocked hooks
+	s.keeper = s.initKeeperWithHooks(hooks)
+
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	// btc/usd is normalized by usdt/usd
+	btcusd := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("BITCOIN", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:            "kucoin",
+				OffChainTicker:  "btc-usdt",
+				NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+			},
+		},
+	}
+
+	for _, market := range []types.Market{usdtusd, usdcusd, btcusd} {
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, market))
+	}
+
+	s.Run("unable to process nil request", func() {
+		resp, err := msgServer.RemoveMarkets(s.ctx, nil)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to process for invalid authority (valid bech32)", func() {
+		msg := &types.MsgRemoveMarkets{
+			Authority: sdk.AccAddress("invalid").String(),
+			Markets:   []string{usdcusd.Ticker.String()},
+		}
+		resp, err := msgServer.RemoveMarkets(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to remove a market that does not exist", func() {
+		msg := &types.MsgRemoveMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []string{ethusdt.Ticker.String()},
+		}
+		resp, err := msgServer.RemoveMarkets(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to remove a market that another market is normalized by", func() {
+		msg := &types.MsgRemoveMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []string{usdtusd.Ticker.String()},
+		}
+		resp, err := msgServer.RemoveMarkets(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+
+		found, err := s.keeper.HasMarket(s.ctx, usdtusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().True(found)
+	})
+
+	s.Run("fail if the hook fails", func() {
+		msg := &types.MsgRemoveMarkets{
+			Authority: s.admin,
+			Markets:   []string{usdcusd.Ticker.String()},
+		}
+
+		hooks.On("AfterMarketRemoved", mock.Anything, usdcusd).Return(fmt.Errorf("hook error")).Once()
+
+		resp, err := msgServer.RemoveMarkets(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+
+		// the state of a failed tx is reverted, reset it
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdcusd))
+	})
+
+	s.Run("the admin can remove a market", func() {
+		msg := &types.MsgRemoveMarkets{
+			Authority: s.admin,
+			Markets:   []string{usdcusd.Ticker.String()},
+		}
+
+		hooks.On("AfterMarketRemoved", mock.Anything, usdcusd).Return(nil).Once()
+
+		s.ctx = s.ctx.WithBlockHeight(13)
+
+		resp, err := msgServer.RemoveMarkets(s.ctx, msg)
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		found, err := s.keeper.HasMarket(s.ctx, usdcusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(found)
+
+		// check that last updated is correct
+		lastUpdated, err := s.keeper.GetLastUpdated(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(s.ctx.BlockHeight()), lastUpdated)
+
+		// check that the emitted events are correct (get the last event)
+		event := s.ctx.EventManager().Events()[len(s.ctx.EventManager().Events())-1]
+		s.Require().Equal(types.EventTypeRemoveMarket, event.Type)
+		s.Require().Equal(usdcusd.Ticker.String(), event.Attributes[0].Value)
+	})
+
+	s.Run("a market authority can remove a market along with the markets that depend on it", func() {
+		msg := &types.MsgRemoveMarkets{
+			Authority: s.marketAuthorities[1],
+			Markets:   []string{usdtusd.Ticker.String(), btcusd.Ticker.String()},
+		}
+
+		hooks.On("AfterMarketRemoved", mock.Anything, usdtusd).Return(nil).Once()
+		hooks.On("AfterMarketRemoved", mock.Anything, btcusd).Return(nil).Once()
+
+		resp, err := msgServer.RemoveMarkets(s.ctx, msg)
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		mm, err := s.keeper.GetAllMarkets(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(mm)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgServerMarketAuthorityRoles() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	scoped, toggler, limited := s.marketAuthorities[0], s.marketAuthorities[1], s.marketAuthorities[2]
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.Params{
+		MarketAuthorities: s.marketAuthorities,
+		Admin:             s.admin,
+		MarketAuthorityRoles: []types.MarketAuthorityRole{
+			{Address: scoped, AllowedQuotes: []string{"USD"}, AllowedProviders: []string{"kucoin"}},
+			{Address: toggler, EnableDisableOnly: true},
+			{Address: limited, MaxMarketsPerBlock: 2},
+		},
+	}))
+
+	usdt, usdc, btc, eth := usdtusd, usdcusd, btcusdt, ethusdt
+
+	s.Run("an authority restricted to quote assets cannot create markets of other quote assets", func() {
+		resp, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     scoped,
+			CreateMarkets: []types.Market{btc},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("an authority restricted to providers cannot use other providers", func() {
+		market := usdc
+		market.ProviderConfigs = []types.ProviderConfig{{Name: "binance", OffChainTicker: "USDCUSD"}}
+
+		resp, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     scoped,
+			CreateMarkets: []types.Market{market},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("an authority can create markets within its scope", func() {
+		resp, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     scoped,
+			CreateMarkets: []types.Market{usdt, usdc},
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+	})
+
+	s.Run("an authority restricted to providers cannot change the ticker of a market", func() {
+		market := usdt
+		market.Ticker.Decimals = 10
+
+		resp, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     scoped,
+			UpdateMarkets: []types.Market{market},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("an authority restricted to providers cannot change the configs of other providers", func() {
+		stored := usdc
+		stored.ProviderConfigs = []types.ProviderConfig{
+			{Name: "kucoin", OffChainTicker: "usdc-usd"},
+			{Name: "binance", OffChainTicker: "USDCUSD"},
+		}
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, stored))
+
+		// removing the binance config
+		resp, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     scoped,
+			UpdateMarkets: []types.Market{usdc},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+
+		// changing the binance config
+		market := stored
+		market.ProviderConfigs = []types.ProviderConfig{
+			{Name: "kucoin", OffChainTicker: "usdc-usd"},
+			{Name: "binance", OffChainTicker: "USDC_USD"},
+		}
+
+		resp, err = msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     scoped,
+			UpdateMarkets: []types.Market{market},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("an authority restricted to providers can change the configs of its providers", func() {
+		market, err := s.keeper.GetMarket(s.ctx, usdc.Ticker.String())
+		s.Require().NoError(err)
+		market.ProviderConfigs = []types.ProviderConfig{
+			{Name: "kucoin", OffChainTicker: "USDC-USD"},
+			{Name: "binance", OffChainTicker: "USDCUSD"},
+		}
+
+		resp, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     scoped,
+			UpdateMarkets: []types.Market{market},
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		got, err := s.keeper.GetMarket(s.ctx, usdc.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(market, got)
+	})
+
+	s.Run("an enable / disable only authority cannot create markets", func() {
+		resp, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     toggler,
+			CreateMarkets: []types.Market{btc},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("an enable / disable only authority cannot change other fields", func() {
+		market := usdt
+		market.Ticker.Decimals = 10
+
+		resp, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     toggler,
+			UpdateMarkets: []types.Market{market},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("an enable / disable only authority cannot remove markets", func() {
+		resp, err := msgServer.RemoveMarkets(s.ctx, &types.MsgRemoveMarkets{
+			Authority: toggler,
+			Markets:   []string{usdc.Ticker.String()},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("an enable / disable only authority can enable a market", func() {
+		market := usdt
+		market.Ticker.Enabled = true
+
+		resp, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     toggler,
+			UpdateMarkets: []types.Market{market},
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		got, err := s.keeper.GetMarket(s.ctx, usdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().True(got.Ticker.Enabled)
+	})
+
+	s.Run("a rate limited authority cannot change more markets than its limit per block", func() {
+		resp, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     limited,
+			CreateMarkets: []types.Market{btc, eth},
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		market := usdc
+		market.Ticker.MinProviderCount = 2
+
+		resp2, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     limited,
+			UpdateMarkets: []types.Market{market},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp2)
+
+		// the limit is reset in the next block
+		resp2, err = msgServer.UpdateMarkets(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+1), &types.MsgUpdateMarkets{
+			Authority:     limited,
+			UpdateMarkets: []types.Market{market},
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp2)
+	})
+
+	s.Run("the roles of removed market authorities are removed", func() {
+		resp, err := msgServer.RemoveMarketAuthorities(s.ctx, &types.MsgRemoveMarketAuthorities{
+			Admin:           s.admin,
+			RemoveAddresses: []string{toggler},
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		params, err := s.keeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().NoError(params.ValidateBasic())
+
+		_, ok := params.GetMarketAuthorityRole(toggler)
+		s.Require().False(ok)
+		s.Require().Len(params.MarketAuthorityRoles, 2)
+	})
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// SchedulePendingMarketUpdate schedules the given market update to be applied at the beginning of the block at the
+// given height. The market must exist, and an update of the same market that is already scheduled for the same
+// height is replaced.
+func (k *Keeper) SchedulePendingMarketUpdate(ctx sdk.Context, height uint64, market types.Market) error {
+	if height <= uint64(ctx.BlockHeight()) {
+		return fmt.Errorf("height %d of the update must be greater than the current height %d", height, ctx.BlockHeight())
+	}
+
+	exists, err := k.HasMarket(ctx, market.Ticker.String())
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return types.NewMarketDoesNotExistsError(types.TickerString(market.Ticker.String()))
+	}
+
+	return k.pendingUpdates.Set(ctx, collections.Join(height, market.Ticker.String()), market)
+}
+
+// CancelPendingMarketUpdate removes the update of the given market that is scheduled for the given height.
+func (k *Keeper) CancelPendingMarketUpdate(ctx sdk.Context, height uint64, tickerStr string) error {
+	key := collections.Join(height, tickerStr)
+
+	has, err := k.pendingUpdates.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if !has {
+		return fmt.Errorf("no update of market %s is scheduled for height %d", tickerStr, height)
+	}
+
+	return k.pendingUpdates.Remove(ctx, key)
+}
+
+// GetAllPendingMarketUpdates returns all scheduled market updates, ordered by the height they are applied at.
+func (k *Keeper) GetAllPendingMarketUpdates(ctx sdk.Context) ([]types.PendingMarketUpdate, error) {
+	var updates []types.PendingMarketUpdate
+	err := k.pendingUpdates.Walk(ctx, nil, func(key collections.Pair[uint64, string], market types.Market) (bool, error) {
+		updates = append(updates, types.PendingMarketUpdate{
+			Height: key.K1(),
+			Market: market,
+		})
+
+		return false, nil
+	})
+
+	return updates, err
+}
+
+// ApplyPendingMarketUpdates applies all market updates that are scheduled for the current height or earlier, and
+// runs the AfterMarketUpdated hook for each of them. Each update is applied on its own, so an update that fails
+// (e.g. because its market has been removed since it was scheduled, or because it would lead to an invalid market
+// map) is dropped without affecting the other updates. Applied and dropped updates are removed from the schedule.
+func (k *Keeper) ApplyPendingMarketUpdates(ctx sdk.Context) error {
+	rng := collections.NewPrefixUntilPairRange[uint64, string](uint64(ctx.BlockHeight()))
+
+	// collect the due updates first, since the store cannot be modified while it is iterated
+	iter, err := k.pendingUpdates.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	due, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	var applied bool
+	for _, kv := range due {
+		if err := k.pendingUpdates.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+
+		if err := k.ApplyMarketUpdate(ctx, kv.Value); err != nil {
+			ctx.Logger().With("module", "x/"+types.ModuleName).Error(
+				"failed to apply pending market update",
+				"market", kv.Value.Ticker.String(),
+				"height", kv.Key.K1(),
+				"error", err,
+			)
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeMarketUpdateFailed,
+				sdk.NewAttribute(types.AttributeKeyCurrencyPair, kv.Value.Ticker.String()),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(kv.Key.K1(), 10)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+
+			continue
+		}
+
+		applied = true
+	}
+
+	if !applied {
+		return nil
+	}
+
+	return k.SetLastUpdated(ctx, uint64(ctx.BlockHeight()))
+}
+
+// ApplyMarketUpdate updates the given market and runs the AfterMarketUpdated hook on a branch of the given context,
+// which is only written, along with an update market event, if the resulting market map is valid. The last updated
+// height of the market map is left for the caller to set.
+func (k *Keeper) ApplyMarketUpdate(ctx sdk.Context, market types.Market) error {
+	cacheCtx, write := ctx.CacheContext()
+
+	if err := k.UpdateMarket(cacheCtx, market); err != nil {
+		return fmt.Errorf("unable to update market: %w", err)
+	}
+
+	if err := k.hooks.AfterMarketUpdated(cacheCtx, market); err != nil {
+		return fmt.Errorf("unable to run update market hook: %w", err)
+	}
+
+	if err := k.ValidateState(cacheCtx, []types.Market{market}); err != nil {
+		return fmt.Errorf("invalid state resulting from update: %w", err)
+	}
+
+	cacheCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateMarket,
+		sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
+		sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(market.Ticker.Decimals, 10)),
+		sdk.NewAttribute(types.AttributeKeyMinProviderCount, strconv.FormatUint(market.Ticker.MinProviderCount, 10)),
+		sdk.NewAttribute(types.AttributeKeyMetadata, market.Ticker.Metadata_JSON),
+	))
+
+	write()
+
+	return nil
+}
@@ -0,0 +1,284 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+	mmmocks "github.com/skip-mev/connect/v2/x/marketmap/types/mocks"
+)
+
+func (s *KeeperTestSuite) TestMsgServerScheduleMarketUpdates() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	usdt := usdtusd
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdt))
+
+	updated := usdt
+	updated.Ticker.Decimals = 10
+
+	s.Run("unable to process for invalid authority (valid bech32)", func() {
+		msg := &types.MsgScheduleMarketUpdates{
+			Authority: sdk.AccAddress("invalid").String(),
+			Height:    20,
+			Markets:   []types.Market{updated},
+		}
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to schedule an update at the current height", func() {
+		msg := &types.MsgScheduleMarketUpdates{
+			Authority: s.marketAuthorities[0],
+			Height:    uint64(s.ctx.BlockHeight()),
+			Markets:   []types.Market{updated},
+		}
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to schedule an update of a market that does not exist", func() {
+		msg := &types.MsgScheduleMarketUpdates{
+			Authority: s.marketAuthorities[0],
+			Height:    20,
+			Markets:   []types.Market{usdcusd},
+		}
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("a market authority can schedule an update", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		msg := &types.MsgScheduleMarketUpdates{
+			Authority: s.marketAuthorities[0],
+			Height:    20,
+			Markets:   []types.Market{updated},
+		}
+		resp, err := msgServer.ScheduleMarketUpdates(ctx, msg)
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		// the market is not updated until the scheduled height
+		market, err := s.keeper.GetMarket(ctx, usdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(usdt, market)
+
+		updates, err := s.keeper.GetAllPendingMarketUpdates(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PendingMarketUpdate{{Height: 20, Market: updated}}, updates)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeScheduleMarketUpdate, events[0].Type)
+
+		attr, ok := events[0].GetAttribute(types.AttributeKeyHeight)
+		s.Require().True(ok)
+		s.Require().Equal("20", attr.Value)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgServerCancelMarketUpdates() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	usdt := usdtusd
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdt))
+	s.Require().NoError(s.keeper.SchedulePendingMarketUpdate(s.ctx, 20, usdt))
+
+	s.Run("unable to process nil request", func() {
+		resp, err := msgServer.CancelMarketUpdates(s.ctx, nil)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("a market authority cannot cancel an update", func() {
+		msg := &types.MsgCancelMarketUpdates{
+			Admin:   s.marketAuthorities[0],
+			Height:  20,
+			Markets: []string{usdt.Ticker.String()},
+		}
+		resp, err := msgServer.CancelMarketUpdates(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to cancel an update that is not scheduled", func() {
+		msg := &types.MsgCancelMarketUpdates{
+			Admin:   s.admin,
+			Height:  21,
+			Markets: []string{usdt.Ticker.String()},
+		}
+		resp, err := msgServer.CancelMarketUpdates(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("the admin can cancel an update", func() {
+		msg := &types.MsgCancelMarketUpdates{
+			Admin:   s.admin,
+			Height:  20,
+			Markets: []string{usdt.Ticker.String()},
+		}
+		resp, err := msgServer.CancelMarketUpdates(s.ctx, msg)
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		updates, err := s.keeper.GetAllPendingMarketUpdates(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(updates)
+	})
+}
+
+func (s *KeeperTestSuite) TestApplyPendingMarketUpdates() {
+	hooks := mmmocks.NewMarketMapHooks(s.T())
+
+	// init keeper w/ mocked hooks
+	s.keeper = s.initKeeperWithHooks(hooks)
+
+	usdt, usdc, btc := usdtusd, usdcusd, btcusdt
+	for _, market := range []types.Market{usdt, usdc, btc} {
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, market))
+	}
+
+	updatedUSDT := usdt
+	updatedUSDT.Ticker.Decimals = 10
+
+	updatedUSDC := usdc
+	updatedUSDC.Ticker.MinProviderCount = 2
+
+	// btc/usdt cannot be normalized by a market that does not exist
+	updatedBTC := btc
+	updatedBTC.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:            "kucoin",
+			OffChainTicker:  "btc-usdt",
+			NormalizeByPair: &ethusdt.Ticker.CurrencyPair,
+		},
+	}
+
+	s.Require().NoError(s.keeper.SchedulePendingMarketUpdate(s.ctx, 11, updatedUSDT))
+	s.Require().NoError(s.keeper.SchedulePendingMarketUpdate(s.ctx, 11, updatedBTC))
+	s.Require().NoError(s.keeper.SchedulePendingMarketUpdate(s.ctx, 12, updatedUSDC))
+
+	s.Run("no updates are applied before the scheduled height", func() {
+		s.Require().NoError(s.keeper.ApplyPendingMarketUpdates(s.ctx))
+
+		updates, err := s.keeper.GetAllPendingMarketUpdates(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(updates, 3)
+	})
+
+	s.Run("the updates scheduled for the current height are applied", func() {
+		ctx := s.ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+
+		hooks.On("AfterMarketUpdated", mock.Anything, updatedUSDT).Return(nil).Once()
+		hooks.On("AfterMarketUpdated", mock.Anything, updatedBTC).Return(nil).Once()
+
+		s.Require().NoError(s.keeper.ApplyPendingMarketUpdates(ctx))
+
+		market, err := s.keeper.GetMarket(ctx, usdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(updatedUSDT, market)
+
+		// the update of btc/usdt leads to an invalid state, so it is dropped
+		market, err = s.keeper.GetMarket(ctx, btc.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(btc, market)
+
+		updates, err := s.keeper.GetAllPendingMarketUpdates(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PendingMarketUpdate{{Height: 12, Market: updatedUSDC}}, updates)
+
+		lastUpdated, err := s.keeper.GetLastUpdated(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(11), lastUpdated)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 2)
+		s.Require().Equal(types.EventTypeMarketUpdateFailed, events[0].Type)
+		s.Require().Equal(types.EventTypeUpdateMarket, events[1].Type)
+	})
+
+	s.Run("an update is dropped if the hook fails", func() {
+		ctx := s.ctx.WithBlockHeight(15)
+
+		hooks.On("AfterMarketUpdated", mock.Anything, updatedUSDC).Return(fmt.Errorf("hook error")).Once()
+
+		s.Require().NoError(s.keeper.ApplyPendingMarketUpdates(ctx))
+
+		market, err := s.keeper.GetMarket(ctx, usdc.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(usdc, market)
+
+		updates, err := s.keeper.GetAllPendingMarketUpdates(ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(updates)
+
+		// no update was applied
+		lastUpdated, err := s.keeper.GetLastUpdated(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(11), lastUpdated)
+	})
+}
+
+func (s *KeeperTestSuite) TestPendingMarketUpdates() {
+	qs := keeper.NewQueryServer(s.keeper)
+
+	usdt, usdc := usdtusd, usdcusd
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdt))
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdc))
+	s.Require().NoError(s.keeper.SchedulePendingMarketUpdate(s.ctx, 30, usdt))
+	s.Require().NoError(s.keeper.SchedulePendingMarketUpdate(s.ctx, 20, usdc))
+
+	s.Run("run invalid nil request", func() {
+		_, err := qs.PendingMarketUpdates(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("all updates are returned ordered by height", func() {
+		resp, err := qs.PendingMarketUpdates(s.ctx, &types.PendingMarketUpdatesRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PendingMarketUpdate{
+			{Height: 20, Market: usdc},
+			{Height: 30, Market: usdt},
+		}, resp.PendingMarketUpdates)
+		s.Require().Nil(resp.Pagination)
+	})
+
+	s.Run("updates are paginated", func() {
+		resp, err := qs.PendingMarketUpdates(s.ctx, &types.PendingMarketUpdatesRequest{
+			Pagination: &query.PageRequest{Limit: 1},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PendingMarketUpdate{{Height: 20, Market: usdc}}, resp.PendingMarketUpdates)
+		s.Require().NotNil(resp.Pagination.NextKey)
+
+		resp, err = qs.PendingMarketUpdates(s.ctx, &types.PendingMarketUpdatesRequest{
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PendingMarketUpdate{{Height: 30, Market: usdt}}, resp.PendingMarketUpdates)
+		s.Require().Nil(resp.Pagination.NextKey)
+	})
+
+	s.Run("pending updates are exported and imported in genesis", func() {
+		gs := s.keeper.ExportGenesis(s.ctx)
+		s.Require().Len(gs.PendingMarketUpdates, 2)
+		s.Require().NoError(gs.ValidateBasic())
+
+		k := s.initKeeperWithHooks(&types.NoopMarketMapHooks{})
+		s.Require().NotPanics(func() {
+			k.InitGenesis(s.ctx, *gs)
+		})
+
+		updates, err := k.GetAllPendingMarketUpdates(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(gs.PendingMarketUpdates, updates)
+	})
+}
@@ -3,8 +3,11 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
 )
@@ -18,7 +21,8 @@ func NewQueryServer(k *Keeper) types.QueryServer {
 	return &queryServerImpl{k}
 }
 
-// MarketMap returns the full MarketMap and associated information stored in the x/marketmap module.
+// MarketMap returns the MarketMap and associated information stored in the x/marketmap module. The full MarketMap
+// is returned unless the request is paginated or filtered.
 func (q queryServerImpl) MarketMap(goCtx context.Context, req *types.MarketMapRequest) (*types.MarketMapResponse, error) {
 	if req == nil {
 		return nil, fmt.Errorf("request cannot be nil")
@@ -27,7 +31,17 @@ func (q queryServerImpl) MarketMap(goCtx context.Context, req *types.MarketMapRe
 	// unwrap the context
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	markets, err := q.k.GetAllMarkets(ctx)
+	var (
+		markets    map[string]types.Market
+		pagination *query.PageResponse
+		err        error
+	)
+
+	if req.Pagination == nil && !isMarketMapFiltered(req) {
+		markets, err = q.k.GetAllMarkets(ctx)
+	} else {
+		markets, pagination, err = q.filteredMarkets(ctx, req)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -40,10 +54,86 @@ func (q queryServerImpl) MarketMap(goCtx context.Context, req *types.MarketMapRe
 
 			LastUpdated: lastUpdated,
 			ChainId:     ctx.ChainID(),
+			Pagination:  pagination,
 		},
 		err
 }
 
+// filteredMarkets returns the markets that match the filters of the given request. If the request is paginated, only
+// the requested page of markets is returned, along with the page response.
+func (q queryServerImpl) filteredMarkets(
+	ctx sdk.Context,
+	req *types.MarketMapRequest,
+) (map[string]types.Market, *query.PageResponse, error) {
+	markets := make(map[string]types.Market)
+
+	// return all matching markets if the request is not paginated
+	if req.Pagination == nil {
+		err := q.k.markets.Walk(ctx, nil, func(key types.TickerString, market types.Market) (bool, error) {
+			if marketMatchesFilter(req, market) {
+				markets[string(key)] = market
+			}
+
+			return false, nil
+		})
+
+		return markets, nil, err
+	}
+
+	_, pagination, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.markets,
+		req.Pagination,
+		func(_ types.TickerString, market types.Market) (bool, error) {
+			return marketMatchesFilter(req, market), nil
+		},
+		func(key types.TickerString, market types.Market) (types.Market, error) {
+			markets[string(key)] = market
+			return market, nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return markets, pagination, nil
+}
+
+// isMarketMapFiltered returns whether any of the filters of the given request are set.
+func isMarketMapFiltered(req *types.MarketMapRequest) bool {
+	return req.Enabled != types.EnabledFilter_ENABLED_FILTER_ALL || req.Provider != "" || req.Quote != ""
+}
+
+// marketMatchesFilter returns whether the given market matches all the filters of the given request.
+func marketMatchesFilter(req *types.MarketMapRequest, market types.Market) bool {
+	switch req.Enabled {
+	case types.EnabledFilter_ENABLED_FILTER_ENABLED:
+		if !market.Ticker.Enabled {
+			return false
+		}
+	case types.EnabledFilter_ENABLED_FILTER_DISABLED:
+		if market.Ticker.Enabled {
+			return false
+		}
+	}
+
+	if req.Quote != "" && !strings.EqualFold(market.Ticker.CurrencyPair.Quote, req.Quote) {
+		return false
+	}
+
+	if req.Provider == "" {
+		return true
+	}
+
+	for _, providerConfig := range market.ProviderConfigs {
+		if providerConfig.Name == req.Provider {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Market returns the requested market stored in the x/marketmap module.
 func (q queryServerImpl) Market(goCtx context.Context, req *types.MarketRequest) (*types.MarketResponse, error) {
 	if req == nil {
@@ -98,3 +188,74 @@ func (q queryServerImpl) Params(goCtx context.Context, req *types.ParamsRequest)
 
 	return &types.ParamsResponse{Params: params}, nil
 }
+
+// SimulateMarketMapUpdate returns the changes that upserting the given markets would make to the market map stored
+// in the x/marketmap module, along with any errors the update would fail with. The update is not committed to state.
+func (q queryServerImpl) SimulateMarketMapUpdate(
+	goCtx context.Context,
+	req *types.SimulateMarketMapUpdateRequest,
+) (*types.SimulateMarketMapUpdateResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	if len(req.Markets) == 0 {
+		return nil, fmt.Errorf("no markets to simulate")
+	}
+
+	// unwrap the context
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	diffs, errs, err := q.k.SimulateMarketUpdates(ctx, req.Markets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SimulateMarketMapUpdateResponse{
+		Diffs:  diffs,
+		Errors: errs,
+	}, nil
+}
+
+// PendingMarketUpdates returns the market updates that are scheduled in the x/marketmap module, ordered by the height
+// they are applied at. All scheduled updates are returned unless the request is paginated.
+func (q queryServerImpl) PendingMarketUpdates(
+	goCtx context.Context,
+	req *types.PendingMarketUpdatesRequest,
+) (*types.PendingMarketUpdatesResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	// unwrap the context
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Pagination == nil {
+		updates, err := q.k.GetAllPendingMarketUpdates(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.PendingMarketUpdatesResponse{PendingMarketUpdates: updates}, nil
+	}
+
+	updates, pagination, err := query.CollectionPaginate(
+		ctx,
+		q.k.pendingUpdates,
+		req.Pagination,
+		func(key collections.Pair[uint64, string], market types.Market) (types.PendingMarketUpdate, error) {
+			return types.PendingMarketUpdate{
+				Height: key.K1(),
+				Market: market,
+			}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PendingMarketUpdatesResponse{
+		PendingMarketUpdates: updates,
+		Pagination:           pagination,
+	}, nil
+}
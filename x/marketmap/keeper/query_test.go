@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
 	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
@@ -139,3 +141,182 @@ func (s *KeeperTestSuite) TestLastUpdated() {
 		s.Require().Error(err)
 	})
 }
+
+func (s *KeeperTestSuite) TestSimulateMarketMapUpdate() {
+	qs := keeper.NewQueryServer(s.keeper)
+
+	// other tests modify the shared fixture
+	btcusdt := btcusdt
+	btcusdt.Ticker.MinProviderCount = 1
+
+	for _, market := range []types.Market{btcusdt, usdtusd} {
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, market))
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, market.Ticker.CurrencyPair))
+	}
+
+	s.Run("invalid for nil request", func() {
+		_, err := qs.SimulateMarketMapUpdate(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("invalid for empty request", func() {
+		_, err := qs.SimulateMarketMapUpdate(s.ctx, &types.SimulateMarketMapUpdateRequest{})
+		s.Require().Error(err)
+	})
+
+	s.Run("valid update returns the diff without committing it", func() {
+		updated := btcusdt
+		updated.Ticker.Enabled = true
+		updated.ProviderConfigs = []types.ProviderConfig{
+			{
+				Name:            "kucoin",
+				OffChainTicker:  "btc-usd",
+				NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+			},
+		}
+
+		resp, err := qs.SimulateMarketMapUpdate(s.ctx, &types.SimulateMarketMapUpdateRequest{
+			Markets: []types.Market{updated, ethusdt},
+		})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.Errors)
+		s.Require().Equal([]types.MarketDiff{
+			{
+				Ticker:                 btcusdt.Ticker.String(),
+				DiffType:               types.MarketDiffType_MARKET_DIFF_TYPE_UPDATED,
+				EnabledChange:          types.EnabledChange_ENABLED_CHANGE_ENABLED,
+				AddedProviderConfigs:   updated.ProviderConfigs,
+				RemovedProviderConfigs: btcusdt.ProviderConfigs,
+			},
+			{
+				Ticker:               ethusdt.Ticker.String(),
+				DiffType:             types.MarketDiffType_MARKET_DIFF_TYPE_CREATED,
+				AddedProviderConfigs: ethusdt.ProviderConfigs,
+			},
+		}, resp.Diffs)
+
+		// the update is not committed to state
+		market, err := s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(btcusdt, market)
+
+		has, err := s.keeper.HasMarket(s.ctx, ethusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(has)
+
+		// nor are the changes of the hooks
+		s.Require().False(s.oracleKeeper.HasCurrencyPair(s.ctx, ethusdt.Ticker.CurrencyPair))
+	})
+
+	s.Run("invalid update returns the validation errors", func() {
+		invalid := ethusdt
+		invalid.ProviderConfigs = []types.ProviderConfig{
+			{
+				Name:            "kucoin",
+				OffChainTicker:  "eth-usd",
+				NormalizeByPair: &usdcusd.Ticker.CurrencyPair,
+			},
+		}
+
+		resp, err := qs.SimulateMarketMapUpdate(s.ctx, &types.SimulateMarketMapUpdateRequest{
+			Markets: []types.Market{invalid, invalid},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(resp.Diffs, 1)
+		s.Require().Len(resp.Errors, 2)
+		s.Require().Contains(resp.Errors[0], "duplicate ticker")
+		s.Require().Contains(resp.Errors[1], "invalid state resulting from update")
+	})
+
+	s.Run("update that fails in a hook returns the hook error", func() {
+		// the currency pair of the market is already tracked by x/oracle
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, ethusdt.Ticker.CurrencyPair))
+
+		resp, err := qs.SimulateMarketMapUpdate(s.ctx, &types.SimulateMarketMapUpdateRequest{
+			Markets: []types.Market{ethusdt},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(resp.Diffs, 1)
+		s.Require().Len(resp.Errors, 1)
+		s.Require().Contains(resp.Errors[0], "unable to run create market hook")
+	})
+}
+
+func (s *KeeperTestSuite) TestMarketMapFiltered() {
+	qs := keeper.NewQueryServer(s.keeper)
+
+	// other tests modify the shared fixtures
+	btcusdt := btcusdt
+	btcusdt.Ticker.MinProviderCount = 1
+
+	ethusdt := ethusdt
+	ethusdt.Ticker.Enabled = true
+	ethusdt.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:           "binance",
+			OffChainTicker: "ETHUSDT",
+		},
+	}
+
+	usdtusd := usdtusd
+	usdtusd.Ticker.Enabled = true
+
+	for _, market := range []types.Market{btcusdt, ethusdt, usdtusd} {
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, market))
+	}
+
+	s.Run("filter by enabled status", func() {
+		resp, err := qs.MarketMap(s.ctx, &types.MarketMapRequest{Enabled: types.EnabledFilter_ENABLED_FILTER_ENABLED})
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]types.Market{
+			ethusdt.Ticker.String(): ethusdt,
+			usdtusd.Ticker.String(): usdtusd,
+		}, resp.MarketMap.Markets)
+		s.Require().Nil(resp.Pagination)
+
+		resp, err = qs.MarketMap(s.ctx, &types.MarketMapRequest{Enabled: types.EnabledFilter_ENABLED_FILTER_DISABLED})
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]types.Market{
+			btcusdt.Ticker.String(): btcusdt,
+		}, resp.MarketMap.Markets)
+	})
+
+	s.Run("filter by provider and quote", func() {
+		resp, err := qs.MarketMap(s.ctx, &types.MarketMapRequest{Provider: "kucoin", Quote: "usdt"})
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]types.Market{
+			btcusdt.Ticker.String(): btcusdt,
+		}, resp.MarketMap.Markets)
+	})
+
+	s.Run("paginate filtered markets", func() {
+		resp, err := qs.MarketMap(s.ctx, &types.MarketMapRequest{
+			Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+			Quote:      "USDT",
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]types.Market{
+			btcusdt.Ticker.String(): btcusdt,
+		}, resp.MarketMap.Markets)
+		s.Require().Equal(uint64(2), resp.Pagination.Total)
+		s.Require().NotNil(resp.Pagination.NextKey)
+
+		resp, err = qs.MarketMap(s.ctx, &types.MarketMapRequest{
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1},
+			Quote:      "USDT",
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]types.Market{
+			ethusdt.Ticker.String(): ethusdt,
+		}, resp.MarketMap.Markets)
+
+		// the next key does not account for the filters, so the last page may be empty
+		resp, err = qs.MarketMap(s.ctx, &types.MarketMapRequest{
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1},
+			Quote:      "USDT",
+		})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.MarketMap.Markets)
+		s.Require().Nil(resp.Pagination.NextKey)
+	})
+}
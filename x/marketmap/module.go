@@ -28,7 +28,7 @@ import (
 
 // ConsensusVersion is the x/marketmap module's current version, as modules integrate and updates are made, this value determines what
 // version of the module is being run by the chain.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.HasName        = AppModule{}
@@ -51,9 +51,9 @@ func (amb AppModuleBasic) Name() string {
 	return types.ModuleName
 }
 
-// GetTxCmd is a no-op, as no txs are registered for submission (apart from messages that can only be executed by governance).
+// GetTxCmd returns the x/marketmap module base tx cli-command.
 func (amb AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.GetTxCmd()
 }
 
 // GetQueryCmd returns the x/marketmap module base query cli-command.
@@ -108,9 +108,9 @@ type AppModule struct {
 	k *keeper.Keeper
 }
 
-// BeginBlock is a no-op for x/marketmap.
-func (am AppModule) BeginBlock(_ context.Context) error {
-	return nil
+// BeginBlock applies the market updates that are scheduled for the current height.
+func (am AppModule) BeginBlock(goCtx context.Context) error {
+	return am.k.ApplyPendingMarketUpdates(sdk.UnwrapSDKContext(goCtx))
 }
 
 // EndBlock is a no-op for x/marketmap.
@@ -144,6 +144,12 @@ func (am AppModule) RegisterServices(cfc module.Configurator) {
 
 	// register Query Service
 	types.RegisterQueryServer(cfc.QueryServer(), keeper.NewQueryServer(am.k))
+
+	// register in-place store migrations
+	m := keeper.NewMigrator(am.k)
+	if err := cfc.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
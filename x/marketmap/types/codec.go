@@ -15,6 +15,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgCreateMarkets{}, "slinky/x/marketmap/MsgCreateMarkets")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateMarkets{}, "slinky/x/marketmap/MsgUpdateMarkets")
 	legacy.RegisterAminoMsg(cdc, &MsgParams{}, "slinky/x/marketmap/MsgParams")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveMarkets{}, "slinky/x/marketmap/MsgRemoveMarkets")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleMarketUpdates{}, "slinky/x/marketmap/MsgScheduleMarketUpdates")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelMarketUpdates{}, "slinky/x/marketmap/MsgCancelMarketUpdates")
 }
 
 // RegisterInterfaces registers the x/marketmap messages + message service w/ the InterfaceRegistry (registry).
@@ -24,6 +27,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgCreateMarkets{},
 		&MsgUpdateMarkets{},
 		&MsgParams{},
+		&MsgRemoveMarkets{},
+		&MsgScheduleMarketUpdates{},
+		&MsgCancelMarketUpdates{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
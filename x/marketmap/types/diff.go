@@ -0,0 +1,85 @@
+package types
+
+import (
+	"strconv"
+)
+
+// NewMarketDiff returns the changes that updating the existing market to the updated market makes. A nil
+// existing market means that the updated market is created. Provider configs are compared as a whole, so a
+// provider config that is changed is both removed and added.
+func NewMarketDiff(existing *Market, updated Market) MarketDiff {
+	diff := MarketDiff{
+		Ticker: updated.Ticker.String(),
+	}
+
+	if existing == nil {
+		diff.DiffType = MarketDiffType_MARKET_DIFF_TYPE_CREATED
+		if updated.Ticker.Enabled {
+			diff.EnabledChange = EnabledChange_ENABLED_CHANGE_ENABLED
+		}
+		diff.AddedProviderConfigs = append(diff.AddedProviderConfigs, updated.ProviderConfigs...)
+
+		return diff
+	}
+
+	if existing.Ticker.Decimals != updated.Ticker.Decimals {
+		diff.UpdatedFields = append(diff.UpdatedFields, FieldDiff{
+			Field:    "decimals",
+			OldValue: strconv.FormatUint(existing.Ticker.Decimals, 10),
+			NewValue: strconv.FormatUint(updated.Ticker.Decimals, 10),
+		})
+	}
+
+	if existing.Ticker.MinProviderCount != updated.Ticker.MinProviderCount {
+		diff.UpdatedFields = append(diff.UpdatedFields, FieldDiff{
+			Field:    "min_provider_count",
+			OldValue: strconv.FormatUint(existing.Ticker.MinProviderCount, 10),
+			NewValue: strconv.FormatUint(updated.Ticker.MinProviderCount, 10),
+		})
+	}
+
+	if existing.Ticker.Metadata_JSON != updated.Ticker.Metadata_JSON {
+		diff.UpdatedFields = append(diff.UpdatedFields, FieldDiff{
+			Field:    "metadata_JSON",
+			OldValue: existing.Ticker.Metadata_JSON,
+			NewValue: updated.Ticker.Metadata_JSON,
+		})
+	}
+
+	switch {
+	case !existing.Ticker.Enabled && updated.Ticker.Enabled:
+		diff.EnabledChange = EnabledChange_ENABLED_CHANGE_ENABLED
+	case existing.Ticker.Enabled && !updated.Ticker.Enabled:
+		diff.EnabledChange = EnabledChange_ENABLED_CHANGE_DISABLED
+	}
+
+	diff.AddedProviderConfigs = providerConfigsNotIn(updated.ProviderConfigs, existing.ProviderConfigs)
+	diff.RemovedProviderConfigs = providerConfigsNotIn(existing.ProviderConfigs, updated.ProviderConfigs)
+
+	if len(diff.UpdatedFields) > 0 || diff.EnabledChange != EnabledChange_ENABLED_CHANGE_NONE ||
+		len(diff.AddedProviderConfigs) > 0 || len(diff.RemovedProviderConfigs) > 0 {
+		diff.DiffType = MarketDiffType_MARKET_DIFF_TYPE_UPDATED
+	}
+
+	return diff
+}
+
+// providerConfigsNotIn returns the provider configs that do not have an equal provider config in others.
+func providerConfigsNotIn(providerConfigs, others []ProviderConfig) []ProviderConfig {
+	var notIn []ProviderConfig
+	for _, providerConfig := range providerConfigs {
+		found := false
+		for _, other := range others {
+			if providerConfig.Equal(other) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			notIn = append(notIn, providerConfig)
+		}
+	}
+
+	return notIn
+}
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestNewMarketDiff(t *testing.T) {
+	kucoin := types.ProviderConfig{Name: "kucoin", OffChainTicker: "btc-usd"}
+	binance := types.ProviderConfig{Name: "binance", OffChainTicker: "BTCUSD"}
+
+	market := types.Market{
+		Ticker:          types.NewTicker("BTC", "USD", 8, 1, true),
+		ProviderConfigs: []types.ProviderConfig{kucoin},
+	}
+
+	t.Run("created market", func(t *testing.T) {
+		require.Equal(t, types.MarketDiff{
+			Ticker:               "BTC/USD",
+			DiffType:             types.MarketDiffType_MARKET_DIFF_TYPE_CREATED,
+			EnabledChange:        types.EnabledChange_ENABLED_CHANGE_ENABLED,
+			AddedProviderConfigs: []types.ProviderConfig{kucoin},
+		}, types.NewMarketDiff(nil, market))
+	})
+
+	t.Run("unchanged market", func(t *testing.T) {
+		require.Equal(t, types.MarketDiff{
+			Ticker:   "BTC/USD",
+			DiffType: types.MarketDiffType_MARKET_DIFF_TYPE_UNCHANGED,
+		}, types.NewMarketDiff(&market, market))
+	})
+
+	t.Run("updated market", func(t *testing.T) {
+		updated := types.Market{
+			Ticker:          types.NewTicker("BTC", "USD", 9, 2, false),
+			ProviderConfigs: []types.ProviderConfig{binance, kucoin},
+		}
+		updated.Ticker.Metadata_JSON = "{}"
+
+		require.Equal(t, types.MarketDiff{
+			Ticker:   "BTC/USD",
+			DiffType: types.MarketDiffType_MARKET_DIFF_TYPE_UPDATED,
+			UpdatedFields: []types.FieldDiff{
+				{Field: "decimals", OldValue: "8", NewValue: "9"},
+				{Field: "min_provider_count", OldValue: "1", NewValue: "2"},
+				{Field: "metadata_JSON", OldValue: "", NewValue: "{}"},
+			},
+			EnabledChange:        types.EnabledChange_ENABLED_CHANGE_DISABLED,
+			AddedProviderConfigs: []types.ProviderConfig{binance},
+		}, types.NewMarketDiff(&market, updated))
+	})
+
+	t.Run("changed provider configs are removed and added", func(t *testing.T) {
+		inverted := kucoin
+		inverted.Invert = true
+
+		updated := market
+		updated.ProviderConfigs = []types.ProviderConfig{inverted}
+
+		diff := types.NewMarketDiff(&market, updated)
+		require.Equal(t, types.MarketDiffType_MARKET_DIFF_TYPE_UPDATED, diff.DiffType)
+		require.Equal(t, []types.ProviderConfig{inverted}, diff.AddedProviderConfigs)
+		require.Equal(t, []types.ProviderConfig{kucoin}, diff.RemovedProviderConfigs)
+	})
+}
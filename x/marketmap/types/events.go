@@ -5,9 +5,16 @@ package types
 const (
 	EventTypeCreateMarket = "create_market"
 	EventTypeUpdateMarket = "update_market"
+	EventTypeRemoveMarket = "remove_market"
+
+	EventTypeScheduleMarketUpdate = "schedule_market_update"
+	EventTypeCancelMarketUpdate   = "cancel_market_update"
+	EventTypeMarketUpdateFailed   = "market_update_failed"
 
 	AttributeKeyCurrencyPair     = "currency_pair"
 	AttributeKeyDecimals         = "decimals"
 	AttributeKeyMinProviderCount = "min_provider_count"
 	AttributeKeyMetadata         = "metadata"
+	AttributeKeyHeight           = "height"
+	AttributeKeyError            = "error"
 )
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // NewGenesisState returns an instance of GenesisState.
 func NewGenesisState(
 	marketMap MarketMap,
@@ -19,6 +21,23 @@ func (gs *GenesisState) ValidateBasic() error {
 		return err
 	}
 
+	seenUpdates := make(map[string]struct{}, len(gs.PendingMarketUpdates))
+	for _, update := range gs.PendingMarketUpdates {
+		key := fmt.Sprintf("%d/%s", update.Height, update.Market.Ticker.String())
+		if _, seen := seenUpdates[key]; seen {
+			return fmt.Errorf("duplicate pending update of market %s at height %d", update.Market.Ticker.String(), update.Height)
+		}
+		seenUpdates[key] = struct{}{}
+
+		if _, ok := gs.MarketMap.Markets[update.Market.Ticker.String()]; !ok {
+			return fmt.Errorf("pending update of market %s that is not in the market map", update.Market.Ticker.String())
+		}
+
+		if err := update.Market.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid pending update of market %s: %w", update.Market.Ticker.String(), err)
+		}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
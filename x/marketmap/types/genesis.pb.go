@@ -34,6 +34,9 @@ type GenesisState struct {
 	LastUpdated uint64 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
 	// Params are the parameters for the x/marketmap module.
 	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// PendingMarketUpdates are the market updates that are scheduled to be
+	// applied at a future height.
+	PendingMarketUpdates []PendingMarketUpdate `protobuf:"bytes,4,rep,name=pending_market_updates,json=pendingMarketUpdates,proto3" json:"pending_market_updates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +93,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPendingMarketUpdates() []PendingMarketUpdate {
+	if m != nil {
+		return m.PendingMarketUpdates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "slinky.marketmap.v1.GenesisState")
 }
@@ -97,25 +107,27 @@ func init() {
 func init() { proto.RegisterFile("slinky/marketmap/v1/genesis.proto", fileDescriptor_a621f29fb8bf99f4) }
 
 var fileDescriptor_a621f29fb8bf99f4 = []byte{
-	// 274 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0xce, 0xc9, 0xcc,
-	0xcb, 0xae, 0xd4, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xc9, 0x4d, 0x2c, 0xd0, 0x2f, 0x33, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x83, 0x2b, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb,
-	0x83, 0x58, 0x10, 0xa5, 0x52, 0x0a, 0xd8, 0x4c, 0x83, 0x70, 0xf0, 0xa9, 0x28, 0x48, 0x2c, 0x4a,
-	0xcc, 0x85, 0x5a, 0xa7, 0xb4, 0x91, 0x91, 0x8b, 0xc7, 0x1d, 0xe2, 0x80, 0xe0, 0x92, 0xc4, 0x92,
-	0x54, 0x21, 0x67, 0x2e, 0x2e, 0x88, 0xea, 0xf8, 0xdc, 0xc4, 0x02, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x6e, 0x23, 0x39, 0x3d, 0x2c, 0x8e, 0xd2, 0xf3, 0x05, 0x73, 0x7c, 0x13, 0x0b, 0x9c, 0x58, 0x4e,
-	0xdc, 0x93, 0x67, 0x08, 0xe2, 0xcc, 0x85, 0x09, 0x08, 0x29, 0x72, 0xf1, 0xe4, 0x24, 0x16, 0x97,
-	0xc4, 0x97, 0x16, 0xa4, 0x24, 0x96, 0xa4, 0xa6, 0x48, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x04, 0x71,
-	0x83, 0xc4, 0x42, 0x21, 0x42, 0x42, 0x96, 0x5c, 0x6c, 0x10, 0x87, 0x48, 0x30, 0x83, 0xed, 0x90,
-	0xc6, 0x6a, 0x47, 0x00, 0x58, 0x09, 0xd4, 0x02, 0xa8, 0x06, 0x27, 0xaf, 0x13, 0x8f, 0xe4, 0x18,
-	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
-	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce,
-	0xcf, 0xd5, 0x2f, 0xce, 0xce, 0x2c, 0xd0, 0xcd, 0x4d, 0x2d, 0xd3, 0x4f, 0xce, 0xcf, 0xcb, 0x4b,
-	0x4d, 0x2e, 0xd1, 0x2f, 0x33, 0xd2, 0xaf, 0x40, 0x0a, 0x89, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24,
-	0x36, 0x70, 0x30, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x33, 0x9d, 0x32, 0x5d, 0x9a, 0x01,
-	0x00, 0x00,
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xbf, 0x4b, 0xc3, 0x40,
+	0x14, 0xc7, 0x73, 0x6d, 0x29, 0x78, 0xed, 0x14, 0x8b, 0x84, 0x0a, 0x67, 0xea, 0x94, 0xc5, 0x3b,
+	0x5b, 0x27, 0xd7, 0x3a, 0x08, 0x42, 0x41, 0x2a, 0x2e, 0x2e, 0xe1, 0x9a, 0x1c, 0x31, 0xa4, 0xf7,
+	0x83, 0xdc, 0x35, 0xd8, 0xff, 0xc2, 0xcd, 0x7f, 0xa9, 0x63, 0x47, 0x27, 0x91, 0xe4, 0x1f, 0x91,
+	0xe6, 0xa2, 0x08, 0x06, 0xb7, 0xbb, 0xf7, 0x3e, 0xef, 0x7d, 0x3f, 0x3c, 0x38, 0xd1, 0xeb, 0x54,
+	0x64, 0x5b, 0xc2, 0x69, 0x9e, 0x31, 0xc3, 0xa9, 0x22, 0xc5, 0x94, 0x24, 0x4c, 0x30, 0x9d, 0x6a,
+	0xac, 0x72, 0x69, 0xa4, 0x7b, 0x6c, 0x11, 0xfc, 0x83, 0xe0, 0x62, 0x3a, 0x1e, 0x25, 0x32, 0x91,
+	0x75, 0x9f, 0x1c, 0x5e, 0x16, 0x1d, 0xfb, 0x6d, 0xdb, 0xec, 0xe7, 0x3f, 0x42, 0xd1, 0x9c, 0xf2,
+	0x26, 0xee, 0xfc, 0xad, 0x03, 0x87, 0xb7, 0x56, 0xe0, 0xc1, 0x50, 0xc3, 0xdc, 0x1b, 0x08, 0x2d,
+	0x1d, 0x72, 0xaa, 0x3c, 0xe0, 0x83, 0x60, 0x30, 0x43, 0xb8, 0x45, 0x0a, 0x2f, 0xea, 0xcf, 0x82,
+	0xaa, 0x79, 0x6f, 0xf7, 0x71, 0xe6, 0x2c, 0x8f, 0xf8, 0x77, 0xc1, 0x9d, 0xc0, 0xe1, 0x9a, 0x6a,
+	0x13, 0x6e, 0x54, 0x4c, 0x0d, 0x8b, 0xbd, 0x8e, 0x0f, 0x82, 0xde, 0x72, 0x70, 0xa8, 0x3d, 0xda,
+	0x92, 0x7b, 0x0d, 0xfb, 0x56, 0xc4, 0xeb, 0xd6, 0x19, 0xa7, 0xad, 0x19, 0xf7, 0x35, 0xd2, 0x04,
+	0x34, 0x03, 0x6e, 0x0c, 0x4f, 0x14, 0x13, 0x71, 0x2a, 0x92, 0xb0, 0x51, 0xb5, 0x39, 0xda, 0xeb,
+	0xf9, 0xdd, 0x60, 0x30, 0x0b, 0xda, 0x57, 0xd9, 0x11, 0x6b, 0x6d, 0x2d, 0x9a, 0xbd, 0x23, 0xf5,
+	0xb7, 0xa5, 0xe7, 0x77, 0xbb, 0x12, 0x81, 0x7d, 0x89, 0xc0, 0x67, 0x89, 0xc0, 0x6b, 0x85, 0x9c,
+	0x7d, 0x85, 0x9c, 0xf7, 0x0a, 0x39, 0x4f, 0x97, 0x49, 0x6a, 0x9e, 0x37, 0x2b, 0x1c, 0x49, 0x4e,
+	0x74, 0x96, 0xaa, 0x0b, 0xce, 0x0a, 0x12, 0x49, 0x21, 0x58, 0x64, 0x48, 0x31, 0x23, 0x2f, 0xbf,
+	0xee, 0x6d, 0xb6, 0x8a, 0xe9, 0x55, 0xbf, 0x3e, 0xf6, 0xd5, 0xd7, 0x00, 0xa6, 0x7c, 0x3e, 0xa0,
+	0x00, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -138,6 +150,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingMarketUpdates) > 0 {
+		for iNdEx := len(m.PendingMarketUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingMarketUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -190,6 +216,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingMarketUpdates) > 0 {
+		for _, e := range m.PendingMarketUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -313,6 +345,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMarketUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingMarketUpdates = append(m.PendingMarketUpdates, PendingMarketUpdate{})
+			if err := m.PendingMarketUpdates[len(m.PendingMarketUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
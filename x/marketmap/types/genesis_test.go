@@ -21,12 +21,36 @@ func TestGenesisState(t *testing.T) {
 		require.Error(t, gs.ValidateBasic())
 	})
 
+	t.Run("pending update of a market that is not in the market map - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.PendingMarketUpdates = []types.PendingMarketUpdate{{Height: 10, Market: btcusdt}}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("duplicate pending update - fail", func(t *testing.T) {
+		gs := types.GenesisState{
+			MarketMap: types.MarketMap{
+				Markets: markets,
+			},
+			Params: types.DefaultParams(),
+			PendingMarketUpdates: []types.PendingMarketUpdate{
+				{Height: 10, Market: btcusdt},
+				{Height: 10, Market: btcusdt},
+			},
+		}
+		require.Error(t, gs.ValidateBasic())
+	})
+
 	t.Run("good populated genesis state", func(t *testing.T) {
 		gs := types.GenesisState{
 			MarketMap: types.MarketMap{
 				Markets: markets,
 			},
 			Params: types.DefaultParams(),
+			PendingMarketUpdates: []types.PendingMarketUpdate{
+				{Height: 10, Market: btcusdt},
+				{Height: 11, Market: btcusdt},
+			},
 		}
 		require.NoError(t, gs.ValidateBasic())
 	})
@@ -22,11 +22,27 @@ var (
 	// ParamsPrefix is the key prefix of the module Params.
 	ParamsPrefix = collections.NewPrefix(3)
 
+	// PendingUpdatesPrefix is the key prefix for scheduled market updates.
+	PendingUpdatesPrefix = collections.NewPrefix(4)
+
+	// AuthorityChangesPrefix is the key prefix for the number of markets changed by each market authority in the
+	// current block.
+	AuthorityChangesPrefix = collections.NewPrefix(5)
+
 	// TickersCodec is the collections.KeyCodec value used for the markets map.
 	TickersCodec = codec.NewStringKeyCodec[TickerString]()
 
 	// LastUpdatedCodec is the collections.KeyCodec value used for the lastUpdated value.
 	LastUpdatedCodec = codec.KeyToValueCodec[uint64](codec.NewUint64Key[uint64]())
+
+	// PendingUpdatesCodec is the collections.KeyCodec value used for the pending updates map. Pending updates
+	// are keyed by the height they are applied at, followed by the ticker of the updated market.
+	PendingUpdatesCodec = collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)
+
+	// AuthorityChangesCodec is the collections.ValueCodec value used for the authority changes map. The value is
+	// the height of the last block the authority changed markets in, followed by the number of markets changed in
+	// that block.
+	AuthorityChangesCodec = codec.KeyToValueCodec(collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key))
 )
 
 // TickerString is the key used to identify unique pairs of Base/Quote with corresponding PathsConfig objects--or in other words AggregationConfigs.
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 )
 
 // ValidateBasic validates the market map configuration and its expected configuration.
@@ -12,6 +14,8 @@ import (
 //		   markets are supported by the market map.
 //		2. Ensure that each provider config has a valid corresponding ticker.
 //	 	3. Ensure that all normalization markets are enabled.
+//		4. Ensure that no market can only be normalized by its own index price (i.e. a cycle).
+//		5. Ensure that each derived market is derived from a market that is not derived.
 func (mm *MarketMap) ValidateBasic() error {
 	for ticker, market := range mm.Markets {
 		if err := market.ValidateBasic(); err != nil {
@@ -24,20 +28,131 @@ func (mm *MarketMap) ValidateBasic() error {
 		}
 
 		for _, providerConfig := range market.ProviderConfigs {
-			if providerConfig.NormalizeByPair != nil {
-				normalizeMarket, found := mm.Markets[providerConfig.NormalizeByPair.String()]
+			for _, hop := range providerConfig.NormalizationPath() {
+				normalizeMarket, found := mm.Markets[hop.CurrencyPair.String()]
 				if !found {
-					return fmt.Errorf("provider's (%s) pair for normalization (%s) was not found in the marketmap", providerConfig.Name, providerConfig.NormalizeByPair.String())
+					return fmt.Errorf("provider's (%s) pair for normalization (%s) was not found in the marketmap", providerConfig.Name, hop.CurrencyPair.String())
 				}
 
 				if !normalizeMarket.Ticker.Enabled && market.Ticker.Enabled {
 					return fmt.Errorf("enabled market %s cannot have use a normalization market %s that is disabled", market.Ticker.String(), normalizeMarket.Ticker.String())
 				}
+
+				if normalizeMarket.Ticker.IsDerived() {
+					return fmt.Errorf("market %s cannot be normalized by the derived market %s", market.Ticker.String(), normalizeMarket.Ticker.String())
+				}
+			}
+		}
+
+		// Derived markets have no provider configs as they are derived from their source market.
+		derived, err := market.Ticker.DerivedTicker()
+		if err != nil {
+			return err
+		}
+
+		if derived != nil {
+			sourceMarket, found := mm.Markets[derived.Source]
+			if !found {
+				return fmt.Errorf("source market (%s) of derived market %s was not found in the marketmap", derived.Source, market.Ticker.String())
+			}
+
+			if !sourceMarket.Ticker.Enabled && market.Ticker.Enabled {
+				return fmt.Errorf("enabled derived market %s cannot be derived from a market %s that is disabled", market.Ticker.String(), sourceMarket.Ticker.String())
+			}
+
+			if sourceMarket.Ticker.IsDerived() {
+				return fmt.Errorf("derived market %s cannot be derived from the derived market %s", market.Ticker.String(), sourceMarket.Ticker.String())
 			}
 		}
 	}
 
-	return nil
+	return mm.ValidateNormalizationDependencies()
+}
+
+// NormalizationDependencies returns the tickers of the markets whose index prices are required to
+// normalize the prices of the given market, in the order they are first used by its provider configs.
+func (m *Market) NormalizationDependencies() []string {
+	var (
+		dependencies []string
+		seen         = make(map[string]struct{})
+	)
+
+	for _, providerConfig := range m.ProviderConfigs {
+		for _, hop := range providerConfig.NormalizationPath() {
+			ticker := hop.CurrencyPair.String()
+			if _, ok := seen[ticker]; ok {
+				continue
+			}
+
+			seen[ticker] = struct{}{}
+			dependencies = append(dependencies, ticker)
+		}
+	}
+
+	return dependencies
+}
+
+// ValidateNormalizationDependencies ensures that the index price of every market can be resolved
+// without depending on its own index price. A market can be resolved if at least one of its provider
+// configs only depends on markets that can be resolved. Markets that depend on each other (e.g. USDT/USD
+// normalized by ETH/USD, and ETH/USD normalized by USDT/USD) are valid as long as one of them can be
+// resolved through a different provider config, otherwise they form a normalization cycle.
+func (mm *MarketMap) ValidateNormalizationDependencies() error {
+	resolved := make(map[string]struct{}, len(mm.Markets))
+
+	// Resolve markets until no more markets can be resolved.
+	for progress := true; progress; {
+		progress = false
+
+		for ticker, market := range mm.Markets {
+			if _, ok := resolved[ticker]; ok {
+				continue
+			}
+
+			// A derived market can be resolved once its source market is resolved.
+			if derived, err := market.Ticker.DerivedTicker(); err == nil && derived != nil {
+				if _, ok := resolved[derived.Source]; ok {
+					resolved[ticker] = struct{}{}
+					progress = true
+				}
+
+				continue
+			}
+
+			for _, providerConfig := range market.ProviderConfigs {
+				resolvable := true
+				for _, hop := range providerConfig.NormalizationPath() {
+					if _, ok := resolved[hop.CurrencyPair.String()]; !ok {
+						resolvable = false
+						break
+					}
+				}
+
+				if resolvable {
+					resolved[ticker] = struct{}{}
+					progress = true
+					break
+				}
+			}
+		}
+	}
+
+	if len(resolved) == len(mm.Markets) {
+		return nil
+	}
+
+	unresolved := make([]string, 0, len(mm.Markets)-len(resolved))
+	for ticker := range mm.Markets {
+		if _, ok := resolved[ticker]; !ok {
+			unresolved = append(unresolved, ticker)
+		}
+	}
+	slices.Sort(unresolved)
+
+	return fmt.Errorf(
+		"normalization cycle detected: markets %s can only be normalized by their own index prices",
+		strings.Join(unresolved, ", "),
+	)
 }
 
 // String returns the string representation of the market map.
@@ -54,6 +169,16 @@ func (m *Market) ValidateBasic() error {
 		return err
 	}
 
+	// Derived markets are calculated from the index price history of their source market, and
+	// cannot be fetched from providers.
+	if m.Ticker.IsDerived() {
+		if len(m.ProviderConfigs) > 0 {
+			return fmt.Errorf("derived ticker %q cannot have provider configs", m.Ticker.String())
+		}
+
+		return nil
+	}
+
 	if uint64(len(m.ProviderConfigs)) < m.Ticker.MinProviderCount {
 		return fmt.Errorf(
 			"ticker %q must have at least %d providers; got %d",
@@ -76,6 +201,17 @@ func (m *Market) ValidateBasic() error {
 		}
 		seenProviders[key] = struct{}{}
 
+		// a multi-hop normalization path must end at the quote of the ticker.
+		if hops := providerConfig.NormalizeByPairs; len(hops) > 0 {
+			if last := hops[len(hops)-1]; last.Quote() != m.Ticker.CurrencyPair.Quote {
+				return fmt.Errorf(
+					"provider's (%s) normalization path ends at %s; expected %s",
+					providerConfig.Name,
+					last.Quote(),
+					m.Ticker.CurrencyPair.Quote,
+				)
+			}
+		}
 	}
 
 	return nil
@@ -183,6 +183,18 @@ type ProviderConfig struct {
 	// Invert is a boolean indicating if the BASE and QUOTE of the market should
 	// be inverted. i.e. BASE -> QUOTE, QUOTE -> BASE
 	Invert bool `protobuf:"varint,4,opt,name=invert,proto3" json:"invert,omitempty"`
+	// Weight is the relative weight of this provider's price when the oracle
+	// computes the weighted median for the ticker, i.e. a deep venue can be
+	// given more weight than a thin one. A weight of zero is treated as the
+	// default weight of one. This field is optional.
+	Weight uint64 `protobuf:"varint,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	// NormalizeByPairs is an ordered list of currency pairs that this ticker is
+	// normalized by, one after the other. This allows a market to be reached
+	// through multiple hops i.e. if the desired Ticker is TOKEN/USD, this market
+	// could be reached using: OffChainTicker = TOKEN/WETH NormalizeByPairs =
+	// [WETH/USDT, USDT/USD]. This field is optional and cannot be set alongside
+	// NormalizeByPair.
+	NormalizeByPairs []NormalizationPair `protobuf:"bytes,6,rep,name=normalize_by_pairs,json=normalizeByPairs,proto3" json:"normalize_by_pairs"`
 	// MetadataJSON is a string of JSON that encodes any extra configuration
 	// for the given provider config.
 	Metadata_JSON string `protobuf:"bytes,15,opt,name=metadata_JSON,json=metadataJSON,proto3" json:"metadata_JSON,omitempty"`
@@ -249,6 +261,20 @@ func (m *ProviderConfig) GetInvert() bool {
 	return false
 }
 
+func (m *ProviderConfig) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *ProviderConfig) GetNormalizeByPairs() []NormalizationPair {
+	if m != nil {
+		return m.NormalizeByPairs
+	}
+	return nil
+}
+
 func (m *ProviderConfig) GetMetadata_JSON() string {
 	if m != nil {
 		return m.Metadata_JSON
@@ -256,6 +282,62 @@ func (m *ProviderConfig) GetMetadata_JSON() string {
 	return ""
 }
 
+// NormalizationPair is a single hop of a multi-hop normalization path.
+type NormalizationPair struct {
+	// CurrencyPair is the currency pair whose index price is used for this hop.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// Invert is a boolean indicating if the index price of the currency pair
+	// should be inverted for this hop. i.e. USD/USDT can be used as a USDT/USD
+	// hop.
+	Invert bool `protobuf:"varint,2,opt,name=invert,proto3" json:"invert,omitempty"`
+}
+
+func (m *NormalizationPair) Reset()      { *m = NormalizationPair{} }
+func (*NormalizationPair) ProtoMessage() {}
+func (*NormalizationPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fefe265720fc8a78, []int{3}
+}
+func (m *NormalizationPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NormalizationPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NormalizationPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NormalizationPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NormalizationPair.Merge(m, src)
+}
+func (m *NormalizationPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *NormalizationPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_NormalizationPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NormalizationPair proto.InternalMessageInfo
+
+func (m *NormalizationPair) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *NormalizationPair) GetInvert() bool {
+	if m != nil {
+		return m.Invert
+	}
+	return false
+}
+
 // MarketMap maps ticker strings to their Markets.
 type MarketMap struct {
 	// Markets is the full list of tickers and their associated configurations
@@ -266,7 +348,7 @@ type MarketMap struct {
 func (m *MarketMap) Reset()      { *m = MarketMap{} }
 func (*MarketMap) ProtoMessage() {}
 func (*MarketMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fefe265720fc8a78, []int{3}
+	return fileDescriptor_fefe265720fc8a78, []int{4}
 }
 func (m *MarketMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,53 +384,117 @@ func (m *MarketMap) GetMarkets() map[string]Market {
 	return nil
 }
 
+// PendingMarketUpdate is an update of an existing market that is scheduled to
+// be applied at a future height.
+type PendingMarketUpdate struct {
+	// Height is the height at the beginning of which the update is applied.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Market is the updated market.
+	Market Market `protobuf:"bytes,2,opt,name=market,proto3" json:"market"`
+}
+
+func (m *PendingMarketUpdate) Reset()         { *m = PendingMarketUpdate{} }
+func (m *PendingMarketUpdate) String() string { return proto.CompactTextString(m) }
+func (*PendingMarketUpdate) ProtoMessage()    {}
+func (*PendingMarketUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fefe265720fc8a78, []int{5}
+}
+func (m *PendingMarketUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMarketUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingMarketUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMarketUpdate.Merge(m, src)
+}
+func (m *PendingMarketUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingMarketUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMarketUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingMarketUpdate proto.InternalMessageInfo
+
+func (m *PendingMarketUpdate) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PendingMarketUpdate) GetMarket() Market {
+	if m != nil {
+		return m.Market
+	}
+	return Market{}
+}
+
 func init() {
 	proto.RegisterType((*Market)(nil), "slinky.marketmap.v1.Market")
 	proto.RegisterType((*Ticker)(nil), "slinky.marketmap.v1.Ticker")
 	proto.RegisterType((*ProviderConfig)(nil), "slinky.marketmap.v1.ProviderConfig")
+	proto.RegisterType((*NormalizationPair)(nil), "slinky.marketmap.v1.NormalizationPair")
 	proto.RegisterType((*MarketMap)(nil), "slinky.marketmap.v1.MarketMap")
 	proto.RegisterMapType((map[string]Market)(nil), "slinky.marketmap.v1.MarketMap.MarketsEntry")
+	proto.RegisterType((*PendingMarketUpdate)(nil), "slinky.marketmap.v1.PendingMarketUpdate")
 }
 
 func init() { proto.RegisterFile("slinky/marketmap/v1/market.proto", fileDescriptor_fefe265720fc8a78) }
 
 var fileDescriptor_fefe265720fc8a78 = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x8b, 0xd3, 0x4e,
-	0x14, 0xcf, 0x6c, 0xfb, 0xef, 0xb6, 0xb3, 0xdd, 0xb6, 0xff, 0x51, 0x24, 0x54, 0xcc, 0x86, 0xf6,
-	0x12, 0x50, 0x13, 0x5b, 0x2f, 0xba, 0xc7, 0x16, 0x41, 0x57, 0x56, 0x97, 0xb8, 0x20, 0x78, 0x09,
-	0xd3, 0x74, 0xda, 0x1d, 0xda, 0xcc, 0x84, 0xc9, 0x34, 0x58, 0x4f, 0x7e, 0x04, 0x8f, 0x1e, 0x05,
-	0x3f, 0x86, 0x5f, 0x60, 0x8f, 0x7b, 0x12, 0x0f, 0x22, 0xd2, 0xe2, 0xf7, 0x90, 0x4c, 0xa6, 0xdd,
-	0x16, 0x2a, 0xec, 0xed, 0xbd, 0x37, 0xbf, 0xf9, 0xbd, 0xf9, 0xfd, 0xde, 0x1b, 0x68, 0x27, 0x53,
-	0xca, 0x26, 0x73, 0x2f, 0xc2, 0x62, 0x42, 0x64, 0x84, 0x63, 0x2f, 0xed, 0xe8, 0xc4, 0x8d, 0x05,
-	0x97, 0x1c, 0xdd, 0xca, 0x11, 0xee, 0x1a, 0xe1, 0xa6, 0x9d, 0xe6, 0xed, 0x31, 0x1f, 0x73, 0x75,
-	0xee, 0x65, 0x51, 0x0e, 0x6d, 0xb6, 0x35, 0x99, 0x9c, 0xc7, 0x24, 0xc9, 0x88, 0xc2, 0x99, 0x10,
-	0x84, 0x85, 0xf3, 0x20, 0xc6, 0x54, 0xe4, 0xa0, 0xd6, 0x57, 0x00, 0x4b, 0xa7, 0x8a, 0x0b, 0x3d,
-	0x85, 0x25, 0x49, 0xc3, 0x09, 0x11, 0x26, 0xb0, 0x81, 0x73, 0xd0, 0xbd, 0xeb, 0xee, 0xe8, 0xe5,
-	0x9e, 0x2b, 0x48, 0xaf, 0x78, 0xf9, 0xeb, 0xc8, 0xf0, 0xf5, 0x05, 0x74, 0x0e, 0x1b, 0xb1, 0xe0,
-	0x29, 0x1d, 0x12, 0x11, 0x84, 0x9c, 0x8d, 0xe8, 0x38, 0x31, 0xf7, 0xec, 0x82, 0x73, 0xd0, 0x6d,
-	0xef, 0x24, 0x39, 0xd3, 0xe0, 0xbe, 0xc2, 0x6a, 0xb2, 0x7a, 0xbc, 0x55, 0x4d, 0x8e, 0xcb, 0x9f,
-	0xbf, 0x1c, 0x19, 0x1f, 0x7f, 0xda, 0x46, 0xeb, 0x0f, 0x80, 0xa5, 0xbc, 0x31, 0x7a, 0x0e, 0x0f,
-	0xb7, 0x74, 0xe8, 0xc7, 0xde, 0x5b, 0xf5, 0x51, 0x6a, 0xb3, 0x1e, 0x7d, 0x8d, 0x3a, 0xc3, 0x74,
-	0xf5, 0xdc, 0x6a, 0xb8, 0x51, 0x43, 0x4d, 0x58, 0x1e, 0x92, 0x90, 0x46, 0x78, 0x9a, 0x3d, 0x16,
-	0x38, 0x45, 0x7f, 0x9d, 0xa3, 0x07, 0x10, 0x45, 0x94, 0x05, 0x1b, 0xa2, 0x66, 0x4c, 0x9a, 0x05,
-	0x85, 0x6a, 0x44, 0x94, 0x5d, 0x0b, 0x98, 0x31, 0x89, 0x4c, 0xb8, 0x4f, 0x18, 0x1e, 0x4c, 0xc9,
-	0xd0, 0xac, 0xd9, 0xc0, 0x29, 0xfb, 0xab, 0x14, 0xb5, 0xe1, 0x61, 0x44, 0x24, 0x1e, 0x62, 0x89,
-	0x83, 0x93, 0x37, 0xaf, 0x5f, 0x99, 0x75, 0x1b, 0x38, 0x15, 0xbf, 0xba, 0x2a, 0x66, 0xb5, 0x0d,
-	0x9d, 0xdf, 0x01, 0xac, 0x6d, 0x7b, 0x83, 0x10, 0x2c, 0x32, 0x1c, 0x11, 0x25, 0xb3, 0xe2, 0xab,
-	0x18, 0x39, 0xb0, 0xc1, 0x47, 0xa3, 0x20, 0xbc, 0xc0, 0x94, 0x05, 0x7a, 0x66, 0x7b, 0xea, 0xbc,
-	0xc6, 0x47, 0xa3, 0x7e, 0x56, 0xd6, 0x6e, 0xbd, 0x80, 0xff, 0x33, 0x2e, 0x22, 0x3c, 0xa5, 0x1f,
-	0x48, 0x30, 0xd0, 0x8e, 0x15, 0x6e, 0xe0, 0x98, 0x5f, 0x5f, 0xdf, 0xeb, 0xe5, 0x76, 0xdd, 0x81,
-	0x25, 0xca, 0x52, 0x22, 0xa4, 0x59, 0x54, 0x1a, 0x75, 0x76, 0x23, 0x89, 0xad, 0x6f, 0x00, 0x56,
-	0xf2, 0x35, 0x3b, 0xc5, 0x31, 0x7a, 0x09, 0xf7, 0xf3, 0x75, 0x48, 0x4c, 0xa0, 0xb6, 0xe4, 0xfe,
-	0xce, 0x2d, 0x59, 0x5f, 0xd0, 0x51, 0xf2, 0x8c, 0x49, 0x31, 0xd7, 0xb3, 0x5c, 0x31, 0x34, 0xdf,
-	0xc2, 0xea, 0xe6, 0x31, 0x6a, 0xc0, 0xc2, 0x84, 0xcc, 0xb5, 0x5f, 0x59, 0x88, 0x3a, 0xf0, 0xbf,
-	0x14, 0x4f, 0x67, 0x44, 0x79, 0xf4, 0xaf, 0xbd, 0xce, 0x39, 0xfc, 0x1c, 0x79, 0xbc, 0xf7, 0x04,
-	0x5c, 0x8f, 0xa5, 0x77, 0x72, 0xb9, 0xb0, 0xc0, 0xd5, 0xc2, 0x02, 0xbf, 0x17, 0x16, 0xf8, 0xb4,
-	0xb4, 0x8c, 0xab, 0xa5, 0x65, 0xfc, 0x58, 0x5a, 0xc6, 0xbb, 0x47, 0x63, 0x2a, 0x2f, 0x66, 0x03,
-	0x37, 0xe4, 0x91, 0x97, 0x4c, 0x68, 0xfc, 0x30, 0x22, 0xa9, 0x17, 0x72, 0xc6, 0x48, 0x28, 0xbd,
-	0xb4, 0xeb, 0xbd, 0xdf, 0xf8, 0xca, 0xca, 0xe7, 0x41, 0x49, 0xfd, 0xbb, 0xc7, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xd1, 0x9c, 0x8f, 0x90, 0xeb, 0x03, 0x00, 0x00,
+	// 641 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0xaf, 0xdb, 0xae, 0xdb, 0xbc, 0x7f, 0x9d, 0x87, 0x50, 0x54, 0x44, 0x17, 0x6d, 0x12, 0x8a,
+	0x04, 0x24, 0x6c, 0x5c, 0x60, 0xc7, 0x4d, 0x48, 0x30, 0xb4, 0x31, 0x85, 0x21, 0xa4, 0x5d, 0x22,
+	0x37, 0x71, 0x53, 0xab, 0x8d, 0x1d, 0x25, 0x6e, 0xa0, 0x5c, 0xe0, 0x23, 0x70, 0xe4, 0x88, 0xc4,
+	0xc7, 0xe0, 0x0b, 0x4c, 0x9c, 0x76, 0xe4, 0x80, 0x10, 0xda, 0xc4, 0xf7, 0x40, 0xb1, 0x9d, 0x2e,
+	0x63, 0x15, 0xea, 0x81, 0x9b, 0xdf, 0xf3, 0xcf, 0xbf, 0xf7, 0x7e, 0xbf, 0x67, 0x1b, 0x9a, 0xe9,
+	0x80, 0xb2, 0xfe, 0xc8, 0x89, 0x70, 0xd2, 0x27, 0x22, 0xc2, 0xb1, 0x93, 0x6d, 0xe9, 0xc0, 0x8e,
+	0x13, 0x2e, 0x38, 0x5a, 0x53, 0x08, 0x7b, 0x8c, 0xb0, 0xb3, 0xad, 0xd6, 0x8d, 0x90, 0x87, 0x5c,
+	0xee, 0x3b, 0xf9, 0x4a, 0x41, 0x5b, 0x9b, 0x9a, 0x4c, 0x8c, 0x62, 0x92, 0xe6, 0x44, 0xfe, 0x30,
+	0x49, 0x08, 0xf3, 0x47, 0x5e, 0x8c, 0x69, 0xa2, 0x40, 0x1b, 0x5f, 0x00, 0x6c, 0x1c, 0x48, 0x2e,
+	0xf4, 0x18, 0x36, 0x04, 0xf5, 0xfb, 0x24, 0x31, 0x80, 0x09, 0xac, 0x85, 0xed, 0x5b, 0xf6, 0x84,
+	0x5a, 0xf6, 0xb1, 0x84, 0xec, 0xd6, 0x4f, 0x7f, 0xae, 0x57, 0x5c, 0x7d, 0x00, 0x1d, 0xc3, 0x66,
+	0x9c, 0xf0, 0x8c, 0x06, 0x24, 0xf1, 0x7c, 0xce, 0xba, 0x34, 0x4c, 0x8d, 0xaa, 0x59, 0xb3, 0x16,
+	0xb6, 0x37, 0x27, 0x92, 0x1c, 0x69, 0xf0, 0x9e, 0xc4, 0x6a, 0xb2, 0x95, 0xf8, 0x4a, 0x36, 0xdd,
+	0x99, 0xfb, 0xf4, 0x79, 0xbd, 0xf2, 0xe1, 0x87, 0x59, 0xd9, 0xf8, 0x0d, 0x60, 0x43, 0x15, 0x46,
+	0x4f, 0xe1, 0xd2, 0x15, 0x1d, 0xba, 0xd9, 0xdb, 0x45, 0x1d, 0xa9, 0x36, 0xaf, 0xb1, 0xa7, 0x51,
+	0x47, 0x98, 0x16, 0xed, 0x2e, 0xfa, 0xa5, 0x1c, 0x6a, 0xc1, 0xb9, 0x80, 0xf8, 0x34, 0xc2, 0x83,
+	0xbc, 0x59, 0x60, 0xd5, 0xdd, 0x71, 0x8c, 0xee, 0x41, 0x14, 0x51, 0xe6, 0x95, 0x44, 0x0d, 0x99,
+	0x30, 0x6a, 0x12, 0xd5, 0x8c, 0x28, 0xbb, 0x14, 0x30, 0x64, 0x02, 0x19, 0x70, 0x96, 0x30, 0xdc,
+	0x19, 0x90, 0xc0, 0x58, 0x36, 0x81, 0x35, 0xe7, 0x16, 0x21, 0xda, 0x84, 0x4b, 0x11, 0x11, 0x38,
+	0xc0, 0x02, 0x7b, 0xfb, 0x2f, 0x5f, 0x1c, 0x1a, 0x2b, 0x26, 0xb0, 0xe6, 0xdd, 0xc5, 0x22, 0x99,
+	0xe7, 0x4a, 0x3a, 0xbf, 0x55, 0xe1, 0xf2, 0x55, 0x6f, 0x10, 0x82, 0x75, 0x86, 0x23, 0x22, 0x65,
+	0xce, 0xbb, 0x72, 0x8d, 0x2c, 0xd8, 0xe4, 0xdd, 0xae, 0xe7, 0xf7, 0x30, 0x65, 0x9e, 0x9e, 0x59,
+	0x55, 0xee, 0x2f, 0xf3, 0x6e, 0x77, 0x2f, 0x4f, 0x6b, 0xb7, 0x9e, 0xc1, 0x55, 0xc6, 0x93, 0x08,
+	0x0f, 0xe8, 0x3b, 0xe2, 0x75, 0xb4, 0x63, 0xb5, 0x29, 0x1c, 0x73, 0x57, 0xc6, 0xe7, 0x76, 0x95,
+	0x5d, 0x37, 0x61, 0x83, 0xb2, 0x8c, 0x24, 0xc2, 0xa8, 0x4b, 0x8d, 0x3a, 0xca, 0xf3, 0x6f, 0x08,
+	0x0d, 0x7b, 0xc2, 0x98, 0x91, 0xf6, 0xe8, 0x08, 0x9d, 0x40, 0x74, 0xad, 0x74, 0x6a, 0x34, 0xe4,
+	0xad, 0xb8, 0x33, 0xf1, 0x56, 0x1c, 0x6a, 0x38, 0x16, 0x94, 0xb3, 0xd2, 0xd8, 0x9a, 0x7f, 0xb5,
+	0x92, 0x4e, 0x65, 0xeb, 0xc6, 0x7b, 0xb8, 0x7a, 0x8d, 0xf1, 0x3f, 0x5e, 0x9f, 0x4b, 0x3f, 0xaa,
+	0x65, 0x3f, 0x4a, 0xd3, 0xfc, 0x0a, 0xe0, 0xbc, 0x7a, 0x5b, 0x07, 0x38, 0x46, 0xcf, 0xe1, 0xac,
+	0x52, 0x9b, 0x1a, 0x40, 0x9a, 0x70, 0x77, 0xa2, 0x09, 0xe3, 0x03, 0x7a, 0x95, 0x3e, 0x61, 0x22,
+	0x19, 0xe9, 0x0e, 0x0a, 0x86, 0xd6, 0x6b, 0xb8, 0x58, 0xde, 0x46, 0x4d, 0x58, 0xeb, 0x93, 0x91,
+	0xbe, 0x24, 0xf9, 0x12, 0x6d, 0xc1, 0x99, 0x0c, 0x0f, 0x86, 0xc4, 0xa8, 0xfe, 0xe3, 0x31, 0x2b,
+	0x0e, 0x57, 0x21, 0x77, 0xaa, 0x8f, 0x40, 0xa9, 0xfb, 0x1e, 0x5c, 0x3b, 0x22, 0x2c, 0xa0, 0x2c,
+	0x54, 0xa8, 0x57, 0x71, 0x80, 0x05, 0xc9, 0x65, 0xf7, 0xd4, 0xb8, 0x81, 0x1a, 0xb7, 0x8a, 0xf2,
+	0xdf, 0x43, 0x51, 0x4f, 0x51, 0xb0, 0xf8, 0x3d, 0xd4, 0xd6, 0xee, 0xfe, 0xe9, 0x79, 0x1b, 0x9c,
+	0x9d, 0xb7, 0xc1, 0xaf, 0xf3, 0x36, 0xf8, 0x78, 0xd1, 0xae, 0x9c, 0x5d, 0xb4, 0x2b, 0xdf, 0x2f,
+	0xda, 0x95, 0x93, 0x07, 0x21, 0x15, 0xbd, 0x61, 0xc7, 0xf6, 0x79, 0xe4, 0xa4, 0x7d, 0x1a, 0xdf,
+	0x8f, 0x48, 0xe6, 0xf8, 0x9c, 0x31, 0xe2, 0x0b, 0x27, 0xdb, 0x76, 0xde, 0x96, 0x7e, 0x4a, 0x39,
+	0xb9, 0x4e, 0x43, 0x7e, 0x6b, 0x0f, 0xff, 0x0c, 0x00, 0xb5, 0xae, 0xa4, 0x99, 0x4a, 0x05, 0x00,
+	0x00,
 }
 
 func (m *Market) Marshal() (dAtA []byte, err error) {
@@ -485,6 +631,25 @@ func (m *ProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x7a
 	}
+	if len(m.NormalizeByPairs) > 0 {
+		for iNdEx := len(m.NormalizeByPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NormalizeByPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Weight != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Invert {
 		i--
 		if m.Invert {
@@ -524,6 +689,49 @@ func (m *ProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NormalizationPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NormalizationPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NormalizationPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Invert {
+		i--
+		if m.Invert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MarketMap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -571,6 +779,44 @@ func (m *MarketMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingMarketUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMarket(v)
 	base := offset
@@ -644,6 +890,15 @@ func (m *ProviderConfig) Size() (n int) {
 	if m.Invert {
 		n += 2
 	}
+	if m.Weight != 0 {
+		n += 1 + sovMarket(uint64(m.Weight))
+	}
+	if len(m.NormalizeByPairs) > 0 {
+		for _, e := range m.NormalizeByPairs {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
 	l = len(m.Metadata_JSON)
 	if l > 0 {
 		n += 1 + l + sovMarket(uint64(l))
@@ -651,6 +906,20 @@ func (m *ProviderConfig) Size() (n int) {
 	return n
 }
 
+func (m *NormalizationPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.Invert {
+		n += 2
+	}
+	return n
+}
+
 func (m *MarketMap) Size() (n int) {
 	if m == nil {
 		return 0
@@ -669,6 +938,20 @@ func (m *MarketMap) Size() (n int) {
 	return n
 }
 
+func (m *PendingMarketUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMarket(uint64(m.Height))
+	}
+	l = m.Market.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
 func sovMarket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1114,6 +1397,59 @@ func (m *ProviderConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Invert = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizeByPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizeByPairs = append(m.NormalizeByPairs, NormalizationPair{})
+			if err := m.NormalizeByPairs[len(m.NormalizeByPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 15:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata_JSON", wireType)
@@ -1167,6 +1503,109 @@ func (m *ProviderConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NormalizationPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NormalizationPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NormalizationPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Invert = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MarketMap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1346,6 +1785,108 @@ func (m *MarketMap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingMarketUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingMarketUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingMarketUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMarket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
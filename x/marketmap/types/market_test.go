@@ -316,6 +316,295 @@ func TestMarketMapValidateBasic(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "valid multi-hop normalization",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					usdtusd.Ticker.String(): usdtusd,
+					ethusdt.Ticker.String(): ethusdt,
+					"TOKEN/USD": {
+						Ticker: types.Ticker{
+							CurrencyPair:     slinkytypes.NewCurrencyPair("TOKEN", "USD"),
+							Decimals:         8,
+							MinProviderCount: 1,
+						},
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:           "raydium",
+								OffChainTicker: "TOKEN/ETHEREUM",
+								NormalizeByPairs: []types.NormalizationPair{
+									{CurrencyPair: ethusdt.Ticker.CurrencyPair},
+									{CurrencyPair: usdtusd.Ticker.CurrencyPair},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "multi-hop normalization that does not end at the ticker quote",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					ethusdt.Ticker.String(): ethusdt,
+					"TOKEN/USD": {
+						Ticker: types.Ticker{
+							CurrencyPair:     slinkytypes.NewCurrencyPair("TOKEN", "USD"),
+							Decimals:         8,
+							MinProviderCount: 1,
+						},
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:           "raydium",
+								OffChainTicker: "TOKEN/ETHEREUM",
+								NormalizeByPairs: []types.NormalizationPair{
+									{CurrencyPair: ethusdt.Ticker.CurrencyPair},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "multi-hop normalization with a missing hop market",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					ethusdt.Ticker.String(): ethusdt,
+					"TOKEN/USD": {
+						Ticker: types.Ticker{
+							CurrencyPair:     slinkytypes.NewCurrencyPair("TOKEN", "USD"),
+							Decimals:         8,
+							MinProviderCount: 1,
+						},
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:           "raydium",
+								OffChainTicker: "TOKEN/ETHEREUM",
+								NormalizeByPairs: []types.NormalizationPair{
+									{CurrencyPair: ethusdt.Ticker.CurrencyPair},
+									{CurrencyPair: usdtusd.Ticker.CurrencyPair},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "normalization cycle",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					"USDT/USD": {
+						Ticker: usdtusd.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:            "kucoin",
+								OffChainTicker:  "usdt-usdc",
+								NormalizeByPair: &usdcusd.Ticker.CurrencyPair,
+							},
+						},
+					},
+					"USDC/USD": {
+						Ticker: usdcusd.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:           "kucoin",
+								OffChainTicker: "usdc-usdt",
+								NormalizeByPairs: []types.NormalizationPair{
+									{CurrencyPair: usdtusd.Ticker.CurrencyPair},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid normalization cycle that can be resolved by a direct provider",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					"USDT/USD": {
+						Ticker: usdtusd.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:           "kucoin",
+								OffChainTicker: "usdt-usd",
+							},
+							{
+								Name:            "kucoin",
+								OffChainTicker:  "usdt-usdc",
+								NormalizeByPair: &usdcusd.Ticker.CurrencyPair,
+							},
+						},
+					},
+					"USDC/USD": {
+						Ticker: usdcusd.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:            "kucoin",
+								OffChainTicker:  "usdc-usdt",
+								NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+							},
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "market normalized by itself",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					"USDT/USD": {
+						Ticker: usdtusd.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:            "kucoin",
+								OffChainTicker:  "usdt-usd",
+								NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid derived market",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					usdtusd.Ticker.String(): usdtusd,
+					"USDTTWAP/USD": {
+						Ticker: types.Ticker{
+							CurrencyPair:     slinkytypes.NewCurrencyPair("USDTTWAP", "USD"),
+							Decimals:         8,
+							MinProviderCount: 1,
+							Enabled:          true,
+							Metadata_JSON:    `{"derived":{"source":"USDT/USD","method":"twap","window_seconds":300}}`,
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "derived market with provider configs",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					usdtusd.Ticker.String(): usdtusd,
+					"USDTTWAP/USD": {
+						Ticker: types.Ticker{
+							CurrencyPair:     slinkytypes.NewCurrencyPair("USDTTWAP", "USD"),
+							Decimals:         8,
+							MinProviderCount: 1,
+							Metadata_JSON:    `{"derived":{"source":"USDT/USD","method":"twap","window_seconds":300}}`,
+						},
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:           "kucoin",
+								OffChainTicker: "usdt-usd",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "derived market with a missing source",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					"USDTTWAP/USD": {
+						Ticker: types.Ticker{
+							CurrencyPair:     slinkytypes.NewCurrencyPair("USDTTWAP", "USD"),
+							Decimals:         8,
+							MinProviderCount: 1,
+							Metadata_JSON:    `{"derived":{"source":"USDT/USD","method":"twap","window_seconds":300}}`,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "enabled derived market with a disabled source",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					usdtusdDisabled.Ticker.String(): usdtusdDisabled,
+					"USDTTWAP/USD": {
+						Ticker: types.Ticker{
+							CurrencyPair:     slinkytypes.NewCurrencyPair("USDTTWAP", "USD"),
+							Decimals:         8,
+							MinProviderCount: 1,
+							Enabled:          true,
+							Metadata_JSON:    `{"derived":{"source":"USDT/USD","method":"ema","periods":30}}`,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "derived market with a derived source",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					usdtusd.Ticker.String(): usdtusd,
+					"USDTTWAP/USD": {
+						Ticker: types.Ticker{
+							CurrencyPair:     slinkytypes.NewCurrencyPair("USDTTWAP", "USD"),
+							Decimals:         8,
+							MinProviderCount: 1,
+							Metadata_JSON:    `{"derived":{"source":"USDT/USD","method":"twap","window_seconds":300}}`,
+						},
+					},
+					"USDTEMA/USD": {
+						Ticker: types.Ticker{
+							CurrencyPair:     slinkytypes.NewCurrencyPair("USDTEMA", "USD"),
+							Decimals:         8,
+							MinProviderCount: 1,
+							Metadata_JSON:    `{"derived":{"source":"USDTTWAP/USD","method":"ema","periods":30}}`,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "market normalized by a derived market",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					usdtusd.Ticker.String(): usdtusd,
+					"USDTTWAP/USD": {
+						Ticker: types.Ticker{
+							CurrencyPair:     slinkytypes.NewCurrencyPair("USDTTWAP", "USD"),
+							Decimals:         8,
+							MinProviderCount: 1,
+							Metadata_JSON:    `{"derived":{"source":"USDT/USD","method":"twap","window_seconds":300}}`,
+						},
+					},
+					"BTC/USD": {
+						Ticker: types.Ticker{
+							CurrencyPair:     slinkytypes.NewCurrencyPair("BTC", "USD"),
+							Decimals:         8,
+							MinProviderCount: 1,
+						},
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:            "kucoin",
+								OffChainTicker:  "btc-usdt",
+								NormalizeByPair: &slinkytypes.CurrencyPair{Base: "USDTTWAP", Quote: "USD"},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "valid single provider",
 			marketMap: types.MarketMap{
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
 )
 
 var (
@@ -12,6 +14,9 @@ var (
 	_ sdk.Msg = &MsgParams{}
 	_ sdk.Msg = &MsgRemoveMarketAuthorities{}
 	_ sdk.Msg = &MsgUpsertMarkets{}
+	_ sdk.Msg = &MsgRemoveMarkets{}
+	_ sdk.Msg = &MsgScheduleMarketUpdates{}
+	_ sdk.Msg = &MsgCancelMarketUpdates{}
 )
 
 // ValidateBasic asserts that the authority address in the upsert-markets message is formatted correctly.
@@ -124,3 +129,99 @@ func (m *MsgRemoveMarketAuthorities) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address, and that the markets to remove are valid and not repeated.
+func (m *MsgRemoveMarkets) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if len(m.Markets) == 0 {
+		return fmt.Errorf("no markets to remove")
+	}
+
+	seenTickers := make(map[string]struct{}, len(m.Markets))
+	for _, ticker := range m.Markets {
+		if _, seen := seenTickers[ticker]; seen {
+			return fmt.Errorf("duplicate ticker: %s", ticker)
+		}
+
+		if _, err := slinkytypes.CurrencyPairFromString(ticker); err != nil {
+			return fmt.Errorf("invalid ticker %s: %w", ticker, err)
+		}
+
+		seenTickers[ticker] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address, the height is set, and the markets to update are valid and
+// not repeated.
+func (m *MsgScheduleMarketUpdates) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if m.Height == 0 {
+		return fmt.Errorf("height must be set")
+	}
+
+	if len(m.Markets) == 0 {
+		return fmt.Errorf("no markets to update")
+	}
+
+	seenTickers := make(map[string]struct{}, len(m.Markets))
+	for _, market := range m.Markets {
+		ticker := market.Ticker.String()
+
+		if _, seen := seenTickers[ticker]; seen {
+			return fmt.Errorf("duplicate ticker: %s", ticker)
+		}
+
+		if err := market.ValidateBasic(); err != nil {
+			return err
+		}
+
+		seenTickers[ticker] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address, the height is set, and the tickers of the cancelled updates are
+// valid and not repeated.
+func (m *MsgCancelMarketUpdates) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return err
+	}
+
+	if m.Height == 0 {
+		return fmt.Errorf("height must be set")
+	}
+
+	if len(m.Markets) == 0 {
+		return fmt.Errorf("no market updates to cancel")
+	}
+
+	seenTickers := make(map[string]struct{}, len(m.Markets))
+	for _, ticker := range m.Markets {
+		if _, seen := seenTickers[ticker]; seen {
+			return fmt.Errorf("duplicate ticker: %s", ticker)
+		}
+
+		if _, err := slinkytypes.CurrencyPairFromString(ticker); err != nil {
+			return fmt.Errorf("invalid ticker %s: %w", ticker, err)
+		}
+
+		seenTickers[ticker] = struct{}{}
+	}
+
+	return nil
+}
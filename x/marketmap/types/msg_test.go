@@ -516,3 +516,195 @@ func TestValidateBasicMsgRemoveMarketAuthorities(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBasicMsgRemoveMarkets(t *testing.T) {
+	rng := sample.Rand()
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgRemoveMarkets
+		expectPass bool
+	}{
+		{
+			"if the Authority is not an acc-address - fail",
+			types.MsgRemoveMarkets{
+				Authority: "invalid",
+				Markets:   []string{"BTC/USD"},
+			},
+			false,
+		},
+		{
+			name: "invalid message (no markets) - fail",
+			msg: types.MsgRemoveMarkets{
+				Authority: sample.Address(rng),
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (invalid ticker) - fail",
+			msg: types.MsgRemoveMarkets{
+				Authority: sample.Address(rng),
+				Markets:   []string{"BTCUSD"},
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (duplicate tickers) - fail",
+			msg: types.MsgRemoveMarkets{
+				Authority: sample.Address(rng),
+				Markets:   []string{"BTC/USD", "BTC/USD"},
+			},
+			expectPass: false,
+		},
+		{
+			name: "valid message",
+			msg: types.MsgRemoveMarkets{
+				Authority: sample.Address(rng),
+				Markets:   []string{"BTC/USD", "ETH/USD"},
+			},
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgScheduleMarketUpdates(t *testing.T) {
+	rng := sample.Rand()
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgScheduleMarketUpdates
+		expectPass bool
+	}{
+		{
+			"if the Authority is not an acc-address - fail",
+			types.MsgScheduleMarketUpdates{
+				Authority: "invalid",
+				Height:    10,
+				Markets:   []types.Market{btcusdt},
+			},
+			false,
+		},
+		{
+			name: "invalid message (no height) - fail",
+			msg: types.MsgScheduleMarketUpdates{
+				Authority: sample.Address(rng),
+				Markets:   []types.Market{btcusdt},
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (no markets) - fail",
+			msg: types.MsgScheduleMarketUpdates{
+				Authority: sample.Address(rng),
+				Height:    10,
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (duplicate markets) - fail",
+			msg: types.MsgScheduleMarketUpdates{
+				Authority: sample.Address(rng),
+				Height:    10,
+				Markets:   []types.Market{btcusdt, btcusdt},
+			},
+			expectPass: false,
+		},
+		{
+			name: "valid message",
+			msg: types.MsgScheduleMarketUpdates{
+				Authority: sample.Address(rng),
+				Height:    10,
+				Markets:   []types.Market{btcusdt, usdtusd},
+			},
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgCancelMarketUpdates(t *testing.T) {
+	rng := sample.Rand()
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgCancelMarketUpdates
+		expectPass bool
+	}{
+		{
+			"if the Admin is not an acc-address - fail",
+			types.MsgCancelMarketUpdates{
+				Admin:   "invalid",
+				Height:  10,
+				Markets: []string{"BTC/USD"},
+			},
+			false,
+		},
+		{
+			name: "invalid message (no height) - fail",
+			msg: types.MsgCancelMarketUpdates{
+				Admin:   sample.Address(rng),
+				Markets: []string{"BTC/USD"},
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (invalid ticker) - fail",
+			msg: types.MsgCancelMarketUpdates{
+				Admin:   sample.Address(rng),
+				Height:  10,
+				Markets: []string{"BTCUSD"},
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (duplicate tickers) - fail",
+			msg: types.MsgCancelMarketUpdates{
+				Admin:   sample.Address(rng),
+				Height:  10,
+				Markets: []string{"BTC/USD", "BTC/USD"},
+			},
+			expectPass: false,
+		},
+		{
+			name: "valid message",
+			msg: types.MsgCancelMarketUpdates{
+				Admin:   sample.Address(rng),
+				Height:  10,
+				Markets: []string{"BTC/USD", "ETH/USD"},
+			},
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
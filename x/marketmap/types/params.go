@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -51,5 +52,104 @@ func (p *Params) ValidateBasic() error {
 		return fmt.Errorf("invalid marketmap admin string: %w", err)
 	}
 
+	seenRoles := make(map[string]struct{}, len(p.MarketAuthorityRoles))
+	for _, role := range p.MarketAuthorityRoles {
+		if _, seen := seenRoles[role.Address]; seen {
+			return fmt.Errorf("duplicate role for market authority %s found", role.Address)
+		}
+
+		if _, ok := seenAuthorities[role.Address]; !ok {
+			return fmt.Errorf("role for %s that is not a market authority", role.Address)
+		}
+
+		if err := role.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid role for market authority %s: %w", role.Address, err)
+		}
+
+		seenRoles[role.Address] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetMarketAuthorityRole returns the role of the given market authority, and whether the authority has a role.
+func (p *Params) GetMarketAuthorityRole(authority string) (MarketAuthorityRole, bool) {
+	for _, role := range p.MarketAuthorityRoles {
+		if role.Address == authority {
+			return role, true
+		}
+	}
+
+	return MarketAuthorityRole{}, false
+}
+
+// ValidateBasic performs stateless validation of the MarketAuthorityRole.
+func (r *MarketAuthorityRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return fmt.Errorf("invalid market authority string: %w", err)
+	}
+
+	seenQuotes := make(map[string]struct{}, len(r.AllowedQuotes))
+	for _, quote := range r.AllowedQuotes {
+		if quote == "" {
+			return fmt.Errorf("allowed quote cannot be empty")
+		}
+
+		if _, seen := seenQuotes[quote]; seen {
+			return fmt.Errorf("duplicate allowed quote %s found", quote)
+		}
+
+		seenQuotes[quote] = struct{}{}
+	}
+
+	seenProviders := make(map[string]struct{}, len(r.AllowedProviders))
+	for _, provider := range r.AllowedProviders {
+		if provider == "" {
+			return fmt.Errorf("allowed provider cannot be empty")
+		}
+
+		if _, seen := seenProviders[provider]; seen {
+			return fmt.Errorf("duplicate allowed provider %s found", provider)
+		}
+
+		seenProviders[provider] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateMarket checks that updating the existing market to the given market is within the scope of the role. A nil
+// existing market means that the market is created. The quote asset of the market must be allowed, and every provider
+// config that is added, removed or changed must be of an allowed provider. Roles restricted to providers can only
+// change the provider configs of existing markets, and not their tickers, except for enabling or disabling them if
+// the role is enable / disable only.
+func (r *MarketAuthorityRole) ValidateMarket(existing *Market, market Market) error {
+	if len(r.AllowedQuotes) > 0 && !slices.Contains(r.AllowedQuotes, market.Ticker.CurrencyPair.Quote) {
+		return fmt.Errorf("market authority %s is not allowed to change markets quoted in %s", r.Address, market.Ticker.CurrencyPair.Quote)
+	}
+
+	if len(r.AllowedProviders) == 0 {
+		return nil
+	}
+
+	diff := NewMarketDiff(existing, market)
+	if existing != nil {
+		if len(diff.UpdatedFields) > 0 {
+			return fmt.Errorf("market authority %s is not allowed to change the ticker of %s", r.Address, market.Ticker.String())
+		}
+
+		if diff.EnabledChange != EnabledChange_ENABLED_CHANGE_NONE && !r.EnableDisableOnly {
+			return fmt.Errorf("market authority %s is not allowed to enable or disable %s", r.Address, market.Ticker.String())
+		}
+	}
+
+	for _, providerConfigs := range [][]ProviderConfig{diff.AddedProviderConfigs, diff.RemovedProviderConfigs} {
+		for _, providerConfig := range providerConfigs {
+			if !slices.Contains(r.AllowedProviders, providerConfig.Name) {
+				return fmt.Errorf("market authority %s is not allowed to configure markets with provider %s", r.Address, providerConfig.Name)
+			}
+		}
+	}
+
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -30,6 +31,10 @@ type Params struct {
 	// Admin is an address that can remove addresses from the MarketAuthorities
 	// list. Only governance can add to the MarketAuthorities or change the Admin.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// MarketAuthorityRoles restricts the market updates that individual
+	// MarketAuthorities are allowed to make. MarketAuthorities without a role are
+	// allowed to make any market update.
+	MarketAuthorityRoles []MarketAuthorityRole `protobuf:"bytes,3,rep,name=market_authority_roles,json=marketAuthorityRoles,proto3" json:"market_authority_roles"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,27 +84,139 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetMarketAuthorityRoles() []MarketAuthorityRole {
+	if m != nil {
+		return m.MarketAuthorityRoles
+	}
+	return nil
+}
+
+// MarketAuthorityRole restricts the market updates that a market authority is
+// allowed to make. Market authorities without a role are unrestricted.
+type MarketAuthorityRole struct {
+	// Address is the address of the market authority that the role applies to.
+	// It must be one of the MarketAuthorities.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// AllowedQuotes is the list of quote assets of the markets that the
+	// authority is allowed to change. If empty, markets of any quote asset can be
+	// changed.
+	AllowedQuotes []string `protobuf:"bytes,2,rep,name=allowed_quotes,json=allowedQuotes,proto3" json:"allowed_quotes,omitempty"`
+	// AllowedProviders is the list of providers whose provider configs the
+	// authority is allowed to add, remove or change. An authority restricted to
+	// providers cannot change the tickers of existing markets. If empty, any
+	// provider can be used.
+	AllowedProviders []string `protobuf:"bytes,3,rep,name=allowed_providers,json=allowedProviders,proto3" json:"allowed_providers,omitempty"`
+	// EnableDisableOnly restricts the authority to enabling and disabling
+	// existing markets. Such an authority cannot create, remove or otherwise
+	// change markets.
+	EnableDisableOnly bool `protobuf:"varint,4,opt,name=enable_disable_only,json=enableDisableOnly,proto3" json:"enable_disable_only,omitempty"`
+	// MaxMarketsPerBlock is the maximum number of markets that the authority can
+	// change in a single block. If zero, the number of markets is not limited.
+	MaxMarketsPerBlock uint64 `protobuf:"varint,5,opt,name=max_markets_per_block,json=maxMarketsPerBlock,proto3" json:"max_markets_per_block,omitempty"`
+}
+
+func (m *MarketAuthorityRole) Reset()         { *m = MarketAuthorityRole{} }
+func (m *MarketAuthorityRole) String() string { return proto.CompactTextString(m) }
+func (*MarketAuthorityRole) ProtoMessage()    {}
+func (*MarketAuthorityRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee4934564ff92a6f, []int{1}
+}
+func (m *MarketAuthorityRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketAuthorityRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketAuthorityRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketAuthorityRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketAuthorityRole.Merge(m, src)
+}
+func (m *MarketAuthorityRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketAuthorityRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketAuthorityRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketAuthorityRole proto.InternalMessageInfo
+
+func (m *MarketAuthorityRole) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MarketAuthorityRole) GetAllowedQuotes() []string {
+	if m != nil {
+		return m.AllowedQuotes
+	}
+	return nil
+}
+
+func (m *MarketAuthorityRole) GetAllowedProviders() []string {
+	if m != nil {
+		return m.AllowedProviders
+	}
+	return nil
+}
+
+func (m *MarketAuthorityRole) GetEnableDisableOnly() bool {
+	if m != nil {
+		return m.EnableDisableOnly
+	}
+	return false
+}
+
+func (m *MarketAuthorityRole) GetMaxMarketsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxMarketsPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "slinky.marketmap.v1.Params")
+	proto.RegisterType((*MarketAuthorityRole)(nil), "slinky.marketmap.v1.MarketAuthorityRole")
 }
 
 func init() { proto.RegisterFile("slinky/marketmap/v1/params.proto", fileDescriptor_ee4934564ff92a6f) }
 
 var fileDescriptor_ee4934564ff92a6f = []byte{
-	// 198 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0xce, 0xc9, 0xcc,
-	0xcb, 0xae, 0xd4, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xc9, 0x4d, 0x2c, 0xd0, 0x2f, 0x33, 0xd4,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xa8,
-	0xd0, 0x83, 0xab, 0xd0, 0x2b, 0x33, 0x54, 0xf2, 0xe5, 0x62, 0x0b, 0x00, 0x2b, 0x12, 0xd2, 0xe5,
-	0x12, 0x82, 0xc8, 0xc4, 0x27, 0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x96, 0x64, 0xa6, 0x16, 0x4b,
-	0x30, 0x2a, 0x30, 0x6b, 0x70, 0x06, 0x09, 0x42, 0x64, 0x1c, 0x11, 0x12, 0x42, 0x22, 0x5c, 0xac,
-	0x89, 0x29, 0xb9, 0x99, 0x79, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x93, 0xd7,
-	0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c,
-	0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa4, 0x67, 0x96, 0x64, 0x94,
-	0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0x67, 0x67, 0x16, 0xe8, 0xe6, 0xa6, 0x96, 0xe9, 0x27,
-	0xe7, 0xe7, 0xe5, 0xa5, 0x26, 0x97, 0xe8, 0x97, 0x19, 0xe9, 0x57, 0x20, 0xb9, 0xbc, 0xa4, 0xb2,
-	0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x6c, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x25, 0xf1,
-	0xe0, 0xdb, 0xda, 0x00, 0x00, 0x00,
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xdd, 0xea, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0x7d, 0xe9, 0x22, 0x8a, 0xcb, 0xa6, 0x14, 0x0f, 0x6a, 0x19, 0x08, 0x05, 0x59,
+	0xe3, 0xe6, 0x15, 0x38, 0x3c, 0x12, 0xc4, 0xd9, 0x43, 0x4f, 0x4a, 0xda, 0x86, 0x2d, 0x34, 0x69,
+	0x6a, 0x92, 0xd5, 0xf5, 0x2e, 0xbc, 0x17, 0x6f, 0x62, 0x87, 0x3b, 0xf4, 0x48, 0x64, 0x03, 0xaf,
+	0x43, 0xda, 0x6c, 0xfa, 0xff, 0xd8, 0x51, 0xf2, 0xbe, 0xbf, 0xe7, 0x7d, 0x79, 0x1e, 0x12, 0xe8,
+	0x6b, 0xce, 0x8a, 0xbc, 0xc6, 0x82, 0xa8, 0x9c, 0x1a, 0x41, 0x4a, 0x5c, 0xcd, 0x71, 0x49, 0x14,
+	0x11, 0x3a, 0x2c, 0x95, 0x34, 0x12, 0x8d, 0xad, 0x22, 0xfc, 0xa7, 0x08, 0xab, 0xf9, 0x8b, 0xc9,
+	0x5a, 0xae, 0x65, 0xcb, 0x71, 0x73, 0xb3, 0xd2, 0xe9, 0x0f, 0x00, 0x07, 0xab, 0x76, 0x16, 0xcd,
+	0x20, 0xb2, 0x03, 0x31, 0xd9, 0x9a, 0x8d, 0x54, 0xcc, 0x30, 0xaa, 0x5d, 0xe0, 0x77, 0x83, 0x61,
+	0x34, 0xb2, 0xe4, 0xdd, 0x7f, 0x80, 0x26, 0xb0, 0x4f, 0x32, 0xc1, 0x0a, 0xb7, 0xe3, 0x83, 0x60,
+	0x18, 0xd9, 0x02, 0x65, 0xf0, 0xf9, 0x9d, 0x25, 0x75, 0xac, 0x24, 0xa7, 0xda, 0xed, 0xfa, 0xdd,
+	0xe0, 0xd1, 0x22, 0x08, 0xaf, 0x78, 0x0b, 0x3f, 0xde, 0xda, 0x5e, 0x47, 0x92, 0xd3, 0x65, 0x6f,
+	0xff, 0xeb, 0xa5, 0x13, 0x4d, 0xc4, 0x7d, 0xa4, 0xa7, 0x7f, 0x00, 0x1c, 0x5f, 0x99, 0x41, 0x2e,
+	0x7c, 0x40, 0xb2, 0x4c, 0x51, 0xdd, 0xf8, 0x6e, 0x5c, 0x5d, 0x4a, 0xf4, 0x0a, 0x3e, 0x21, 0x9c,
+	0xcb, 0x6f, 0x34, 0x8b, 0xbf, 0x6e, 0xa5, 0xa1, 0xda, 0xed, 0xb4, 0xc1, 0x1e, 0x9f, 0xbb, 0x9f,
+	0xdb, 0x26, 0x7a, 0x0d, 0x47, 0x17, 0x59, 0xa9, 0x64, 0xc5, 0x32, 0xaa, 0xac, 0xf3, 0x61, 0xf4,
+	0xf4, 0x0c, 0x56, 0x97, 0x3e, 0x0a, 0xe1, 0x98, 0x16, 0x24, 0xe1, 0x34, 0xce, 0x98, 0x6e, 0x4f,
+	0x59, 0xf0, 0xda, 0xed, 0xf9, 0x20, 0x78, 0x18, 0x8d, 0x2c, 0x7a, 0x6f, 0xc9, 0xa7, 0x82, 0xd7,
+	0x68, 0x0e, 0x9f, 0x09, 0xb2, 0x8b, 0x6d, 0x22, 0x1d, 0x97, 0x54, 0xc5, 0x09, 0x97, 0x69, 0xee,
+	0xf6, 0x7d, 0x10, 0xf4, 0x22, 0x24, 0xc8, 0xce, 0x86, 0xd2, 0x2b, 0xaa, 0x96, 0x0d, 0x59, 0x7e,
+	0xd8, 0x1f, 0x3d, 0x70, 0x38, 0x7a, 0xe0, 0xf7, 0xd1, 0x03, 0xdf, 0x4f, 0x9e, 0x73, 0x38, 0x79,
+	0xce, 0xcf, 0x93, 0xe7, 0x7c, 0x79, 0xb3, 0x66, 0x66, 0xb3, 0x4d, 0xc2, 0x54, 0x0a, 0xac, 0x73,
+	0x56, 0xce, 0x04, 0xad, 0x70, 0x2a, 0x8b, 0x82, 0xa6, 0x06, 0x57, 0x0b, 0xbc, 0xbb, 0xf1, 0x3f,
+	0x4c, 0x5d, 0x52, 0x9d, 0x0c, 0xda, 0x17, 0x7f, 0xfb, 0x77, 0x00, 0x38, 0x51, 0x76, 0x95, 0x40,
+	0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +239,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MarketAuthorityRoles) > 0 {
+		for iNdEx := len(m.MarketAuthorityRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketAuthorityRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -141,6 +272,69 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MarketAuthorityRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketAuthorityRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketAuthorityRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxMarketsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMarketsPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EnableDisableOnly {
+		i--
+		if m.EnableDisableOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AllowedProviders) > 0 {
+		for iNdEx := len(m.AllowedProviders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedProviders[iNdEx])
+			copy(dAtA[i:], m.AllowedProviders[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedProviders[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedQuotes) > 0 {
+		for iNdEx := len(m.AllowedQuotes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedQuotes[iNdEx])
+			copy(dAtA[i:], m.AllowedQuotes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedQuotes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -168,6 +362,43 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if len(m.MarketAuthorityRoles) > 0 {
+		for _, e := range m.MarketAuthorityRoles {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketAuthorityRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.AllowedQuotes) > 0 {
+		for _, s := range m.AllowedQuotes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AllowedProviders) > 0 {
+		for _, s := range m.AllowedProviders {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.EnableDisableOnly {
+		n += 2
+	}
+	if m.MaxMarketsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxMarketsPerBlock))
+	}
 	return n
 }
 
@@ -270,6 +501,225 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketAuthorityRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketAuthorityRoles = append(m.MarketAuthorityRoles, MarketAuthorityRole{})
+			if err := m.MarketAuthorityRoles[len(m.MarketAuthorityRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketAuthorityRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketAuthorityRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketAuthorityRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedQuotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedQuotes = append(m.AllowedQuotes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedProviders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedProviders = append(m.AllowedProviders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableDisableOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableDisableOnly = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMarketsPerBlock", wireType)
+			}
+			m.MaxMarketsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMarketsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
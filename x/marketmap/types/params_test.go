@@ -7,6 +7,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
+	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
 )
 
@@ -63,6 +64,56 @@ func TestValidateBasic(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "valid market authority role",
+			params: types.Params{
+				MarketAuthorities: []string{authtypes.NewModuleAddress(authtypes.ModuleName).String(), authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+				Admin:             authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				MarketAuthorityRoles: []types.MarketAuthorityRole{
+					{
+						Address:            authtypes.NewModuleAddress(authtypes.ModuleName).String(),
+						AllowedQuotes:      []string{"USD", "USDT"},
+						AllowedProviders:   []string{"binance_ws"},
+						MaxMarketsPerBlock: 10,
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid role for an address that is not a market authority",
+			params: types.Params{
+				MarketAuthorities: []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+				Admin:             authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				MarketAuthorityRoles: []types.MarketAuthorityRole{
+					{Address: authtypes.NewModuleAddress(authtypes.ModuleName).String()},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid duplicate market authority role",
+			params: types.Params{
+				MarketAuthorities: []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+				Admin:             authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				MarketAuthorityRoles: []types.MarketAuthorityRole{
+					{Address: authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+					{Address: authtypes.NewModuleAddress(govtypes.ModuleName).String(), EnableDisableOnly: true},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid duplicate allowed quote",
+			params: types.Params{
+				MarketAuthorities: []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+				Admin:             authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				MarketAuthorityRoles: []types.MarketAuthorityRole{
+					{Address: authtypes.NewModuleAddress(govtypes.ModuleName).String(), AllowedQuotes: []string{"USD", "USD"}},
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name:      "invalid empty params",
 			params:    types.Params{},
@@ -82,3 +133,122 @@ func TestValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMarketAuthorityRoleValidateMarket(t *testing.T) {
+	existing := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("BTC", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{Name: "kucoin", OffChainTicker: "btc-usd"},
+			{Name: "binance", OffChainTicker: "BTCUSD"},
+		},
+	}
+
+	withProviderConfigs := func(providerConfigs ...types.ProviderConfig) types.Market {
+		market := existing
+		market.ProviderConfigs = providerConfigs
+		return market
+	}
+
+	enabled := existing
+	enabled.Ticker.Enabled = true
+
+	updatedTicker := existing
+	updatedTicker.Ticker.Decimals = 10
+
+	scoped := types.MarketAuthorityRole{Address: "scoped", AllowedQuotes: []string{"USD"}, AllowedProviders: []string{"kucoin"}}
+	scopedToggler := types.MarketAuthorityRole{Address: "toggler", AllowedProviders: []string{"kucoin"}, EnableDisableOnly: true}
+
+	testCases := []struct {
+		name      string
+		role      types.MarketAuthorityRole
+		existing  *types.Market
+		market    types.Market
+		expectErr bool
+	}{
+		{
+			name:      "create a market with other providers",
+			role:      scoped,
+			market:    existing,
+			expectErr: true,
+		},
+		{
+			name:   "create a market with allowed providers",
+			role:   scoped,
+			market: withProviderConfigs(types.ProviderConfig{Name: "kucoin", OffChainTicker: "btc-usd"}),
+		},
+		{
+			name:     "change an allowed provider config",
+			role:     scoped,
+			existing: &existing,
+			market: withProviderConfigs(
+				types.ProviderConfig{Name: "kucoin", OffChainTicker: "BTC-USD"},
+				types.ProviderConfig{Name: "binance", OffChainTicker: "BTCUSD"},
+			),
+		},
+		{
+			name:     "change another provider config",
+			role:     scoped,
+			existing: &existing,
+			market: withProviderConfigs(
+				types.ProviderConfig{Name: "kucoin", OffChainTicker: "btc-usd"},
+				types.ProviderConfig{Name: "binance", OffChainTicker: "BTC_USD"},
+			),
+			expectErr: true,
+		},
+		{
+			name:      "remove another provider config",
+			role:      scoped,
+			existing:  &existing,
+			market:    withProviderConfigs(types.ProviderConfig{Name: "kucoin", OffChainTicker: "btc-usd"}),
+			expectErr: true,
+		},
+		{
+			name:      "change the ticker",
+			role:      scoped,
+			existing:  &existing,
+			market:    updatedTicker,
+			expectErr: true,
+		},
+		{
+			name:      "enable a market",
+			role:      scoped,
+			existing:  &existing,
+			market:    enabled,
+			expectErr: true,
+		},
+		{
+			name:     "enable a market with an enable / disable only role",
+			role:     scopedToggler,
+			existing: &existing,
+			market:   enabled,
+		},
+		{
+			name:      "change a market of another quote",
+			role:      scoped,
+			market:    types.Market{Ticker: types.Ticker{CurrencyPair: slinkytypes.NewCurrencyPair("BTC", "USDT")}},
+			expectErr: true,
+		},
+		{
+			name:     "change the ticker with an unrestricted role",
+			role:     types.MarketAuthorityRole{Address: "unrestricted"},
+			existing: &existing,
+			market:   updatedTicker,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.role.ValidateMarket(tc.existing, tc.market)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
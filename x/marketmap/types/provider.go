@@ -6,6 +6,17 @@ import (
 	"github.com/skip-mev/connect/v2/pkg/json"
 )
 
+const (
+	// DefaultProviderWeight is the weight given to a provider config that does not
+	// set a weight.
+	DefaultProviderWeight = 1
+	// MaxProviderWeight is the maximum weight that can be given to a provider config.
+	MaxProviderWeight = 10_000
+	// MaxNormalizationHops is the maximum number of normalization pairs a provider config
+	// can be normalized by.
+	MaxNormalizationHops = 4
+)
+
 // ValidateBasic performs basic validation on a ProviderConfig.
 func (pc *ProviderConfig) ValidateBasic() error {
 	if len(pc.Name) == 0 {
@@ -21,6 +32,40 @@ func (pc *ProviderConfig) ValidateBasic() error {
 		if err := pc.NormalizeByPair.ValidateBasic(); err != nil {
 			return err
 		}
+
+		if len(pc.NormalizeByPairs) > 0 {
+			return fmt.Errorf("provider config cannot set both a normalize by pair and normalize by pairs")
+		}
+	}
+
+	if len(pc.NormalizeByPairs) > MaxNormalizationHops {
+		return fmt.Errorf(
+			"provider config can have at most %d normalization hops; got %d",
+			MaxNormalizationHops,
+			len(pc.NormalizeByPairs),
+		)
+	}
+
+	for i, hop := range pc.NormalizeByPairs {
+		if err := hop.CurrencyPair.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// each hop must start at the asset the previous hop ended at.
+		if i > 0 {
+			prev := pc.NormalizeByPairs[i-1]
+			if prev.Quote() != hop.Base() {
+				return fmt.Errorf(
+					"normalization hop %s does not start at the quote of the previous hop %s",
+					hop.String(),
+					prev.String(),
+				)
+			}
+		}
+	}
+
+	if pc.Weight > MaxProviderWeight {
+		return fmt.Errorf("provider weight must be at most %d; got %d", MaxProviderWeight, pc.Weight)
 	}
 
 	if len(pc.Metadata_JSON) > MaxMetadataJSONFieldLength {
@@ -34,6 +79,31 @@ func (pc *ProviderConfig) ValidateBasic() error {
 	return nil
 }
 
+// EffectiveWeight returns the weight of the provider config, defaulting to
+// DefaultProviderWeight if no weight is set.
+func (pc *ProviderConfig) EffectiveWeight() uint64 {
+	if pc.Weight == 0 {
+		return DefaultProviderWeight
+	}
+
+	return pc.Weight
+}
+
+// NormalizationPath returns the ordered normalization hops of the provider config. A provider
+// config that uses the single NormalizeByPair is returned as a path with one hop that is not
+// inverted. A provider config without normalization returns an empty path.
+func (pc *ProviderConfig) NormalizationPath() []NormalizationPair {
+	if pc.NormalizeByPair != nil {
+		return []NormalizationPair{
+			{
+				CurrencyPair: *pc.NormalizeByPair,
+			},
+		}
+	}
+
+	return pc.NormalizeByPairs
+}
+
 // Equal returns true iff the ProviderConfig is equal to the given ProviderConfig.
 func (pc *ProviderConfig) Equal(other ProviderConfig) bool {
 	if pc.Name != other.Name {
@@ -48,6 +118,20 @@ func (pc *ProviderConfig) Equal(other ProviderConfig) bool {
 		return false
 	}
 
+	if pc.Weight != other.Weight {
+		return false
+	}
+
+	if len(pc.NormalizeByPairs) != len(other.NormalizeByPairs) {
+		return false
+	}
+
+	for i, hop := range pc.NormalizeByPairs {
+		if !hop.Equal(other.NormalizeByPairs[i]) {
+			return false
+		}
+	}
+
 	if pc.NormalizeByPair == nil {
 		if other.NormalizeByPair != nil {
 			return false
@@ -64,3 +148,31 @@ func (pc *ProviderConfig) Equal(other ProviderConfig) bool {
 
 	return pc.Metadata_JSON == other.Metadata_JSON
 }
+
+// Base returns the base asset of the hop, taking the inversion into account.
+func (np *NormalizationPair) Base() string {
+	if np.Invert {
+		return np.CurrencyPair.Quote
+	}
+
+	return np.CurrencyPair.Base
+}
+
+// Quote returns the quote asset of the hop, taking the inversion into account.
+func (np *NormalizationPair) Quote() string {
+	if np.Invert {
+		return np.CurrencyPair.Base
+	}
+
+	return np.CurrencyPair.Quote
+}
+
+// String returns the string representation of the hop, taking the inversion into account.
+func (np *NormalizationPair) String() string {
+	return fmt.Sprintf("%s/%s", np.Base(), np.Quote())
+}
+
+// Equal returns true iff the NormalizationPair is equal to the given NormalizationPair.
+func (np *NormalizationPair) Equal(other NormalizationPair) bool {
+	return np.Invert == other.Invert && np.CurrencyPair.Equal(other.CurrencyPair)
+}
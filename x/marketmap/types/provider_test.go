@@ -52,6 +52,56 @@ func TestProviderConfigValidateBasic(t *testing.T) {
 		}
 		require.Error(t, pc.ValidateBasic())
 	})
+	t.Run("valid config with normalize by pairs - pass", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			NormalizeByPairs: []types.NormalizationPair{
+				{CurrencyPair: slinkytypes.NewCurrencyPair("WETH", "USDT")},
+				{CurrencyPair: slinkytypes.NewCurrencyPair("USD", "USDT"), Invert: true},
+			},
+			Metadata_JSON: "",
+		}
+		require.NoError(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with normalize by and normalize by pairs - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:            "mexc",
+			OffChainTicker:  "ticker",
+			NormalizeByPair: &slinkytypes.CurrencyPair{Base: "USDT", Quote: "USD"},
+			NormalizeByPairs: []types.NormalizationPair{
+				{CurrencyPair: slinkytypes.NewCurrencyPair("USDT", "USD")},
+			},
+			Metadata_JSON: "",
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with disconnected normalize by pairs - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			NormalizeByPairs: []types.NormalizationPair{
+				{CurrencyPair: slinkytypes.NewCurrencyPair("WETH", "USDT")},
+				{CurrencyPair: slinkytypes.NewCurrencyPair("USDC", "USD")},
+			},
+			Metadata_JSON: "",
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with too many normalize by pairs - fail", func(t *testing.T) {
+		hops := make([]types.NormalizationPair, types.MaxNormalizationHops+1)
+		for i := range hops {
+			hops[i] = types.NormalizationPair{CurrencyPair: slinkytypes.NewCurrencyPair("USD", "USD")}
+		}
+
+		pc := types.ProviderConfig{
+			Name:             "mexc",
+			OffChainTicker:   "ticker",
+			NormalizeByPairs: hops,
+			Metadata_JSON:    "",
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
 	t.Run("invalid name - fail", func(t *testing.T) {
 		pc := types.ProviderConfig{
 			Name:           "",
@@ -68,6 +118,24 @@ func TestProviderConfigValidateBasic(t *testing.T) {
 		}
 		require.Error(t, pc.ValidateBasic())
 	})
+	t.Run("valid config with weight - pass", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			Weight:         types.MaxProviderWeight,
+			Metadata_JSON:  "",
+		}
+		require.NoError(t, pc.ValidateBasic())
+	})
+	t.Run("invalid weight - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			Weight:         types.MaxProviderWeight + 1,
+			Metadata_JSON:  "",
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
 	t.Run("invalid json - fail", func(t *testing.T) {
 		pc := types.ProviderConfig{
 			Name:           "mexc",
@@ -185,6 +253,19 @@ func TestProviderConfigEqual(t *testing.T) {
 			},
 			exp: false,
 		},
+		{
+			name: "different weight",
+			pc: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+				Weight:         2,
+			},
+			other: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+			},
+			exp: false,
+		},
 		{
 			name: "different normalize by",
 			pc: types.ProviderConfig{
@@ -209,6 +290,24 @@ func TestProviderConfigEqual(t *testing.T) {
 			},
 			exp: false,
 		},
+		{
+			name: "different normalize by pairs inversion",
+			pc: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+				NormalizeByPairs: []types.NormalizationPair{
+					{CurrencyPair: slinkytypes.NewCurrencyPair("USD", "USDT"), Invert: true},
+				},
+			},
+			other: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+				NormalizeByPairs: []types.NormalizationPair{
+					{CurrencyPair: slinkytypes.NewCurrencyPair("USD", "USDT")},
+				},
+			},
+			exp: false,
+		},
 		{
 			name: "different metadata",
 			pc: types.ProviderConfig{
@@ -231,3 +330,49 @@ func TestProviderConfigEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestProviderConfigEffectiveWeight(t *testing.T) {
+	pc := types.ProviderConfig{
+		Name:           "mexc",
+		OffChainTicker: "ticker",
+	}
+	require.Equal(t, uint64(types.DefaultProviderWeight), pc.EffectiveWeight())
+
+	pc.Weight = 5
+	require.Equal(t, uint64(5), pc.EffectiveWeight())
+}
+
+func TestProviderConfigNormalizationPath(t *testing.T) {
+	t.Run("no normalization", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+		}
+		require.Empty(t, pc.NormalizationPath())
+	})
+
+	t.Run("normalize by pair is a single hop", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:            "mexc",
+			OffChainTicker:  "ticker",
+			NormalizeByPair: &slinkytypes.CurrencyPair{Base: "USDT", Quote: "USD"},
+		}
+		require.Equal(t, []types.NormalizationPair{
+			{CurrencyPair: slinkytypes.NewCurrencyPair("USDT", "USD")},
+		}, pc.NormalizationPath())
+	})
+
+	t.Run("normalize by pairs keeps the order and inversion", func(t *testing.T) {
+		hops := []types.NormalizationPair{
+			{CurrencyPair: slinkytypes.NewCurrencyPair("WETH", "USDT")},
+			{CurrencyPair: slinkytypes.NewCurrencyPair("USD", "USDT"), Invert: true},
+		}
+		pc := types.ProviderConfig{
+			Name:             "mexc",
+			OffChainTicker:   "ticker",
+			NormalizeByPairs: hops,
+		}
+		require.Equal(t, hops, pc.NormalizationPath())
+		require.Equal(t, "USDT/USD", pc.NormalizationPath()[1].String())
+	})
+}
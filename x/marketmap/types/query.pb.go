@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,9 +31,115 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// MarketMapRequest is the query request for the MarketMap query.
-// It takes no arguments.
+// EnabledFilter filters markets by whether they are enabled.
+type EnabledFilter int32
+
+const (
+	// ENABLED_FILTER_ALL returns both enabled and disabled markets.
+	EnabledFilter_ENABLED_FILTER_ALL EnabledFilter = 0
+	// ENABLED_FILTER_ENABLED only returns enabled markets.
+	EnabledFilter_ENABLED_FILTER_ENABLED EnabledFilter = 1
+	// ENABLED_FILTER_DISABLED only returns disabled markets.
+	EnabledFilter_ENABLED_FILTER_DISABLED EnabledFilter = 2
+)
+
+var EnabledFilter_name = map[int32]string{
+	0: "ENABLED_FILTER_ALL",
+	1: "ENABLED_FILTER_ENABLED",
+	2: "ENABLED_FILTER_DISABLED",
+}
+
+var EnabledFilter_value = map[string]int32{
+	"ENABLED_FILTER_ALL":      0,
+	"ENABLED_FILTER_ENABLED":  1,
+	"ENABLED_FILTER_DISABLED": 2,
+}
+
+func (x EnabledFilter) String() string {
+	return proto.EnumName(EnabledFilter_name, int32(x))
+}
+
+func (EnabledFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{0}
+}
+
+// MarketDiffType is the type of change that an update makes to a market.
+type MarketDiffType int32
+
+const (
+	// MARKET_DIFF_TYPE_UNCHANGED means the market is not changed.
+	MarketDiffType_MARKET_DIFF_TYPE_UNCHANGED MarketDiffType = 0
+	// MARKET_DIFF_TYPE_CREATED means the market is created.
+	MarketDiffType_MARKET_DIFF_TYPE_CREATED MarketDiffType = 1
+	// MARKET_DIFF_TYPE_UPDATED means the market is updated.
+	MarketDiffType_MARKET_DIFF_TYPE_UPDATED MarketDiffType = 2
+)
+
+var MarketDiffType_name = map[int32]string{
+	0: "MARKET_DIFF_TYPE_UNCHANGED",
+	1: "MARKET_DIFF_TYPE_CREATED",
+	2: "MARKET_DIFF_TYPE_UPDATED",
+}
+
+var MarketDiffType_value = map[string]int32{
+	"MARKET_DIFF_TYPE_UNCHANGED": 0,
+	"MARKET_DIFF_TYPE_CREATED":   1,
+	"MARKET_DIFF_TYPE_UPDATED":   2,
+}
+
+func (x MarketDiffType) String() string {
+	return proto.EnumName(MarketDiffType_name, int32(x))
+}
+
+func (MarketDiffType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{1}
+}
+
+// EnabledChange is whether an update enables or disables a market.
+type EnabledChange int32
+
+const (
+	// ENABLED_CHANGE_NONE means the update does not enable or disable the
+	// market.
+	EnabledChange_ENABLED_CHANGE_NONE EnabledChange = 0
+	// ENABLED_CHANGE_ENABLED means the update enables the market.
+	EnabledChange_ENABLED_CHANGE_ENABLED EnabledChange = 1
+	// ENABLED_CHANGE_DISABLED means the update disables the market.
+	EnabledChange_ENABLED_CHANGE_DISABLED EnabledChange = 2
+)
+
+var EnabledChange_name = map[int32]string{
+	0: "ENABLED_CHANGE_NONE",
+	1: "ENABLED_CHANGE_ENABLED",
+	2: "ENABLED_CHANGE_DISABLED",
+}
+
+var EnabledChange_value = map[string]int32{
+	"ENABLED_CHANGE_NONE":     0,
+	"ENABLED_CHANGE_ENABLED":  1,
+	"ENABLED_CHANGE_DISABLED": 2,
+}
+
+func (x EnabledChange) String() string {
+	return proto.EnumName(EnabledChange_name, int32(x))
+}
+
+func (EnabledChange) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{2}
+}
+
+// MarketMapRequest is the query request for the MarketMap query. The full
+// market map is returned unless pagination or a filter is set.
 type MarketMapRequest struct {
+	// Pagination defines the pagination of the markets, ordered by ticker. All
+	// matching markets are returned if it is not set.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Enabled filters the markets by whether they are enabled.
+	Enabled EnabledFilter `protobuf:"varint,2,opt,name=enabled,proto3,enum=slinky.marketmap.v1.EnabledFilter" json:"enabled,omitempty"`
+	// Provider filters the markets by the name of one of their providers.
+	Provider string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Quote filters the markets by their quote asset.
+	Quote string `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
 }
 
 func (m *MarketMapRequest) Reset()         { *m = MarketMapRequest{} }
@@ -68,6 +175,34 @@ func (m *MarketMapRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarketMapRequest proto.InternalMessageInfo
 
+func (m *MarketMapRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *MarketMapRequest) GetEnabled() EnabledFilter {
+	if m != nil {
+		return m.Enabled
+	}
+	return EnabledFilter_ENABLED_FILTER_ALL
+}
+
+func (m *MarketMapRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *MarketMapRequest) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
 // MarketMapResponse is the query response for the MarketMap query.
 type MarketMapResponse struct {
 	// MarketMap defines the global set of market configurations for all providers
@@ -79,6 +214,9 @@ type MarketMapResponse struct {
 	LastUpdated uint64 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
 	// ChainId is the chain identifier for the market map.
 	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Pagination defines the pagination of the response. It is only set if the
+	// request is paginated.
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *MarketMapResponse) Reset()         { *m = MarketMapResponse{} }
@@ -135,6 +273,13 @@ func (m *MarketMapResponse) GetChainId() string {
 	return ""
 }
 
+func (m *MarketMapResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // MarketRequest is the query request for the Market query.
 // It takes the currency pair of the market as an argument.
 type MarketRequest struct {
@@ -395,7 +540,376 @@ func (m *LastUpdatedResponse) GetLastUpdated() uint64 {
 	return 0
 }
 
+// PendingMarketUpdatesRequest is the request type for the
+// Query/PendingMarketUpdates RPC method.
+type PendingMarketUpdatesRequest struct {
+	// Pagination defines the pagination of the pending market updates.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PendingMarketUpdatesRequest) Reset()         { *m = PendingMarketUpdatesRequest{} }
+func (m *PendingMarketUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingMarketUpdatesRequest) ProtoMessage()    {}
+func (*PendingMarketUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{8}
+}
+func (m *PendingMarketUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingMarketUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMarketUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingMarketUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMarketUpdatesRequest.Merge(m, src)
+}
+func (m *PendingMarketUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingMarketUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMarketUpdatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingMarketUpdatesRequest proto.InternalMessageInfo
+
+func (m *PendingMarketUpdatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// PendingMarketUpdatesResponse is the response type for the
+// Query/PendingMarketUpdates RPC method.
+type PendingMarketUpdatesResponse struct {
+	// PendingMarketUpdates is the list of scheduled market updates.
+	PendingMarketUpdates []PendingMarketUpdate `protobuf:"bytes,1,rep,name=pending_market_updates,json=pendingMarketUpdates,proto3" json:"pending_market_updates"`
+	// Pagination defines the pagination of the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PendingMarketUpdatesResponse) Reset()         { *m = PendingMarketUpdatesResponse{} }
+func (m *PendingMarketUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingMarketUpdatesResponse) ProtoMessage()    {}
+func (*PendingMarketUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{9}
+}
+func (m *PendingMarketUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingMarketUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMarketUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingMarketUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMarketUpdatesResponse.Merge(m, src)
+}
+func (m *PendingMarketUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingMarketUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMarketUpdatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingMarketUpdatesResponse proto.InternalMessageInfo
+
+func (m *PendingMarketUpdatesResponse) GetPendingMarketUpdates() []PendingMarketUpdate {
+	if m != nil {
+		return m.PendingMarketUpdates
+	}
+	return nil
+}
+
+func (m *PendingMarketUpdatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// SimulateMarketMapUpdateRequest is the request type for the
+// Query/SimulateMarketMapUpdate RPC method.
+type SimulateMarketMapUpdateRequest struct {
+	// Markets is the list of markets to upsert, i.e. markets that do not exist
+	// are created and markets that exist are updated.
+	Markets []Market `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets"`
+}
+
+func (m *SimulateMarketMapUpdateRequest) Reset()         { *m = SimulateMarketMapUpdateRequest{} }
+func (m *SimulateMarketMapUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateMarketMapUpdateRequest) ProtoMessage()    {}
+func (*SimulateMarketMapUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{10}
+}
+func (m *SimulateMarketMapUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateMarketMapUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateMarketMapUpdateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateMarketMapUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateMarketMapUpdateRequest.Merge(m, src)
+}
+func (m *SimulateMarketMapUpdateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateMarketMapUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateMarketMapUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateMarketMapUpdateRequest proto.InternalMessageInfo
+
+func (m *SimulateMarketMapUpdateRequest) GetMarkets() []Market {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
+}
+
+// SimulateMarketMapUpdateResponse is the response type for the
+// Query/SimulateMarketMapUpdate RPC method.
+type SimulateMarketMapUpdateResponse struct {
+	// Diffs is the list of changes that the update makes to each of the given
+	// markets.
+	Diffs []MarketDiff `protobuf:"bytes,1,rep,name=diffs,proto3" json:"diffs"`
+	// Errors is the list of errors that the update would fail with. The update
+	// is valid if it is empty.
+	Errors []string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (m *SimulateMarketMapUpdateResponse) Reset()         { *m = SimulateMarketMapUpdateResponse{} }
+func (m *SimulateMarketMapUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateMarketMapUpdateResponse) ProtoMessage()    {}
+func (*SimulateMarketMapUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{11}
+}
+func (m *SimulateMarketMapUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateMarketMapUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateMarketMapUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateMarketMapUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateMarketMapUpdateResponse.Merge(m, src)
+}
+func (m *SimulateMarketMapUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateMarketMapUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateMarketMapUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateMarketMapUpdateResponse proto.InternalMessageInfo
+
+func (m *SimulateMarketMapUpdateResponse) GetDiffs() []MarketDiff {
+	if m != nil {
+		return m.Diffs
+	}
+	return nil
+}
+
+func (m *SimulateMarketMapUpdateResponse) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+// MarketDiff describes the changes that an update makes to a single market.
+type MarketDiff struct {
+	// Ticker is the ticker of the market.
+	Ticker string `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// DiffType is the type of change that is made to the market.
+	DiffType MarketDiffType `protobuf:"varint,2,opt,name=diff_type,json=diffType,proto3,enum=slinky.marketmap.v1.MarketDiffType" json:"diff_type,omitempty"`
+	// UpdatedFields is the list of ticker fields that are changed by the update.
+	UpdatedFields []FieldDiff `protobuf:"bytes,3,rep,name=updated_fields,json=updatedFields,proto3" json:"updated_fields"`
+	// EnabledChange is whether the market is enabled or disabled by the update.
+	EnabledChange EnabledChange `protobuf:"varint,4,opt,name=enabled_change,json=enabledChange,proto3,enum=slinky.marketmap.v1.EnabledChange" json:"enabled_change,omitempty"`
+	// AddedProviderConfigs is the list of provider configs that are added to the
+	// market. Provider configs that are changed are both removed and added.
+	AddedProviderConfigs []ProviderConfig `protobuf:"bytes,5,rep,name=added_provider_configs,json=addedProviderConfigs,proto3" json:"added_provider_configs"`
+	// RemovedProviderConfigs is the list of provider configs that are removed
+	// from the market.
+	RemovedProviderConfigs []ProviderConfig `protobuf:"bytes,6,rep,name=removed_provider_configs,json=removedProviderConfigs,proto3" json:"removed_provider_configs"`
+}
+
+func (m *MarketDiff) Reset()         { *m = MarketDiff{} }
+func (m *MarketDiff) String() string { return proto.CompactTextString(m) }
+func (*MarketDiff) ProtoMessage()    {}
+func (*MarketDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{12}
+}
+func (m *MarketDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketDiff.Merge(m, src)
+}
+func (m *MarketDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketDiff proto.InternalMessageInfo
+
+func (m *MarketDiff) GetTicker() string {
+	if m != nil {
+		return m.Ticker
+	}
+	return ""
+}
+
+func (m *MarketDiff) GetDiffType() MarketDiffType {
+	if m != nil {
+		return m.DiffType
+	}
+	return MarketDiffType_MARKET_DIFF_TYPE_UNCHANGED
+}
+
+func (m *MarketDiff) GetUpdatedFields() []FieldDiff {
+	if m != nil {
+		return m.UpdatedFields
+	}
+	return nil
+}
+
+func (m *MarketDiff) GetEnabledChange() EnabledChange {
+	if m != nil {
+		return m.EnabledChange
+	}
+	return EnabledChange_ENABLED_CHANGE_NONE
+}
+
+func (m *MarketDiff) GetAddedProviderConfigs() []ProviderConfig {
+	if m != nil {
+		return m.AddedProviderConfigs
+	}
+	return nil
+}
+
+func (m *MarketDiff) GetRemovedProviderConfigs() []ProviderConfig {
+	if m != nil {
+		return m.RemovedProviderConfigs
+	}
+	return nil
+}
+
+// FieldDiff describes the change of a single field.
+type FieldDiff struct {
+	// Field is the name of the field.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// OldValue is the value of the field before the update.
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// NewValue is the value of the field after the update.
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (m *FieldDiff) Reset()         { *m = FieldDiff{} }
+func (m *FieldDiff) String() string { return proto.CompactTextString(m) }
+func (*FieldDiff) ProtoMessage()    {}
+func (*FieldDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{13}
+}
+func (m *FieldDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldDiff.Merge(m, src)
+}
+func (m *FieldDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldDiff proto.InternalMessageInfo
+
+func (m *FieldDiff) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *FieldDiff) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
+	}
+	return ""
+}
+
+func (m *FieldDiff) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("slinky.marketmap.v1.EnabledFilter", EnabledFilter_name, EnabledFilter_value)
+	proto.RegisterEnum("slinky.marketmap.v1.MarketDiffType", MarketDiffType_name, MarketDiffType_value)
+	proto.RegisterEnum("slinky.marketmap.v1.EnabledChange", EnabledChange_name, EnabledChange_value)
 	proto.RegisterType((*MarketMapRequest)(nil), "slinky.marketmap.v1.MarketMapRequest")
 	proto.RegisterType((*MarketMapResponse)(nil), "slinky.marketmap.v1.MarketMapResponse")
 	proto.RegisterType((*MarketRequest)(nil), "slinky.marketmap.v1.MarketRequest")
@@ -404,49 +918,95 @@ func init() {
 	proto.RegisterType((*ParamsResponse)(nil), "slinky.marketmap.v1.ParamsResponse")
 	proto.RegisterType((*LastUpdatedRequest)(nil), "slinky.marketmap.v1.LastUpdatedRequest")
 	proto.RegisterType((*LastUpdatedResponse)(nil), "slinky.marketmap.v1.LastUpdatedResponse")
+	proto.RegisterType((*PendingMarketUpdatesRequest)(nil), "slinky.marketmap.v1.PendingMarketUpdatesRequest")
+	proto.RegisterType((*PendingMarketUpdatesResponse)(nil), "slinky.marketmap.v1.PendingMarketUpdatesResponse")
+	proto.RegisterType((*SimulateMarketMapUpdateRequest)(nil), "slinky.marketmap.v1.SimulateMarketMapUpdateRequest")
+	proto.RegisterType((*SimulateMarketMapUpdateResponse)(nil), "slinky.marketmap.v1.SimulateMarketMapUpdateResponse")
+	proto.RegisterType((*MarketDiff)(nil), "slinky.marketmap.v1.MarketDiff")
+	proto.RegisterType((*FieldDiff)(nil), "slinky.marketmap.v1.FieldDiff")
 }
 
 func init() { proto.RegisterFile("slinky/marketmap/v1/query.proto", fileDescriptor_b5d6ff68f3c474a0) }
 
 var fileDescriptor_b5d6ff68f3c474a0 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xc1, 0x6e, 0xd3, 0x4a,
-	0x14, 0xcd, 0xf4, 0xb5, 0x79, 0x64, 0xd2, 0x14, 0x98, 0x76, 0x11, 0xd2, 0xd6, 0x49, 0x1d, 0x01,
-	0xd9, 0xe0, 0xa1, 0x61, 0x03, 0x0b, 0x36, 0xcd, 0x86, 0x50, 0x2a, 0x4a, 0x24, 0x16, 0x64, 0x13,
-	0x4d, 0x9d, 0x51, 0x6a, 0x25, 0xf6, 0x4c, 0xed, 0x71, 0x44, 0xb6, 0x7c, 0x01, 0x12, 0xa2, 0x7f,
-	0xc0, 0x07, 0xf0, 0x17, 0x5d, 0x56, 0x62, 0xc3, 0x0a, 0xa1, 0x84, 0x0f, 0x41, 0xf6, 0x8c, 0x53,
-	0x9b, 0xda, 0xce, 0xce, 0xbe, 0x73, 0xee, 0x39, 0x67, 0xce, 0xbd, 0x1a, 0x58, 0xf7, 0x26, 0x96,
-	0x33, 0x9e, 0x61, 0x9b, 0xb8, 0x63, 0x2a, 0x6c, 0xc2, 0xf1, 0xf4, 0x10, 0x5f, 0xf8, 0xd4, 0x9d,
-	0x19, 0xdc, 0x65, 0x82, 0xa1, 0x6d, 0x09, 0x30, 0x96, 0x00, 0x63, 0x7a, 0x58, 0xdb, 0x19, 0xb1,
-	0x11, 0x0b, 0xcf, 0x71, 0xf0, 0x25, 0xa1, 0xb5, 0xbd, 0x11, 0x63, 0xa3, 0x09, 0xc5, 0x84, 0x5b,
-	0x98, 0x38, 0x0e, 0x13, 0x44, 0x58, 0xcc, 0xf1, 0xd4, 0x69, 0x53, 0x29, 0x89, 0x19, 0xa7, 0x5e,
-	0xa0, 0x62, 0xfa, 0xae, 0x4b, 0x1d, 0x73, 0x36, 0xe0, 0xc4, 0x72, 0x15, 0xa8, 0x91, 0x66, 0x47,
-	0xfe, 0xe4, 0x21, 0x38, 0x71, 0x89, 0xad, 0x84, 0x74, 0x04, 0xef, 0x9d, 0x84, 0x87, 0x27, 0x84,
-	0xf7, 0xe8, 0x85, 0x4f, 0x3d, 0xa1, 0x5f, 0x02, 0x78, 0x3f, 0x56, 0xf4, 0x38, 0x73, 0x3c, 0x8a,
-	0x3a, 0x10, 0x4a, 0x9a, 0x81, 0x4d, 0x78, 0x15, 0x34, 0x40, 0xab, 0xdc, 0xd6, 0x8c, 0x94, 0x0b,
-	0x1b, 0xcb, 0xde, 0xa3, 0xf5, 0xab, 0x5f, 0xf5, 0x42, 0xaf, 0x64, 0x47, 0x05, 0x74, 0x00, 0x37,
-	0x27, 0xc4, 0x13, 0x03, 0x9f, 0x0f, 0x89, 0xa0, 0xc3, 0xea, 0x5a, 0x03, 0xb4, 0xd6, 0x7b, 0xe5,
-	0xa0, 0xf6, 0x5e, 0x96, 0xd0, 0x03, 0x78, 0xc7, 0x3c, 0x27, 0x96, 0x33, 0xb0, 0x86, 0xd5, 0xff,
-	0x1a, 0xa0, 0x55, 0xea, 0xfd, 0x1f, 0xfe, 0x77, 0x87, 0xfa, 0x07, 0x58, 0x91, 0xdc, 0xca, 0x29,
-	0x7a, 0x05, 0x2b, 0x89, 0x60, 0x94, 0xad, 0xfd, 0xc8, 0x56, 0x18, 0x5f, 0x60, 0xa9, 0xa3, 0x50,
-	0xa7, 0xc4, 0x72, 0x95, 0xab, 0x4d, 0x33, 0x56, 0xd3, 0x8f, 0xe1, 0x56, 0x44, 0xad, 0xee, 0xfb,
-	0x02, 0x16, 0xa5, 0x6f, 0x45, 0xba, 0x9b, 0x73, 0x57, 0x45, 0xa9, 0x1a, 0xf4, 0xbb, 0xb0, 0x72,
-	0x1a, 0x86, 0x1c, 0x25, 0x7a, 0x0c, 0xb7, 0xa2, 0xc2, 0x0d, 0xbb, 0x9c, 0x43, 0x2e, 0xbb, 0x6c,
-	0x8a, 0xd8, 0x65, 0x83, 0xbe, 0x03, 0xd1, 0x9b, 0x9b, 0xbc, 0x22, 0x89, 0xe7, 0x70, 0x3b, 0x51,
-	0x55, 0x3a, 0xff, 0x06, 0x0e, 0x6e, 0x05, 0xde, 0xfe, 0xba, 0x01, 0x37, 0xde, 0x05, 0x4b, 0x8c,
-	0xbe, 0x01, 0x58, 0x5a, 0x0e, 0x0f, 0x3d, 0xcc, 0x1f, 0xae, 0x12, 0xae, 0x3d, 0x5a, 0x05, 0x93,
-	0x4e, 0xf4, 0xee, 0xa7, 0x1f, 0x7f, 0xbe, 0xac, 0x75, 0xfa, 0x0d, 0xa4, 0xe1, 0xec, 0xc5, 0xb5,
-	0x09, 0x47, 0x75, 0x6c, 0x32, 0xc7, 0xa1, 0xa6, 0xc8, 0x02, 0x5c, 0x02, 0x58, 0x94, 0x02, 0x48,
-	0xcf, 0x51, 0x8f, 0x1c, 0x36, 0x73, 0x31, 0xca, 0x5e, 0x27, 0xb4, 0xf7, 0xb2, 0xbf, 0x8f, 0x76,
-	0x73, 0xec, 0xa1, 0xbd, 0x3c, 0x6f, 0xe8, 0x3b, 0x80, 0xe5, 0xd8, 0x14, 0xd0, 0xe3, 0x54, 0xe5,
-	0xdb, 0xd3, 0xab, 0xb5, 0x56, 0x03, 0x95, 0xcf, 0xb7, 0xa1, 0xcf, 0x6e, 0xbf, 0x89, 0x0e, 0x52,
-	0x7d, 0xc6, 0xa7, 0x8d, 0xf4, 0x74, 0xb7, 0x09, 0x4c, 0x10, 0xa6, 0xdc, 0xb3, 0x8c, 0x30, 0x13,
-	0xab, 0x9c, 0x11, 0x66, 0x72, 0xbb, 0x57, 0x86, 0x29, 0x37, 0x39, 0x2b, 0x4c, 0x79, 0x7a, 0xf4,
-	0xfa, 0x6a, 0xae, 0x81, 0xeb, 0xb9, 0x06, 0x7e, 0xcf, 0x35, 0xf0, 0x79, 0xa1, 0x15, 0xae, 0x17,
-	0x5a, 0xe1, 0xe7, 0x42, 0x2b, 0xf4, 0x9f, 0x8e, 0x2c, 0x71, 0xee, 0x9f, 0x19, 0x26, 0xb3, 0xb1,
-	0x37, 0xb6, 0xf8, 0x13, 0x9b, 0x4e, 0x97, 0x54, 0xd3, 0x36, 0xfe, 0x18, 0xe3, 0x0b, 0x9f, 0x80,
-	0xb3, 0x62, 0xf8, 0xda, 0x3d, 0xfb, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xb0, 0xa2, 0x29, 0xc2,
-	0x05, 0x00, 0x00,
+	// 1222 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5d, 0x4f, 0x23, 0x55,
+	0x18, 0xee, 0xb4, 0xc0, 0xd2, 0x97, 0x2d, 0xe2, 0x81, 0x94, 0x5a, 0xd8, 0xd2, 0x1d, 0xe2, 0x6e,
+	0x43, 0xb4, 0x03, 0xec, 0x9a, 0xa8, 0x68, 0x22, 0xf4, 0x63, 0xb7, 0xf2, 0xb1, 0x75, 0x16, 0x4c,
+	0x16, 0x63, 0xc6, 0xc3, 0xcc, 0x69, 0x99, 0xd0, 0xf9, 0x60, 0x66, 0xda, 0x95, 0x5b, 0xef, 0x4d,
+	0x4c, 0x4c, 0xfc, 0x07, 0xfe, 0x00, 0x7f, 0x82, 0x89, 0x17, 0x1b, 0x13, 0x93, 0x4d, 0xbc, 0xf1,
+	0x4a, 0x0d, 0xf8, 0x43, 0xcc, 0xcc, 0x79, 0xa7, 0x1f, 0x30, 0x2d, 0x60, 0xbc, 0xeb, 0x39, 0xef,
+	0xd7, 0xf3, 0x3c, 0xf3, 0x9e, 0xf3, 0x9e, 0xc2, 0x92, 0xdb, 0xd2, 0xcd, 0x93, 0x33, 0xc9, 0xa0,
+	0xce, 0x09, 0xf3, 0x0c, 0x6a, 0x4b, 0x9d, 0x35, 0xe9, 0xb4, 0xcd, 0x9c, 0xb3, 0xa2, 0xed, 0x58,
+	0x9e, 0x45, 0x66, 0xb9, 0x43, 0xb1, 0xeb, 0x50, 0xec, 0xac, 0x65, 0x57, 0x54, 0xcb, 0x35, 0x2c,
+	0x57, 0x3a, 0xa2, 0x2e, 0xe3, 0xde, 0x52, 0x67, 0xed, 0x88, 0x79, 0x74, 0x4d, 0xb2, 0x69, 0x53,
+	0x37, 0xa9, 0xa7, 0x5b, 0x26, 0x4f, 0x90, 0x9d, 0x6b, 0x5a, 0x4d, 0x2b, 0xf8, 0x29, 0xf9, 0xbf,
+	0x70, 0x77, 0xb1, 0x69, 0x59, 0xcd, 0x16, 0x93, 0xa8, 0xad, 0x4b, 0xd4, 0x34, 0x2d, 0x2f, 0x08,
+	0x71, 0xd1, 0xba, 0x8c, 0xa8, 0xbc, 0x33, 0x9b, 0xb9, 0x3e, 0x22, 0xb5, 0xed, 0x38, 0xcc, 0x54,
+	0xcf, 0x14, 0x9b, 0xea, 0x0e, 0x3a, 0xe5, 0xa3, 0xa0, 0xf3, 0xc5, 0x28, 0x0f, 0x9b, 0x3a, 0xd4,
+	0xc0, 0x42, 0xe2, 0xaf, 0x02, 0xcc, 0xec, 0x06, 0xd6, 0x5d, 0x6a, 0xcb, 0xec, 0xb4, 0xcd, 0x5c,
+	0x8f, 0x54, 0x01, 0x7a, 0x2c, 0x32, 0x42, 0x5e, 0x28, 0x4c, 0xad, 0x3f, 0x28, 0x72, 0xca, 0x45,
+	0x9f, 0x72, 0x91, 0x0b, 0x84, 0x94, 0x8b, 0x75, 0xda, 0x64, 0x18, 0x2b, 0xf7, 0x45, 0x92, 0x8f,
+	0xe0, 0x0e, 0x33, 0xe9, 0x51, 0x8b, 0x69, 0x99, 0x78, 0x5e, 0x28, 0x4c, 0xaf, 0x8b, 0xc5, 0x08,
+	0x31, 0x8b, 0x15, 0xee, 0x53, 0xd5, 0x5b, 0x1e, 0x73, 0xe4, 0x30, 0x84, 0x64, 0x61, 0xd2, 0x76,
+	0xac, 0x8e, 0xae, 0x31, 0x27, 0x93, 0xc8, 0x0b, 0x85, 0xa4, 0xdc, 0x5d, 0x93, 0x39, 0x18, 0x3f,
+	0x6d, 0x5b, 0x1e, 0xcb, 0x8c, 0x05, 0x06, 0xbe, 0x10, 0xff, 0x12, 0xe0, 0xcd, 0x3e, 0x32, 0xae,
+	0x6d, 0x99, 0x2e, 0x23, 0x25, 0x00, 0x5e, 0x4e, 0x31, 0xa8, 0x8d, 0x6c, 0x72, 0x91, 0x40, 0xba,
+	0xb1, 0x5b, 0x63, 0xaf, 0xfe, 0x5c, 0x8a, 0xc9, 0x49, 0x23, 0xdc, 0x20, 0xf7, 0xe1, 0x6e, 0x8b,
+	0xba, 0x9e, 0xd2, 0xb6, 0x35, 0xea, 0x21, 0x9f, 0x31, 0x79, 0xca, 0xdf, 0x3b, 0xe0, 0x5b, 0xe4,
+	0x2d, 0x98, 0x54, 0x8f, 0xa9, 0x6e, 0x2a, 0xba, 0x86, 0x78, 0xef, 0x04, 0xeb, 0x9a, 0x46, 0x9e,
+	0x0c, 0x08, 0x3a, 0x16, 0x40, 0x78, 0x78, 0xad, 0xa0, 0x1c, 0x7f, 0xbf, 0xa2, 0xe2, 0x0b, 0x48,
+	0x71, 0x90, 0xe1, 0xa7, 0x7a, 0x0a, 0xa9, 0x81, 0xd6, 0x40, 0x7e, 0xf7, 0x42, 0x7e, 0x41, 0x03,
+	0xf9, 0xdc, 0x4a, 0xe8, 0x55, 0xa7, 0xba, 0x83, 0xf4, 0xee, 0xaa, 0x7d, 0x7b, 0xe2, 0x36, 0x4c,
+	0x87, 0xa9, 0x51, 0xb8, 0x0f, 0x60, 0x82, 0x0b, 0x80, 0x49, 0x17, 0x46, 0x88, 0x86, 0x29, 0x31,
+	0x40, 0x7c, 0x03, 0x52, 0xf5, 0xa0, 0xcd, 0x10, 0xa7, 0x9f, 0x3d, 0xdc, 0xe8, 0x65, 0xe7, 0x9d,
+	0x38, 0x32, 0x3b, 0x0f, 0x0a, 0xb3, 0xf3, 0x00, 0x71, 0x0e, 0xc8, 0x4e, 0x4f, 0xf8, 0xb0, 0xc4,
+	0xfb, 0x30, 0x3b, 0xb0, 0x8b, 0x75, 0x2e, 0x7f, 0x39, 0xe1, 0xca, 0x97, 0x13, 0x19, 0x2c, 0xd4,
+	0x99, 0xa9, 0xe9, 0x66, 0x93, 0x93, 0xe1, 0xfb, 0xee, 0xff, 0x7c, 0x1c, 0xc4, 0xdf, 0x04, 0x58,
+	0x8c, 0xae, 0x83, 0x50, 0x35, 0x48, 0xdb, 0xdc, 0xae, 0x60, 0xc7, 0x72, 0xd0, 0xbe, 0x44, 0x89,
+	0xc2, 0xd4, 0x7a, 0x21, 0x5a, 0xa2, 0xab, 0x29, 0x51, 0xaf, 0x39, 0x3b, 0xa2, 0xda, 0xa5, 0x66,
+	0x8c, 0xff, 0xf7, 0x66, 0xfc, 0x12, 0x72, 0xcf, 0x75, 0xa3, 0xdd, 0xa2, 0x1e, 0xeb, 0x9e, 0x1c,
+	0x5e, 0x24, 0x54, 0x6e, 0x03, 0xee, 0x70, 0xa8, 0x21, 0x83, 0x1b, 0xb4, 0x50, 0x18, 0x21, 0x76,
+	0x60, 0x69, 0x68, 0x7a, 0x14, 0x6c, 0x03, 0xc6, 0x35, 0xbd, 0xd1, 0x08, 0xb3, 0x2f, 0x8d, 0xc8,
+	0x5e, 0xd6, 0x1b, 0x0d, 0xac, 0xc0, 0x63, 0x48, 0x1a, 0x26, 0x98, 0xe3, 0x58, 0x8e, 0x9b, 0x89,
+	0xe7, 0x13, 0x85, 0xa4, 0x8c, 0x2b, 0xf1, 0x97, 0x04, 0x40, 0x2f, 0xc6, 0x77, 0xf3, 0x74, 0xf5,
+	0x84, 0xf1, 0xa3, 0x95, 0x94, 0x71, 0x45, 0x3e, 0x81, 0xa4, 0x9f, 0x47, 0xf1, 0x4f, 0x18, 0x5e,
+	0x6f, 0xcb, 0xd7, 0xd4, 0xdf, 0x3f, 0xb3, 0x99, 0x3c, 0xa9, 0xe1, 0x2f, 0xb2, 0x0d, 0xd3, 0xd8,
+	0x94, 0x4a, 0x43, 0x67, 0x2d, 0xcd, 0xcd, 0x24, 0xf2, 0x89, 0xa1, 0x97, 0x53, 0xd5, 0x77, 0xe9,
+	0x63, 0x91, 0xc2, 0xd8, 0x60, 0xdf, 0x25, 0x35, 0x98, 0xc6, 0x8b, 0x53, 0x51, 0x8f, 0xa9, 0xd9,
+	0xe4, 0x57, 0xe3, 0x35, 0x57, 0x6e, 0x29, 0xf0, 0x94, 0x53, 0xac, 0x7f, 0x49, 0x14, 0x48, 0x53,
+	0x4d, 0x63, 0x9a, 0x12, 0x5e, 0xb7, 0x8a, 0x6a, 0x99, 0x0d, 0xbd, 0xe9, 0x66, 0xc6, 0x03, 0x7c,
+	0xd1, 0x34, 0xeb, 0xe8, 0x5c, 0x0a, 0x7c, 0xc3, 0x0e, 0x0c, 0x12, 0x0d, 0x9a, 0x5c, 0xa2, 0x42,
+	0xc6, 0x61, 0x86, 0xd5, 0x89, 0x2a, 0x31, 0x71, 0xdb, 0x12, 0x69, 0x4c, 0x75, 0xa9, 0x88, 0xf8,
+	0x05, 0x24, 0xbb, 0x92, 0xf9, 0xf3, 0x22, 0x90, 0x18, 0xbf, 0x21, 0x5f, 0x90, 0x05, 0x48, 0x5a,
+	0x2d, 0x4d, 0xe9, 0xd0, 0x56, 0x9b, 0x7f, 0xc2, 0xa4, 0x3c, 0x69, 0xb5, 0xb4, 0xcf, 0xfd, 0xb5,
+	0x6f, 0x34, 0xd9, 0x4b, 0x34, 0xe2, 0xfc, 0x31, 0xd9, 0xcb, 0xc0, 0xb8, 0xf2, 0x15, 0xa4, 0x06,
+	0xa6, 0x16, 0x49, 0x03, 0xa9, 0xec, 0x6d, 0x6e, 0xed, 0x54, 0xca, 0x4a, 0xb5, 0xb6, 0xb3, 0x5f,
+	0x91, 0x95, 0xcd, 0x9d, 0x9d, 0x99, 0x18, 0xc9, 0x42, 0xfa, 0xd2, 0x3e, 0x2e, 0x67, 0x04, 0xb2,
+	0x00, 0xf3, 0x97, 0x6c, 0xe5, 0xda, 0x73, 0x6e, 0x8c, 0xaf, 0xb4, 0xc2, 0xeb, 0x38, 0x6c, 0x1c,
+	0x92, 0x83, 0xec, 0xee, 0xa6, 0xbc, 0x5d, 0xd9, 0x57, 0xca, 0xb5, 0x6a, 0x55, 0xd9, 0x7f, 0x51,
+	0xaf, 0x28, 0x07, 0x7b, 0xa5, 0xa7, 0x9b, 0x7b, 0x4f, 0x2a, 0xe5, 0x99, 0x18, 0x59, 0x84, 0xcc,
+	0x15, 0x7b, 0x49, 0xae, 0x6c, 0xee, 0x07, 0xc5, 0xa2, 0xac, 0x07, 0xf5, 0x72, 0x60, 0x8d, 0xaf,
+	0xd0, 0x2e, 0x1f, 0xec, 0x81, 0x79, 0x98, 0x0d, 0xb1, 0xf1, 0x0a, 0xca, 0xde, 0xb3, 0xbd, 0xca,
+	0x20, 0x21, 0x34, 0x44, 0x12, 0x42, 0x5b, 0x8f, 0xd0, 0xfa, 0xb7, 0x93, 0x30, 0xfe, 0x99, 0x7f,
+	0xb1, 0x90, 0x1f, 0x05, 0x48, 0x76, 0x4f, 0x34, 0x79, 0x7b, 0xf4, 0x28, 0xc6, 0xab, 0x24, 0xfb,
+	0xe0, 0x3a, 0x37, 0x7e, 0x25, 0x88, 0xb5, 0x6f, 0x7e, 0xff, 0xe7, 0xfb, 0x78, 0x89, 0x2c, 0x49,
+	0xaa, 0x65, 0x9a, 0x4c, 0xf5, 0xa2, 0x9e, 0x47, 0x06, 0xb5, 0x0f, 0xf3, 0x24, 0x27, 0x0d, 0x7f,
+	0x40, 0x19, 0xd4, 0x26, 0x3f, 0x08, 0x30, 0xc1, 0x0b, 0x10, 0x71, 0x44, 0xf5, 0x10, 0xe1, 0xf2,
+	0x48, 0x1f, 0x84, 0x57, 0x0a, 0xe0, 0x7d, 0x4c, 0x16, 0x47, 0xc1, 0x3b, 0xbc, 0x47, 0x16, 0x46,
+	0x60, 0x23, 0x3f, 0x09, 0x30, 0xd5, 0x37, 0xea, 0xc8, 0xc3, 0xc8, 0xca, 0x57, 0x47, 0x64, 0xb6,
+	0x70, 0xbd, 0x23, 0xe2, 0x7c, 0x16, 0xe0, 0xac, 0x1d, 0x2e, 0x93, 0xfb, 0x91, 0x48, 0xfa, 0x47,
+	0x2a, 0x11, 0xa3, 0xc9, 0x0c, 0xf8, 0xf8, 0x62, 0xf2, 0x61, 0x3e, 0x44, 0xcc, 0x81, 0xf7, 0x42,
+	0x76, 0x79, 0xa4, 0xcf, 0xcd, 0xc4, 0xe4, 0xaf, 0x85, 0xa1, 0x62, 0x72, 0xb3, 0x2f, 0xe6, 0x5c,
+	0xd4, 0x54, 0x26, 0xab, 0x37, 0x9d, 0xb6, 0x5d, 0xd0, 0x6b, 0xb7, 0x88, 0x40, 0x0a, 0x8f, 0x03,
+	0x0a, 0x45, 0xf2, 0xce, 0x10, 0x0a, 0x91, 0xcf, 0x01, 0xf2, 0xb3, 0x00, 0xf3, 0x43, 0x66, 0x23,
+	0x79, 0x14, 0x09, 0x62, 0xf4, 0xa0, 0xce, 0x3e, 0xbe, 0x5d, 0x10, 0x82, 0xdf, 0x08, 0xc0, 0xbf,
+	0xf7, 0xa1, 0xb0, 0x22, 0xae, 0x46, 0xe3, 0x77, 0x31, 0x83, 0xd2, 0x7b, 0x81, 0x23, 0x89, 0xad,
+	0x4f, 0x5f, 0x9d, 0xe7, 0x84, 0xd7, 0xe7, 0x39, 0xe1, 0xef, 0xf3, 0x9c, 0xf0, 0xdd, 0x45, 0x2e,
+	0xf6, 0xfa, 0x22, 0x17, 0xfb, 0xe3, 0x22, 0x17, 0x3b, 0x5c, 0x6d, 0xea, 0xde, 0x71, 0xfb, 0xa8,
+	0xa8, 0x5a, 0x86, 0xe4, 0x9e, 0xe8, 0xf6, 0xbb, 0x06, 0xeb, 0x74, 0xd3, 0x77, 0xd6, 0xa5, 0xaf,
+	0xfb, 0x6a, 0x04, 0xef, 0xdb, 0xa3, 0x89, 0xe0, 0xcf, 0xcc, 0xa3, 0x7f, 0x07, 0x00, 0x95, 0x09,
+	0x88, 0x25, 0xcd, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -471,6 +1031,13 @@ type QueryClient interface {
 	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// PendingMarketUpdates returns the market updates that are scheduled to be
+	// applied at a future height, ordered by height.
+	PendingMarketUpdates(ctx context.Context, in *PendingMarketUpdatesRequest, opts ...grpc.CallOption) (*PendingMarketUpdatesResponse, error)
+	// SimulateMarketMapUpdate returns the changes that upserting the given
+	// markets would make to the market map, and any errors that the update
+	// would fail with. The update is not committed to state.
+	SimulateMarketMapUpdate(ctx context.Context, in *SimulateMarketMapUpdateRequest, opts ...grpc.CallOption) (*SimulateMarketMapUpdateResponse, error)
 }
 
 type queryClient struct {
@@ -517,6 +1084,24 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) PendingMarketUpdates(ctx context.Context, in *PendingMarketUpdatesRequest, opts ...grpc.CallOption) (*PendingMarketUpdatesResponse, error) {
+	out := new(PendingMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/PendingMarketUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateMarketMapUpdate(ctx context.Context, in *SimulateMarketMapUpdateRequest, opts ...grpc.CallOption) (*SimulateMarketMapUpdateResponse, error) {
+	out := new(SimulateMarketMapUpdateResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/SimulateMarketMapUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// MarketMap returns the full market map stored in the x/marketmap
@@ -529,6 +1114,13 @@ type QueryServer interface {
 	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// PendingMarketUpdates returns the market updates that are scheduled to be
+	// applied at a future height, ordered by height.
+	PendingMarketUpdates(context.Context, *PendingMarketUpdatesRequest) (*PendingMarketUpdatesResponse, error)
+	// SimulateMarketMapUpdate returns the changes that upserting the given
+	// markets would make to the market map, and any errors that the update
+	// would fail with. The update is not committed to state.
+	SimulateMarketMapUpdate(context.Context, *SimulateMarketMapUpdateRequest) (*SimulateMarketMapUpdateResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -547,6 +1139,12 @@ func (*UnimplementedQueryServer) LastUpdated(ctx context.Context, req *LastUpdat
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) PendingMarketUpdates(ctx context.Context, req *PendingMarketUpdatesRequest) (*PendingMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingMarketUpdates not implemented")
+}
+func (*UnimplementedQueryServer) SimulateMarketMapUpdate(ctx context.Context, req *SimulateMarketMapUpdateRequest) (*SimulateMarketMapUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateMarketMapUpdate not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -624,6 +1222,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingMarketUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Query/PendingMarketUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingMarketUpdates(ctx, req.(*PendingMarketUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateMarketMapUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateMarketMapUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateMarketMapUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Query/SimulateMarketMapUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateMarketMapUpdate(ctx, req.(*SimulateMarketMapUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "slinky.marketmap.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -644,6 +1278,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PendingMarketUpdates",
+			Handler:    _Query_PendingMarketUpdates_Handler,
+		},
+		{
+			MethodName: "SimulateMarketMapUpdate",
+			Handler:    _Query_SimulateMarketMapUpdate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/marketmap/v1/query.proto",
@@ -669,6 +1311,37 @@ func (m *MarketMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Enabled != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Enabled))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -692,6 +1365,18 @@ func (m *MarketMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
 		copy(dAtA[i:], m.ChainId)
@@ -890,6 +1575,299 @@ func (m *LastUpdatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingMarketUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMarketUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMarketUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingMarketUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMarketUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMarketUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingMarketUpdates) > 0 {
+		for iNdEx := len(m.PendingMarketUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingMarketUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateMarketMapUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateMarketMapUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateMarketMapUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateMarketMapUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateMarketMapUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateMarketMapUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Diffs) > 0 {
+		for iNdEx := len(m.Diffs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Diffs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedProviderConfigs) > 0 {
+		for iNdEx := len(m.RemovedProviderConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemovedProviderConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AddedProviderConfigs) > 0 {
+		for iNdEx := len(m.AddedProviderConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddedProviderConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.EnabledChange != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EnabledChange))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UpdatedFields) > 0 {
+		for iNdEx := len(m.UpdatedFields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdatedFields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.DiffType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DiffType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -907,6 +1885,21 @@ func (m *MarketMapRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Enabled != 0 {
+		n += 1 + sovQuery(uint64(m.Enabled))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -925,6 +1918,10 @@ func (m *MarketMapResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -991,10 +1988,136 @@ func (m *LastUpdatedResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *PendingMarketUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
+
+func (m *PendingMarketUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingMarketUpdates) > 0 {
+		for _, e := range m.PendingMarketUpdates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateMarketMapUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulateMarketMapUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Diffs) > 0 {
+		for _, e := range m.Diffs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DiffType != 0 {
+		n += 1 + sovQuery(uint64(m.DiffType))
+	}
+	if len(m.UpdatedFields) > 0 {
+		for _, e := range m.UpdatedFields {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.EnabledChange != 0 {
+		n += 1 + sovQuery(uint64(m.EnabledChange))
+	}
+	if len(m.AddedProviderConfigs) > 0 {
+		for _, e := range m.AddedProviderConfigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RemovedProviderConfigs) > 0 {
+		for _, e := range m.RemovedProviderConfigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
@@ -1026,6 +2149,125 @@ func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MarketMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			m.Enabled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Enabled |= EnabledFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1160,6 +2402,42 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1599,6 +2877,780 @@ func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingMarketUpdatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingMarketUpdatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingMarketUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingMarketUpdatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingMarketUpdatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingMarketUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMarketUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingMarketUpdates = append(m.PendingMarketUpdates, PendingMarketUpdate{})
+			if err := m.PendingMarketUpdates[len(m.PendingMarketUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateMarketMapUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateMarketMapUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateMarketMapUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateMarketMapUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateMarketMapUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateMarketMapUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diffs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diffs = append(m.Diffs, MarketDiff{})
+			if err := m.Diffs[len(m.Diffs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiffType", wireType)
+			}
+			m.DiffType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiffType |= MarketDiffType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedFields = append(m.UpdatedFields, FieldDiff{})
+			if err := m.UpdatedFields[len(m.UpdatedFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledChange", wireType)
+			}
+			m.EnabledChange = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnabledChange |= EnabledChange(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedProviderConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedProviderConfigs = append(m.AddedProviderConfigs, ProviderConfig{})
+			if err := m.AddedProviderConfigs[len(m.AddedProviderConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedProviderConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedProviderConfigs = append(m.RemovedProviderConfigs, ProviderConfig{})
+			if err := m.RemovedProviderConfigs[len(m.RemovedProviderConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,10 +1,11 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
-	"github.com/skip-mev/connect/v2/pkg/json"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
 
 	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
 )
@@ -56,13 +57,55 @@ func (t *Ticker) ValidateBasic() error {
 		return fmt.Errorf("metadata json field is longer than maximum length of %d", MaxMetadataJSONFieldLength)
 	}
 
-	if err := json.IsValid([]byte(t.Metadata_JSON)); err != nil {
-		return fmt.Errorf("invalid ticker metadata json: %w", err)
+	// the metadata may be any valid JSON, but only a JSON object configures the settings of the ticker
+	if len(t.Metadata_JSON) > 0 && !json.Valid([]byte(t.Metadata_JSON)) {
+		return fmt.Errorf("invalid ticker metadata json for %s", t.CurrencyPair.String())
+	}
+
+	aggregation, err := tickermetadata.AggregationFromJSONString(t.Metadata_JSON)
+	if err != nil {
+		return fmt.Errorf("invalid ticker aggregation metadata: %w", err)
+	}
+
+	if err := aggregation.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid ticker aggregation metadata for %s: %w", t.CurrencyPair.String(), err)
+	}
+
+	if _, err := tickermetadata.CircuitBreakerFromJSONString(t.Metadata_JSON); err != nil {
+		return fmt.Errorf("invalid ticker circuit breaker metadata: %w", err)
+	}
+
+	derived, err := t.DerivedTicker()
+	if err != nil {
+		return fmt.Errorf("invalid ticker derived metadata: %w", err)
+	}
+
+	if derived != nil {
+		if err := derived.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid ticker derived metadata for %s: %w", t.CurrencyPair.String(), err)
+		}
+
+		if derived.Source == t.String() {
+			return fmt.Errorf("derived ticker %s cannot be derived from itself", t.CurrencyPair.String())
+		}
 	}
 
 	return nil
 }
 
+// DerivedTicker returns the derived ticker configuration in the Ticker's metadata, or nil if the
+// Ticker is not a derived ticker.
+func (t *Ticker) DerivedTicker() (*tickermetadata.DerivedTicker, error) {
+	return tickermetadata.DerivedTickerFromJSONString(t.Metadata_JSON)
+}
+
+// IsDerived returns true iff the Ticker's price is derived from the index price history of
+// another Ticker (i.e. a TWAP or EMA) rather than fetched from providers.
+func (t *Ticker) IsDerived() bool {
+	derived, err := t.DerivedTicker()
+	return err == nil && derived != nil
+}
+
 // Equal returns true iff the Ticker is equal to the given Ticker.
 func (t *Ticker) Equal(other Ticker) bool {
 	return t.CurrencyPair.Equal(other.CurrencyPair) &&
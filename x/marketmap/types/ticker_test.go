@@ -30,6 +30,32 @@ func TestTicker(t *testing.T) {
 			},
 			expErr: false,
 		},
+		{
+			name: "valid ticker with a circuit breaker",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				Metadata_JSON:    `{"max_price_change_bps":500}`,
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid circuit breaker metadata",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				Metadata_JSON:    `{"max_price_change_bps":-1}`,
+			},
+			expErr: true,
+		},
 		{
 			name: "empty base",
 			ticker: types.Ticker{
@@ -134,6 +160,123 @@ func TestTicker(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "valid metadata json array",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				Metadata_JSON:    `[1,2,3]`,
+			},
+			expErr: false,
+		},
+		{
+			name: "valid metadata json string",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				Metadata_JSON:    `"metadata"`,
+			},
+			expErr: false,
+		},
+		{
+			name: "valid metadata json number",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				Metadata_JSON:    `42`,
+			},
+			expErr: false,
+		},
+		{
+			name: "valid aggregation strategy",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				Metadata_JSON:    `{"aggregation_strategy":"trimmed_mean","trim_percentage":20}`,
+			},
+			expErr: false,
+		},
+		{
+			name: "unknown aggregation strategy",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				Metadata_JSON:    `{"aggregation_strategy":"mode"}`,
+			},
+			expErr: true,
+		},
+		{
+			name: "trim percentage too large",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				Metadata_JSON:    `{"aggregation_strategy":"trimmed_mean","trim_percentage":50}`,
+			},
+			expErr: true,
+		},
+		{
+			name: "valid derived ticker",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOINTWAP",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				Metadata_JSON:    `{"derived":{"source":"BITCOIN/USDT","method":"twap","window_seconds":300}}`,
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid derived ticker",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOINEMA",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				Metadata_JSON:    `{"derived":{"source":"BITCOIN/USDT","method":"ema"}}`,
+			},
+			expErr: true,
+		},
+		{
+			name: "derived ticker derived from itself",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				Metadata_JSON:    `{"derived":{"source":"BITCOIN/USDT","method":"ema","periods":30}}`,
+			},
+			expErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,142 @@
+package tickermetadata
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AggregationStrategy is the strategy the oracle sidecar uses to combine the prices reported
+// by each provider into a single index price for a Ticker.
+type AggregationStrategy string
+
+const (
+	// AggregationStrategyMedian takes the median of the provider prices. This is the default.
+	AggregationStrategyMedian AggregationStrategy = "median"
+	// AggregationStrategyTrimmedMean takes the mean of the provider prices after discarding
+	// TrimPercentage percent of the prices from each end.
+	AggregationStrategyTrimmedMean AggregationStrategy = "trimmed_mean"
+	// AggregationStrategyVolumeWeightedMean takes the mean of the provider prices weighted by
+	// the configured ProviderVolumes.
+	AggregationStrategyVolumeWeightedMean AggregationStrategy = "volume_weighted_mean"
+	// AggregationStrategyLiquidityWeightedMedian takes the median of the provider prices weighted
+	// by the configured ProviderLiquidity.
+	AggregationStrategyLiquidityWeightedMedian AggregationStrategy = "liquidity_weighted_median"
+
+	// MaxTrimPercentage is the maximum percentage of prices that can be trimmed from each end
+	// when using the trimmed mean strategy.
+	MaxTrimPercentage = 49
+)
+
+// OutlierFilterMethod is the method the oracle sidecar uses to reject outlier provider prices
+// before they are aggregated.
+type OutlierFilterMethod string
+
+const (
+	// OutlierFilterMethodMAD rejects provider prices that are more than Threshold median absolute
+	// deviations away from the median of the provider prices. The median absolute deviation is
+	// at least 0.5% of the median, so that prices are not rejected when most providers agree.
+	OutlierFilterMethodMAD OutlierFilterMethod = "mad"
+	// OutlierFilterMethodPercentage rejects provider prices that deviate from the median of the
+	// provider prices by more than Threshold percent.
+	OutlierFilterMethodPercentage OutlierFilterMethod = "percentage"
+)
+
+// OutlierFilter configures the rejection of outlier provider prices before aggregation.
+type OutlierFilter struct {
+	// Method is the method used to detect outliers.
+	Method OutlierFilterMethod `json:"method"`
+	// Threshold is the maximum allowed deviation from the median. It is measured in median
+	// absolute deviations for the MAD method, and in percent for the percentage method.
+	Threshold float64 `json:"threshold"`
+}
+
+// ValidateBasic performs basic validation on the OutlierFilter.
+func (f OutlierFilter) ValidateBasic() error {
+	switch f.Method {
+	case OutlierFilterMethodMAD, OutlierFilterMethodPercentage:
+	default:
+		return fmt.Errorf("unknown outlier filter method: %s", f.Method)
+	}
+
+	if f.Threshold <= 0 {
+		return fmt.Errorf("outlier filter threshold must be positive; got %f", f.Threshold)
+	}
+
+	return nil
+}
+
+// Aggregation is the part of Ticker.Metadata_JSON that configures how the oracle sidecar aggregates
+// provider prices for a Ticker. These fields can be set alongside any other ticker metadata.
+type Aggregation struct {
+	// Strategy is the aggregation strategy used for the Ticker. If empty, the median is used.
+	Strategy AggregationStrategy `json:"aggregation_strategy,omitempty"`
+	// TrimPercentage is the percentage of prices discarded from each end of the sorted provider
+	// prices when using the trimmed mean strategy.
+	TrimPercentage uint64 `json:"trim_percentage,omitempty"`
+	// ProviderVolumes maps a provider name to its traded volume for the Ticker. It is used as the
+	// weight of each provider price when using the volume weighted mean strategy.
+	ProviderVolumes map[string]uint64 `json:"provider_volumes,omitempty"`
+	// ProviderLiquidity maps a provider name to its available liquidity for the Ticker. It is used
+	// as the weight of each provider price when using the liquidity weighted median strategy.
+	ProviderLiquidity map[string]uint64 `json:"provider_liquidity,omitempty"`
+	// OutlierFilter configures the rejection of outlier provider prices before they are aggregated.
+	// If nil, no provider prices are rejected.
+	OutlierFilter *OutlierFilter `json:"outlier_filter,omitempty"`
+}
+
+// NewAggregation returns a new Aggregation instance.
+func NewAggregation(strategy AggregationStrategy) Aggregation {
+	return Aggregation{
+		Strategy: strategy,
+	}
+}
+
+// GetStrategy returns the configured strategy, defaulting to the median.
+func (a Aggregation) GetStrategy() AggregationStrategy {
+	if a.Strategy == "" {
+		return AggregationStrategyMedian
+	}
+
+	return a.Strategy
+}
+
+// ValidateBasic performs basic validation on the Aggregation.
+func (a Aggregation) ValidateBasic() error {
+	switch a.GetStrategy() {
+	case AggregationStrategyMedian, AggregationStrategyVolumeWeightedMean, AggregationStrategyLiquidityWeightedMedian:
+	case AggregationStrategyTrimmedMean:
+		if a.TrimPercentage > MaxTrimPercentage {
+			return fmt.Errorf("trim percentage must be at most %d; got %d", MaxTrimPercentage, a.TrimPercentage)
+		}
+	default:
+		return fmt.Errorf("unknown aggregation strategy: %s", a.Strategy)
+	}
+
+	if a.OutlierFilter != nil {
+		return a.OutlierFilter.ValidateBasic()
+	}
+
+	return nil
+}
+
+// MarshalAggregation returns the JSON byte encoding of the Aggregation.
+func MarshalAggregation(m Aggregation) ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// AggregationFromJSONString returns an Aggregation instance from a JSON string.
+func AggregationFromJSONString(jsonString string) (Aggregation, error) {
+	return AggregationFromJSONBytes([]byte(jsonString))
+}
+
+// AggregationFromJSONBytes returns an Aggregation instance from JSON bytes. Empty
+// metadata, or metadata that is not a JSON object, results in the default Aggregation.
+func AggregationFromJSONBytes(jsonBytes []byte) (Aggregation, error) {
+	var elem Aggregation
+	if !isJSONObject(jsonBytes) {
+		return elem, nil
+	}
+
+	err := json.Unmarshal(jsonBytes, &elem)
+	return elem, err
+}
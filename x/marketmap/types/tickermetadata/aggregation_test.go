@@ -0,0 +1,127 @@
+package tickermetadata_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+func Test_UnmarshalAggregation(t *testing.T) {
+	t.Run("can marshal and unmarshal the same struct and values", func(t *testing.T) {
+		elem := tickermetadata.Aggregation{
+			Strategy:        tickermetadata.AggregationStrategyVolumeWeightedMean,
+			ProviderVolumes: map[string]uint64{"binance_api": 100, "mexc_ws": 1},
+		}
+
+		bz, err := tickermetadata.MarshalAggregation(elem)
+		require.NoError(t, err)
+
+		elem2, err := tickermetadata.AggregationFromJSONBytes(bz)
+		require.NoError(t, err)
+		require.Equal(t, elem, elem2)
+	})
+
+	t.Run("can unmarshal alongside other ticker metadata", func(t *testing.T) {
+		elemJSON := `{"aggregate_ids":[{"venue":"coingecko","ID":"id"}],"aggregation_strategy":"trimmed_mean","trim_percentage":25}`
+		elem, err := tickermetadata.AggregationFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.AggregationStrategyTrimmedMean, elem.GetStrategy())
+		require.Equal(t, uint64(25), elem.TrimPercentage)
+	})
+
+	t.Run("empty metadata defaults to the median", func(t *testing.T) {
+		elem, err := tickermetadata.AggregationFromJSONString("")
+		require.NoError(t, err)
+		require.Equal(t, tickermetadata.AggregationStrategyMedian, elem.GetStrategy())
+		require.NoError(t, elem.ValidateBasic())
+	})
+
+	t.Run("metadata that is not a json object defaults to the median", func(t *testing.T) {
+		for _, elemJSON := range []string{`["trimmed_mean"]`, `"trimmed_mean"`, `25`} {
+			elem, err := tickermetadata.AggregationFromJSONString(elemJSON)
+			require.NoError(t, err)
+			require.Equal(t, tickermetadata.AggregationStrategyMedian, elem.GetStrategy())
+		}
+	})
+}
+
+func TestAggregationValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		elem   tickermetadata.Aggregation
+		expErr bool
+	}{
+		{
+			name: "default aggregation",
+			elem: tickermetadata.Aggregation{},
+		},
+		{
+			name: "valid trimmed mean",
+			elem: tickermetadata.Aggregation{
+				Strategy:       tickermetadata.AggregationStrategyTrimmedMean,
+				TrimPercentage: tickermetadata.MaxTrimPercentage,
+			},
+		},
+		{
+			name: "trimmed mean with too large trim percentage",
+			elem: tickermetadata.Aggregation{
+				Strategy:       tickermetadata.AggregationStrategyTrimmedMean,
+				TrimPercentage: tickermetadata.MaxTrimPercentage + 1,
+			},
+			expErr: true,
+		},
+		{
+			name: "valid liquidity weighted median",
+			elem: tickermetadata.NewAggregation(tickermetadata.AggregationStrategyLiquidityWeightedMedian),
+		},
+		{
+			name: "valid outlier filter",
+			elem: tickermetadata.Aggregation{
+				OutlierFilter: &tickermetadata.OutlierFilter{
+					Method:    tickermetadata.OutlierFilterMethodMAD,
+					Threshold: 3,
+				},
+			},
+		},
+		{
+			name: "outlier filter with unknown method",
+			elem: tickermetadata.Aggregation{
+				OutlierFilter: &tickermetadata.OutlierFilter{
+					Method:    "zscore",
+					Threshold: 3,
+				},
+			},
+			expErr: true,
+		},
+		{
+			name: "outlier filter with non-positive threshold",
+			elem: tickermetadata.Aggregation{
+				OutlierFilter: &tickermetadata.OutlierFilter{
+					Method:    tickermetadata.OutlierFilterMethodPercentage,
+					Threshold: 0,
+				},
+			},
+			expErr: true,
+		},
+		{
+			name:   "unknown strategy",
+			elem:   tickermetadata.NewAggregation("mode"),
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.elem.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
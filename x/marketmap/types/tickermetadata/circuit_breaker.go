@@ -0,0 +1,41 @@
+package tickermetadata
+
+import "encoding/json"
+
+// CircuitBreaker is the part of Ticker.Metadata_JSON that configures the x/oracle circuit breaker of a Ticker. These
+// fields can be set alongside any other ticker metadata.
+type CircuitBreaker struct {
+	// MaxPriceChangeBps is the maximum change (in basis points) of the price of the Ticker from one update to the
+	// next, if the circuit breaker is enabled by the x/oracle params. If zero, the maximum price change of the params
+	// is used.
+	MaxPriceChangeBps uint64 `json:"max_price_change_bps,omitempty"`
+}
+
+// NewCircuitBreaker returns a new CircuitBreaker instance.
+func NewCircuitBreaker(maxPriceChangeBps uint64) CircuitBreaker {
+	return CircuitBreaker{
+		MaxPriceChangeBps: maxPriceChangeBps,
+	}
+}
+
+// MarshalCircuitBreaker returns the JSON byte encoding of the CircuitBreaker.
+func MarshalCircuitBreaker(m CircuitBreaker) ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// CircuitBreakerFromJSONString returns a CircuitBreaker instance from a JSON string.
+func CircuitBreakerFromJSONString(jsonString string) (CircuitBreaker, error) {
+	return CircuitBreakerFromJSONBytes([]byte(jsonString))
+}
+
+// CircuitBreakerFromJSONBytes returns a CircuitBreaker instance from JSON bytes. Empty metadata, or metadata that is
+// not a JSON object, results in the default CircuitBreaker, which uses the x/oracle params.
+func CircuitBreakerFromJSONBytes(jsonBytes []byte) (CircuitBreaker, error) {
+	var elem CircuitBreaker
+	if !isJSONObject(jsonBytes) {
+		return elem, nil
+	}
+
+	err := json.Unmarshal(jsonBytes, &elem)
+	return elem, err
+}
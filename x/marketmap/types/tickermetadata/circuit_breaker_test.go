@@ -0,0 +1,48 @@
+package tickermetadata_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+func Test_UnmarshalCircuitBreaker(t *testing.T) {
+	t.Run("can marshal and unmarshal the same struct and values", func(t *testing.T) {
+		elem := tickermetadata.NewCircuitBreaker(500)
+
+		bz, err := tickermetadata.MarshalCircuitBreaker(elem)
+		require.NoError(t, err)
+
+		elem2, err := tickermetadata.CircuitBreakerFromJSONBytes(bz)
+		require.NoError(t, err)
+		require.Equal(t, elem, elem2)
+	})
+
+	t.Run("can unmarshal alongside other ticker metadata", func(t *testing.T) {
+		elemJSON := `{"aggregation_strategy":"trimmed_mean","trim_percentage":25,"max_price_change_bps":2500}`
+		elem, err := tickermetadata.CircuitBreakerFromJSONString(elemJSON)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2500), elem.MaxPriceChangeBps)
+	})
+
+	t.Run("empty metadata uses the params", func(t *testing.T) {
+		elem, err := tickermetadata.CircuitBreakerFromJSONString("")
+		require.NoError(t, err)
+		require.Zero(t, elem.MaxPriceChangeBps)
+	})
+
+	t.Run("metadata that is not a json object uses the params", func(t *testing.T) {
+		for _, elemJSON := range []string{`[500]`, `"max_price_change_bps"`, `500`} {
+			elem, err := tickermetadata.CircuitBreakerFromJSONString(elemJSON)
+			require.NoError(t, err)
+			require.Zero(t, elem.MaxPriceChangeBps)
+		}
+	})
+
+	t.Run("invalid maximum price change", func(t *testing.T) {
+		_, err := tickermetadata.CircuitBreakerFromJSONString(`{"max_price_change_bps":"high"}`)
+		require.Error(t, err)
+	})
+}
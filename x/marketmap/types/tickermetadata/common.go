@@ -1,6 +1,9 @@
 package tickermetadata
 
-import "encoding/json"
+import (
+	"bytes"
+	"encoding/json"
+)
 
 type AggregatorID struct {
 	// Venue is the name of the aggregator for which the ID is valid.
@@ -36,3 +39,10 @@ func AggregatorIDFromJSONBytes(jsonBytes []byte) (AggregatorID, error) {
 	err := json.Unmarshal(jsonBytes, &elem)
 	return elem, err
 }
+
+// isJSONObject returns true iff the given JSON bytes encode a JSON object. Ticker metadata that is
+// not a JSON object, e.g. an array or a string, configures no settings.
+func isJSONObject(jsonBytes []byte) bool {
+	trimmed := bytes.TrimSpace(jsonBytes)
+	return len(trimmed) > 0 && trimmed[0] == '{'
+}
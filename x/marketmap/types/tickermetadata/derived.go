@@ -0,0 +1,107 @@
+package tickermetadata
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DerivedMethod is the method the oracle sidecar uses to derive the price of a derived Ticker
+// from the history of the index price of its source Ticker.
+type DerivedMethod string
+
+const (
+	// DerivedMethodTWAP is the time weighted average of the source index price over the last
+	// WindowSeconds seconds.
+	DerivedMethodTWAP DerivedMethod = "twap"
+	// DerivedMethodEMA is the exponential moving average of the source index price over the
+	// last Periods index prices, i.e. with a smoothing factor of 2 / (Periods + 1).
+	DerivedMethodEMA DerivedMethod = "ema"
+
+	// MaxDerivedWindowSeconds is the maximum window of a TWAP derived Ticker.
+	MaxDerivedWindowSeconds = 3600
+	// MaxDerivedPeriods is the maximum number of periods of an EMA derived Ticker.
+	MaxDerivedPeriods = 1024
+)
+
+// DerivedTicker configures a Ticker whose price is derived by the oracle sidecar from the history
+// of the index price of another Ticker, i.e. BTC/USD 5m TWAP. A derived Ticker has no provider configs.
+type DerivedTicker struct {
+	// Source is the Ticker (i.e. BTC/USD) whose index price history is used.
+	Source string `json:"source"`
+	// Method is the method used to derive the price.
+	Method DerivedMethod `json:"method"`
+	// WindowSeconds is the window of the time weighted average when using the TWAP method.
+	WindowSeconds uint64 `json:"window_seconds,omitempty"`
+	// Periods is the number of index prices of the exponential moving average when using the
+	// EMA method.
+	Periods uint64 `json:"periods,omitempty"`
+}
+
+// derivedTickerMetadata is the part of Ticker.Metadata_JSON that configures a derived Ticker.
+type derivedTickerMetadata struct {
+	Derived *DerivedTicker `json:"derived,omitempty"`
+}
+
+// NewTWAPDerivedTicker returns a new DerivedTicker that is the TWAP of the source over the window.
+func NewTWAPDerivedTicker(source string, windowSeconds uint64) DerivedTicker {
+	return DerivedTicker{
+		Source:        source,
+		Method:        DerivedMethodTWAP,
+		WindowSeconds: windowSeconds,
+	}
+}
+
+// NewEMADerivedTicker returns a new DerivedTicker that is the EMA of the source over the periods.
+func NewEMADerivedTicker(source string, periods uint64) DerivedTicker {
+	return DerivedTicker{
+		Source:  source,
+		Method:  DerivedMethodEMA,
+		Periods: periods,
+	}
+}
+
+// ValidateBasic performs basic validation on the DerivedTicker.
+func (d DerivedTicker) ValidateBasic() error {
+	if len(d.Source) == 0 {
+		return fmt.Errorf("derived ticker source must not be empty")
+	}
+
+	switch d.Method {
+	case DerivedMethodTWAP:
+		if d.WindowSeconds == 0 || d.WindowSeconds > MaxDerivedWindowSeconds {
+			return fmt.Errorf("twap window must be between 1 and %d seconds; got %d", MaxDerivedWindowSeconds, d.WindowSeconds)
+		}
+	case DerivedMethodEMA:
+		if d.Periods == 0 || d.Periods > MaxDerivedPeriods {
+			return fmt.Errorf("ema periods must be between 1 and %d; got %d", MaxDerivedPeriods, d.Periods)
+		}
+	default:
+		return fmt.Errorf("unknown derived ticker method: %s", d.Method)
+	}
+
+	return nil
+}
+
+// MarshalDerivedTicker returns the JSON byte encoding of the DerivedTicker as ticker metadata.
+func MarshalDerivedTicker(m DerivedTicker) ([]byte, error) {
+	return json.Marshal(derivedTickerMetadata{Derived: &m})
+}
+
+// DerivedTickerFromJSONString returns a DerivedTicker instance from a JSON string. If the
+// metadata does not configure a derived ticker, nil is returned.
+func DerivedTickerFromJSONString(jsonString string) (*DerivedTicker, error) {
+	return DerivedTickerFromJSONBytes([]byte(jsonString))
+}
+
+// DerivedTickerFromJSONBytes returns a DerivedTicker instance from JSON bytes. If the
+// metadata does not configure a derived ticker, e.g. because it is not a JSON object, nil
+// is returned.
+func DerivedTickerFromJSONBytes(jsonBytes []byte) (*DerivedTicker, error) {
+	if !isJSONObject(jsonBytes) {
+		return nil, nil
+	}
+
+	var elem derivedTickerMetadata
+	err := json.Unmarshal(jsonBytes, &elem)
+	return elem.Derived, err
+}
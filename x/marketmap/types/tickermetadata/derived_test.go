@@ -0,0 +1,105 @@
+package tickermetadata_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+func Test_UnmarshalDerivedTicker(t *testing.T) {
+	t.Run("can marshal and unmarshal the same struct and values", func(t *testing.T) {
+		elem := tickermetadata.NewTWAPDerivedTicker("BTC/USD", 300)
+
+		bz, err := tickermetadata.MarshalDerivedTicker(elem)
+		require.NoError(t, err)
+
+		elem2, err := tickermetadata.DerivedTickerFromJSONBytes(bz)
+		require.NoError(t, err)
+		require.Equal(t, elem, *elem2)
+	})
+
+	t.Run("can unmarshal alongside other ticker metadata", func(t *testing.T) {
+		elemJSON := `{"aggregate_ids":[{"venue":"coingecko","ID":"id"}],"derived":{"source":"ETH/USD","method":"ema","periods":30}}`
+		elem, err := tickermetadata.DerivedTickerFromJSONString(elemJSON)
+		require.NoError(t, err)
+		require.Equal(t, tickermetadata.NewEMADerivedTicker("ETH/USD", 30), *elem)
+	})
+
+	t.Run("metadata without a derived ticker", func(t *testing.T) {
+		elem, err := tickermetadata.DerivedTickerFromJSONString(`{"aggregation_strategy":"median"}`)
+		require.NoError(t, err)
+		require.Nil(t, elem)
+
+		elem, err = tickermetadata.DerivedTickerFromJSONString("")
+		require.NoError(t, err)
+		require.Nil(t, elem)
+
+		elem, err = tickermetadata.DerivedTickerFromJSONString(`[{"derived":{"source":"BTC/USD","method":"ema","periods":30}}]`)
+		require.NoError(t, err)
+		require.Nil(t, elem)
+	})
+}
+
+func TestDerivedTickerValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		elem   tickermetadata.DerivedTicker
+		expErr bool
+	}{
+		{
+			name: "valid twap",
+			elem: tickermetadata.NewTWAPDerivedTicker("BTC/USD", tickermetadata.MaxDerivedWindowSeconds),
+		},
+		{
+			name: "valid ema",
+			elem: tickermetadata.NewEMADerivedTicker("BTC/USD", tickermetadata.MaxDerivedPeriods),
+		},
+		{
+			name:   "empty source",
+			elem:   tickermetadata.NewTWAPDerivedTicker("", 300),
+			expErr: true,
+		},
+		{
+			name:   "twap without a window",
+			elem:   tickermetadata.NewTWAPDerivedTicker("BTC/USD", 0),
+			expErr: true,
+		},
+		{
+			name:   "twap with too large a window",
+			elem:   tickermetadata.NewTWAPDerivedTicker("BTC/USD", tickermetadata.MaxDerivedWindowSeconds+1),
+			expErr: true,
+		},
+		{
+			name:   "ema without periods",
+			elem:   tickermetadata.NewEMADerivedTicker("BTC/USD", 0),
+			expErr: true,
+		},
+		{
+			name:   "ema with too many periods",
+			elem:   tickermetadata.NewEMADerivedTicker("BTC/USD", tickermetadata.MaxDerivedPeriods+1),
+			expErr: true,
+		},
+		{
+			name: "unknown method",
+			elem: tickermetadata.DerivedTicker{
+				Source: "BTC/USD",
+				Method: "vwap",
+			},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.elem.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -516,6 +516,310 @@ func (m *MsgRemoveMarketAuthoritiesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveMarketAuthoritiesResponse proto.InternalMessageInfo
 
+// MsgRemoveMarkets defines the Msg/RemoveMarkets request type. It contains the
+// markets to remove from the market map.
+type MsgRemoveMarkets struct {
+	// Authority is the signer of this transaction. This authority must be the
+	// admin or a market authority of the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Markets is the list of tickers (BASE/QUOTE) of the markets to remove.
+	Markets []string `protobuf:"bytes,2,rep,name=markets,proto3" json:"markets,omitempty"`
+}
+
+func (m *MsgRemoveMarkets) Reset()         { *m = MsgRemoveMarkets{} }
+func (m *MsgRemoveMarkets) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMarkets) ProtoMessage()    {}
+func (*MsgRemoveMarkets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{10}
+}
+func (m *MsgRemoveMarkets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMarkets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMarkets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMarkets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMarkets.Merge(m, src)
+}
+func (m *MsgRemoveMarkets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMarkets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMarkets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMarkets proto.InternalMessageInfo
+
+func (m *MsgRemoveMarkets) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveMarkets) GetMarkets() []string {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
+}
+
+// MsgRemoveMarketsResponse defines the Msg/RemoveMarkets response type.
+type MsgRemoveMarketsResponse struct {
+}
+
+func (m *MsgRemoveMarketsResponse) Reset()         { *m = MsgRemoveMarketsResponse{} }
+func (m *MsgRemoveMarketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMarketsResponse) ProtoMessage()    {}
+func (*MsgRemoveMarketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{11}
+}
+func (m *MsgRemoveMarketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMarketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMarketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMarketsResponse.Merge(m, src)
+}
+func (m *MsgRemoveMarketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMarketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMarketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMarketsResponse proto.InternalMessageInfo
+
+// MsgScheduleMarketUpdates defines a message carrying a payload for scheduling
+// updates of existing markets in the x/marketmap module.
+type MsgScheduleMarketUpdates struct {
+	// Authority is the signer of this transaction. This authority must be
+	// authorized by the module to execute the message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Height is the future height at the beginning of which the updates are
+	// applied.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Markets is the list of all markets to be updated at the given height.
+	Markets []Market `protobuf:"bytes,3,rep,name=markets,proto3" json:"markets"`
+}
+
+func (m *MsgScheduleMarketUpdates) Reset()         { *m = MsgScheduleMarketUpdates{} }
+func (m *MsgScheduleMarketUpdates) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMarketUpdates) ProtoMessage()    {}
+func (*MsgScheduleMarketUpdates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{12}
+}
+func (m *MsgScheduleMarketUpdates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMarketUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMarketUpdates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMarketUpdates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMarketUpdates.Merge(m, src)
+}
+func (m *MsgScheduleMarketUpdates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMarketUpdates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMarketUpdates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleMarketUpdates proto.InternalMessageInfo
+
+func (m *MsgScheduleMarketUpdates) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleMarketUpdates) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgScheduleMarketUpdates) GetMarkets() []Market {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
+}
+
+// MsgScheduleMarketUpdatesResponse is the response message for
+// MsgScheduleMarketUpdates.
+type MsgScheduleMarketUpdatesResponse struct {
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) Reset()         { *m = MsgScheduleMarketUpdatesResponse{} }
+func (m *MsgScheduleMarketUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMarketUpdatesResponse) ProtoMessage()    {}
+func (*MsgScheduleMarketUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{13}
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMarketUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMarketUpdatesResponse.Merge(m, src)
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMarketUpdatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleMarketUpdatesResponse proto.InternalMessageInfo
+
+// MsgCancelMarketUpdates defines a message carrying a payload for cancelling
+// scheduled market updates in the x/marketmap module.
+type MsgCancelMarketUpdates struct {
+	// Admin defines the authority that is the x/marketmap
+	// Admin account.  This account is set in the module parameters.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// Height is the height that the updates are scheduled for.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Markets is the list of tickers of the markets whose updates are
+	// cancelled.
+	Markets []string `protobuf:"bytes,3,rep,name=markets,proto3" json:"markets,omitempty"`
+}
+
+func (m *MsgCancelMarketUpdates) Reset()         { *m = MsgCancelMarketUpdates{} }
+func (m *MsgCancelMarketUpdates) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelMarketUpdates) ProtoMessage()    {}
+func (*MsgCancelMarketUpdates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{14}
+}
+func (m *MsgCancelMarketUpdates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelMarketUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMarketUpdates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelMarketUpdates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMarketUpdates.Merge(m, src)
+}
+func (m *MsgCancelMarketUpdates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelMarketUpdates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMarketUpdates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelMarketUpdates proto.InternalMessageInfo
+
+func (m *MsgCancelMarketUpdates) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgCancelMarketUpdates) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgCancelMarketUpdates) GetMarkets() []string {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
+}
+
+// MsgCancelMarketUpdatesResponse is the response message for
+// MsgCancelMarketUpdates.
+type MsgCancelMarketUpdatesResponse struct {
+}
+
+func (m *MsgCancelMarketUpdatesResponse) Reset()         { *m = MsgCancelMarketUpdatesResponse{} }
+func (m *MsgCancelMarketUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelMarketUpdatesResponse) ProtoMessage()    {}
+func (*MsgCancelMarketUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{15}
+}
+func (m *MsgCancelMarketUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelMarketUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMarketUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelMarketUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMarketUpdatesResponse.Merge(m, src)
+}
+func (m *MsgCancelMarketUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelMarketUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMarketUpdatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelMarketUpdatesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpsertMarkets)(nil), "slinky.marketmap.v1.MsgUpsertMarkets")
 	proto.RegisterType((*MsgUpsertMarketsResponse)(nil), "slinky.marketmap.v1.MsgUpsertMarketsResponse")
@@ -528,56 +832,73 @@ func init() {
 	proto.RegisterType((*MsgParamsResponse)(nil), "slinky.marketmap.v1.MsgParamsResponse")
 	proto.RegisterType((*MsgRemoveMarketAuthorities)(nil), "slinky.marketmap.v1.MsgRemoveMarketAuthorities")
 	proto.RegisterType((*MsgRemoveMarketAuthoritiesResponse)(nil), "slinky.marketmap.v1.MsgRemoveMarketAuthoritiesResponse")
+	proto.RegisterType((*MsgRemoveMarkets)(nil), "slinky.marketmap.v1.MsgRemoveMarkets")
+	proto.RegisterType((*MsgRemoveMarketsResponse)(nil), "slinky.marketmap.v1.MsgRemoveMarketsResponse")
+	proto.RegisterType((*MsgScheduleMarketUpdates)(nil), "slinky.marketmap.v1.MsgScheduleMarketUpdates")
+	proto.RegisterType((*MsgScheduleMarketUpdatesResponse)(nil), "slinky.marketmap.v1.MsgScheduleMarketUpdatesResponse")
+	proto.RegisterType((*MsgCancelMarketUpdates)(nil), "slinky.marketmap.v1.MsgCancelMarketUpdates")
+	proto.RegisterType((*MsgCancelMarketUpdatesResponse)(nil), "slinky.marketmap.v1.MsgCancelMarketUpdatesResponse")
 }
 
 func init() { proto.RegisterFile("slinky/marketmap/v1/tx.proto", fileDescriptor_e9adadfc18297083) }
 
 var fileDescriptor_e9adadfc18297083 = []byte{
-	// 692 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x6f, 0xd3, 0x4c,
-	0x10, 0xce, 0xa6, 0x1f, 0xef, 0x9b, 0x85, 0x94, 0x76, 0x5b, 0xa9, 0xc6, 0x20, 0x37, 0x32, 0x14,
-	0x95, 0x4a, 0xb5, 0x69, 0x91, 0xf8, 0x08, 0x97, 0x36, 0x08, 0x09, 0x21, 0x45, 0x42, 0x46, 0xe5,
-	0xc0, 0x25, 0x72, 0xed, 0x95, 0x6b, 0xa5, 0xeb, 0xb5, 0xbc, 0x1b, 0xab, 0xb9, 0x21, 0x7a, 0xe3,
-	0x80, 0x38, 0x72, 0x03, 0xfe, 0x41, 0x0f, 0xfc, 0x03, 0x2e, 0xbd, 0x51, 0x71, 0xe2, 0x84, 0x50,
-	0x7b, 0x28, 0x3f, 0x03, 0xc5, 0xbb, 0x71, 0xe3, 0x36, 0x0e, 0x09, 0x70, 0xb1, 0x76, 0x67, 0x9e,
-	0x99, 0x7d, 0x9e, 0x99, 0x9d, 0x35, 0xbc, 0xca, 0x76, 0xfc, 0xa0, 0xd9, 0x36, 0x89, 0x1d, 0x35,
-	0x31, 0x27, 0x76, 0x68, 0xc6, 0xab, 0x26, 0xdf, 0x35, 0xc2, 0x88, 0x72, 0x8a, 0x66, 0x85, 0xd7,
-	0x48, 0xbd, 0x46, 0xbc, 0xaa, 0xce, 0x3b, 0x94, 0x11, 0xca, 0x4c, 0xc2, 0xbc, 0x0e, 0x98, 0x30,
-	0x4f, 0xa0, 0xd5, 0x39, 0x8f, 0x7a, 0x34, 0x59, 0x9a, 0x9d, 0x95, 0xb4, 0x5e, 0x16, 0xf0, 0x86,
-	0x70, 0x88, 0x8d, 0x74, 0xcd, 0xd8, 0xc4, 0x0f, 0xa8, 0x99, 0x7c, 0xa5, 0xa9, 0xd2, 0x8f, 0x8f,
-	0xd8, 0x0c, 0x42, 0x84, 0x76, 0x64, 0x13, 0x99, 0x56, 0xff, 0x0c, 0xe0, 0x74, 0x9d, 0x79, 0x9b,
-	0x21, 0xc3, 0x11, 0xaf, 0x27, 0x30, 0x86, 0xee, 0xc0, 0x92, 0xdd, 0xe2, 0xdb, 0x34, 0xf2, 0x79,
-	0x5b, 0x01, 0x15, 0xb0, 0x54, 0xaa, 0x29, 0x5f, 0x3f, 0xad, 0xcc, 0x49, 0x42, 0x1b, 0xae, 0x1b,
-	0x61, 0xc6, 0x9e, 0xf1, 0xc8, 0x0f, 0x3c, 0xeb, 0x14, 0x8a, 0x1e, 0xc0, 0xff, 0xc4, 0x49, 0x4c,
-	0x29, 0x56, 0xc6, 0x96, 0x2e, 0xac, 0x5d, 0x31, 0xfa, 0x14, 0xc5, 0x10, 0xc7, 0xd4, 0xc6, 0x0f,
-	0xbe, 0x2f, 0x14, 0xac, 0x6e, 0x44, 0xb5, 0xfa, 0xf3, 0xc3, 0x42, 0xe1, 0xd5, 0xc9, 0xfe, 0xf2,
-	0x69, 0xc2, 0xd7, 0x27, 0xfb, 0xcb, 0xd7, 0xa4, 0x84, 0xdd, 0x1e, 0x11, 0x67, 0x09, 0xeb, 0x5f,
-	0x00, 0x54, 0xce, 0x1a, 0x2d, 0xcc, 0x42, 0x1a, 0x30, 0x8c, 0x9a, 0x70, 0x4a, 0x84, 0x36, 0x5a,
-	0xa1, 0x6b, 0x73, 0xcc, 0x14, 0x90, 0x90, 0x5b, 0xef, 0x4f, 0x2e, 0x27, 0x8d, 0x64, 0xbd, 0x29,
-	0x52, 0x3c, 0x0a, 0x78, 0xd4, 0xae, 0x15, 0x15, 0x60, 0x95, 0x49, 0xaf, 0x5d, 0x5d, 0x87, 0xe8,
-	0x3c, 0x10, 0x4d, 0xc3, 0xb1, 0x26, 0x96, 0xa5, 0xb4, 0x3a, 0x4b, 0x34, 0x07, 0x27, 0x62, 0x7b,
-	0xa7, 0x85, 0x95, 0x62, 0x05, 0x2c, 0xfd, 0x6f, 0x89, 0x4d, 0xb5, 0x78, 0x0f, 0x54, 0xc7, 0xdf,
-	0x7d, 0x5c, 0x00, 0xfa, 0xa1, 0xe8, 0xcb, 0xc3, 0x08, 0xdb, 0x1c, 0xff, 0x6d, 0x5f, 0x1e, 0xc3,
-	0x29, 0x27, 0x49, 0xd4, 0x18, 0xb9, 0x3d, 0x65, 0xa7, 0x97, 0xc1, 0x88, 0x4d, 0xca, 0xb0, 0xd7,
-	0xd5, 0xa4, 0x47, 0x19, 0x5b, 0xb7, 0xb8, 0x5d, 0xb9, 0xa2, 0x68, 0xff, 0x40, 0xae, 0xe8, 0xf4,
-	0x1f, 0xc8, 0x6d, 0xf5, 0x32, 0x18, 0xf9, 0x4e, 0xba, 0xe7, 0xe4, 0x66, 0x6c, 0xa9, 0xdc, 0x37,
-	0x00, 0x96, 0xea, 0xcc, 0x7b, 0x9a, 0x4c, 0x22, 0xba, 0x0f, 0x27, 0xc5, 0x4c, 0x26, 0x22, 0xf3,
-	0x78, 0x0a, 0xb0, 0xe4, 0x29, 0x03, 0xb2, 0x25, 0x2a, 0x0e, 0x5d, 0xa2, 0xea, 0x54, 0x56, 0x94,
-	0x3e, 0x0b, 0x67, 0x52, 0x3e, 0x29, 0xcb, 0x3d, 0x00, 0xd5, 0x3a, 0xf3, 0x2c, 0x4c, 0x68, 0x2c,
-	0x25, 0x6c, 0xc8, 0x08, 0x1f, 0x33, 0x74, 0x13, 0x4e, 0x47, 0x89, 0xab, 0x61, 0x8b, 0x63, 0xe4,
-	0x64, 0x95, 0xac, 0x4b, 0xc2, 0xbe, 0xd1, 0x35, 0x23, 0x03, 0x4e, 0xd8, 0x2e, 0xf1, 0x83, 0xdf,
-	0x52, 0x14, 0xb0, 0x2a, 0xec, 0xd0, 0x13, 0x6b, 0xfd, 0x3a, 0xd4, 0xf3, 0x49, 0x74, 0xb9, 0xae,
-	0xbd, 0x1f, 0x87, 0x63, 0x75, 0xe6, 0x21, 0x0c, 0xcb, 0xd9, 0x99, 0x59, 0xcc, 0x9b, 0xf2, 0x0c,
-	0x4c, 0x5d, 0x19, 0x0a, 0x96, 0xbe, 0x29, 0x18, 0x96, 0xb3, 0x77, 0x75, 0x31, 0xff, 0x31, 0x71,
-	0x87, 0x39, 0xa6, 0xef, 0x3d, 0x41, 0xcf, 0xe1, 0x45, 0xe1, 0x90, 0x37, 0x45, 0xcb, 0x0b, 0x17,
-	0x7e, 0xf5, 0xc6, 0x60, 0x7f, 0x9a, 0x77, 0x0f, 0xc0, 0xf9, 0xbc, 0xb6, 0x9a, 0x79, 0x39, 0x72,
-	0x02, 0xd4, 0xbb, 0x23, 0x06, 0x64, 0x8b, 0xd8, 0xfb, 0xdf, 0x59, 0x1c, 0xea, 0x45, 0x1e, 0x54,
-	0xc4, 0x3e, 0x0f, 0xb7, 0x3a, 0xf1, 0xf2, 0x64, 0x7f, 0x19, 0xd4, 0x9e, 0x1c, 0x1c, 0x69, 0xe0,
-	0xf0, 0x48, 0x03, 0x3f, 0x8e, 0x34, 0xf0, 0xf6, 0x58, 0x2b, 0x1c, 0x1e, 0x6b, 0x85, 0x6f, 0xc7,
-	0x5a, 0xe1, 0xc5, 0x2d, 0xcf, 0xe7, 0xdb, 0xad, 0x2d, 0xc3, 0xa1, 0xc4, 0x64, 0x4d, 0x3f, 0x5c,
-	0x21, 0x38, 0x36, 0x1d, 0x1a, 0x04, 0xd8, 0xe1, 0x66, 0xbc, 0x96, 0x19, 0x73, 0xde, 0x0e, 0x31,
-	0xdb, 0x9a, 0x4c, 0x7e, 0x9e, 0xb7, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x1c, 0x93, 0xe9,
-	0x12, 0x08, 0x00, 0x00,
+	// 865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x4f, 0xdb, 0x48,
+	0x14, 0xcf, 0x24, 0x90, 0xdd, 0xcc, 0x6e, 0x58, 0x30, 0x2c, 0x78, 0xbd, 0x2b, 0x13, 0x79, 0x37,
+	0x2b, 0xfe, 0x28, 0xf1, 0x02, 0x5a, 0x96, 0x4d, 0x2f, 0x90, 0xaa, 0x52, 0x55, 0x29, 0x52, 0x65,
+	0x44, 0x0f, 0xbd, 0x44, 0xc6, 0x19, 0x39, 0x56, 0xe2, 0x3f, 0xf2, 0x38, 0x29, 0x39, 0x54, 0xaa,
+	0xca, 0xad, 0x87, 0xaa, 0xc7, 0x1e, 0x7a, 0x68, 0xbf, 0x01, 0x07, 0xbe, 0x41, 0x2f, 0xdc, 0x8a,
+	0x7a, 0xea, 0xa9, 0xaa, 0xe0, 0x40, 0xa5, 0x7e, 0x87, 0xaa, 0x8a, 0x67, 0xe2, 0x78, 0xc0, 0xa6,
+	0x4e, 0xda, 0x4b, 0x34, 0xf3, 0xde, 0x6f, 0xde, 0xfb, 0xbd, 0x37, 0x6f, 0x7e, 0x31, 0xfc, 0x03,
+	0xb7, 0x0d, 0xab, 0xd5, 0x93, 0x4d, 0xd5, 0x6d, 0x21, 0xcf, 0x54, 0x1d, 0xb9, 0xbb, 0x26, 0x7b,
+	0x07, 0x65, 0xc7, 0xb5, 0x3d, 0x9b, 0x9b, 0x25, 0xde, 0x72, 0xe0, 0x2d, 0x77, 0xd7, 0x84, 0x05,
+	0xcd, 0xc6, 0xa6, 0x8d, 0x65, 0x13, 0xeb, 0x7d, 0xb0, 0x89, 0x75, 0x82, 0x16, 0xe6, 0x74, 0x5b,
+	0xb7, 0xfd, 0xa5, 0xdc, 0x5f, 0x51, 0xeb, 0x6f, 0x04, 0x5e, 0x27, 0x0e, 0xb2, 0xa1, 0xae, 0x19,
+	0xd5, 0x34, 0x2c, 0x5b, 0xf6, 0x7f, 0xa9, 0xa9, 0x10, 0xc5, 0x87, 0x6c, 0xae, 0x43, 0x38, 0xaa,
+	0xab, 0x9a, 0x34, 0xac, 0xf4, 0x1a, 0xc0, 0xe9, 0x1a, 0xd6, 0xf7, 0x1c, 0x8c, 0x5c, 0xaf, 0xe6,
+	0xc3, 0x30, 0xb7, 0x09, 0x73, 0x6a, 0xc7, 0x6b, 0xda, 0xae, 0xe1, 0xf5, 0x78, 0x50, 0x00, 0x4b,
+	0xb9, 0x2a, 0xff, 0xf6, 0xb8, 0x34, 0x47, 0x09, 0xed, 0x34, 0x1a, 0x2e, 0xc2, 0x78, 0xd7, 0x73,
+	0x0d, 0x4b, 0x57, 0x86, 0x50, 0xee, 0x06, 0xfc, 0x81, 0x64, 0xc2, 0x7c, 0xba, 0x90, 0x59, 0xfa,
+	0x69, 0xfd, 0xf7, 0x72, 0x44, 0x53, 0xca, 0x24, 0x4d, 0x75, 0xe2, 0xe4, 0xfd, 0x62, 0x4a, 0x19,
+	0x9c, 0xa8, 0x54, 0x3e, 0xbe, 0x5c, 0x4c, 0x3d, 0xbe, 0x38, 0x5a, 0x19, 0x06, 0x7c, 0x72, 0x71,
+	0xb4, 0xf2, 0x27, 0x2d, 0xe1, 0x20, 0x54, 0xc4, 0x65, 0xc2, 0xd2, 0x1b, 0x00, 0xf9, 0xcb, 0x46,
+	0x05, 0x61, 0xc7, 0xb6, 0x30, 0xe2, 0x5a, 0x70, 0x8a, 0x1c, 0xad, 0x77, 0x9c, 0x86, 0xea, 0x21,
+	0xcc, 0x03, 0x9f, 0xdc, 0x76, 0x34, 0xb9, 0x98, 0x30, 0x94, 0xf5, 0x1e, 0x09, 0x71, 0xcb, 0xf2,
+	0xdc, 0x5e, 0x35, 0xcd, 0x03, 0x25, 0x6f, 0x86, 0xed, 0xc2, 0x36, 0xe4, 0xae, 0x02, 0xb9, 0x69,
+	0x98, 0x69, 0x21, 0xda, 0x4a, 0xa5, 0xbf, 0xe4, 0xe6, 0xe0, 0x64, 0x57, 0x6d, 0x77, 0x10, 0x9f,
+	0x2e, 0x80, 0xa5, 0x1f, 0x15, 0xb2, 0xa9, 0xa4, 0xb7, 0x40, 0x65, 0xe2, 0xf9, 0xab, 0x45, 0x20,
+	0x9d, 0x92, 0x7b, 0xb9, 0xe9, 0x22, 0xd5, 0x43, 0xdf, 0x7a, 0x2f, 0xb7, 0xe1, 0x94, 0xe6, 0x07,
+	0xaa, 0x8f, 0x7c, 0x3d, 0x79, 0x2d, 0xcc, 0x60, 0xc4, 0x4b, 0x62, 0xd8, 0x4b, 0x02, 0xe4, 0x2f,
+	0xdb, 0x06, 0xcd, 0x1d, 0x94, 0x4b, 0x9a, 0xf6, 0x1d, 0xca, 0x25, 0x37, 0x3d, 0x46, 0xb9, 0x1d,
+	0xa7, 0x31, 0x76, 0xb9, 0x0c, 0x7b, 0x5a, 0x2e, 0x63, 0x0b, 0xca, 0x7d, 0x0a, 0x60, 0xae, 0x86,
+	0xf5, 0xbb, 0xfe, 0x4b, 0xe4, 0xfe, 0x87, 0x59, 0xf2, 0x26, 0xfd, 0x22, 0xe3, 0x78, 0x12, 0x30,
+	0xe5, 0x49, 0x0f, 0xb0, 0x2d, 0x4a, 0x27, 0x6e, 0x51, 0x65, 0x8a, 0x2d, 0x4a, 0x9a, 0x85, 0x33,
+	0x01, 0x9f, 0x80, 0xe5, 0x21, 0x80, 0x42, 0x0d, 0xeb, 0x0a, 0x32, 0xed, 0x2e, 0x2d, 0x61, 0x87,
+	0x9e, 0x30, 0x10, 0xe6, 0x96, 0xe1, 0xb4, 0xeb, 0xbb, 0xea, 0x2a, 0x49, 0x43, 0x5f, 0x56, 0x4e,
+	0xf9, 0x85, 0xd8, 0x77, 0x06, 0x66, 0xae, 0x0c, 0x27, 0xd5, 0x86, 0x69, 0x58, 0x5f, 0xa5, 0x48,
+	0x60, 0x15, 0xd8, 0xa7, 0x47, 0xd6, 0xd2, 0x5f, 0x50, 0x8a, 0x27, 0x11, 0x70, 0x7d, 0x41, 0x06,
+	0x28, 0x0c, 0x1b, 0x7f, 0x80, 0x78, 0x56, 0xc7, 0x72, 0x43, 0x91, 0xda, 0x4c, 0x3c, 0x0c, 0x0c,
+	0x13, 0x3a, 0x0c, 0x8c, 0x2d, 0xa0, 0xfe, 0x89, 0x88, 0xd7, 0xae, 0xd6, 0x44, 0x8d, 0x4e, 0x1b,
+	0x31, 0xf2, 0x31, 0x76, 0x09, 0xf3, 0x30, 0xdb, 0x44, 0x86, 0xde, 0xf4, 0xfc, 0x96, 0x4f, 0x28,
+	0x74, 0x17, 0x96, 0xe8, 0xcc, 0xc8, 0x12, 0x5d, 0x8d, 0x7e, 0x0e, 0xab, 0xd1, 0x1d, 0x88, 0x2c,
+	0x48, 0x92, 0x60, 0x21, 0xce, 0x17, 0x74, 0xe4, 0x18, 0xc0, 0xf9, 0xbe, 0x54, 0xa8, 0x96, 0x86,
+	0xda, 0x6c, 0x3f, 0x82, 0x49, 0x02, 0x89, 0x26, 0x29, 0xb6, 0x0f, 0x3c, 0xdb, 0x87, 0xd0, 0x15,
+	0x6f, 0x0d, 0x67, 0xaf, 0x5f, 0xdc, 0x72, 0x8c, 0xb4, 0x5d, 0xe5, 0x26, 0x15, 0xa0, 0x18, 0xed,
+	0x19, 0x14, 0xb6, 0xfe, 0x39, 0x0b, 0x33, 0x35, 0xac, 0x73, 0x08, 0xe6, 0x59, 0x65, 0x2f, 0xc6,
+	0xfd, 0x17, 0x31, 0x30, 0xa1, 0x94, 0x08, 0x16, 0xfc, 0xf3, 0x21, 0x98, 0x67, 0x15, 0xb5, 0x18,
+	0xff, 0x97, 0xd7, 0x48, 0x92, 0x26, 0x52, 0xcd, 0xb8, 0x7b, 0xf0, 0x67, 0xe2, 0xa0, 0x7a, 0x26,
+	0xc6, 0x1d, 0x27, 0x7e, 0xe1, 0xef, 0xeb, 0xfd, 0x41, 0xdc, 0x43, 0x00, 0x17, 0xe2, 0xc4, 0x47,
+	0x8e, 0x8b, 0x11, 0x73, 0x40, 0xf8, 0x6f, 0xc4, 0x03, 0x6c, 0x13, 0xc3, 0x5f, 0x47, 0xc5, 0x44,
+	0xdf, 0x0d, 0x42, 0x29, 0x11, 0x2c, 0x9c, 0x86, 0x15, 0xaf, 0x62, 0x12, 0xc2, 0xd7, 0xa4, 0x89,
+	0x14, 0x1b, 0xee, 0x21, 0xfc, 0x35, 0x5a, 0x68, 0x62, 0xe3, 0x44, 0xc2, 0x85, 0x7f, 0x47, 0x82,
+	0x07, 0xe9, 0x1f, 0xc0, 0xd9, 0xa8, 0x57, 0xbd, 0x1a, 0x3b, 0xd7, 0x57, 0xc1, 0xc2, 0xc6, 0x08,
+	0xe0, 0x41, 0x62, 0x61, 0xf2, 0xd1, 0xc5, 0xd1, 0x0a, 0xa8, 0xde, 0x39, 0x39, 0x13, 0xc1, 0xe9,
+	0x99, 0x08, 0x3e, 0x9c, 0x89, 0xe0, 0xd9, 0xb9, 0x98, 0x3a, 0x3d, 0x17, 0x53, 0xef, 0xce, 0xc5,
+	0xd4, 0xfd, 0x7f, 0x74, 0xc3, 0x6b, 0x76, 0xf6, 0xcb, 0x9a, 0x6d, 0xca, 0xb8, 0x65, 0x38, 0x25,
+	0x13, 0x75, 0x65, 0xcd, 0xb6, 0x2c, 0xa4, 0x79, 0x72, 0x77, 0x9d, 0x79, 0xff, 0x5e, 0xcf, 0x41,
+	0x78, 0x3f, 0xeb, 0x7f, 0x41, 0x6f, 0x7c, 0x19, 0x00, 0x00, 0xe9, 0x37, 0x3b, 0x17, 0x0c, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -606,6 +927,17 @@ type MsgClient interface {
 	// Specifically if a market does not exist it will be created, otherwise it
 	// will be updated. The response will be a map between ticker -> updated.
 	UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opts ...grpc.CallOption) (*MsgUpsertMarketsResponse, error)
+	// RemoveMarkets removes the given markets from the market map. A market
+	// cannot be removed while other markets still depend on it. The signer must
+	// be the admin or a market authority.
+	RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates schedules updates of existing markets that are
+	// applied at the beginning of a future block. The signer must be a market
+	// authority.
+	ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error)
+	// CancelMarketUpdates cancels market updates that are scheduled for a future
+	// block. The signer must be the admin.
+	CancelMarketUpdates(ctx context.Context, in *MsgCancelMarketUpdates, opts ...grpc.CallOption) (*MsgCancelMarketUpdatesResponse, error)
 }
 
 type msgClient struct {
@@ -661,6 +993,33 @@ func (c *msgClient) UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opt
 	return out, nil
 }
 
+func (c *msgClient) RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error) {
+	out := new(MsgRemoveMarketsResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Msg/RemoveMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error) {
+	out := new(MsgScheduleMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Msg/ScheduleMarketUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelMarketUpdates(ctx context.Context, in *MsgCancelMarketUpdates, opts ...grpc.CallOption) (*MsgCancelMarketUpdatesResponse, error) {
+	out := new(MsgCancelMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Msg/CancelMarketUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateMarkets creates markets from the given message.
@@ -677,6 +1036,17 @@ type MsgServer interface {
 	// Specifically if a market does not exist it will be created, otherwise it
 	// will be updated. The response will be a map between ticker -> updated.
 	UpsertMarkets(context.Context, *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error)
+	// RemoveMarkets removes the given markets from the market map. A market
+	// cannot be removed while other markets still depend on it. The signer must
+	// be the admin or a market authority.
+	RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates schedules updates of existing markets that are
+	// applied at the beginning of a future block. The signer must be a market
+	// authority.
+	ScheduleMarketUpdates(context.Context, *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error)
+	// CancelMarketUpdates cancels market updates that are scheduled for a future
+	// block. The signer must be the admin.
+	CancelMarketUpdates(context.Context, *MsgCancelMarketUpdates) (*MsgCancelMarketUpdatesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -698,6 +1068,15 @@ func (*UnimplementedMsgServer) RemoveMarketAuthorities(ctx context.Context, req
 func (*UnimplementedMsgServer) UpsertMarkets(ctx context.Context, req *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertMarkets not implemented")
 }
+func (*UnimplementedMsgServer) RemoveMarkets(ctx context.Context, req *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarkets not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleMarketUpdates(ctx context.Context, req *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMarketUpdates not implemented")
+}
+func (*UnimplementedMsgServer) CancelMarketUpdates(ctx context.Context, req *MsgCancelMarketUpdates) (*MsgCancelMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMarketUpdates not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -793,6 +1172,60 @@ func _Msg_UpsertMarkets_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RemoveMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Msg/RemoveMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMarkets(ctx, req.(*MsgRemoveMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMarketUpdates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Msg/ScheduleMarketUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, req.(*MsgScheduleMarketUpdates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMarketUpdates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Msg/CancelMarketUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMarketUpdates(ctx, req.(*MsgCancelMarketUpdates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "slinky.marketmap.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -817,6 +1250,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertMarkets",
 			Handler:    _Msg_UpsertMarkets_Handler,
 		},
+		{
+			MethodName: "RemoveMarkets",
+			Handler:    _Msg_RemoveMarkets_Handler,
+		},
+		{
+			MethodName: "ScheduleMarketUpdates",
+			Handler:    _Msg_ScheduleMarketUpdates_Handler,
+		},
+		{
+			MethodName: "CancelMarketUpdates",
+			Handler:    _Msg_CancelMarketUpdates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/marketmap/v1/tx.proto",
@@ -1175,16 +1620,217 @@ func (m *MsgRemoveMarketAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRemoveMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Markets[iNdEx])
+			copy(dAtA[i:], m.Markets[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Markets[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleMarketUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMarketUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMarketUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelMarketUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMarketUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMarketUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Markets[iNdEx])
+			copy(dAtA[i:], m.Markets[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Markets[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelMarketUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMarketUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMarketUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *MsgUpsertMarkets) Size() (n int) {
 	if m == nil {
@@ -1330,6 +1976,96 @@ func (m *MsgRemoveMarketAuthoritiesResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRemoveMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Markets) > 0 {
+		for _, s := range m.Markets {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleMarketUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelMarketUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if len(m.Markets) > 0 {
+		for _, s := range m.Markets {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelMarketUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1452,7 +2188,619 @@ func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpsertMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpsertMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MarketUpdates == nil {
+				m.MarketUpdates = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTx(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTx
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MarketUpdates[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateMarkets = append(m.CreateMarkets, Market{})
+			if err := m.CreateMarkets[len(m.CreateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateMarkets = append(m.UpdateMarkets, Market{})
+			if err := m.UpdateMarkets[len(m.UpdateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1475,127 +2823,12 @@ func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpsertMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpsertMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketUpdates", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MarketUpdates == nil {
-				m.MarketUpdates = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthTx
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthTx
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTx(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthTx
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.MarketUpdates[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1617,7 +2850,7 @@ func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1640,15 +2873,15 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1676,13 +2909,13 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1692,25 +2925,23 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreateMarkets = append(m.CreateMarkets, Market{})
-			if err := m.CreateMarkets[len(m.CreateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1733,7 +2964,7 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1756,10 +2987,10 @@ func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1783,7 +3014,7 @@ func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1806,10 +3037,10 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMarkets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1846,9 +3077,9 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1858,25 +3089,23 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateMarkets = append(m.UpdateMarkets, Market{})
-			if err := m.UpdateMarkets[len(m.UpdateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Markets = append(m.Markets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1899,7 +3128,7 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMarketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1922,10 +3151,10 @@ func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMarketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1949,7 +3178,7 @@ func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgParams) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleMarketUpdates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1972,17 +3201,17 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleMarketUpdates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleMarketUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1992,30 +3221,48 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2025,23 +3272,25 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2064,7 +3313,7 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleMarketUpdatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2087,10 +3336,10 @@ func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleMarketUpdatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleMarketUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2114,7 +3363,7 @@ func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelMarketUpdates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2137,15 +3386,15 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelMarketUpdates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelMarketUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2173,11 +3422,30 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2205,7 +3473,7 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Markets = append(m.Markets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2228,7 +3496,7 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelMarketUpdatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2251,10 +3519,10 @@ func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelMarketUpdatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelMarketUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
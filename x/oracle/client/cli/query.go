@@ -2,15 +2,24 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
 	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
 	"github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
+const (
+	flagStartHeight = "start-height"
+	flagEndHeight   = "end-height"
+	flagStartTime   = "start-time"
+	flagEndTime     = "end-time"
+)
+
 // GetQueryCmd returns the parent command for all x/oracle cli query commands. The
 // provided clientCtx should have, at a minimum, a verifier, CometBFT RPC client,
 // and marshaler set.
@@ -25,7 +34,13 @@ func GetQueryCmd() *cobra.Command {
 	// add sub-commands
 	cmd.AddCommand(
 		GetPriceCmd(),
+		GetAllPricesCmd(),
 		GetAllCurrencyPairsCmd(),
+		GetPriceHistoryCmd(),
+		GetMarketStatusCmd(),
+		GetValidatorPriceStatsCmd(),
+		GetValidatorOracleStatsCmd(),
+		GetParamsCmd(),
 	)
 
 	return cmd
@@ -66,6 +81,41 @@ func GetPriceCmd() *cobra.Command {
 	return cmd
 }
 
+// GetAllPricesCmd returns the cli-command that queries the price information of all CurrencyPairs that have a price, paginated
+// by CurrencyPair.
+func GetAllPricesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prices",
+		Short: "Query for the prices of all currency-pairs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			qc := types.NewQueryClient(clientCtx)
+
+			res, err := qc.GetAllPrices(cmd.Context(), &types.GetAllPricesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "prices")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetAllCurrencyPairsCmd returns the cli-command that queries for all CurrencyPairs in the module. This is essentially a wrapper around the module's
 // QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn embedded in the clientCtx.
 func GetAllCurrencyPairsCmd() *cobra.Command {
@@ -95,3 +145,188 @@ func GetAllCurrencyPairsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetPriceHistoryCmd returns the cli-command that queries the historical prices of a given CurrencyPair, within
+// either a height range or a time range, paginated by nonce.
+func GetPriceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [base] [quote]",
+		Short: "Query for the historical prices of a specified currency-pair",
+		Example: fmt.Sprintf(
+			"%s query %s price-history BTC USD --start-height 100 --end-height 200\n"+
+				"%s query %s price-history BTC USD --start-time 2024-01-01T00:00:00Z",
+			version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.GetPriceHistoryRequest{
+				CurrencyPair: slinkytypes.NewCurrencyPair(args[0], args[1]),
+			}
+
+			if req.StartHeight, err = cmd.Flags().GetUint64(flagStartHeight); err != nil {
+				return err
+			}
+			if req.EndHeight, err = cmd.Flags().GetUint64(flagEndHeight); err != nil {
+				return err
+			}
+			if req.StartTime, err = parseTimeFlag(cmd, flagStartTime); err != nil {
+				return err
+			}
+			if req.EndTime, err = parseTimeFlag(cmd, flagEndTime); err != nil {
+				return err
+			}
+			if req.Pagination, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+				return err
+			}
+
+			qc := types.NewQueryClient(clientCtx)
+
+			res, err := qc.GetPriceHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint64(flagStartHeight, 0, "the lowest block height of the returned prices")
+	cmd.Flags().Uint64(flagEndHeight, 0, "the highest block height of the returned prices")
+	cmd.Flags().String(flagStartTime, "", "the earliest block time (RFC3339) of the returned prices")
+	cmd.Flags().String(flagEndTime, "", "the latest block time (RFC3339) of the returned prices")
+	flags.AddPaginationFlagsToCmd(cmd, "price-history")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetMarketStatusCmd returns the cli-command that queries whether the price of a given CurrencyPair is active, stale or
+// halted by the circuit breaker.
+func GetMarketStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-status [base] [quote]",
+		Short: "Query for the status of the price of a specified currency-pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			qc := types.NewQueryClient(clientCtx)
+
+			res, err := qc.GetMarketStatus(cmd.Context(), &types.GetMarketStatusRequest{
+				CurrencyPair: slinkytypes.NewCurrencyPair(args[0], args[1]),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseTimeFlag parses the RFC3339 time of the given flag, returning nil if the flag is not set.
+func parseTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", flag, err)
+	}
+
+	return &t, nil
+}
+
+// GetValidatorPriceStatsCmd returns the cli-command that queries the rolling price stats of a validator, identified
+// by its consensus address.
+func GetValidatorPriceStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-price-stats [cons-address]",
+		Short: "Query for the rolling price deviation stats of a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			qc := types.NewQueryClient(clientCtx)
+
+			res, err := qc.GetValidatorPriceStats(cmd.Context(), &types.GetValidatorPriceStatsRequest{
+				ConsAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetValidatorOracleStatsCmd returns the cli-command that queries the oracle participation of a validator,
+// identified by its consensus address.
+func GetValidatorOracleStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-oracle-stats [cons-address]",
+		Short: "Query for the oracle participation of a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			qc := types.NewQueryClient(clientCtx)
+
+			res, err := qc.GetValidatorOracleStats(cmd.Context(), &types.GetValidatorOracleStatsRequest{
+				ConsAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsCmd returns the cli-command that queries the module's parameters.
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query for the parameters of the module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			qc := types.NewQueryClient(clientCtx)
+
+			res, err := qc.GetParams(cmd.Context(), &types.GetParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
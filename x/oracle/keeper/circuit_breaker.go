@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// bpsDenominator is the number of basis points in 100%.
+var bpsDenominator = math.NewInt(10_000)
+
+// applyCircuitBreaker checks whether the given price update of the CurrencyPair changes its price by more than the
+// maximum price change. If it does, the CurrencyPair is flagged as halted, an event is emitted, and the price is either
+// clamped to the maximum price change or rejected, depending on the circuit breaker mode. The price to write is returned,
+// along with whether it should be written at all. The halted flag is cleared once a price update is within bounds.
+//
+// In the reject mode, the circuit breaker re-anchors to a new price once it has rejected the reanchor count of
+// consecutive price updates, each within the maximum price change of the previously rejected one, i.e. once the price
+// has moved for good. The latest rejected price is then written, and the halted flag is cleared.
+func (k *Keeper) applyCircuitBreaker(
+	ctx sdk.Context,
+	cp slinkytypes.CurrencyPair,
+	cps *types.CurrencyPairState,
+	qp types.QuotePrice,
+) (types.QuotePrice, bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return qp, false, err
+	}
+
+	// the circuit breaker is disabled, or there is no previous price to compare against
+	if params.MaxPriceChangeBps == 0 || cps.Price == nil || !cps.Price.Price.IsPositive() {
+		clearCircuitBreaker(cps)
+		return qp, true, nil
+	}
+
+	maxPriceChangeBps, err := k.maxPriceChangeBps(ctx, cp, params)
+	if err != nil {
+		return qp, false, err
+	}
+
+	previous := cps.Price.Price
+	if withinPriceChange(previous, qp.Price, maxPriceChangeBps) {
+		clearCircuitBreaker(cps)
+		return qp, true, nil
+	}
+
+	cps.Halted = true
+
+	event := sdk.NewEvent(
+		types.EventTypeCircuitBreaker,
+		sdk.NewAttribute(types.AttributeKeyCurrencyPair, cp.String()),
+		sdk.NewAttribute(types.AttributeKeyPrice, qp.Price.String()),
+		sdk.NewAttribute(types.AttributeKeyPreviousPrice, previous.String()),
+		sdk.NewAttribute(types.AttributeKeyMode, params.CircuitBreakerMode.String()),
+	)
+
+	if params.CircuitBreakerMode == types.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_REJECT {
+		if cps.RejectedPrice != nil && withinPriceChange(cps.RejectedPrice.Price, qp.Price, maxPriceChangeBps) {
+			cps.RejectedCount++
+		} else {
+			cps.RejectedCount = 1
+		}
+
+		rejected := qp
+		cps.RejectedPrice = &rejected
+
+		if params.CircuitBreakerReanchorCount == 0 || cps.RejectedCount < params.CircuitBreakerReanchorCount {
+			ctx.EventManager().EmitEvent(event)
+			return qp, false, nil
+		}
+
+		// the price has consistently moved out of bounds, so it is accepted as the new price
+		clearCircuitBreaker(cps)
+
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyAppliedPrice, qp.Price.String()))
+		ctx.EventManager().EmitEvent(event)
+
+		return qp, true, nil
+	}
+
+	// clamp the price to the maximum price change
+	maxChange := previous.MulRaw(int64(maxPriceChangeBps)).Quo(bpsDenominator) //nolint:gosec
+	if qp.Price.GT(previous) {
+		qp.Price = previous.Add(maxChange)
+	} else {
+		qp.Price = previous.Sub(maxChange)
+	}
+
+	event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyAppliedPrice, qp.Price.String()))
+	ctx.EventManager().EmitEvent(event)
+
+	return qp, true, nil
+}
+
+// maxPriceChangeBps returns the maximum price change of the given CurrencyPair, if the circuit breaker is enabled by
+// the params. This is the maximum price change in the ticker metadata of its market in x/marketmap if it is set, and
+// the maximum price change of the params otherwise.
+func (k *Keeper) maxPriceChangeBps(ctx sdk.Context, cp slinkytypes.CurrencyPair, params types.Params) (uint64, error) {
+	if k.mmKeeper == nil {
+		return params.MaxPriceChangeBps, nil
+	}
+
+	market, err := k.mmKeeper.GetMarket(ctx, cp.String())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return params.MaxPriceChangeBps, nil
+		}
+
+		return 0, err
+	}
+
+	// the metadata of markets is validated when they are written, so it is only invalid for legacy markets, which
+	// use the params
+	circuitBreaker, err := tickermetadata.CircuitBreakerFromJSONString(market.Ticker.Metadata_JSON)
+	if err != nil || circuitBreaker.MaxPriceChangeBps == 0 {
+		return params.MaxPriceChangeBps, nil
+	}
+
+	return circuitBreaker.MaxPriceChangeBps, nil
+}
+
+// clearCircuitBreaker clears the halted flag and the rejected prices of the given CurrencyPair state.
+func clearCircuitBreaker(cps *types.CurrencyPairState) {
+	cps.Halted = false
+	cps.RejectedPrice = nil
+	cps.RejectedCount = 0
+}
+
+// withinPriceChange returns whether the change from the previous to the given price is at most the given maximum
+// price change (in basis points) of the previous price.
+func withinPriceChange(previous, price math.Int, maxPriceChangeBps uint64) bool {
+	maxChange := previous.MulRaw(int64(maxPriceChangeBps)).Quo(bpsDenominator) //nolint:gosec
+	return price.Sub(previous).Abs().LTE(maxChange)
+}
+
+// GetMarketStatus returns the status of the price of the given CurrencyPair. The price is halted if its latest update
+// tripped the circuit breaker, stale if it does not exist or has not been updated within the maximum price age, and
+// active otherwise.
+func (k *Keeper) GetMarketStatus(ctx sdk.Context, cp slinkytypes.CurrencyPair) (types.MarketStatus, error) {
+	cps, err := k.currencyPairs.Get(ctx, cp.String())
+	if err != nil {
+		return types.MarketStatus_MARKET_STATUS_UNSPECIFIED, types.NewCurrencyPairNotExistError(cp)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.MarketStatus_MARKET_STATUS_UNSPECIFIED, err
+	}
+
+	switch {
+	case cps.Halted:
+		return types.MarketStatus_MARKET_STATUS_HALTED, nil
+	case cps.Price == nil:
+		return types.MarketStatus_MARKET_STATUS_STALE, nil
+	case params.MaxPriceAge > 0 && uint64(ctx.BlockHeight()) > cps.Price.BlockHeight+params.MaxPriceAge: //nolint:gosec
+		return types.MarketStatus_MARKET_STATUS_STALE, nil
+	default:
+		return types.MarketStatus_MARKET_STATUS_ACTIVE, nil
+	}
+}
@@ -0,0 +1,256 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// priceAt returns a quote price with the given price, written at the given height.
+func priceAt(price int64, height uint64) types.QuotePrice {
+	return types.QuotePrice{
+		Price:       sdkmath.NewInt(price),
+		BlockHeight: height,
+	}
+}
+
+// rejectedCount returns the number of consecutive rejected price updates of the only currency pair in state.
+func (s *KeeperTestSuite) rejectedCount(ctx sdk.Context) uint64 {
+	gs := s.oracleKeeper.ExportGenesis(ctx)
+	s.Require().Len(gs.CurrencyPairGenesis, 1)
+
+	return gs.CurrencyPairGenesis[0].RejectedCount
+}
+
+func (s *KeeperTestSuite) TestCircuitBreaker() {
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, btcUSD))
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, btcUSD.String()).Return(marketmaptypes.Market{}, collections.ErrNotFound).Maybe()
+
+	s.Run("the first price is always written", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{MaxPriceChangeBps: 1_000}))
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(1_000, 1)))
+
+		qp, err := s.oracleKeeper.GetPriceForCurrencyPair(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(1_000), qp.Price)
+	})
+
+	s.Run("a price change within bounds is written", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(ctx, btcUSD, priceAt(1_100, 2)))
+
+		qp, err := s.oracleKeeper.GetPriceForCurrencyPair(ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(1_100), qp.Price)
+		s.Require().Empty(ctx.EventManager().Events())
+	})
+
+	s.Run("a price increase out of bounds is clamped", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(ctx, btcUSD, priceAt(2_000, 3)))
+
+		qp, err := s.oracleKeeper.GetPriceForCurrencyPair(ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(1_210), qp.Price)
+
+		status, err := s.oracleKeeper.GetMarketStatus(ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(types.MarketStatus_MARKET_STATUS_HALTED, status)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeCircuitBreaker, events[0].Type)
+
+		attr, ok := events[0].GetAttribute(types.AttributeKeyPreviousPrice)
+		s.Require().True(ok)
+		s.Require().Equal("1100", attr.Value)
+
+		attr, ok = events[0].GetAttribute(types.AttributeKeyAppliedPrice)
+		s.Require().True(ok)
+		s.Require().Equal("1210", attr.Value)
+	})
+
+	s.Run("a price decrease out of bounds is clamped", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(100, 4)))
+
+		qp, err := s.oracleKeeper.GetPriceForCurrencyPair(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(1_089), qp.Price)
+	})
+
+	s.Run("the halt is cleared once a price change is within bounds", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx.WithBlockHeight(5), btcUSD, priceAt(1_000, 5)))
+
+		status, err := s.oracleKeeper.GetMarketStatus(s.ctx.WithBlockHeight(5), btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(types.MarketStatus_MARKET_STATUS_ACTIVE, status)
+	})
+
+	s.Run("a price change out of bounds is rejected", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{
+			MaxPriceChangeBps:           1_000,
+			CircuitBreakerMode:          types.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_REJECT,
+			CircuitBreakerReanchorCount: 3,
+		}))
+
+		before, err := s.oracleKeeper.GetPriceWithNonceForCurrencyPair(s.ctx, btcUSD)
+		s.Require().NoError(err)
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(ctx, btcUSD, priceAt(500, 6)))
+
+		after, err := s.oracleKeeper.GetPriceWithNonceForCurrencyPair(ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(1_000), after.Price)
+		s.Require().Equal(before.Nonce(), after.Nonce())
+
+		status, err := s.oracleKeeper.GetMarketStatus(ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(types.MarketStatus_MARKET_STATUS_HALTED, status)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+
+		_, ok := events[0].GetAttribute(types.AttributeKeyAppliedPrice)
+		s.Require().False(ok)
+		s.Require().Equal(uint64(1), s.rejectedCount(ctx))
+	})
+
+	s.Run("a rejected price that is not consistent with the previously rejected price restarts the count", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(1_500, 7)))
+		s.Require().Equal(uint64(1), s.rejectedCount(s.ctx))
+
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(1_450, 8)))
+		s.Require().Equal(uint64(2), s.rejectedCount(s.ctx))
+
+		qp, err := s.oracleKeeper.GetPriceForCurrencyPair(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(1_000), qp.Price)
+	})
+
+	s.Run("the circuit breaker re-anchors to consistently rejected prices", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager()).WithBlockHeight(9)
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(ctx, btcUSD, priceAt(1_420, 9)))
+
+		qp, err := s.oracleKeeper.GetPriceForCurrencyPair(ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(1_420), qp.Price)
+		s.Require().Equal(uint64(0), s.rejectedCount(ctx))
+
+		status, err := s.oracleKeeper.GetMarketStatus(ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(types.MarketStatus_MARKET_STATUS_ACTIVE, status)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+
+		attr, ok := events[0].GetAttribute(types.AttributeKeyAppliedPrice)
+		s.Require().True(ok)
+		s.Require().Equal("1420", attr.Value)
+	})
+
+	s.Run("the circuit breaker can be disabled", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{}))
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(5_000, 7)))
+
+		qp, err := s.oracleKeeper.GetPriceForCurrencyPair(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(5_000), qp.Price)
+
+		status, err := s.oracleKeeper.GetMarketStatus(s.ctx.WithBlockHeight(7), btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(types.MarketStatus_MARKET_STATUS_ACTIVE, status)
+	})
+}
+
+func (s *KeeperTestSuite) TestCircuitBreakerMarketOverride() {
+	market := marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{
+			CurrencyPair:  btcUSD,
+			Decimals:      8,
+			Enabled:       true,
+			Metadata_JSON: `{"max_price_change_bps":5000}`,
+		},
+	}
+
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, btcUSD.String()).Return(market, nil)
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, btcUSD))
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{MaxPriceChangeBps: 1_000}))
+	s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(1_000, 1)))
+
+	s.Run("a price change within the bounds of the market is written", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(1_400, 2)))
+
+		qp, err := s.oracleKeeper.GetPriceForCurrencyPair(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(1_400), qp.Price)
+	})
+
+	s.Run("a price change out of the bounds of the market is clamped", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(2_800, 3)))
+
+		qp, err := s.oracleKeeper.GetPriceForCurrencyPair(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(2_100), qp.Price)
+	})
+}
+
+func (s *KeeperTestSuite) TestGetMarketStatus() {
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{MaxPriceAge: 10}))
+
+	s.Run("a currency pair that does not exist fails", func() {
+		_, err := s.oracleKeeper.GetMarketStatus(s.ctx, btcUSD)
+		s.Require().Error(err)
+	})
+
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, btcUSD))
+
+	s.Run("a currency pair without a price is stale", func() {
+		status, err := s.oracleKeeper.GetMarketStatus(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(types.MarketStatus_MARKET_STATUS_STALE, status)
+	})
+
+	s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(100, 20)))
+
+	s.Run("a recent price is active", func() {
+		status, err := s.oracleKeeper.GetMarketStatus(s.ctx.WithBlockHeight(30), btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(types.MarketStatus_MARKET_STATUS_ACTIVE, status)
+	})
+
+	s.Run("an old price is stale", func() {
+		status, err := s.oracleKeeper.GetMarketStatus(s.ctx.WithBlockHeight(31), btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(types.MarketStatus_MARKET_STATUS_STALE, status)
+	})
+
+	s.Run("a price never goes stale if the maximum price age is disabled", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{}))
+
+		status, err := s.oracleKeeper.GetMarketStatus(s.ctx.WithBlockHeight(1_000), btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(types.MarketStatus_MARKET_STATUS_ACTIVE, status)
+	})
+
+	s.Run("the query returns the status and price", func() {
+		qs := keeper.NewQueryServer(s.oracleKeeper)
+
+		_, err := qs.GetMarketStatus(s.ctx, nil)
+		s.Require().Error(err)
+
+		_, err = qs.GetMarketStatus(s.ctx, &types.GetMarketStatusRequest{CurrencyPair: ethUSD})
+		s.Require().Error(err)
+
+		res, err := qs.GetMarketStatus(s.ctx, &types.GetMarketStatusRequest{CurrencyPair: btcUSD})
+		s.Require().NoError(err)
+		s.Require().Equal(types.MarketStatus_MARKET_STATUS_ACTIVE, res.Status)
+		s.Require().NotNil(res.Price)
+		s.Require().Equal(sdkmath.NewInt(100), res.Price.Price)
+	})
+}
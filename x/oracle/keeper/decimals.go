@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// UpdateDecimalsForCurrencyPair records the given decimals of the market of the CurrencyPair. If the CurrencyPair has a
+// price that is quoted in different decimals, the price is either rescaled to the given decimals (along with its price
+// history) or invalidated, depending on the decimals change mode, and an event is emitted. In both cases, the circuit
+// breaker of the CurrencyPair is cleared. A price that is quoted in unknown decimals is always invalidated, since it
+// cannot be rescaled.
+func (k *Keeper) UpdateDecimalsForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair, decimals uint64) error {
+	cps, err := k.currencyPairs.Get(ctx, cp.String())
+	if err != nil {
+		return err
+	}
+
+	if cps.Decimals == decimals {
+		return nil
+	}
+
+	previousDecimals := cps.Decimals
+	cps.Decimals = decimals
+
+	if cps.Price == nil {
+		return k.currencyPairs.Set(ctx, cp.String(), cps)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	previousPrice := cps.Price.Price
+
+	var price string
+	if previousDecimals == 0 || params.DecimalsChangeMode == types.DecimalsChangeMode_DECIMALS_CHANGE_MODE_INVALIDATE {
+		cps.Price = nil
+
+		if err := k.removePriceHistory(ctx, cp); err != nil {
+			return err
+		}
+	} else {
+		qp := *cps.Price
+		qp.Price = rescalePrice(qp.Price, previousDecimals, decimals)
+		cps.Price = &qp
+		price = qp.Price.String()
+
+		if err := k.rescalePriceHistory(ctx, cp, previousDecimals, decimals); err != nil {
+			return err
+		}
+	}
+
+	// the circuit breaker compares prices quoted in the previous decimals, so it is cleared along with them
+	clearCircuitBreaker(&cps)
+
+	if err := k.currencyPairs.Set(ctx, cp.String(), cps); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDecimalsChange,
+		sdk.NewAttribute(types.AttributeKeyCurrencyPair, cp.String()),
+		sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(cps.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyPreviousDecimals, strconv.FormatUint(previousDecimals, 10)),
+		sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(decimals, 10)),
+		sdk.NewAttribute(types.AttributeKeyPreviousPrice, previousPrice.String()),
+		sdk.NewAttribute(types.AttributeKeyPrice, price),
+		sdk.NewAttribute(types.AttributeKeyMode, params.DecimalsChangeMode.String()),
+	))
+
+	return nil
+}
+
+// initDecimalsForCurrencyPair records the given decimals of the market of the CurrencyPair, if its decimals are not
+// known yet. The price of the CurrencyPair, if any, is assumed to be quoted in the given decimals.
+func (k *Keeper) initDecimalsForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair, decimals uint64) error {
+	cps, err := k.currencyPairs.Get(ctx, cp.String())
+	if err != nil {
+		return err
+	}
+
+	if cps.Decimals != 0 {
+		return nil
+	}
+
+	cps.Decimals = decimals
+	return k.currencyPairs.Set(ctx, cp.String(), cps)
+}
+
+// rescalePriceHistory rescales the price history of the CurrencyPair from the previous decimals to the given decimals.
+func (k *Keeper) rescalePriceHistory(ctx sdk.Context, cp slinkytypes.CurrencyPair, previousDecimals, decimals uint64) error {
+	entries, err := k.GetPriceHistory(ctx, cp)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		entry.Price.Price = rescalePrice(entry.Price.Price, previousDecimals, decimals)
+		if err := k.priceHistory.Set(ctx, collections.Join(cp.String(), entry.Nonce), entry.Price); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rescalePrice rescales the given price from the previous decimals to the given decimals. Prices that are rescaled to
+// fewer decimals are truncated.
+func rescalePrice(price math.Int, previousDecimals, decimals uint64) math.Int {
+	if decimals > previousDecimals {
+		return price.Mul(pow10(decimals - previousDecimals))
+	}
+
+	return price.Quo(pow10(previousDecimals - decimals))
+}
+
+// pow10 returns 10 to the power of the given exponent.
+func pow10(exp uint64) math.Int {
+	return math.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(exp), nil))
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// emitPriceUpdatedEvent emits an event for the price that was written for the given CurrencyPair, if price events are
+// enabled.
+func (k *Keeper) emitPriceUpdatedEvent(ctx sdk.Context, cp slinkytypes.CurrencyPair, cps types.CurrencyPairState) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.EmitPriceEvents || cps.Price == nil {
+		return nil
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPriceUpdated{
+		CurrencyPair: cp,
+		Id:           cps.Id,
+		Price:        cps.Price.Price,
+		Nonce:        cps.Nonce,
+		Height:       cps.Price.BlockHeight,
+	})
+}
+
+// emitPriceMissedEvent emits an event for a CurrencyPair whose price was not updated at the current height, e.g. because
+// too few validators reported a price for it, if price events are enabled.
+func (k *Keeper) emitPriceMissedEvent(ctx sdk.Context, cp slinkytypes.CurrencyPair, cps types.CurrencyPairState) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.EmitPriceEvents {
+		return nil
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPriceMissed{
+		CurrencyPair: cp,
+		Id:           cps.Id,
+		Nonce:        cps.Nonce,
+		Height:       uint64(ctx.BlockHeight()),
+	})
+}
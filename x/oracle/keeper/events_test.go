@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestPriceEvents() {
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, btcUSD))
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, btcUSD.String()).Return(marketmaptypes.Market{}, collections.ErrNotFound)
+
+	s.Run("no events are emitted if price events are disabled", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{}))
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(ctx, btcUSD, priceAt(100, 1)))
+		s.Require().NoError(s.oracleKeeper.RecordPriceMissed(ctx, btcUSD))
+		s.Require().Empty(ctx.EventManager().Events())
+	})
+
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{EmitPriceEvents: true}))
+
+	id, found := s.oracleKeeper.GetIDForCurrencyPair(s.ctx, btcUSD)
+	s.Require().True(found)
+
+	s.Run("a price updated event is emitted for a written price", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(ctx, btcUSD, priceAt(200, 2)))
+
+		events := ctx.EventManager().ABCIEvents()
+		s.Require().Len(events, 1)
+
+		event, err := sdk.ParseTypedEvent(events[0])
+		s.Require().NoError(err)
+		s.Require().Equal(&types.EventPriceUpdated{
+			CurrencyPair: btcUSD,
+			Id:           id,
+			Price:        math.NewInt(200),
+			Nonce:        2,
+			Height:       2,
+		}, event)
+	})
+
+	s.Run("a price missed event is emitted for a missed price", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager()).WithBlockHeight(3)
+		s.Require().NoError(s.oracleKeeper.RecordPriceMissed(ctx, btcUSD))
+
+		events := ctx.EventManager().ABCIEvents()
+		s.Require().Len(events, 1)
+
+		event, err := sdk.ParseTypedEvent(events[0])
+		s.Require().NoError(err)
+		s.Require().Equal(&types.EventPriceMissed{
+			CurrencyPair: btcUSD,
+			Id:           id,
+			Nonce:        2,
+			Height:       3,
+		}, event)
+	})
+
+	s.Run("a price missed event for a currency pair that does not exist fails", func() {
+		s.Require().Error(s.oracleKeeper.RecordPriceMissed(s.ctx, ethUSD))
+	})
+}
@@ -20,10 +20,19 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 	// initialize all CurrencyPairs + genesis prices
 	for _, cpg := range gs.CurrencyPairGenesis {
 		state := types.NewCurrencyPairState(cpg.Id, cpg.Nonce, cpg.CurrencyPairPrice)
+		state.Halted = cpg.Halted
+		state.Decimals = cpg.Decimals
+		state.MissedHeights = cpg.MissedHeights
+		state.RejectedPrice = cpg.RejectedPrice
+		state.RejectedCount = cpg.RejectedCount
 
 		if err := k.currencyPairs.Set(ctx, cpg.CurrencyPair.String(), state); err != nil {
 			panic(fmt.Errorf("error in genesis: %w", err))
 		}
+
+		if err := k.setPriceHistory(ctx, cpg.CurrencyPair, cpg.PriceHistory); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
 	}
 
 	// set the next ID to state
@@ -38,6 +47,22 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 	if err := k.numRemoves.Set(ctx, 0); err != nil {
 		panic(fmt.Errorf("error in genesis: %w", err))
 	}
+
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	for _, stats := range gs.ValidatorPriceStats {
+		if err := k.setValidatorPriceStats(ctx, stats); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	for _, stats := range gs.ValidatorOracleStats {
+		if err := k.setValidatorOracleStats(ctx, stats); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
 }
 
 // ExportGenesis retrieve all CurrencyPairs + QuotePrices set for the module, and return them as a genesis state.
@@ -57,17 +82,43 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 
 	// next, iterate over NonceKey to retrieve any CurrencyPairs that have not yet been traversed (CurrencyPairs w/ no Price info)
 	err = k.IterateCurrencyPairs(ctx, func(cp slinkytypes.CurrencyPair, cps types.CurrencyPairState) {
+		history, err := k.GetPriceHistory(ctx, cp)
+		if err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+
 		// append the currency pair + state to the genesis state
 		gs.CurrencyPairGenesis = append(gs.CurrencyPairGenesis, types.CurrencyPairGenesis{
 			CurrencyPair:      cp,
 			Id:                cps.Id,
 			Nonce:             cps.Nonce,
 			CurrencyPairPrice: cps.Price,
+			PriceHistory:      history,
+			Halted:            cps.Halted,
+			Decimals:          cps.Decimals,
+			MissedHeights:     cps.MissedHeights,
+			RejectedPrice:     cps.RejectedPrice,
+			RejectedCount:     cps.RejectedCount,
 		})
 	})
 	if err != nil {
 		panic(err)
 	}
 
+	gs.Params, err = k.GetParams(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	gs.ValidatorPriceStats, err = k.GetAllValidatorPriceStats(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	gs.ValidatorOracleStats, err = k.GetAllValidatorOracleStats(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
 	return gs
 }
@@ -256,3 +256,108 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		}
 	})
 }
+
+func (s *KeeperTestSuite) TestGenesisValidatorPriceStats() {
+	gs := types.DefaultGenesisState()
+	gs.Params = types.Params{ValidatorStatsWindow: 10, MaxPriceDeviationBps: 25}
+	gs.ValidatorPriceStats = []types.ValidatorPriceStats{
+		{
+			ConsAddress:    sdk.ConsAddress("val1").String(),
+			StartHeight:    10,
+			ReportCount:    4,
+			MissCount:      1,
+			DeviationCount: 2,
+			LastHeight:     12,
+			BucketHeights:  1,
+			Buckets: []types.ValidatorPriceStatsBucket{
+				{ReportCount: 2, MissCount: 1},
+				{},
+				{ReportCount: 2, DeviationCount: 2},
+				{}, {}, {}, {}, {}, {}, {},
+			},
+		},
+	}
+
+	s.oracleKeeper.InitGenesis(s.ctx, *gs)
+
+	exported := s.oracleKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal(gs.Params, exported.Params)
+	s.Require().Equal(gs.ValidatorPriceStats, exported.ValidatorPriceStats)
+}
+
+func (s *KeeperTestSuite) TestGenesisValidatorOracleStats() {
+	gs := types.DefaultGenesisState()
+	gs.Params = types.Params{ParticipationWindow: 8}
+	gs.ValidatorOracleStats = []types.ValidatorOracleStats{
+		{
+			ConsAddress:         sdk.ConsAddress("val1").String(),
+			StartHeight:         10,
+			LastHeight:          12,
+			IndexOffset:         3,
+			ParticipationBitmap: []byte{0b101},
+			ParticipatedCount:   2,
+			MissedCount:         1,
+		},
+	}
+
+	s.oracleKeeper.InitGenesis(s.ctx, *gs)
+
+	exported := s.oracleKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal(gs.Params, exported.Params)
+	s.Require().Equal(gs.ValidatorOracleStats, exported.ValidatorOracleStats)
+
+	// participation continues to be recorded from the imported state
+	ctx := s.ctx.WithBlockHeight(13)
+	s.Require().NoError(s.oracleKeeper.RecordValidatorParticipation(ctx, sdk.ConsAddress("val1"), true))
+
+	stats, err := s.oracleKeeper.GetValidatorOracleStats(ctx, sdk.ConsAddress("val1"))
+	s.Require().NoError(err)
+	s.Require().Equal([]byte{0b1101}, stats.ParticipationBitmap)
+	s.Require().Equal(uint64(3), stats.ParticipatedCount)
+}
+
+func (s *KeeperTestSuite) TestGenesisPriceHistory() {
+	price := types.QuotePrice{Price: sdkmath.NewInt(300)}
+
+	gs := types.NewGenesisState([]types.CurrencyPairGenesis{
+		{
+			CurrencyPair:      slinkytypes.NewCurrencyPair("BTC", "USD"),
+			CurrencyPairPrice: &price,
+			Nonce:             3,
+			PriceHistory: []types.PriceHistoryEntry{
+				{Nonce: 2, Price: types.QuotePrice{Price: sdkmath.NewInt(200)}},
+				{Nonce: 3, Price: price},
+			},
+		},
+	}, 1)
+	gs.Params = types.Params{PriceHistoryLength: 2}
+
+	s.oracleKeeper.InitGenesis(s.ctx, *gs)
+
+	exported := s.oracleKeeper.ExportGenesis(s.ctx)
+	s.Require().Len(exported.CurrencyPairGenesis, 1)
+	s.Require().Equal(gs.CurrencyPairGenesis[0].PriceHistory, exported.CurrencyPairGenesis[0].PriceHistory)
+}
+
+func (s *KeeperTestSuite) TestGenesisHalted() {
+	price := types.QuotePrice{Price: sdkmath.NewInt(100)}
+
+	gs := types.NewGenesisState([]types.CurrencyPairGenesis{
+		{
+			CurrencyPair:      slinkytypes.NewCurrencyPair("BTC", "USD"),
+			CurrencyPairPrice: &price,
+			Nonce:             1,
+			Halted:            true,
+		},
+	}, 1)
+
+	s.oracleKeeper.InitGenesis(s.ctx, *gs)
+
+	status, err := s.oracleKeeper.GetMarketStatus(s.ctx, slinkytypes.NewCurrencyPair("BTC", "USD"))
+	s.Require().NoError(err)
+	s.Require().Equal(types.MarketStatus_MARKET_STATUS_HALTED, status)
+
+	exported := s.oracleKeeper.ExportGenesis(s.ctx)
+	s.Require().Len(exported.CurrencyPairGenesis, 1)
+	s.Require().True(exported.CurrencyPairGenesis[0].Halted)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
 	"github.com/skip-mev/connect/v2/x/oracle/types"
@@ -124,6 +126,169 @@ func (q queryServer) GetPrices(goCtx context.Context, req *types.GetPricesReques
 	}, nil
 }
 
+// GetAllPrices gets the QuotePrice and the nonce for the QuotePrice of all the CurrencyPairs that have a price, along with
+// the CurrencyPair they are for, paginated by CurrencyPair.
+func (q queryServer) GetAllPrices(goCtx context.Context, req *types.GetAllPricesRequest) (*types.GetAllPricesResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	prices, pagination, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.currencyPairs,
+		req.Pagination,
+		func(_ string, cps types.CurrencyPairState) (bool, error) {
+			return cps.Price != nil, nil
+		},
+		func(key string, cps types.CurrencyPairState) (types.CurrencyPairPrice, error) {
+			cp, err := slinkytypes.CurrencyPairFromString(key)
+			if err != nil {
+				return types.CurrencyPairPrice{}, fmt.Errorf("error unmarshalling CurrencyPairID: %w", err)
+			}
+
+			decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
+			if err != nil {
+				return types.CurrencyPairPrice{}, err
+			}
+
+			return types.CurrencyPairPrice{
+				CurrencyPair: cp,
+				Price:        cps.Price,
+				Nonce:        cps.Nonce,
+				Decimals:     decimals,
+				Id:           cps.Id,
+			}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetAllPricesResponse{
+		Prices:     prices,
+		Pagination: pagination,
+	}, nil
+}
+
+// GetPriceHistory gets the historical prices of a given CurrencyPair within the requested height or time range,
+// paginated by nonce. This method fails if the request is nil, if the CurrencyPair is invalid or not tracked by the module, or if both
+// a height and a time range are requested.
+func (q queryServer) GetPriceHistory(goCtx context.Context, req *types.GetPriceHistoryRequest) (*types.GetPriceHistoryResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp := req.CurrencyPair
+	if err := cp.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	byHeight := req.StartHeight != 0 || req.EndHeight != 0
+	byTime := req.StartTime != nil || req.EndTime != nil
+	if byHeight && byTime {
+		return nil, fmt.Errorf("either a height range or a time range can be requested, not both")
+	}
+
+	if req.EndHeight != 0 && req.StartHeight > req.EndHeight {
+		return nil, fmt.Errorf("invalid height range: start height %d is after end height %d", req.StartHeight, req.EndHeight)
+	}
+
+	if req.StartTime != nil && req.EndTime != nil && req.StartTime.After(*req.EndTime) {
+		return nil, fmt.Errorf("invalid time range: start time %s is after end time %s", req.StartTime, req.EndTime)
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !q.k.HasCurrencyPair(ctx, cp) {
+		return nil, fmt.Errorf("no price history for CurrencyPair: %s, the module is not tracking this CurrencyPair", cp.String())
+	}
+
+	decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, pagination, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.priceHistory,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], qp types.QuotePrice) (bool, error) {
+			return inPriceHistoryRange(req, qp), nil
+		},
+		func(key collections.Pair[string, uint64], qp types.QuotePrice) (types.PriceHistoryEntry, error) {
+			return types.PriceHistoryEntry{
+				Nonce: key.K2(),
+				Price: qp,
+			}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](cp.String()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetPriceHistoryResponse{
+		Prices:     prices,
+		Decimals:   decimals,
+		Pagination: pagination,
+	}, nil
+}
+
+// GetMarketStatus gets the status of the price of a given CurrencyPair, i.e. whether it is active, stale or halted by
+// the circuit breaker. This method fails if the request is nil, or if the CurrencyPair is invalid or not tracked by the
+// module.
+func (q queryServer) GetMarketStatus(goCtx context.Context, req *types.GetMarketStatusRequest) (*types.GetMarketStatusResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp := req.CurrencyPair
+	if err := cp.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	status, err := q.k.GetMarketStatus(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.GetMarketStatusResponse{
+		Status: status,
+	}
+
+	if price, err := q.k.GetPriceForCurrencyPair(ctx, cp); err == nil {
+		res.Price = &price
+	}
+
+	return res, nil
+}
+
+// inPriceHistoryRange returns true if the given price is within the height and time range of the request.
+func inPriceHistoryRange(req *types.GetPriceHistoryRequest, qp types.QuotePrice) bool {
+	switch {
+	case req.StartHeight != 0 && qp.BlockHeight < req.StartHeight:
+		return false
+	case req.EndHeight != 0 && qp.BlockHeight > req.EndHeight:
+		return false
+	case req.StartTime != nil && qp.BlockTimestamp.Before(*req.StartTime):
+		return false
+	case req.EndTime != nil && qp.BlockTimestamp.After(*req.EndTime):
+		return false
+	default:
+		return true
+	}
+}
+
 func (q queryServer) GetCurrencyPairMapping(ctx context.Context, _ *types.GetCurrencyPairMappingRequest) (*types.GetCurrencyPairMappingResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	pairs, err := q.k.GetCurrencyPairMapping(sdkCtx)
@@ -132,3 +297,99 @@ func (q queryServer) GetCurrencyPairMapping(ctx context.Context, _ *types.GetCur
 	}
 	return &types.GetCurrencyPairMappingResponse{CurrencyPairMapping: pairs}, nil
 }
+
+// GetValidatorPriceStats returns the miss and deviation counters of the validator with the given consensus
+// address. This method fails if the request is nil, if the address is invalid, or if no counters have been
+// recorded for the validator.
+func (q queryServer) GetValidatorPriceStats(
+	goCtx context.Context,
+	req *types.GetValidatorPriceStatsRequest,
+) (*types.GetValidatorPriceStatsResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	validator, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator consensus address: %w", err)
+	}
+
+	stats, err := q.k.GetValidatorPriceStats(sdk.UnwrapSDKContext(goCtx), validator)
+	if err != nil {
+		return nil, fmt.Errorf("no price stats found for validator: %s", req.ConsAddress)
+	}
+
+	return &types.GetValidatorPriceStatsResponse{
+		Stats: stats,
+	}, nil
+}
+
+// GetAllValidatorPriceStats returns the miss and deviation counters of all validators.
+func (q queryServer) GetAllValidatorPriceStats(
+	goCtx context.Context,
+	_ *types.GetAllValidatorPriceStatsRequest,
+) (*types.GetAllValidatorPriceStatsResponse, error) {
+	stats, err := q.k.GetAllValidatorPriceStats(sdk.UnwrapSDKContext(goCtx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetAllValidatorPriceStatsResponse{
+		Stats: stats,
+	}, nil
+}
+
+// GetValidatorOracleStats returns the oracle participation of the validator with the given consensus address.
+// This method fails if the request is nil, if the address is invalid, or if no participation has been recorded
+// for the validator.
+func (q queryServer) GetValidatorOracleStats(
+	goCtx context.Context,
+	req *types.GetValidatorOracleStatsRequest,
+) (*types.GetValidatorOracleStatsResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	validator, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator consensus address: %w", err)
+	}
+
+	stats, err := q.k.GetValidatorOracleStats(sdk.UnwrapSDKContext(goCtx), validator)
+	if err != nil {
+		return nil, fmt.Errorf("no oracle stats found for validator: %s", req.ConsAddress)
+	}
+
+	return &types.GetValidatorOracleStatsResponse{
+		Stats: stats,
+	}, nil
+}
+
+// GetAllValidatorOracleStats returns the oracle participation of all validators.
+func (q queryServer) GetAllValidatorOracleStats(
+	goCtx context.Context,
+	_ *types.GetAllValidatorOracleStatsRequest,
+) (*types.GetAllValidatorOracleStatsResponse, error) {
+	stats, err := q.k.GetAllValidatorOracleStats(sdk.UnwrapSDKContext(goCtx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetAllValidatorOracleStatsResponse{
+		Stats: stats,
+	}, nil
+}
+
+// GetParams returns the current x/oracle module parameters.
+func (q queryServer) GetParams(goCtx context.Context, _ *types.GetParamsRequest) (*types.GetParamsResponse, error) {
+	params, err := q.k.GetParams(sdk.UnwrapSDKContext(goCtx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetParamsResponse{
+		Params: params,
+	}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/mock"
 
 	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -233,3 +234,54 @@ func (s *KeeperTestSuite) TestGetCurrencyPairMappingGRPC() {
 		}
 	})
 }
+
+func (s *KeeperTestSuite) TestGetAllPricesGRPC() {
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+
+	s.Run("invalid for nil request", func() {
+		_, err := qs.GetAllPrices(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	// only currency pairs with a price are returned
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, slinkytypes.NewCurrencyPair("AA", "BB")))
+	s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, slinkytypes.NewCurrencyPair("CC", "DD"), types.QuotePrice{
+		Price: sdkmath.NewInt(100),
+	}))
+	s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, slinkytypes.NewCurrencyPair("EE", "FF"), types.QuotePrice{
+		Price: sdkmath.NewInt(200),
+	}))
+
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, mock.Anything).Return(marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{Decimals: 8},
+	}, nil)
+
+	s.Run("all prices are returned without pagination", func() {
+		res, err := qs.GetAllPrices(s.ctx, &types.GetAllPricesRequest{})
+		s.Require().NoError(err)
+		s.Require().Len(res.Prices, 2)
+		s.Require().Equal(uint64(2), res.Pagination.Total)
+	})
+
+	s.Run("prices are paginated", func() {
+		res, err := qs.GetAllPrices(s.ctx, &types.GetAllPricesRequest{
+			Pagination: &query.PageRequest{Limit: 1},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(res.Prices, 1)
+		s.Require().Equal(slinkytypes.NewCurrencyPair("CC", "DD"), res.Prices[0].CurrencyPair)
+		s.Require().Equal(sdkmath.NewInt(100), res.Prices[0].Price.Price)
+		s.Require().Equal(uint64(0), res.Prices[0].Nonce)
+		s.Require().Equal(uint64(8), res.Prices[0].Decimals)
+		s.Require().NotNil(res.Pagination.NextKey)
+
+		res, err = qs.GetAllPrices(s.ctx, &types.GetAllPricesRequest{
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(res.Prices, 1)
+		s.Require().Equal(slinkytypes.NewCurrencyPair("EE", "FF"), res.Prices[0].CurrencyPair)
+		s.Require().Equal(sdkmath.NewInt(200), res.Prices[0].Price.Price)
+		s.Require().Nil(res.Pagination.NextKey)
+	})
+}
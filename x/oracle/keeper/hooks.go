@@ -22,25 +22,51 @@ func (k *Keeper) Hooks() Hooks {
 
 // AfterMarketCreated is the marketmap hook for x/oracle that is run after a market is created in
 // the marketmap.  After the market is created, a currency pair and its state are initialized in the
-// oracle module.
+// oracle module, along with the decimals of the market.
 func (h Hooks) AfterMarketCreated(ctx sdk.Context, market marketmaptypes.Market) error {
-	return h.k.CreateCurrencyPair(ctx, market.Ticker.CurrencyPair)
+	if err := h.k.CreateCurrencyPair(ctx, market.Ticker.CurrencyPair); err != nil {
+		return err
+	}
+
+	return h.k.initDecimalsForCurrencyPair(ctx, market.Ticker.CurrencyPair, market.Ticker.Decimals)
 }
 
 // AfterMarketUpdated is the marketmap hook for x/oracle that is run after a market is updated in
-// the marketmap.
-func (h Hooks) AfterMarketUpdated(_ sdk.Context, _ marketmaptypes.Market) error {
-	// TODO
-	return nil
+// the marketmap. If the decimals of the market are changed, the latest price of its currency pair is
+// rescaled to the new decimals, or invalidated, so that it is always quoted in the decimals of the market.
+// If the market is enabled, e.g. it is re-enabled after it was disabled for missing prices, the missed
+// heights of its currency pair are reset.
+func (h Hooks) AfterMarketUpdated(ctx sdk.Context, market marketmaptypes.Market) error {
+	if err := h.k.UpdateDecimalsForCurrencyPair(ctx, market.Ticker.CurrencyPair, market.Ticker.Decimals); err != nil {
+		return err
+	}
+
+	if !market.Ticker.Enabled {
+		return nil
+	}
+
+	return h.k.resetMissedHeights(ctx, market.Ticker.CurrencyPair)
+}
+
+// AfterMarketRemoved is the marketmap hook for x/oracle that is run after a market is removed from
+// the marketmap. After the market is removed, its currency pair and state are removed from the oracle
+// module.
+func (h Hooks) AfterMarketRemoved(ctx sdk.Context, market marketmaptypes.Market) error {
+	return h.k.RemoveCurrencyPair(ctx, market.Ticker.CurrencyPair)
 }
 
 // AfterMarketGenesis verifies that all markets set in the x/marketmap genesis are registered in
-// the x/oracle module.
+// the x/oracle module. The decimals of the markets are recorded for the currency pairs whose
+// decimals are not set in the x/oracle genesis.
 func (h Hooks) AfterMarketGenesis(ctx sdk.Context, markets map[string]marketmaptypes.Market) error {
 	for _, market := range markets {
 		if !h.k.HasCurrencyPair(ctx, market.Ticker.CurrencyPair) {
 			return fmt.Errorf("currency pair %s is registered in x/marketmap but not in x/oracle", market.Ticker.String())
 		}
+
+		if err := h.k.initDecimalsForCurrencyPair(ctx, market.Ticker.CurrencyPair, market.Ticker.Decimals); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -0,0 +1,256 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestAfterMarketRemoved() {
+	market := marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{
+			CurrencyPair: btcUSD,
+		},
+	}
+
+	s.Run("the currency pair of a removed market is removed", func() {
+		s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketCreated(s.ctx, market))
+		s.Require().True(s.oracleKeeper.HasCurrencyPair(s.ctx, btcUSD))
+
+		s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketRemoved(s.ctx, market))
+		s.Require().False(s.oracleKeeper.HasCurrencyPair(s.ctx, btcUSD))
+	})
+
+	s.Run("fail if the currency pair does not exist", func() {
+		s.Require().Error(s.oracleKeeper.Hooks().AfterMarketRemoved(s.ctx, market))
+	})
+}
+
+func (s *KeeperTestSuite) TestAfterMarketUpdated() {
+	market := marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{
+			CurrencyPair: btcUSD,
+			Decimals:     8,
+		},
+	}
+
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{PriceHistoryLength: 2}))
+	s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketCreated(s.ctx, market))
+
+	s.Run("the decimals of a market without a price are recorded", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+
+		market.Ticker.Decimals = 9
+		s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketUpdated(ctx, market))
+		s.Require().Empty(ctx.EventManager().Events())
+
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(ctx, btcUSD, priceAt(123_456_789, 1)))
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(ctx, btcUSD, priceAt(123_456_780, 2)))
+	})
+
+	s.Run("an update that does not change the decimals keeps the price", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+
+		market.Ticker.MinProviderCount = 3
+		s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketUpdated(ctx, market))
+		s.Require().Empty(ctx.EventManager().Events())
+
+		qp, err := s.oracleKeeper.GetPriceForCurrencyPair(ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(123_456_780), qp.Price)
+	})
+
+	s.Run("the price and its history are rescaled to more decimals", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+
+		market.Ticker.Decimals = 11
+		s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketUpdated(ctx, market))
+
+		qp, err := s.oracleKeeper.GetPriceForCurrencyPair(ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(12_345_678_000), qp.Price)
+		s.Require().Equal(uint64(2), qp.BlockHeight)
+
+		history, err := s.oracleKeeper.GetPriceHistory(ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Len(history, 2)
+		s.Require().Equal(sdkmath.NewInt(12_345_678_900), history[0].Price.Price)
+		s.Require().Equal(sdkmath.NewInt(12_345_678_000), history[1].Price.Price)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeDecimalsChange, events[0].Type)
+
+		for key, value := range map[string]string{
+			types.AttributeKeyPreviousDecimals: "9",
+			types.AttributeKeyDecimals:         "11",
+			types.AttributeKeyPreviousPrice:    "123456780",
+			types.AttributeKeyPrice:            "12345678000",
+		} {
+			attr, ok := events[0].GetAttribute(key)
+			s.Require().True(ok)
+			s.Require().Equal(value, attr.Value)
+		}
+	})
+
+	s.Run("the price is truncated when rescaled to fewer decimals", func() {
+		market.Ticker.Decimals = 4
+		s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketUpdated(s.ctx, market))
+
+		qp, err := s.oracleKeeper.GetPriceForCurrencyPair(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(1_234), qp.Price)
+	})
+
+	s.Run("the price is invalidated in invalidate mode", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{
+			PriceHistoryLength: 2,
+			DecimalsChangeMode: types.DecimalsChangeMode_DECIMALS_CHANGE_MODE_INVALIDATE,
+		}))
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+
+		market.Ticker.Decimals = 8
+		s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketUpdated(ctx, market))
+
+		_, err := s.oracleKeeper.GetPriceForCurrencyPair(ctx, btcUSD)
+		s.Require().Error(err)
+
+		history, err := s.oracleKeeper.GetPriceHistory(ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Empty(history)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+
+		attr, ok := events[0].GetAttribute(types.AttributeKeyPrice)
+		s.Require().True(ok)
+		s.Require().Empty(attr.Value)
+	})
+
+	s.Run("a price quoted in unknown decimals is invalidated", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{}))
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, ethUSD, priceAt(100, 1)))
+
+		s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketUpdated(s.ctx, marketmaptypes.Market{
+			Ticker: marketmaptypes.Ticker{
+				CurrencyPair: ethUSD,
+				Decimals:     8,
+			},
+		}))
+
+		_, err := s.oracleKeeper.GetPriceForCurrencyPair(s.ctx, ethUSD)
+		s.Require().Error(err)
+	})
+}
+
+// circuitBreakerState returns the circuit breaker state of the only currency pair in state.
+func (s *KeeperTestSuite) circuitBreakerState(ctx sdk.Context) (bool, *types.QuotePrice, uint64) {
+	gs := s.oracleKeeper.ExportGenesis(ctx)
+	s.Require().Len(gs.CurrencyPairGenesis, 1)
+
+	cpg := gs.CurrencyPairGenesis[0]
+	return cpg.Halted, cpg.RejectedPrice, cpg.RejectedCount
+}
+
+func (s *KeeperTestSuite) TestAfterMarketUpdatedHaltedPair() {
+	market := marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{
+			CurrencyPair: btcUSD,
+			Decimals:     8,
+		},
+	}
+
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, btcUSD.String()).Return(market, nil).Maybe()
+	s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketCreated(s.ctx, market))
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{
+		MaxPriceChangeBps:           1_000,
+		CircuitBreakerMode:          types.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_REJECT,
+		CircuitBreakerReanchorCount: 10,
+	}))
+
+	halt := func(price int64, height uint64) {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(price, height)))
+
+		halted, rejectedPrice, rejectedCount := s.circuitBreakerState(s.ctx)
+		s.Require().True(halted)
+		s.Require().NotNil(rejectedPrice)
+		s.Require().Equal(uint64(1), rejectedCount)
+	}
+
+	s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(1_000, 1)))
+	halt(2_000, 2)
+
+	s.Run("the circuit breaker is cleared when the price is rescaled", func() {
+		market.Ticker.Decimals = 9
+		s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketUpdated(s.ctx, market))
+
+		halted, rejectedPrice, rejectedCount := s.circuitBreakerState(s.ctx)
+		s.Require().False(halted)
+		s.Require().Nil(rejectedPrice)
+		s.Require().Zero(rejectedCount)
+
+		// the next price is compared to the rescaled price
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(10_500, 3)))
+
+		qp, err := s.oracleKeeper.GetPriceForCurrencyPair(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(10_500), qp.Price)
+	})
+
+	s.Run("the circuit breaker is cleared when the price is invalidated", func() {
+		halt(50_000, 4)
+
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{
+			MaxPriceChangeBps:           1_000,
+			CircuitBreakerMode:          types.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_REJECT,
+			CircuitBreakerReanchorCount: 10,
+			DecimalsChangeMode:          types.DecimalsChangeMode_DECIMALS_CHANGE_MODE_INVALIDATE,
+		}))
+
+		market.Ticker.Decimals = 8
+		s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketUpdated(s.ctx, market))
+
+		halted, rejectedPrice, rejectedCount := s.circuitBreakerState(s.ctx)
+		s.Require().False(halted)
+		s.Require().Nil(rejectedPrice)
+		s.Require().Zero(rejectedCount)
+
+		// the next price is written as the first price
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(5_000, 5)))
+
+		qp, err := s.oracleKeeper.GetPriceForCurrencyPair(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(5_000), qp.Price)
+	})
+}
+
+func (s *KeeperTestSuite) TestMigrate2to3() {
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, btcUSD))
+	s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(100, 1)))
+
+	s.mockMarketMapKeeper.On("GetMarket", s.ctx, btcUSD.String()).Return(marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{
+			CurrencyPair: btcUSD,
+			Decimals:     8,
+		},
+	}, nil).Once()
+
+	s.Require().NoError(keeper.NewMigrator(s.oracleKeeper).Migrate2to3(s.ctx))
+
+	// the price is kept, and rescaled once the decimals change
+	s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketUpdated(s.ctx, marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{
+			CurrencyPair: btcUSD,
+			Decimals:     10,
+		},
+	}))
+
+	qp, err := s.oracleKeeper.GetPriceForCurrencyPair(s.ctx, btcUSD)
+	s.Require().NoError(err)
+	s.Require().Equal(sdkmath.NewInt(10_000), qp.Price)
+}
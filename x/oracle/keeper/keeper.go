@@ -67,6 +67,21 @@ type Keeper struct {
 	// numCPs is the number of CPs.
 	numCPs collections.Item[uint64]
 
+	// params is the module's parameters.
+	params collections.Item[types.Params]
+
+	// validatorPriceStats is keyed by validator consensus address and contains the miss and
+	// deviation counters of each validator in the current window.
+	validatorPriceStats collections.Map[sdk.ConsAddress, types.ValidatorPriceStats]
+
+	// validatorOracleStats is keyed by validator consensus address and contains the oracle
+	// participation of each validator within the participation window.
+	validatorOracleStats collections.Map[sdk.ConsAddress, types.ValidatorOracleStats]
+
+	// priceHistory is keyed by CurrencyPair.String() and the nonce of the CurrencyPair at the time
+	// the price was written, and contains the most recent prices of each CurrencyPair.
+	priceHistory collections.Map[collections.Pair[string, uint64], types.QuotePrice]
+
 	// module authority
 	authority sdk.AccAddress
 }
@@ -89,12 +104,34 @@ func NewKeeper(
 	}
 
 	k := Keeper{
-		storeService:       ss,
-		cdc:                cdc,
-		authority:          authority,
-		mmKeeper:           mmKeeper,
-		numRemoves:         collections.NewItem[uint64](sb, types.NumRemovesKeyPrefix, "removed_cps", types.CounterCodec),
-		numCPs:             collections.NewItem[uint64](sb, types.NumCPsKeyPrefix, "num_cps", types.CounterCodec),
+		storeService: ss,
+		cdc:          cdc,
+		authority:    authority,
+		mmKeeper:     mmKeeper,
+		numRemoves:   collections.NewItem[uint64](sb, types.NumRemovesKeyPrefix, "removed_cps", types.CounterCodec),
+		numCPs:       collections.NewItem[uint64](sb, types.NumCPsKeyPrefix, "num_cps", types.CounterCodec),
+		params:       collections.NewItem[types.Params](sb, types.ParamsKeyPrefix, "params", codec.CollValue[types.Params](cdc)),
+		validatorPriceStats: collections.NewMap(
+			sb,
+			types.ValidatorPriceStatsKeyPrefix,
+			"validator_price_stats",
+			sdk.ConsAddressKey,
+			codec.CollValue[types.ValidatorPriceStats](cdc),
+		),
+		validatorOracleStats: collections.NewMap(
+			sb,
+			types.ValidatorOracleStatsKeyPrefix,
+			"validator_oracle_stats",
+			sdk.ConsAddressKey,
+			codec.CollValue[types.ValidatorOracleStats](cdc),
+		),
+		priceHistory: collections.NewMap(
+			sb,
+			types.PriceHistoryKeyPrefix,
+			"price_history",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.QuotePrice](cdc),
+		),
 		nextCurrencyPairID: collections.NewSequence(sb, types.CurrencyPairIDKeyPrefix, "currency_pair_id"),
 		currencyPairs:      collections.NewIndexedMap(sb, types.CurrencyPairKeyPrefix, "currency_pair", collections.StringKey, codec.CollValue[types.CurrencyPairState](cdc), indices),
 		idIndex:            idMulti,
@@ -120,6 +157,9 @@ func (k *Keeper) RemoveCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair
 	if err := k.currencyPairs.Remove(ctx, cp.String()); err != nil {
 		return err
 	}
+	if err := k.removePriceHistory(ctx, cp); err != nil {
+		return err
+	}
 	if err := k.incrementRemovedCPCounter(ctx); err != nil {
 		return err
 	}
@@ -192,7 +232,9 @@ func (k *Keeper) GetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.Currenc
 
 // SetPriceForCurrencyPair sets the given QuotePrice for a given CurrencyPair, and updates the CurrencyPair's nonce. Note, no validation is performed on
 // either the CurrencyPair or the QuotePrice (it is expected the caller performs this validation). If the CurrencyPair does not exist, create the currency-pair
-// and set its nonce to 0.
+// and set its nonce to 0. Price updates that exceed the maximum price change are clamped or rejected by the circuit breaker.
+// If historical prices are stored, the written price is also added to the price history of the CurrencyPair. If price
+// events are enabled, an event is emitted for the written price.
 func (k *Keeper) SetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp types.QuotePrice) error {
 	// get the current state for the currency-pair, fail if it does not exist
 	cps, err := k.currencyPairs.Get(ctx, cp.String())
@@ -205,13 +247,35 @@ func (k *Keeper) SetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.Currenc
 
 		cps = types.NewCurrencyPairState(id, 0, &qp)
 	} else {
+		// a price was received, so the currency-pair no longer misses prices
+		cps.MissedHeights = 0
+
+		var accepted bool
+		qp, accepted, err = k.applyCircuitBreaker(ctx, cp, &cps, qp)
+		if err != nil {
+			return err
+		}
+
+		// the price was rejected by the circuit breaker, only the halted flag is updated
+		if !accepted {
+			return k.currencyPairs.Set(ctx, cp.String(), cps)
+		}
+
 		// update the nonce
 		cps.Nonce++
 		cps.Price = &qp
 	}
 
 	// set the updated state
-	return k.currencyPairs.Set(ctx, cp.String(), cps)
+	if err := k.currencyPairs.Set(ctx, cp.String(), cps); err != nil {
+		return err
+	}
+
+	if err := k.addPriceHistory(ctx, cp, cps.Nonce, qp); err != nil {
+		return err
+	}
+
+	return k.emitPriceUpdatedEvent(ctx, cp, cps)
 }
 
 // CreateCurrencyPair creates a CurrencyPair in state, and sets its ID to the next available ID. If the CurrencyPair already exists, return an error.
@@ -392,3 +456,26 @@ func (k *Keeper) decrementCPCounter(ctx sdk.Context) error {
 func (k *Keeper) GetNumCurrencyPairs(ctx sdk.Context) (uint64, error) {
 	return k.numCPs.Get(ctx)
 }
+
+// SetParams sets the x/oracle module's parameters. If the length of the price history is reduced, the price
+// histories of all CurrencyPairs are pruned to the new length.
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	previous, err := k.params.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		// the params are set for the first time, so there are no price histories to prune
+	case err != nil:
+		return err
+	case params.PriceHistoryLength < previous.PriceHistoryLength:
+		if err := k.prunePriceHistories(ctx, params.PriceHistoryLength); err != nil {
+			return err
+		}
+	}
+
+	return k.params.Set(ctx, params)
+}
+
+// GetParams returns the x/oracle module's parameters.
+func (k *Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
+	return k.params.Get(ctx)
+}
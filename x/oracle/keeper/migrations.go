@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// Migrator is a struct for handling in-place store migrations of the x/oracle module.
+type Migrator struct {
+	k Keeper
+}
+
+// NewMigrator returns a new Migrator for the x/oracle module.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{k: k}
+}
+
+// Migrate1to2 migrates the x/oracle module from consensus version 1 to 2. Version 2 introduces the
+// module parameters, which are set to their defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.k.SetParams(ctx, types.DefaultParams())
+}
+
+// Migrate2to3 migrates the x/oracle module from consensus version 2 to 3. Version 3 records the decimals of the
+// market of each currency pair, so that its price can be rescaled when they change.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	for _, cp := range m.k.GetAllCurrencyPairs(ctx) {
+		decimals, err := m.k.GetDecimalsForCurrencyPair(ctx, cp)
+		if err != nil {
+			return err
+		}
+
+		if err := m.k.initDecimalsForCurrencyPair(ctx, cp, decimals); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// RecordPriceMissed records that the given CurrencyPair did not receive a price at the current height, and emits a
+// price missed event if price events are enabled. CurrencyPairs whose market is disabled in x/marketmap are not
+// expected to receive prices, and are left as is. Once the CurrencyPair has missed a price for the maximum number of
+// consecutive heights, its market is disabled in x/marketmap. This is only attempted at the height the maximum is
+// reached, so a market that cannot be disabled stays enabled until its missed heights are reset. This method fails
+// if the CurrencyPair is not tracked by the module.
+func (k *Keeper) RecordPriceMissed(ctx sdk.Context, cp slinkytypes.CurrencyPair) error {
+	cps, err := k.currencyPairs.Get(ctx, cp.String())
+	if err != nil {
+		return types.NewCurrencyPairNotExistError(cp)
+	}
+
+	market, found, err := k.getMarket(ctx, cp)
+	if err != nil {
+		return err
+	}
+
+	if found && !market.Ticker.Enabled {
+		return nil
+	}
+
+	cps.MissedHeights++
+	if err := k.currencyPairs.Set(ctx, cp.String(), cps); err != nil {
+		return err
+	}
+
+	if err := k.emitPriceMissedEvent(ctx, cp, cps); err != nil {
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !found || params.MaxMissedHeights == 0 || cps.MissedHeights != params.MaxMissedHeights {
+		return nil
+	}
+
+	return k.disableMarket(ctx, cp, cps, market)
+}
+
+// getMarket returns the market of the given CurrencyPair in x/marketmap, and whether it exists.
+func (k *Keeper) getMarket(ctx sdk.Context, cp slinkytypes.CurrencyPair) (marketmaptypes.Market, bool, error) {
+	if k.mmKeeper == nil {
+		return marketmaptypes.Market{}, false, nil
+	}
+
+	market, err := k.mmKeeper.GetMarket(ctx, cp.String())
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return marketmaptypes.Market{}, false, nil
+	case err != nil:
+		return marketmaptypes.Market{}, false, err
+	default:
+		return market, true, nil
+	}
+}
+
+// disableMarket disables the given enabled market of the CurrencyPair in x/marketmap, and emits an event. The market
+// is disabled like any other market update, i.e. the market map hooks are run and the resulting market map is
+// validated before the update is written. Markets that cannot be disabled, e.g. because enabled markets are
+// normalized by or derived from them, are left enabled.
+func (k *Keeper) disableMarket(
+	ctx sdk.Context,
+	cp slinkytypes.CurrencyPair,
+	cps types.CurrencyPairState,
+	market marketmaptypes.Market,
+) error {
+	market.Ticker.Enabled = false
+	if err := k.mmKeeper.ApplyMarketUpdate(ctx, market); err != nil {
+		ctx.Logger().With("module", "x/"+types.ModuleName).Info(
+			"unable to disable market that missed prices",
+			"market", cp.String(),
+			"missed_heights", cps.MissedHeights,
+			"error", err,
+		)
+
+		return nil
+	}
+
+	// the market map is updated, so that the market is no longer fetched by the oracle sidecars
+	if err := k.mmKeeper.SetLastUpdated(ctx, uint64(ctx.BlockHeight())); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMarketDisabled,
+		sdk.NewAttribute(types.AttributeKeyCurrencyPair, cp.String()),
+		sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(cps.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyMissedHeights, strconv.FormatUint(cps.MissedHeights, 10)),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+	))
+
+	return nil
+}
+
+// resetMissedHeights resets the number of consecutive heights the given CurrencyPair missed a price for.
+func (k *Keeper) resetMissedHeights(ctx sdk.Context, cp slinkytypes.CurrencyPair) error {
+	cps, err := k.currencyPairs.Get(ctx, cp.String())
+	if err != nil {
+		return err
+	}
+
+	if cps.MissedHeights == 0 {
+		return nil
+	}
+
+	cps.MissedHeights = 0
+
+	return k.currencyPairs.Set(ctx, cp.String(), cps)
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// missedHeights returns the number of consecutive heights the only currency pair in state missed a price for.
+func (s *KeeperTestSuite) missedHeights(ctx sdk.Context) uint64 {
+	gs := s.oracleKeeper.ExportGenesis(ctx)
+	s.Require().Len(gs.CurrencyPairGenesis, 1)
+
+	return gs.CurrencyPairGenesis[0].MissedHeights
+}
+
+func (s *KeeperTestSuite) TestRecordPriceMissed() {
+	market := marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{
+			CurrencyPair: btcUSD,
+			Decimals:     8,
+			Enabled:      true,
+		},
+	}
+
+	s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketCreated(s.ctx, market))
+
+	id, found := s.oracleKeeper.GetIDForCurrencyPair(s.ctx, btcUSD)
+	s.Require().True(found)
+
+	s.Run("a currency pair that does not exist fails", func() {
+		s.Require().Error(s.oracleKeeper.RecordPriceMissed(s.ctx, ethUSD))
+	})
+
+	s.Run("missed heights are tracked if markets are not disabled", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{}))
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.mockMarketMapKeeper.On("GetMarket", ctx, btcUSD.String()).Return(market, nil).Times(5)
+
+		for i := 0; i < 5; i++ {
+			s.Require().NoError(s.oracleKeeper.RecordPriceMissed(ctx, btcUSD))
+		}
+
+		s.Require().Equal(uint64(5), s.missedHeights(ctx))
+		s.Require().Empty(ctx.EventManager().Events())
+	})
+
+	s.Run("missed heights are reset by a price", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(100, 1)))
+		s.Require().Equal(uint64(0), s.missedHeights(s.ctx))
+	})
+
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{MaxMissedHeights: 3}))
+
+	s.Run("a market is not disabled below the maximum missed heights", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.mockMarketMapKeeper.On("GetMarket", ctx, btcUSD.String()).Return(market, nil).Twice()
+
+		s.Require().NoError(s.oracleKeeper.RecordPriceMissed(ctx, btcUSD))
+		s.Require().NoError(s.oracleKeeper.RecordPriceMissed(ctx, btcUSD))
+
+		s.Require().Equal(uint64(2), s.missedHeights(ctx))
+		s.Require().Empty(ctx.EventManager().Events())
+	})
+
+	s.Run("a market is disabled at the maximum missed heights", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager()).WithBlockHeight(12)
+
+		disabled := market
+		disabled.Ticker.Enabled = false
+
+		s.mockMarketMapKeeper.On("GetMarket", ctx, btcUSD.String()).Return(market, nil).Once()
+		s.mockMarketMapKeeper.On("ApplyMarketUpdate", ctx, disabled).Return(nil).Once()
+		s.mockMarketMapKeeper.On("SetLastUpdated", ctx, uint64(12)).Return(nil).Once()
+
+		s.Require().NoError(s.oracleKeeper.RecordPriceMissed(ctx, btcUSD))
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(sdk.NewEvent(
+			types.EventTypeMarketDisabled,
+			sdk.NewAttribute(types.AttributeKeyCurrencyPair, btcUSD.String()),
+			sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyMissedHeights, "3"),
+			sdk.NewAttribute(types.AttributeKeyHeight, "12"),
+		), events[0])
+	})
+
+	s.Run("a market is not disabled again past the maximum missed heights", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.mockMarketMapKeeper.On("GetMarket", ctx, btcUSD.String()).Return(market, nil).Once()
+
+		s.Require().NoError(s.oracleKeeper.RecordPriceMissed(ctx, btcUSD))
+		s.Require().Equal(uint64(4), s.missedHeights(ctx))
+		s.Require().Empty(ctx.EventManager().Events())
+	})
+
+	s.Run("missed heights are not tracked for a market that is already disabled", func() {
+		disabled := market
+		disabled.Ticker.Enabled = false
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.mockMarketMapKeeper.On("GetMarket", ctx, btcUSD.String()).Return(disabled, nil).Once()
+
+		s.Require().NoError(s.oracleKeeper.RecordPriceMissed(ctx, btcUSD))
+		s.Require().Equal(uint64(4), s.missedHeights(ctx))
+		s.Require().Empty(ctx.EventManager().Events())
+	})
+
+	s.Run("a market that does not exist in the market map is left as is", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.mockMarketMapKeeper.On("GetMarket", ctx, btcUSD.String()).Return(marketmaptypes.Market{}, collections.ErrNotFound).Once()
+
+		s.Require().NoError(s.oracleKeeper.RecordPriceMissed(ctx, btcUSD))
+		s.Require().Equal(uint64(5), s.missedHeights(ctx))
+		s.Require().Empty(ctx.EventManager().Events())
+	})
+
+	s.Run("a market that cannot be disabled is left enabled", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, priceAt(100, 2)))
+
+		disabled := market
+		disabled.Ticker.Enabled = false
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.mockMarketMapKeeper.On("GetMarket", ctx, btcUSD.String()).Return(market, nil).Times(3)
+		s.mockMarketMapKeeper.On("ApplyMarketUpdate", ctx, disabled).Return(fmt.Errorf("market is normalized by an enabled market")).Once()
+
+		for i := 0; i < 3; i++ {
+			s.Require().NoError(s.oracleKeeper.RecordPriceMissed(ctx, btcUSD))
+		}
+
+		s.Require().Equal(uint64(3), s.missedHeights(ctx))
+		s.Require().Empty(ctx.EventManager().Events())
+	})
+
+	s.Run("missed heights are reset when the market is re-enabled", func() {
+		s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketUpdated(s.ctx, market))
+		s.Require().Equal(uint64(0), s.missedHeights(s.ctx))
+	})
+
+	s.Run("missed heights are kept when the market is updated while disabled", func() {
+		s.mockMarketMapKeeper.On("GetMarket", s.ctx, btcUSD.String()).Return(market, nil).Once()
+		s.Require().NoError(s.oracleKeeper.RecordPriceMissed(s.ctx, btcUSD))
+
+		disabled := market
+		disabled.Ticker.Enabled = false
+		s.Require().NoError(s.oracleKeeper.Hooks().AfterMarketUpdated(s.ctx, disabled))
+		s.Require().Equal(uint64(1), s.missedHeights(s.ctx))
+	})
+}
+
+func (s *KeeperTestSuite) TestRecordPriceMissedWithNoMMKeeper() {
+	s.SetupWithNoMMKeeper()
+	s.oracleKeeper.InitGenesis(s.ctx, *types.DefaultGenesisState())
+
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, btcUSD))
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{MaxMissedHeights: 1}))
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.oracleKeeper.RecordPriceMissed(ctx, btcUSD))
+	s.Require().Equal(uint64(1), s.missedHeights(ctx))
+	s.Require().Empty(ctx.EventManager().Events())
+}
@@ -254,3 +254,59 @@ func (s *KeeperTestSuite) TestMsgRemoveCurrencyPairs() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	ms := keeper.NewMsgServer(s.oracleKeeper)
+
+	tcs := []struct {
+		name       string
+		req        *types.MsgUpdateParams
+		expectPass bool
+	}{
+		{
+			"if the request is empty - fail",
+			nil,
+			false,
+		},
+		{
+			"if the authority is not the authority of the module - fail",
+			&types.MsgUpdateParams{
+				Authority: sdk.AccAddress("not-authority").String(),
+				Params:    types.Params{ValidatorStatsWindow: 10, MaxPriceDeviationBps: 10},
+			},
+			false,
+		},
+		{
+			"if the params are invalid - fail",
+			&types.MsgUpdateParams{
+				Authority: sdk.AccAddress(moduleAuth).String(),
+				Params:    types.Params{ValidatorStatsWindow: 10},
+			},
+			false,
+		},
+		{
+			"if the authority is correct and the params are valid - pass",
+			&types.MsgUpdateParams{
+				Authority: sdk.AccAddress(moduleAuth).String(),
+				Params:    types.Params{ValidatorStatsWindow: 10, MaxPriceDeviationBps: 10},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			_, err := ms.UpdateParams(s.ctx, tc.req)
+			if !tc.expectPass {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			params, err := s.oracleKeeper.GetParams(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.req.Params, params)
+		})
+	}
+}
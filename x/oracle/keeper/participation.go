@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// RecordValidatorParticipation records whether the given validator included a valid oracle vote extension
+// at the current height. The participation is tracked over the most recent heights within the participation
+// window. If the participation window has changed since the validator's participation was last recorded, its
+// participation is tracked from scratch. This is a no-op if participation tracking is disabled.
+func (k *Keeper) RecordValidatorParticipation(ctx sdk.Context, validator sdk.ConsAddress, participated bool) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.ParticipationWindow == 0 {
+		return nil
+	}
+
+	height := uint64(ctx.BlockHeight()) //nolint:gosec
+
+	stats, err := k.validatorOracleStats.Get(ctx, validator)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		stats = types.NewValidatorOracleStats(validator, height, params.ParticipationWindow)
+	case err != nil:
+		return err
+	case len(stats.ParticipationBitmap) != types.ParticipationBitmapLen(params.ParticipationWindow):
+		// the window has changed, the bitmap can no longer be interpreted
+		stats = types.NewValidatorOracleStats(validator, height, params.ParticipationWindow)
+	}
+
+	stats.RecordParticipation(height, params.ParticipationWindow, participated)
+
+	return k.validatorOracleStats.Set(ctx, validator, stats)
+}
+
+// GetValidatorOracleStats returns the oracle participation of the given validator within the participation
+// window.
+func (k *Keeper) GetValidatorOracleStats(ctx sdk.Context, validator sdk.ConsAddress) (types.ValidatorOracleStats, error) {
+	return k.validatorOracleStats.Get(ctx, validator)
+}
+
+// GetAllValidatorOracleStats returns the oracle participation of all validators within the participation
+// window.
+func (k *Keeper) GetAllValidatorOracleStats(ctx sdk.Context) ([]types.ValidatorOracleStats, error) {
+	it, err := k.validatorOracleStats.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
+
+// setValidatorOracleStats sets the oracle participation of a validator.
+func (k *Keeper) setValidatorOracleStats(ctx sdk.Context, stats types.ValidatorOracleStats) error {
+	validator, err := sdk.ConsAddressFromBech32(stats.ConsAddress)
+	if err != nil {
+		return err
+	}
+
+	return k.validatorOracleStats.Set(ctx, validator, stats)
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestRecordValidatorParticipation() {
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{ParticipationWindow: 4}))
+
+	s.Run("participation is recorded within the window", func() {
+		for height, participated := range map[int64]bool{10: true, 11: false, 12: true} {
+			ctx := s.ctx.WithBlockHeight(height)
+			s.Require().NoError(s.oracleKeeper.RecordValidatorParticipation(ctx, val1, participated))
+		}
+
+		stats, err := s.oracleKeeper.GetValidatorOracleStats(s.ctx, val1)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(3), stats.IndexOffset)
+		s.Require().Equal(uint64(2), stats.ParticipatedCount)
+		s.Require().Equal(uint64(1), stats.MissedCount)
+	})
+
+	s.Run("the oldest participation is evicted once the window is full", func() {
+		for height := int64(13); height <= 16; height++ {
+			ctx := s.ctx.WithBlockHeight(height)
+			s.Require().NoError(s.oracleKeeper.RecordValidatorParticipation(ctx, val1, false))
+		}
+
+		stats, err := s.oracleKeeper.GetValidatorOracleStats(s.ctx, val1)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(7), stats.IndexOffset)
+		s.Require().Equal(uint64(16), stats.LastHeight)
+		s.Require().Equal(uint64(0), stats.ParticipatedCount)
+		s.Require().Equal(uint64(4), stats.MissedCount)
+	})
+
+	s.Run("participation is tracked from scratch if the window changes", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{ParticipationWindow: 16}))
+
+		ctx := s.ctx.WithBlockHeight(17)
+		s.Require().NoError(s.oracleKeeper.RecordValidatorParticipation(ctx, val1, true))
+
+		stats, err := s.oracleKeeper.GetValidatorOracleStats(s.ctx, val1)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ValidatorOracleStats{
+			ConsAddress:         val1.String(),
+			StartHeight:         17,
+			LastHeight:          17,
+			IndexOffset:         1,
+			ParticipationBitmap: []byte{0b1, 0},
+			ParticipatedCount:   1,
+		}, stats)
+	})
+
+	s.Run("no participation is recorded if tracking is disabled", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{}))
+		s.Require().NoError(s.oracleKeeper.RecordValidatorParticipation(s.ctx, val2, true))
+
+		_, err := s.oracleKeeper.GetValidatorOracleStats(s.ctx, val2)
+		s.Require().Error(err)
+	})
+
+	s.Run("all stats are returned", func() {
+		stats, err := s.oracleKeeper.GetAllValidatorOracleStats(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(stats, 1)
+		s.Require().Equal(val1.String(), stats[0].ConsAddress)
+	})
+}
+
+func (s *KeeperTestSuite) TestValidatorOracleStatsGRPC() {
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+
+	ctx := s.ctx.WithBlockHeight(1)
+	s.Require().NoError(s.oracleKeeper.RecordValidatorParticipation(ctx, val1, true))
+
+	s.Run("nil request - fail", func() {
+		_, err := qs.GetValidatorOracleStats(ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("invalid consensus address - fail", func() {
+		_, err := qs.GetValidatorOracleStats(ctx, &types.GetValidatorOracleStatsRequest{ConsAddress: "invalid"})
+		s.Require().Error(err)
+	})
+
+	s.Run("validator without stats - fail", func() {
+		_, err := qs.GetValidatorOracleStats(ctx, &types.GetValidatorOracleStatsRequest{ConsAddress: val2.String()})
+		s.Require().Error(err)
+	})
+
+	s.Run("validator with stats - pass", func() {
+		res, err := qs.GetValidatorOracleStats(ctx, &types.GetValidatorOracleStatsRequest{ConsAddress: val1.String()})
+		s.Require().NoError(err)
+		s.Require().Equal(val1.String(), res.Stats.ConsAddress)
+		s.Require().Equal(uint64(1), res.Stats.StartHeight)
+		s.Require().Equal(uint64(1), res.Stats.ParticipatedCount)
+		s.Require().Len(res.Stats.ParticipationBitmap, types.ParticipationBitmapLen(types.DefaultParticipationWindow))
+	})
+
+	s.Run("all validators - pass", func() {
+		res, err := qs.GetAllValidatorOracleStats(ctx, &types.GetAllValidatorOracleStatsRequest{})
+		s.Require().NoError(err)
+		s.Require().Len(res.Stats, 1)
+	})
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// addPriceHistory adds the given price, written at the given nonce, to the price history of the CurrencyPair,
+// and prunes the price that is no longer among the most recent prices. If the storage of historical prices
+// is disabled, nothing is stored.
+func (k *Keeper) addPriceHistory(ctx sdk.Context, cp slinkytypes.CurrencyPair, nonce uint64, qp types.QuotePrice) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.PriceHistoryLength == 0 {
+		return nil
+	}
+
+	if err := k.priceHistory.Set(ctx, collections.Join(cp.String(), nonce), qp); err != nil {
+		return err
+	}
+
+	// prune the price written right before the most recent PriceHistoryLength prices. Older prices are pruned
+	// when they are written, or when the length of the price history is reduced.
+	if nonce < params.PriceHistoryLength {
+		return nil
+	}
+
+	return k.priceHistory.Remove(ctx, collections.Join(cp.String(), nonce-params.PriceHistoryLength))
+}
+
+// prunePriceHistories prunes the price histories of all CurrencyPairs to the given length. If the length is
+// zero, the entire price histories are pruned.
+func (k *Keeper) prunePriceHistories(ctx sdk.Context, length uint64) error {
+	return k.currencyPairs.Walk(ctx, nil, func(key string, cps types.CurrencyPairState) (bool, error) {
+		// prune all prices written before the most recent length prices
+		if cps.Nonce+1 <= length {
+			return false, nil
+		}
+
+		rng := collections.NewPrefixedPairRange[string, uint64](key).EndExclusive(cps.Nonce + 1 - length)
+		return false, k.priceHistory.Clear(ctx, rng)
+	})
+}
+
+// GetPriceHistory returns the most recent prices of the given CurrencyPair, in ascending order of their nonces.
+func (k *Keeper) GetPriceHistory(ctx sdk.Context, cp slinkytypes.CurrencyPair) ([]types.PriceHistoryEntry, error) {
+	it, err := k.priceHistory.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](cp.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	kvs, err := it.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []types.PriceHistoryEntry
+	for _, kv := range kvs {
+		entries = append(entries, types.PriceHistoryEntry{
+			Nonce: kv.Key.K2(),
+			Price: kv.Value,
+		})
+	}
+
+	return entries, nil
+}
+
+// setPriceHistory sets the price history of the given CurrencyPair.
+func (k *Keeper) setPriceHistory(ctx sdk.Context, cp slinkytypes.CurrencyPair, entries []types.PriceHistoryEntry) error {
+	for _, entry := range entries {
+		if err := k.priceHistory.Set(ctx, collections.Join(cp.String(), entry.Nonce), entry.Price); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removePriceHistory removes the entire price history of the given CurrencyPair.
+func (k *Keeper) removePriceHistory(ctx sdk.Context, cp slinkytypes.CurrencyPair) error {
+	return k.priceHistory.Clear(ctx, collections.NewPrefixedPairRange[string, uint64](cp.String()))
+}
@@ -0,0 +1,253 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/mock"
+
+	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+var historyStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// historicalPrice returns the price written at the given height in the price history tests.
+func historicalPrice(height uint64) types.QuotePrice {
+	return types.QuotePrice{
+		Price:          sdkmath.NewIntFromUint64(height * 100),
+		BlockHeight:    height,
+		BlockTimestamp: historyStart.Add(time.Duration(height) * time.Second),
+	}
+}
+
+func (s *KeeperTestSuite) TestPriceHistory() {
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{PriceHistoryLength: 3}))
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, btcUSD))
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, ethUSD))
+
+	s.Run("prices are added to the history", func() {
+		for height := uint64(1); height <= 2; height++ {
+			s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, historicalPrice(height)))
+		}
+
+		history, err := s.oracleKeeper.GetPriceHistory(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PriceHistoryEntry{
+			{Nonce: 1, Price: historicalPrice(1)},
+			{Nonce: 2, Price: historicalPrice(2)},
+		}, history)
+	})
+
+	s.Run("the oldest prices are pruned once the history is full", func() {
+		for height := uint64(3); height <= 5; height++ {
+			s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, historicalPrice(height)))
+		}
+
+		history, err := s.oracleKeeper.GetPriceHistory(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PriceHistoryEntry{
+			{Nonce: 3, Price: historicalPrice(3)},
+			{Nonce: 4, Price: historicalPrice(4)},
+			{Nonce: 5, Price: historicalPrice(5)},
+		}, history)
+
+		// the history of other currency pairs is unaffected
+		history, err = s.oracleKeeper.GetPriceHistory(s.ctx, ethUSD)
+		s.Require().NoError(err)
+		s.Require().Empty(history)
+	})
+
+	s.Run("the history is pruned when its length is reduced", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{PriceHistoryLength: 1}))
+
+		history, err := s.oracleKeeper.GetPriceHistory(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PriceHistoryEntry{
+			{Nonce: 5, Price: historicalPrice(5)},
+		}, history)
+
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, historicalPrice(6)))
+
+		history, err = s.oracleKeeper.GetPriceHistory(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PriceHistoryEntry{
+			{Nonce: 6, Price: historicalPrice(6)},
+		}, history)
+	})
+
+	s.Run("the history is pruned when it is disabled", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{}))
+
+		history, err := s.oracleKeeper.GetPriceHistory(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Empty(history)
+	})
+
+	s.Run("prices are not added to a disabled history", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, historicalPrice(7)))
+
+		history, err := s.oracleKeeper.GetPriceHistory(s.ctx, btcUSD)
+		s.Require().NoError(err)
+		s.Require().Empty(history)
+	})
+
+	s.Run("the history is removed with the currency pair", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{PriceHistoryLength: 3}))
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, ethUSD, historicalPrice(8)))
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, ethUSD))
+
+		history, err := s.oracleKeeper.GetPriceHistory(s.ctx, ethUSD)
+		s.Require().NoError(err)
+		s.Require().Empty(history)
+	})
+}
+
+func (s *KeeperTestSuite) TestGetPriceHistoryGRPC() {
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{PriceHistoryLength: 10}))
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, btcUSD))
+	for height := uint64(1); height <= 5; height++ {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, historicalPrice(height)))
+	}
+
+	startTime := historyStart.Add(2 * time.Second)
+	endTime := historyStart.Add(3 * time.Second)
+
+	tcs := []struct {
+		name       string
+		req        *types.GetPriceHistoryRequest
+		heights    []uint64
+		expectPass bool
+	}{
+		{
+			"nil request - fail",
+			nil,
+			nil,
+			false,
+		},
+		{
+			"invalid currency pair - fail",
+			&types.GetPriceHistoryRequest{},
+			nil,
+			false,
+		},
+		{
+			"currency pair that does not exist - fail",
+			&types.GetPriceHistoryRequest{CurrencyPair: slinkytypes.NewCurrencyPair("DD", "EE")},
+			nil,
+			false,
+		},
+		{
+			"both a height and a time range - fail",
+			&types.GetPriceHistoryRequest{CurrencyPair: btcUSD, StartHeight: 1, EndTime: &endTime},
+			nil,
+			false,
+		},
+		{
+			"inverted height range - fail",
+			&types.GetPriceHistoryRequest{CurrencyPair: btcUSD, StartHeight: 3, EndHeight: 2},
+			nil,
+			false,
+		},
+		{
+			"inverted time range - fail",
+			&types.GetPriceHistoryRequest{CurrencyPair: btcUSD, StartTime: &endTime, EndTime: &startTime},
+			nil,
+			false,
+		},
+		{
+			"no range returns the entire history - pass",
+			&types.GetPriceHistoryRequest{CurrencyPair: btcUSD},
+			[]uint64{1, 2, 3, 4, 5},
+			true,
+		},
+		{
+			"height range - pass",
+			&types.GetPriceHistoryRequest{CurrencyPair: btcUSD, StartHeight: 2, EndHeight: 4},
+			[]uint64{2, 3, 4},
+			true,
+		},
+		{
+			"open height range - pass",
+			&types.GetPriceHistoryRequest{CurrencyPair: btcUSD, StartHeight: 4},
+			[]uint64{4, 5},
+			true,
+		},
+		{
+			"time range - pass",
+			&types.GetPriceHistoryRequest{CurrencyPair: btcUSD, StartTime: &startTime, EndTime: &endTime},
+			[]uint64{2, 3},
+			true,
+		},
+		{
+			"open time range - pass",
+			&types.GetPriceHistoryRequest{CurrencyPair: btcUSD, EndTime: &startTime},
+			[]uint64{1, 2},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			if tc.expectPass {
+				s.mockMarketMapKeeper.On("GetMarket", mock.Anything, btcUSD.String()).Return(marketmaptypes.Market{
+					Ticker: marketmaptypes.Ticker{
+						CurrencyPair: btcUSD,
+						Decimals:     8,
+					},
+				}, nil).Once()
+			}
+
+			res, err := qs.GetPriceHistory(s.ctx, tc.req)
+			if !tc.expectPass {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(8), res.Decimals)
+			s.Require().Len(res.Prices, len(tc.heights))
+			for i, height := range tc.heights {
+				s.Require().Equal(historicalPrice(height), res.Prices[i].Price)
+			}
+		})
+	}
+
+	s.Run("prices within the range are paginated", func() {
+		s.mockMarketMapKeeper.On("GetMarket", mock.Anything, btcUSD.String()).Return(marketmaptypes.Market{
+			Ticker: marketmaptypes.Ticker{
+				CurrencyPair: btcUSD,
+				Decimals:     8,
+			},
+		}, nil).Twice()
+
+		res, err := qs.GetPriceHistory(s.ctx, &types.GetPriceHistoryRequest{
+			CurrencyPair: btcUSD,
+			StartHeight:  2,
+			Pagination:   &query.PageRequest{Limit: 2},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PriceHistoryEntry{
+			{Nonce: 2, Price: historicalPrice(2)},
+			{Nonce: 3, Price: historicalPrice(3)},
+		}, res.Prices)
+		s.Require().NotNil(res.Pagination.NextKey)
+
+		res, err = qs.GetPriceHistory(s.ctx, &types.GetPriceHistoryRequest{
+			CurrencyPair: btcUSD,
+			StartHeight:  2,
+			Pagination:   &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PriceHistoryEntry{
+			{Nonce: 4, Price: historicalPrice(4)},
+			{Nonce: 5, Price: historicalPrice(5)},
+		}, res.Prices)
+		s.Require().Nil(res.Pagination.NextKey)
+	})
+}
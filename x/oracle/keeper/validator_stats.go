@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// UpdateValidatorPriceStats updates the miss and deviation counters of the given validator with the
+// deviations of the prices it reported at the current height from the aggregated prices. Each currency
+// pair with an aggregated price counts as a report. A report is counted as a miss if the validator did
+// not report a price, and as a deviation if the reported price deviated more than the maximum price
+// deviation (in basis points) from the aggregated price. The counters cover the most recent heights
+// within the validator stats window, which slides one bucket of heights at a time. If the window has
+// changed since the validator's stats were last updated, its stats are tracked from scratch. This is a
+// no-op if validator price stats are disabled.
+func (k *Keeper) UpdateValidatorPriceStats(
+	ctx sdk.Context,
+	validator sdk.ConsAddress,
+	deviations map[slinkytypes.CurrencyPair]types.PriceDeviation,
+) error {
+	if len(deviations) == 0 {
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.ValidatorStatsWindow == 0 {
+		return nil
+	}
+
+	height := uint64(ctx.BlockHeight()) //nolint:gosec
+
+	stats, err := k.validatorPriceStats.Get(ctx, validator)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		stats = types.NewValidatorPriceStats(validator, height, params.ValidatorStatsWindow)
+	case err != nil:
+		return err
+	case stats.BucketHeights != types.ValidatorPriceStatsBucketHeights(params.ValidatorStatsWindow),
+		len(stats.Buckets) != types.ValidatorPriceStatsBucketsLen(params.ValidatorStatsWindow):
+		// the window has changed, the buckets can no longer be interpreted
+		stats = types.NewValidatorPriceStats(validator, height, params.ValidatorStatsWindow)
+	}
+
+	stats.RecordDeviations(height, params.MaxPriceDeviationBps, deviations)
+
+	return k.validatorPriceStats.Set(ctx, validator, stats)
+}
+
+// GetValidatorPriceStats returns the miss and deviation counters of the given validator. Notice, the
+// window only slides when the validator's stats are updated, so the returned counters cover the window
+// ending at their LastHeight.
+func (k *Keeper) GetValidatorPriceStats(ctx sdk.Context, validator sdk.ConsAddress) (types.ValidatorPriceStats, error) {
+	return k.validatorPriceStats.Get(ctx, validator)
+}
+
+// GetAllValidatorPriceStats returns the miss and deviation counters of all validators.
+func (k *Keeper) GetAllValidatorPriceStats(ctx sdk.Context) ([]types.ValidatorPriceStats, error) {
+	it, err := k.validatorPriceStats.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
+
+// setValidatorPriceStats sets the miss and deviation counters of a validator.
+func (k *Keeper) setValidatorPriceStats(ctx sdk.Context, stats types.ValidatorPriceStats) error {
+	validator, err := sdk.ConsAddressFromBech32(stats.ConsAddress)
+	if err != nil {
+		return err
+	}
+
+	return k.validatorPriceStats.Set(ctx, validator, stats)
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+var (
+	btcUSD = slinkytypes.NewCurrencyPair("BTC", "USD")
+	ethUSD = slinkytypes.NewCurrencyPair("ETH", "USD")
+	solUSD = slinkytypes.NewCurrencyPair("SOL", "USD")
+
+	val1 = sdk.ConsAddress("val1")
+	val2 = sdk.ConsAddress("val2")
+)
+
+func (s *KeeperTestSuite) TestUpdateValidatorPriceStats() {
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{ValidatorStatsWindow: 100, MaxPriceDeviationBps: 50}))
+
+	deviations := map[slinkytypes.CurrencyPair]types.PriceDeviation{
+		btcUSD: {DeviationBps: 10},
+		ethUSD: {DeviationBps: 51},
+		solUSD: {Missed: true},
+	}
+
+	s.Run("counters are accumulated within a window", func() {
+		ctx := s.ctx.WithBlockHeight(101)
+		s.Require().NoError(s.oracleKeeper.UpdateValidatorPriceStats(ctx, val1, deviations))
+
+		ctx = s.ctx.WithBlockHeight(102)
+		s.Require().NoError(s.oracleKeeper.UpdateValidatorPriceStats(ctx, val1, deviations))
+
+		stats, err := s.oracleKeeper.GetValidatorPriceStats(ctx, val1)
+		s.Require().NoError(err)
+		s.Require().Equal(val1.String(), stats.ConsAddress)
+		s.Require().Equal(uint64(101), stats.StartHeight)
+		s.Require().Equal(uint64(102), stats.LastHeight)
+		s.Require().Equal(uint64(6), stats.ReportCount)
+		s.Require().Equal(uint64(2), stats.MissCount)
+		s.Require().Equal(uint64(2), stats.DeviationCount)
+		s.Require().NoError(stats.ValidateBasic())
+	})
+
+	s.Run("counters of heights that fall out of the window are evicted", func() {
+		// the window now covers heights 102 to 201
+		ctx := s.ctx.WithBlockHeight(201)
+		s.Require().NoError(s.oracleKeeper.UpdateValidatorPriceStats(ctx, val1, map[slinkytypes.CurrencyPair]types.PriceDeviation{
+			btcUSD: {Missed: true},
+		}))
+
+		stats, err := s.oracleKeeper.GetValidatorPriceStats(ctx, val1)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(4), stats.ReportCount)
+		s.Require().Equal(uint64(2), stats.MissCount)
+		s.Require().Equal(uint64(1), stats.DeviationCount)
+		s.Require().NoError(stats.ValidateBasic())
+	})
+
+	s.Run("all counters are evicted after a full window", func() {
+		ctx := s.ctx.WithBlockHeight(400)
+		s.Require().NoError(s.oracleKeeper.UpdateValidatorPriceStats(ctx, val1, map[slinkytypes.CurrencyPair]types.PriceDeviation{
+			btcUSD: {DeviationBps: 60},
+		}))
+
+		stats, err := s.oracleKeeper.GetValidatorPriceStats(ctx, val1)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(101), stats.StartHeight)
+		s.Require().Equal(uint64(1), stats.ReportCount)
+		s.Require().Equal(uint64(0), stats.MissCount)
+		s.Require().Equal(uint64(1), stats.DeviationCount)
+		s.Require().NoError(stats.ValidateBasic())
+	})
+
+	s.Run("counters are tracked from scratch if the window changes", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{ValidatorStatsWindow: 1000, MaxPriceDeviationBps: 50}))
+
+		ctx := s.ctx.WithBlockHeight(401)
+		s.Require().NoError(s.oracleKeeper.UpdateValidatorPriceStats(ctx, val1, map[slinkytypes.CurrencyPair]types.PriceDeviation{
+			btcUSD: {Missed: true},
+		}))
+
+		stats, err := s.oracleKeeper.GetValidatorPriceStats(ctx, val1)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(401), stats.StartHeight)
+		s.Require().Equal(uint64(10), stats.BucketHeights)
+		s.Require().Len(stats.Buckets, 100)
+		s.Require().Equal(uint64(1), stats.ReportCount)
+		s.Require().Equal(uint64(1), stats.MissCount)
+		s.Require().Equal(uint64(0), stats.DeviationCount)
+	})
+
+	s.Run("no stats are recorded without deviations", func() {
+		s.Require().NoError(s.oracleKeeper.UpdateValidatorPriceStats(s.ctx, val2, nil))
+
+		_, err := s.oracleKeeper.GetValidatorPriceStats(s.ctx, val2)
+		s.Require().Error(err)
+	})
+
+	s.Run("no stats are recorded if tracking is disabled", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.Params{}))
+		s.Require().NoError(s.oracleKeeper.UpdateValidatorPriceStats(s.ctx, val2, deviations))
+
+		_, err := s.oracleKeeper.GetValidatorPriceStats(s.ctx, val2)
+		s.Require().Error(err)
+	})
+
+	s.Run("all stats are returned", func() {
+		stats, err := s.oracleKeeper.GetAllValidatorPriceStats(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(stats, 1)
+		s.Require().Equal(val1.String(), stats[0].ConsAddress)
+	})
+}
+
+func (s *KeeperTestSuite) TestValidatorPriceStatsGRPC() {
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+
+	ctx := s.ctx.WithBlockHeight(1)
+	s.Require().NoError(s.oracleKeeper.UpdateValidatorPriceStats(ctx, val1, map[slinkytypes.CurrencyPair]types.PriceDeviation{
+		btcUSD: {Missed: true},
+	}))
+
+	s.Run("nil request - fail", func() {
+		_, err := qs.GetValidatorPriceStats(ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("invalid consensus address - fail", func() {
+		_, err := qs.GetValidatorPriceStats(ctx, &types.GetValidatorPriceStatsRequest{ConsAddress: "invalid"})
+		s.Require().Error(err)
+	})
+
+	s.Run("validator without stats - fail", func() {
+		_, err := qs.GetValidatorPriceStats(ctx, &types.GetValidatorPriceStatsRequest{ConsAddress: val2.String()})
+		s.Require().Error(err)
+	})
+
+	s.Run("validator with stats - pass", func() {
+		res, err := qs.GetValidatorPriceStats(ctx, &types.GetValidatorPriceStatsRequest{ConsAddress: val1.String()})
+		s.Require().NoError(err)
+		s.Require().Equal(val1.String(), res.Stats.ConsAddress)
+		s.Require().Equal(uint64(1), res.Stats.ReportCount)
+		s.Require().Equal(uint64(1), res.Stats.MissCount)
+	})
+
+	s.Run("all validators - pass", func() {
+		res, err := qs.GetAllValidatorPriceStats(ctx, &types.GetAllValidatorPriceStatsRequest{})
+		s.Require().NoError(err)
+		s.Require().Len(res.Stats, 1)
+	})
+
+	s.Run("params - pass", func() {
+		res, err := qs.GetParams(ctx, &types.GetParamsRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(types.DefaultParams(), res.Params)
+	})
+}
@@ -0,0 +1,20 @@
+package types
+
+// oracle module event types
+
+const (
+	EventTypeCircuitBreaker = "price_circuit_breaker"
+	EventTypeDecimalsChange = "price_decimals_change"
+	EventTypeMarketDisabled = "market_disabled"
+
+	AttributeKeyCurrencyPair     = "currency_pair"
+	AttributeKeyID               = "id"
+	AttributeKeyHeight           = "height"
+	AttributeKeyPrice            = "price"
+	AttributeKeyPreviousPrice    = "previous_price"
+	AttributeKeyAppliedPrice     = "applied_price"
+	AttributeKeyMode             = "mode"
+	AttributeKeyDecimals         = "decimals"
+	AttributeKeyPreviousDecimals = "previous_decimals"
+	AttributeKeyMissedHeights    = "missed_heights"
+)
@@ -11,4 +11,6 @@ import (
 //go:generate mockery --name MarketMapKeeper --output ./mocks/ --case underscore
 type MarketMapKeeper interface {
 	GetMarket(ctx sdk.Context, tickerStr string) (types.Market, error)
+	ApplyMarketUpdate(ctx sdk.Context, market types.Market) error
+	SetLastUpdated(ctx sdk.Context, height uint64) error
 }
@@ -8,8 +8,8 @@ import (
 )
 
 // ValidateBasic validates that the CurrencyPair is valid, and performs any necessary validation on the
-// genesis QuotePrice for the CurrencyPair. This fails if the CurrencyPair is invalid, or if the QuotePrice is nil,
-// but the Nonce is non-nil.
+// genesis QuotePrice for the CurrencyPair. This fails if the CurrencyPair is invalid, if the QuotePrice is nil,
+// but the Nonce is non-nil, or if the historical prices are not ordered by their nonces.
 func (cpg *CurrencyPairGenesis) ValidateBasic() error {
 	// validate the CurrencyPair
 	if err := cpg.CurrencyPair.ValidateBasic(); err != nil {
@@ -21,14 +21,26 @@ func (cpg *CurrencyPairGenesis) ValidateBasic() error {
 		return fmt.Errorf("invalid nonce, no price update but non-zero nonce: %v", cpg.Nonce)
 	}
 
+	// check that the historical prices are ordered by their nonces, and were written before the current nonce
+	for i, entry := range cpg.PriceHistory {
+		if entry.Nonce > cpg.Nonce {
+			return fmt.Errorf("invalid price history, nonce %v exceeds the nonce of the currency-pair: %v", entry.Nonce, cpg.Nonce)
+		}
+
+		if i > 0 && entry.Nonce <= cpg.PriceHistory[i-1].Nonce {
+			return fmt.Errorf("invalid price history, nonces must be strictly increasing: %v", entry.Nonce)
+		}
+	}
+
 	return nil
 }
 
-// NewGenesisState returns a new genesis-state from a set of CurrencyPairGeneses.
+// NewGenesisState returns a new genesis-state from a set of CurrencyPairGeneses, using the default params.
 func NewGenesisState(cpgs []CurrencyPairGenesis, nextID uint64) *GenesisState {
 	return &GenesisState{
 		CurrencyPairGenesis: cpgs,
 		NextId:              nextID,
+		Params:              DefaultParams(),
 	}
 }
 
@@ -38,8 +50,41 @@ func DefaultGenesisState() *GenesisState {
 }
 
 // Validate validates the currency-pair geneses that the Genesis-State is composed of
-// valid CurrencyPairGenesis, and that no ID for a currency-pair is repeated.
+// valid CurrencyPairGenesis, and that no ID for a currency-pair is repeated. It also validates the params
+// and that the price stats and oracle participation of each validator are valid and not repeated.
 func (gs *GenesisState) Validate() error {
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	validators := make(map[string]struct{})
+	for _, stats := range gs.ValidatorPriceStats {
+		if err := stats.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// check for repeated validators
+		if _, ok := validators[stats.ConsAddress]; ok {
+			return fmt.Errorf("repeated validator price stats: %v", stats.ConsAddress)
+		}
+
+		validators[stats.ConsAddress] = struct{}{}
+	}
+
+	validators = make(map[string]struct{})
+	for _, stats := range gs.ValidatorOracleStats {
+		if err := stats.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// check for repeated validators
+		if _, ok := validators[stats.ConsAddress]; ok {
+			return fmt.Errorf("repeated validator oracle stats: %v", stats.ConsAddress)
+		}
+
+		validators[stats.ConsAddress] = struct{}{}
+	}
+
 	ids := make(map[uint64]struct{})
 	cps := make(map[string]struct{})
 	for _, cpg := range gs.CurrencyPairGenesis {
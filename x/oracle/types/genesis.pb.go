@@ -89,6 +89,62 @@ func (m *QuotePrice) GetBlockHeight() uint64 {
 	return 0
 }
 
+// PriceHistoryEntry is a historical price of a CurrencyPair, along with the
+// nonce of the CurrencyPair at the time the price was written.
+type PriceHistoryEntry struct {
+	// Nonce is the nonce of the CurrencyPair when the price was written.
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Price is the historical quote-price of the CurrencyPair.
+	Price QuotePrice `protobuf:"bytes,2,opt,name=price,proto3" json:"price"`
+}
+
+func (m *PriceHistoryEntry) Reset()         { *m = PriceHistoryEntry{} }
+func (m *PriceHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*PriceHistoryEntry) ProtoMessage()    {}
+func (*PriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de36a97821ccc13b, []int{1}
+}
+func (m *PriceHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceHistoryEntry.Merge(m, src)
+}
+func (m *PriceHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceHistoryEntry proto.InternalMessageInfo
+
+func (m *PriceHistoryEntry) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *PriceHistoryEntry) GetPrice() QuotePrice {
+	if m != nil {
+		return m.Price
+	}
+	return QuotePrice{}
+}
+
 // CurrencyPairState represents the stateful information tracked by the x/oracle
 // module per-currency-pair.
 type CurrencyPairState struct {
@@ -99,13 +155,28 @@ type CurrencyPairState struct {
 	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// ID is the ID of the CurrencyPair
 	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// Halted is true if the latest price update of the currency-pair tripped the
+	// circuit breaker, i.e. it was clamped or rejected.
+	Halted bool `protobuf:"varint,4,opt,name=halted,proto3" json:"halted,omitempty"`
+	// Decimals is the number of decimals of the market of the currency-pair in
+	// x/marketmap, which its QuotePrice is quoted in. Zero if it is unknown.
+	Decimals uint64 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// MissedHeights is the number of consecutive heights the currency-pair did
+	// not receive a price for.
+	MissedHeights uint64 `protobuf:"varint,6,opt,name=missed_heights,json=missedHeights,proto3" json:"missed_heights,omitempty"`
+	// RejectedPrice is the latest price update of the currency-pair that was
+	// rejected by the circuit breaker, if its latest price update was rejected.
+	RejectedPrice *QuotePrice `protobuf:"bytes,7,opt,name=rejected_price,json=rejectedPrice,proto3" json:"rejected_price,omitempty"`
+	// RejectedCount is the number of consecutive rejected price updates of the
+	// currency-pair, each within the maximum price change of the previous one.
+	RejectedCount uint64 `protobuf:"varint,8,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
 }
 
 func (m *CurrencyPairState) Reset()         { *m = CurrencyPairState{} }
 func (m *CurrencyPairState) String() string { return proto.CompactTextString(m) }
 func (*CurrencyPairState) ProtoMessage()    {}
 func (*CurrencyPairState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de36a97821ccc13b, []int{1}
+	return fileDescriptor_de36a97821ccc13b, []int{2}
 }
 func (m *CurrencyPairState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,6 +226,341 @@ func (m *CurrencyPairState) GetId() uint64 {
 	return 0
 }
 
+func (m *CurrencyPairState) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+func (m *CurrencyPairState) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *CurrencyPairState) GetMissedHeights() uint64 {
+	if m != nil {
+		return m.MissedHeights
+	}
+	return 0
+}
+
+func (m *CurrencyPairState) GetRejectedPrice() *QuotePrice {
+	if m != nil {
+		return m.RejectedPrice
+	}
+	return nil
+}
+
+func (m *CurrencyPairState) GetRejectedCount() uint64 {
+	if m != nil {
+		return m.RejectedCount
+	}
+	return 0
+}
+
+// ValidatorPriceStats tracks how often a validator did not report a price, or
+// reported a price that deviated too far from the aggregated price, for the
+// currency pairs that received an aggregated price within the most recent
+// heights of the validator stats window. The window slides in buckets of
+// BucketHeights heights, so the oldest bucket is evicted from the window as a
+// whole.
+type ValidatorPriceStats struct {
+	// ConsAddress is the bech32 consensus address of the validator.
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// StartHeight is the height at which the stats of the validator started
+	// being tracked.
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// ReportCount is the number of aggregated prices the validator was measured
+	// against within the window.
+	ReportCount uint64 `protobuf:"varint,3,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	// MissCount is the number of aggregated prices the validator did not report
+	// a price for within the window.
+	MissCount uint64 `protobuf:"varint,4,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
+	// DeviationCount is the number of aggregated prices the validator reported a
+	// price for that deviated more than the maximum price deviation within the
+	// window.
+	DeviationCount uint64 `protobuf:"varint,5,opt,name=deviation_count,json=deviationCount,proto3" json:"deviation_count,omitempty"`
+	// LastHeight is the most recent height at which the stats were updated.
+	LastHeight uint64 `protobuf:"varint,6,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// BucketHeights is the number of consecutive heights whose counters are
+	// accumulated in each bucket.
+	BucketHeights uint64 `protobuf:"varint,7,opt,name=bucket_heights,json=bucketHeights,proto3" json:"bucket_heights,omitempty"`
+	// Buckets is a ring buffer of the counters of each bucket of heights within
+	// the window, indexed by the height divided by BucketHeights.
+	Buckets []ValidatorPriceStatsBucket `protobuf:"bytes,8,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *ValidatorPriceStats) Reset()         { *m = ValidatorPriceStats{} }
+func (m *ValidatorPriceStats) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPriceStats) ProtoMessage()    {}
+func (*ValidatorPriceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de36a97821ccc13b, []int{3}
+}
+func (m *ValidatorPriceStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPriceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPriceStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPriceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPriceStats.Merge(m, src)
+}
+func (m *ValidatorPriceStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPriceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPriceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPriceStats proto.InternalMessageInfo
+
+func (m *ValidatorPriceStats) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *ValidatorPriceStats) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ValidatorPriceStats) GetReportCount() uint64 {
+	if m != nil {
+		return m.ReportCount
+	}
+	return 0
+}
+
+func (m *ValidatorPriceStats) GetMissCount() uint64 {
+	if m != nil {
+		return m.MissCount
+	}
+	return 0
+}
+
+func (m *ValidatorPriceStats) GetDeviationCount() uint64 {
+	if m != nil {
+		return m.DeviationCount
+	}
+	return 0
+}
+
+func (m *ValidatorPriceStats) GetLastHeight() uint64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+func (m *ValidatorPriceStats) GetBucketHeights() uint64 {
+	if m != nil {
+		return m.BucketHeights
+	}
+	return 0
+}
+
+func (m *ValidatorPriceStats) GetBuckets() []ValidatorPriceStatsBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// ValidatorPriceStatsBucket holds the miss and deviation counters of a
+// validator for a bucket of consecutive heights.
+type ValidatorPriceStatsBucket struct {
+	// ReportCount is the number of aggregated prices the validator was measured
+	// against within the bucket.
+	ReportCount uint64 `protobuf:"varint,1,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	// MissCount is the number of aggregated prices the validator did not report
+	// a price for within the bucket.
+	MissCount uint64 `protobuf:"varint,2,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
+	// DeviationCount is the number of aggregated prices the validator reported a
+	// price for that deviated more than the maximum price deviation within the
+	// bucket.
+	DeviationCount uint64 `protobuf:"varint,3,opt,name=deviation_count,json=deviationCount,proto3" json:"deviation_count,omitempty"`
+}
+
+func (m *ValidatorPriceStatsBucket) Reset()         { *m = ValidatorPriceStatsBucket{} }
+func (m *ValidatorPriceStatsBucket) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPriceStatsBucket) ProtoMessage()    {}
+func (*ValidatorPriceStatsBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de36a97821ccc13b, []int{4}
+}
+func (m *ValidatorPriceStatsBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPriceStatsBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPriceStatsBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPriceStatsBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPriceStatsBucket.Merge(m, src)
+}
+func (m *ValidatorPriceStatsBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPriceStatsBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPriceStatsBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPriceStatsBucket proto.InternalMessageInfo
+
+func (m *ValidatorPriceStatsBucket) GetReportCount() uint64 {
+	if m != nil {
+		return m.ReportCount
+	}
+	return 0
+}
+
+func (m *ValidatorPriceStatsBucket) GetMissCount() uint64 {
+	if m != nil {
+		return m.MissCount
+	}
+	return 0
+}
+
+func (m *ValidatorPriceStatsBucket) GetDeviationCount() uint64 {
+	if m != nil {
+		return m.DeviationCount
+	}
+	return 0
+}
+
+// ValidatorOracleStats tracks whether a validator included a valid oracle vote
+// extension at each of the most recent heights within the participation
+// window.
+type ValidatorOracleStats struct {
+	// ConsAddress is the bech32 consensus address of the validator.
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// StartHeight is the height at which the participation of the validator
+	// started being tracked.
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// LastHeight is the most recent height at which the participation of the
+	// validator was recorded.
+	LastHeight uint64 `protobuf:"varint,3,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// IndexOffset is the number of heights the participation of the validator
+	// has been recorded for. The participation at the most recent height is
+	// stored at bit (IndexOffset - 1) % window of the bitmap.
+	IndexOffset uint64 `protobuf:"varint,4,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// ParticipationBitmap is a ring buffer with a bit per height in the window,
+	// which is set if the validator included a valid oracle vote extension at
+	// that height.
+	ParticipationBitmap []byte `protobuf:"bytes,5,opt,name=participation_bitmap,json=participationBitmap,proto3" json:"participation_bitmap,omitempty"`
+	// ParticipatedCount is the number of heights within the window at which the
+	// validator included a valid oracle vote extension.
+	ParticipatedCount uint64 `protobuf:"varint,6,opt,name=participated_count,json=participatedCount,proto3" json:"participated_count,omitempty"`
+	// MissedCount is the number of heights within the window at which the
+	// validator did not include a valid oracle vote extension.
+	MissedCount uint64 `protobuf:"varint,7,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty"`
+}
+
+func (m *ValidatorOracleStats) Reset()         { *m = ValidatorOracleStats{} }
+func (m *ValidatorOracleStats) String() string { return proto.CompactTextString(m) }
+func (*ValidatorOracleStats) ProtoMessage()    {}
+func (*ValidatorOracleStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de36a97821ccc13b, []int{5}
+}
+func (m *ValidatorOracleStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorOracleStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorOracleStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorOracleStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorOracleStats.Merge(m, src)
+}
+func (m *ValidatorOracleStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorOracleStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorOracleStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorOracleStats proto.InternalMessageInfo
+
+func (m *ValidatorOracleStats) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *ValidatorOracleStats) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ValidatorOracleStats) GetLastHeight() uint64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+func (m *ValidatorOracleStats) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ValidatorOracleStats) GetParticipationBitmap() []byte {
+	if m != nil {
+		return m.ParticipationBitmap
+	}
+	return nil
+}
+
+func (m *ValidatorOracleStats) GetParticipatedCount() uint64 {
+	if m != nil {
+		return m.ParticipatedCount
+	}
+	return 0
+}
+
+func (m *ValidatorOracleStats) GetMissedCount() uint64 {
+	if m != nil {
+		return m.MissedCount
+	}
+	return 0
+}
+
 // CurrencyPairGenesis is the information necessary for initialization of a
 // CurrencyPair.
 type CurrencyPairGenesis struct {
@@ -168,13 +574,31 @@ type CurrencyPairGenesis struct {
 	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// id is the ID of the CurrencyPair
 	Id uint64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// price_history is the set of most recent prices of the CurrencyPair, in
+	// ascending order of their nonces
+	PriceHistory []PriceHistoryEntry `protobuf:"bytes,5,rep,name=price_history,json=priceHistory,proto3" json:"price_history"`
+	// halted is true if the latest price update of the CurrencyPair tripped the
+	// circuit breaker
+	Halted bool `protobuf:"varint,6,opt,name=halted,proto3" json:"halted,omitempty"`
+	// decimals is the number of decimals of the market of the CurrencyPair. If
+	// zero, it is set to the decimals of the market in the x/marketmap genesis.
+	Decimals uint64 `protobuf:"varint,7,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// missed_heights is the number of consecutive heights the CurrencyPair did
+	// not receive a price for
+	MissedHeights uint64 `protobuf:"varint,8,opt,name=missed_heights,json=missedHeights,proto3" json:"missed_heights,omitempty"`
+	// rejected_price is the latest price update of the CurrencyPair that was
+	// rejected by the circuit breaker, if its latest price update was rejected
+	RejectedPrice *QuotePrice `protobuf:"bytes,9,opt,name=rejected_price,json=rejectedPrice,proto3" json:"rejected_price,omitempty"`
+	// rejected_count is the number of consecutive rejected price updates of the
+	// CurrencyPair, each within the maximum price change of the previous one
+	RejectedCount uint64 `protobuf:"varint,10,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
 }
 
 func (m *CurrencyPairGenesis) Reset()         { *m = CurrencyPairGenesis{} }
 func (m *CurrencyPairGenesis) String() string { return proto.CompactTextString(m) }
 func (*CurrencyPairGenesis) ProtoMessage()    {}
 func (*CurrencyPairGenesis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de36a97821ccc13b, []int{2}
+	return fileDescriptor_de36a97821ccc13b, []int{6}
 }
 func (m *CurrencyPairGenesis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,6 +655,48 @@ func (m *CurrencyPairGenesis) GetId() uint64 {
 	return 0
 }
 
+func (m *CurrencyPairGenesis) GetPriceHistory() []PriceHistoryEntry {
+	if m != nil {
+		return m.PriceHistory
+	}
+	return nil
+}
+
+func (m *CurrencyPairGenesis) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+func (m *CurrencyPairGenesis) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *CurrencyPairGenesis) GetMissedHeights() uint64 {
+	if m != nil {
+		return m.MissedHeights
+	}
+	return 0
+}
+
+func (m *CurrencyPairGenesis) GetRejectedPrice() *QuotePrice {
+	if m != nil {
+		return m.RejectedPrice
+	}
+	return nil
+}
+
+func (m *CurrencyPairGenesis) GetRejectedCount() uint64 {
+	if m != nil {
+		return m.RejectedCount
+	}
+	return 0
+}
+
 // GenesisState is the genesis-state for the x/oracle module, it takes a set of
 // predefined CurrencyPairGeneses
 type GenesisState struct {
@@ -240,13 +706,21 @@ type GenesisState struct {
 	CurrencyPairGenesis []CurrencyPairGenesis `protobuf:"bytes,1,rep,name=currency_pair_genesis,json=currencyPairGenesis,proto3" json:"currency_pair_genesis"`
 	// NextID is the next ID to be used for a CurrencyPair
 	NextId uint64 `protobuf:"varint,2,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	// Params are the parameters for the x/oracle module.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// ValidatorPriceStats are the miss and deviation counters of each validator
+	// in the current window.
+	ValidatorPriceStats []ValidatorPriceStats `protobuf:"bytes,4,rep,name=validator_price_stats,json=validatorPriceStats,proto3" json:"validator_price_stats"`
+	// ValidatorOracleStats is the oracle participation of each validator within
+	// the participation window.
+	ValidatorOracleStats []ValidatorOracleStats `protobuf:"bytes,5,rep,name=validator_oracle_stats,json=validatorOracleStats,proto3" json:"validator_oracle_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de36a97821ccc13b, []int{3}
+	return fileDescriptor_de36a97821ccc13b, []int{7}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,9 +763,34 @@ func (m *GenesisState) GetNextId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetValidatorPriceStats() []ValidatorPriceStats {
+	if m != nil {
+		return m.ValidatorPriceStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorOracleStats() []ValidatorOracleStats {
+	if m != nil {
+		return m.ValidatorOracleStats
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuotePrice)(nil), "slinky.oracle.v1.QuotePrice")
+	proto.RegisterType((*PriceHistoryEntry)(nil), "slinky.oracle.v1.PriceHistoryEntry")
 	proto.RegisterType((*CurrencyPairState)(nil), "slinky.oracle.v1.CurrencyPairState")
+	proto.RegisterType((*ValidatorPriceStats)(nil), "slinky.oracle.v1.ValidatorPriceStats")
+	proto.RegisterType((*ValidatorPriceStatsBucket)(nil), "slinky.oracle.v1.ValidatorPriceStatsBucket")
+	proto.RegisterType((*ValidatorOracleStats)(nil), "slinky.oracle.v1.ValidatorOracleStats")
 	proto.RegisterType((*CurrencyPairGenesis)(nil), "slinky.oracle.v1.CurrencyPairGenesis")
 	proto.RegisterType((*GenesisState)(nil), "slinky.oracle.v1.GenesisState")
 }
@@ -299,39 +798,70 @@ func init() {
 func init() { proto.RegisterFile("slinky/oracle/v1/genesis.proto", fileDescriptor_de36a97821ccc13b) }
 
 var fileDescriptor_de36a97821ccc13b = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8f, 0x12, 0x3d,
-	0x1c, 0xc7, 0x29, 0xb0, 0xfb, 0x3c, 0x16, 0x5c, 0xdd, 0x61, 0x37, 0x22, 0xd1, 0x01, 0x31, 0x26,
-	0x24, 0x86, 0x36, 0x8b, 0x17, 0xaf, 0xe2, 0x61, 0x97, 0x83, 0x09, 0x8e, 0x9e, 0xbc, 0x4c, 0x86,
-	0x52, 0x87, 0x06, 0xa6, 0x9d, 0x4c, 0x0b, 0x59, 0xde, 0x81, 0xc7, 0x7d, 0x31, 0xbe, 0x01, 0x6f,
-	0x1c, 0x37, 0x9e, 0xd4, 0x03, 0x1a, 0x78, 0x23, 0x66, 0xda, 0x0e, 0x0e, 0xee, 0x1e, 0xbc, 0xcd,
-	0xaf, 0xbf, 0x7f, 0xdf, 0xcf, 0xb7, 0x1d, 0xe8, 0xca, 0x19, 0xe3, 0xd3, 0x25, 0x16, 0x49, 0x40,
-	0x66, 0x14, 0x2f, 0xce, 0x70, 0x48, 0x39, 0x95, 0x4c, 0xa2, 0x38, 0x11, 0x4a, 0x38, 0xf7, 0x4d,
-	0x1e, 0x99, 0x3c, 0x5a, 0x9c, 0x35, 0x4e, 0x42, 0x11, 0x0a, 0x9d, 0xc4, 0xe9, 0x97, 0xa9, 0x6b,
-	0x34, 0x43, 0x21, 0xc2, 0x19, 0xc5, 0x3a, 0x1a, 0xcd, 0x3f, 0x62, 0xc5, 0x22, 0x2a, 0x55, 0x10,
-	0xc5, 0xb6, 0xe0, 0x21, 0x11, 0x32, 0x12, 0xd2, 0x37, 0x9d, 0x26, 0xb0, 0xa9, 0xa7, 0x56, 0x83,
-	0x5a, 0xc6, 0x54, 0xa6, 0x12, 0xc8, 0x3c, 0x49, 0x28, 0x27, 0x4b, 0x3f, 0x0e, 0x58, 0x62, 0x8a,
-	0xda, 0x5f, 0x00, 0x84, 0x6f, 0xe7, 0x42, 0xd1, 0x61, 0xc2, 0x08, 0x75, 0x5e, 0xc1, 0x83, 0x38,
-	0xfd, 0xa8, 0x83, 0x16, 0xe8, 0xdc, 0xe9, 0x3f, 0x5f, 0xad, 0x9b, 0x85, 0x1f, 0xeb, 0xe6, 0xa9,
-	0x19, 0x2c, 0xc7, 0x53, 0xc4, 0x04, 0x8e, 0x02, 0x35, 0x41, 0x03, 0xae, 0xbe, 0x7e, 0xee, 0x42,
-	0xbb, 0x71, 0xc0, 0x95, 0x67, 0x3a, 0x9d, 0x37, 0xf0, 0xde, 0x68, 0x26, 0xc8, 0xd4, 0xdf, 0x49,
-	0xad, 0x17, 0x5b, 0xa0, 0x53, 0xe9, 0x35, 0x90, 0x81, 0x41, 0x19, 0x0c, 0x7a, 0x9f, 0x55, 0xf4,
-	0xff, 0x4f, 0x17, 0x5d, 0xfd, 0x6c, 0x02, 0xef, 0x48, 0x37, 0xef, 0x32, 0xce, 0x13, 0x58, 0x35,
-	0xe3, 0x26, 0x94, 0x85, 0x13, 0x55, 0x2f, 0xb5, 0x40, 0xa7, 0xec, 0x55, 0xf4, 0xd9, 0x85, 0x3e,
-	0x6a, 0x4b, 0x78, 0xfc, 0xda, 0xa2, 0x0d, 0x03, 0x96, 0xbc, 0x53, 0x81, 0xa2, 0xce, 0xcb, 0x3c,
-	0x49, 0xa5, 0xf7, 0x08, 0xfd, 0xed, 0x38, 0xfa, 0x83, 0xdd, 0x2f, 0xaf, 0xd6, 0x4d, 0x90, 0x01,
-	0x9c, 0xc0, 0x03, 0x2e, 0x38, 0xa1, 0x5a, 0x76, 0xd9, 0x33, 0x81, 0x73, 0x04, 0x8b, 0x6c, 0x6c,
-	0xb7, 0x17, 0xd9, 0xb8, 0xfd, 0x1d, 0xc0, 0x5a, 0x7e, 0xeb, 0xb9, 0xb9, 0x5f, 0xe7, 0x02, 0xde,
-	0xdd, 0xf3, 0xd9, 0xee, 0x7f, 0x9c, 0xed, 0xd7, 0xb7, 0x91, 0xae, 0xcf, 0x37, 0x6b, 0x01, 0x05,
-	0xaf, 0x4a, 0x72, 0x67, 0x8e, 0x07, 0x6b, 0x7b, 0x93, 0x7c, 0xc3, 0x53, 0xfc, 0x67, 0x9e, 0xe3,
-	0xfc, 0xb8, 0xe1, 0x3e, 0x5b, 0xe9, 0x26, 0x5b, 0x79, 0xc7, 0xf6, 0x09, 0xc0, 0xaa, 0xe5, 0x31,
-	0x66, 0xfa, 0xf0, 0x74, 0x5f, 0x8a, 0x7d, 0xcd, 0x75, 0xd0, 0x2a, 0x75, 0x2a, 0xbd, 0x67, 0x37,
-	0xc5, 0xdc, 0x62, 0x8d, 0x85, 0xac, 0x91, 0x5b, 0x5c, 0x7b, 0x00, 0xff, 0xe3, 0xf4, 0x52, 0xf9,
-	0x6c, 0x6c, 0x5d, 0x3f, 0x4c, 0xc3, 0xc1, 0xb8, 0x7f, 0xbe, 0xda, 0xb8, 0xe0, 0x7a, 0xe3, 0x82,
-	0x5f, 0x1b, 0x17, 0x5c, 0x6d, 0xdd, 0xc2, 0xf5, 0xd6, 0x2d, 0x7c, 0xdb, 0xba, 0x85, 0x0f, 0xdd,
-	0x90, 0xa9, 0xc9, 0x7c, 0x84, 0x88, 0x88, 0xb0, 0x9c, 0xb2, 0xb8, 0x1b, 0xd1, 0x05, 0x26, 0x82,
-	0x73, 0x4a, 0x14, 0x5e, 0xf4, 0xf0, 0x65, 0xf6, 0xf3, 0x69, 0xc7, 0x47, 0x87, 0xfa, 0xd5, 0xbd,
-	0xf8, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x53, 0x7a, 0x86, 0xff, 0x9a, 0x03, 0x00, 0x00,
+	// 996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x93, 0x34, 0x4d, 0x5f, 0xd2, 0x2c, 0x9d, 0x76, 0x17, 0x6f, 0x45, 0x93, 0x34, 0xab,
+	0x42, 0xa4, 0x55, 0x6d, 0xb5, 0x48, 0x88, 0xeb, 0x66, 0x85, 0xb6, 0x15, 0x82, 0x2d, 0x01, 0x71,
+	0xe0, 0x62, 0x39, 0xf6, 0x34, 0x19, 0x12, 0x7b, 0x2c, 0xcf, 0x24, 0x6a, 0x3e, 0x00, 0x9c, 0xf7,
+	0x4b, 0xf0, 0x0d, 0xf8, 0x00, 0x70, 0xdb, 0xe3, 0x8a, 0x13, 0x02, 0x69, 0x41, 0xed, 0x77, 0xe0,
+	0x8c, 0x3c, 0x6f, 0xec, 0x3a, 0xeb, 0x94, 0x2d, 0xd2, 0xde, 0x32, 0xbf, 0xf7, 0xe6, 0xfd, 0xf9,
+	0xbd, 0x37, 0xbf, 0x18, 0x5a, 0x62, 0xca, 0xc2, 0xc9, 0xc2, 0xe6, 0xb1, 0xeb, 0x4d, 0xa9, 0x3d,
+	0x3f, 0xb6, 0x47, 0x34, 0xa4, 0x82, 0x09, 0x2b, 0x8a, 0xb9, 0xe4, 0xe4, 0x3d, 0xb4, 0x5b, 0x68,
+	0xb7, 0xe6, 0xc7, 0x7b, 0xbb, 0x23, 0x3e, 0xe2, 0xca, 0x68, 0x27, 0xbf, 0xd0, 0x6f, 0xaf, 0x3d,
+	0xe2, 0x7c, 0x34, 0xa5, 0xb6, 0x3a, 0x0d, 0x67, 0x17, 0xb6, 0x64, 0x01, 0x15, 0xd2, 0x0d, 0x22,
+	0xed, 0xf0, 0xd0, 0xe3, 0x22, 0xe0, 0xc2, 0xc1, 0x9b, 0x78, 0xd0, 0xa6, 0x47, 0xba, 0x06, 0xb9,
+	0x88, 0xa8, 0x48, 0x4a, 0xf0, 0x66, 0x71, 0x4c, 0x43, 0x6f, 0xe1, 0x44, 0x2e, 0x8b, 0xb5, 0xd3,
+	0x7e, 0xa1, 0xd0, 0xc8, 0x8d, 0xdd, 0x40, 0xc7, 0xe8, 0xfe, 0x6a, 0x00, 0x7c, 0x35, 0xe3, 0x92,
+	0x9e, 0xc7, 0xcc, 0xa3, 0xe4, 0x09, 0xac, 0x47, 0xc9, 0x0f, 0xd3, 0xe8, 0x18, 0xbd, 0xcd, 0xfe,
+	0xe3, 0x97, 0xaf, 0xdb, 0x6b, 0x7f, 0xbc, 0x6e, 0xdf, 0xc7, 0xbc, 0xc2, 0x9f, 0x58, 0x8c, 0xdb,
+	0x81, 0x2b, 0xc7, 0xd6, 0x59, 0x28, 0x7f, 0xfb, 0xf9, 0x08, 0x74, 0x41, 0x67, 0xa1, 0x1c, 0xe0,
+	0x4d, 0xf2, 0x05, 0xdc, 0x1b, 0x4e, 0xb9, 0x37, 0x71, 0xb2, 0x4e, 0xcc, 0x52, 0xc7, 0xe8, 0xd5,
+	0x4f, 0xf6, 0x2c, 0xec, 0xd5, 0x4a, 0x7b, 0xb5, 0xbe, 0x49, 0x3d, 0xfa, 0xb5, 0x24, 0xd1, 0x8b,
+	0xbf, 0xda, 0xc6, 0xa0, 0xa9, 0x2e, 0x67, 0x16, 0x72, 0x00, 0x0d, 0x0c, 0x37, 0xa6, 0x6c, 0x34,
+	0x96, 0x66, 0xb9, 0x63, 0xf4, 0x2a, 0x83, 0xba, 0xc2, 0x4e, 0x15, 0xd4, 0xf5, 0x60, 0x5b, 0x55,
+	0x7f, 0xca, 0x84, 0xe4, 0xf1, 0xe2, 0xb3, 0x50, 0xc6, 0x0b, 0xb2, 0x0b, 0xeb, 0x21, 0x0f, 0x75,
+	0x27, 0x95, 0x01, 0x1e, 0xc8, 0xa7, 0x69, 0x7f, 0x58, 0xd2, 0x07, 0xd6, 0x9b, 0x63, 0xb2, 0x6e,
+	0xc8, 0xe8, 0x57, 0x92, 0xa2, 0x74, 0x5b, 0xdd, 0x5f, 0x4a, 0xb0, 0xfd, 0x54, 0xf3, 0x7b, 0xee,
+	0xb2, 0xf8, 0x6b, 0xe9, 0xca, 0x5c, 0x3c, 0xe3, 0x8e, 0xf1, 0x8c, 0x94, 0xa6, 0xac, 0xbe, 0x52,
+	0xbe, 0xbe, 0x26, 0x94, 0x98, 0xaf, 0x7b, 0x2c, 0x31, 0x9f, 0x3c, 0x80, 0xea, 0xd8, 0x9d, 0x4a,
+	0xea, 0x9b, 0x95, 0x8e, 0xd1, 0xab, 0x0d, 0xf4, 0x89, 0xec, 0x41, 0xcd, 0xa7, 0x1e, 0x0b, 0xdc,
+	0xa9, 0x30, 0xd7, 0x95, 0x77, 0x76, 0x26, 0x87, 0xd0, 0x0c, 0x98, 0x10, 0xd4, 0xd7, 0x94, 0x09,
+	0xb3, 0xaa, 0x3c, 0xb6, 0x10, 0x45, 0xd2, 0x04, 0x39, 0x83, 0x66, 0x4c, 0xbf, 0xa7, 0x9e, 0xa4,
+	0xbe, 0x83, 0x3d, 0x6c, 0xdc, 0xb9, 0x87, 0xad, 0xf4, 0x26, 0x6e, 0xcd, 0x61, 0x2e, 0x94, 0xc7,
+	0x67, 0xa1, 0x34, 0x6b, 0x98, 0x31, 0x45, 0x9f, 0x26, 0x60, 0xf7, 0xcf, 0x12, 0xec, 0x7c, 0xeb,
+	0x4e, 0x99, 0xef, 0x4a, 0x1e, 0xab, 0x9b, 0x09, 0x89, 0x22, 0x19, 0xb1, 0xc7, 0x43, 0xe1, 0xb8,
+	0xbe, 0x1f, 0x53, 0x21, 0x70, 0xf7, 0x06, 0xf5, 0x04, 0x7b, 0x82, 0x50, 0xe2, 0x22, 0xa4, 0x1b,
+	0xcb, 0x74, 0x0b, 0x90, 0xb4, 0xba, 0xc2, 0xb0, 0xa1, 0xc4, 0x25, 0xa6, 0x11, 0x8f, 0xa5, 0x2e,
+	0x41, 0x2f, 0x0a, 0x62, 0xaa, 0x00, 0xb2, 0x0f, 0x90, 0x70, 0xa0, 0x1d, 0x2a, 0xca, 0x61, 0x33,
+	0x41, 0xd0, 0xfc, 0x11, 0xdc, 0xf3, 0xe9, 0x9c, 0xb9, 0x92, 0xf1, 0x50, 0xfb, 0x20, 0xb7, 0xcd,
+	0x0c, 0x46, 0xc7, 0x36, 0xd4, 0xa7, 0xae, 0xc8, 0x8a, 0x41, 0x7a, 0x21, 0x81, 0x74, 0x2d, 0x87,
+	0xd0, 0x1c, 0xce, 0xbc, 0x09, 0x95, 0xd9, 0x08, 0x36, 0x90, 0x10, 0x44, 0xd3, 0x11, 0x7c, 0x0e,
+	0x1b, 0x08, 0x08, 0xb3, 0xd6, 0x29, 0xf7, 0xea, 0x27, 0x8f, 0x8b, 0xdc, 0xaf, 0x20, 0xac, 0xaf,
+	0xee, 0xe8, 0xf5, 0x4c, 0x23, 0x74, 0x7f, 0x34, 0xe0, 0xe1, 0xad, 0xce, 0x05, 0x76, 0x8c, 0xb7,
+	0xb1, 0x53, 0xba, 0x03, 0x3b, 0xe5, 0x55, 0xec, 0x74, 0x7f, 0x2a, 0xc1, 0x6e, 0x56, 0xc8, 0x73,
+	0xd5, 0xc8, 0xbb, 0x9c, 0xf3, 0x1b, 0xe4, 0x97, 0x0b, 0xe4, 0x1f, 0x40, 0x83, 0x85, 0x3e, 0xbd,
+	0x74, 0xf8, 0xc5, 0x85, 0xa0, 0xe9, 0x9c, 0xeb, 0x0a, 0x7b, 0xae, 0x20, 0x72, 0x0c, 0xbb, 0x91,
+	0x1b, 0x4b, 0xe6, 0xb1, 0x08, 0xfb, 0x19, 0x32, 0x19, 0xb8, 0x91, 0x1a, 0x77, 0x63, 0xb0, 0xb3,
+	0x64, 0xeb, 0x2b, 0x13, 0x39, 0x02, 0x72, 0x03, 0x67, 0x7b, 0x8e, 0xa3, 0xdf, 0xce, 0x5b, 0x90,
+	0xad, 0x03, 0x68, 0xe8, 0x47, 0x88, 0x8e, 0x38, 0xff, 0x3a, 0x62, 0xc8, 0xd3, 0x3f, 0x65, 0xd8,
+	0xc9, 0x2b, 0xca, 0x33, 0xfc, 0x03, 0x21, 0xa7, 0xb0, 0xb5, 0x24, 0xe4, 0x5a, 0x5b, 0xf6, 0xd3,
+	0xdd, 0x50, 0x72, 0x9f, 0xac, 0x46, 0xfe, 0xb2, 0xde, 0x86, 0x86, 0x97, 0xc3, 0xc8, 0x00, 0x76,
+	0x96, 0x22, 0x39, 0xff, 0x4f, 0xfb, 0x8c, 0xc1, 0x76, 0x3e, 0xdc, 0xf9, 0xb2, 0x6e, 0x95, 0x8b,
+	0xba, 0x55, 0xc9, 0x74, 0xeb, 0x4b, 0xd8, 0x52, 0xb9, 0x9c, 0x31, 0x6a, 0xb2, 0xb9, 0xae, 0xf6,
+	0xfb, 0x51, 0x31, 0x67, 0x41, 0xb9, 0xd3, 0x4e, 0xa2, 0x9c, 0x21, 0xa7, 0x83, 0xd5, 0x5b, 0x75,
+	0x70, 0xe3, 0xad, 0x3a, 0x58, 0xbb, 0x9b, 0x0e, 0x6e, 0xbe, 0x3b, 0x1d, 0x84, 0x55, 0x3a, 0xf8,
+	0x43, 0x19, 0x1a, 0x7a, 0xd8, 0xf8, 0x2f, 0xe2, 0xc0, 0xfd, 0xe5, 0x39, 0xe9, 0x6f, 0x09, 0xd3,
+	0x50, 0xac, 0x1d, 0x16, 0x2b, 0x59, 0xb1, 0x37, 0x9a, 0xb7, 0x1d, 0xaf, 0x68, 0x22, 0xef, 0xc3,
+	0x46, 0x48, 0x2f, 0xa5, 0xc3, 0x7c, 0xfd, 0xa2, 0xaa, 0xc9, 0xf1, 0xcc, 0x27, 0x9f, 0x40, 0x15,
+	0x3f, 0x07, 0xd4, 0x38, 0xeb, 0x27, 0xe6, 0x8a, 0x01, 0x29, 0xbb, 0x8e, 0xae, 0xbd, 0x93, 0x8a,
+	0xe7, 0xe9, 0x13, 0x47, 0xd6, 0x1c, 0x91, 0xbc, 0x71, 0xb3, 0x72, 0x5b, 0xc5, 0xab, 0xa4, 0x49,
+	0x57, 0x3c, 0x2f, 0x9a, 0xc8, 0x10, 0x1e, 0xdc, 0x24, 0xc0, 0x28, 0x3a, 0x03, 0x6e, 0xd2, 0x87,
+	0xff, 0x91, 0x21, 0xa7, 0x39, 0x3a, 0xc5, 0xee, 0x7c, 0x95, 0xed, 0xd9, 0xcb, 0xab, 0x96, 0xf1,
+	0xea, 0xaa, 0x65, 0xfc, 0x7d, 0xd5, 0x32, 0x5e, 0x5c, 0xb7, 0xd6, 0x5e, 0x5d, 0xb7, 0xd6, 0x7e,
+	0xbf, 0x6e, 0xad, 0x7d, 0x77, 0x34, 0x62, 0x72, 0x3c, 0x1b, 0x5a, 0x1e, 0x0f, 0x6c, 0x31, 0x61,
+	0xd1, 0x51, 0x40, 0xe7, 0xb6, 0xc7, 0xc3, 0x90, 0x7a, 0xd2, 0x9e, 0x9f, 0xd8, 0x97, 0xe9, 0xe7,
+	0x94, 0x7a, 0x8b, 0xc3, 0xaa, 0xfa, 0xa2, 0xf9, 0xf8, 0xdf, 0x01, 0x00, 0xf6, 0x7e, 0xa3, 0x9a,
+	0x15, 0x0a, 0x00, 0x00,
 }
 
 func (m *QuotePrice) Marshal() (dAtA []byte, err error) {
@@ -380,6 +910,44 @@ func (m *QuotePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CurrencyPairState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -400,6 +968,43 @@ func (m *CurrencyPairState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RejectedCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RejectedCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RejectedPrice != nil {
+		{
+			size, err := m.RejectedPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MissedHeights != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MissedHeights))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Id != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
 		i--
@@ -425,6 +1030,180 @@ func (m *CurrencyPairState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorPriceStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPriceStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPriceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.BucketHeights != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BucketHeights))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DeviationCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DeviationCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MissCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReportCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ReportCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPriceStatsBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPriceStatsBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPriceStatsBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeviationCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DeviationCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MissCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MissCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReportCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ReportCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorOracleStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOracleStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOracleStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MissedCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ParticipatedCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ParticipatedCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ParticipationBitmap) > 0 {
+		i -= len(m.ParticipationBitmap)
+		copy(dAtA[i:], m.ParticipationBitmap)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ParticipationBitmap)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CurrencyPairGenesis) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -445,6 +1224,57 @@ func (m *CurrencyPairGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RejectedCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RejectedCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RejectedPrice != nil {
+		{
+			size, err := m.RejectedPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MissedHeights != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MissedHeights))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PriceHistory) > 0 {
+		for iNdEx := len(m.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Id != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
 		i--
@@ -500,6 +1330,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorOracleStats) > 0 {
+		for iNdEx := len(m.ValidatorOracleStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorOracleStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ValidatorPriceStats) > 0 {
+		for iNdEx := len(m.ValidatorPriceStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPriceStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.NextId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextId))
 		i--
@@ -549,35 +1417,28 @@ func (m *QuotePrice) Size() (n int) {
 	return n
 }
 
-func (m *CurrencyPairState) Size() (n int) {
+func (m *PriceHistoryEntry) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Price != nil {
-		l = m.Price.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
 	if m.Nonce != 0 {
 		n += 1 + sovGenesis(uint64(m.Nonce))
 	}
-	if m.Id != 0 {
-		n += 1 + sovGenesis(uint64(m.Id))
-	}
+	l = m.Price.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
-func (m *CurrencyPairGenesis) Size() (n int) {
+func (m *CurrencyPairState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.CurrencyPair.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.CurrencyPairPrice != nil {
-		l = m.CurrencyPairPrice.Size()
+	if m.Price != nil {
+		l = m.Price.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	if m.Nonce != 0 {
@@ -586,24 +1447,184 @@ func (m *CurrencyPairGenesis) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovGenesis(uint64(m.Id))
 	}
+	if m.Halted {
+		n += 2
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovGenesis(uint64(m.Decimals))
+	}
+	if m.MissedHeights != 0 {
+		n += 1 + sovGenesis(uint64(m.MissedHeights))
+	}
+	if m.RejectedPrice != nil {
+		l = m.RejectedPrice.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RejectedCount != 0 {
+		n += 1 + sovGenesis(uint64(m.RejectedCount))
+	}
 	return n
 }
 
-func (m *GenesisState) Size() (n int) {
+func (m *ValidatorPriceStats) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.CurrencyPairGenesis) > 0 {
-		for _, e := range m.CurrencyPairGenesis {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.NextId != 0 {
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	if m.ReportCount != 0 {
+		n += 1 + sovGenesis(uint64(m.ReportCount))
+	}
+	if m.MissCount != 0 {
+		n += 1 + sovGenesis(uint64(m.MissCount))
+	}
+	if m.DeviationCount != 0 {
+		n += 1 + sovGenesis(uint64(m.DeviationCount))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastHeight))
+	}
+	if m.BucketHeights != 0 {
+		n += 1 + sovGenesis(uint64(m.BucketHeights))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorPriceStatsBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReportCount != 0 {
+		n += 1 + sovGenesis(uint64(m.ReportCount))
+	}
+	if m.MissCount != 0 {
+		n += 1 + sovGenesis(uint64(m.MissCount))
+	}
+	if m.DeviationCount != 0 {
+		n += 1 + sovGenesis(uint64(m.DeviationCount))
+	}
+	return n
+}
+
+func (m *ValidatorOracleStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovGenesis(uint64(m.IndexOffset))
+	}
+	l = len(m.ParticipationBitmap)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ParticipatedCount != 0 {
+		n += 1 + sovGenesis(uint64(m.ParticipatedCount))
+	}
+	if m.MissedCount != 0 {
+		n += 1 + sovGenesis(uint64(m.MissedCount))
+	}
+	return n
+}
+
+func (m *CurrencyPairGenesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.CurrencyPairPrice != nil {
+		l = m.CurrencyPairPrice.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	if len(m.PriceHistory) > 0 {
+		for _, e := range m.PriceHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Halted {
+		n += 2
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovGenesis(uint64(m.Decimals))
+	}
+	if m.MissedHeights != 0 {
+		n += 1 + sovGenesis(uint64(m.MissedHeights))
+	}
+	if m.RejectedPrice != nil {
+		l = m.RejectedPrice.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RejectedCount != 0 {
+		n += 1 + sovGenesis(uint64(m.RejectedCount))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairGenesis) > 0 {
+		for _, e := range m.CurrencyPairGenesis {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextId))
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorPriceStats) > 0 {
+		for _, e := range m.ValidatorPriceStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorOracleStats) > 0 {
+		for _, e := range m.ValidatorOracleStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -749,6 +1770,108 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PriceHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -768,21 +1891,684 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CurrencyPairState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrencyPairState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrencyPairState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrencyPairState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &QuotePrice{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedHeights", wireType)
+			}
+			m.MissedHeights = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedHeights |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RejectedPrice == nil {
+				m.RejectedPrice = &QuotePrice{}
+			}
+			if err := m.RejectedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedCount", wireType)
+			}
+			m.RejectedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPriceStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPriceStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPriceStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportCount", wireType)
+			}
+			m.ReportCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
+			}
+			m.MissCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationCount", wireType)
+			}
+			m.DeviationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketHeights", wireType)
+			}
+			m.BucketHeights = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketHeights |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, ValidatorPriceStatsBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPriceStatsBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPriceStatsBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPriceStatsBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportCount", wireType)
+			}
+			m.ReportCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
+			}
+			m.MissCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationCount", wireType)
+			}
+			m.DeviationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorOracleStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOracleStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOracleStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationBitmap", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -792,33 +2578,31 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Price == nil {
-				m.Price = &QuotePrice{}
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ParticipationBitmap = append(m.ParticipationBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParticipationBitmap == nil {
+				m.ParticipationBitmap = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipatedCount", wireType)
 			}
-			m.Nonce = 0
+			m.ParticipatedCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -828,16 +2612,16 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.ParticipatedCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCount", wireType)
 			}
-			m.Id = 0
+			m.MissedCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -847,7 +2631,7 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.MissedCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1009,6 +2793,153 @@ func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceHistory = append(m.PriceHistory, PriceHistoryEntry{})
+			if err := m.PriceHistory[len(m.PriceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedHeights", wireType)
+			}
+			m.MissedHeights = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedHeights |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RejectedPrice == nil {
+				m.RejectedPrice = &QuotePrice{}
+			}
+			if err := m.RejectedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedCount", wireType)
+			}
+			m.RejectedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1112,6 +3043,107 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPriceStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPriceStats = append(m.ValidatorPriceStats, ValidatorPriceStats{})
+			if err := m.ValidatorPriceStats[len(m.ValidatorPriceStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorOracleStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorOracleStats = append(m.ValidatorOracleStats, ValidatorOracleStats{})
+			if err := m.ValidatorOracleStats[len(m.ValidatorOracleStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
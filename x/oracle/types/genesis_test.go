@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -17,6 +18,53 @@ func TestGenesisValidation(t *testing.T) {
 		nextID     uint64
 		expectPass bool
 	}{
+		{
+			"if the price history of a currency-pair is not ordered by nonce - fail",
+			[]types.CurrencyPairGenesis{
+				{
+					CurrencyPair:      slinkytypes.NewCurrencyPair("AA", "BB"),
+					CurrencyPairPrice: &types.QuotePrice{},
+					Nonce:             2,
+					PriceHistory: []types.PriceHistoryEntry{
+						{Nonce: 2},
+						{Nonce: 1},
+					},
+				},
+			},
+			1,
+			false,
+		},
+		{
+			"if the price history of a currency-pair is newer than its nonce - fail",
+			[]types.CurrencyPairGenesis{
+				{
+					CurrencyPair:      slinkytypes.NewCurrencyPair("AA", "BB"),
+					CurrencyPairPrice: &types.QuotePrice{},
+					Nonce:             1,
+					PriceHistory: []types.PriceHistoryEntry{
+						{Nonce: 2},
+					},
+				},
+			},
+			1,
+			false,
+		},
+		{
+			"if the price history of a currency-pair is valid - pass",
+			[]types.CurrencyPairGenesis{
+				{
+					CurrencyPair:      slinkytypes.NewCurrencyPair("AA", "BB"),
+					CurrencyPairPrice: &types.QuotePrice{},
+					Nonce:             2,
+					PriceHistory: []types.PriceHistoryEntry{
+						{Nonce: 1},
+						{Nonce: 2},
+					},
+				},
+			},
+			1,
+			true,
+		},
 		{
 			"if any of the currency-pair geneses are invalid - fail",
 			[]types.CurrencyPairGenesis{
@@ -129,3 +177,120 @@ func TestGenesisValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisValidatorPriceStatsValidation(t *testing.T) {
+	val1 := sdk.ConsAddress("val1").String()
+	val2 := sdk.ConsAddress("val2").String()
+
+	tcs := []struct {
+		name       string
+		params     types.Params
+		stats      []types.ValidatorPriceStats
+		expectPass bool
+	}{
+		{
+			"if the params are invalid - fail",
+			types.Params{ValidatorStatsWindow: 10},
+			nil,
+			false,
+		},
+		{
+			"if the consensus address of the stats is invalid - fail",
+			types.DefaultParams(),
+			[]types.ValidatorPriceStats{
+				{ConsAddress: "invalid"},
+			},
+			false,
+		},
+		{
+			"if the counters exceed the report count - fail",
+			types.DefaultParams(),
+			[]types.ValidatorPriceStats{
+				{ConsAddress: val1, ReportCount: 2, MissCount: 2, DeviationCount: 1},
+			},
+			false,
+		},
+		{
+			"if the stats of a validator are repeated - fail",
+			types.DefaultParams(),
+			[]types.ValidatorPriceStats{
+				{ConsAddress: val1},
+				{ConsAddress: val1},
+			},
+			false,
+		},
+		{
+			"if the params and stats are valid - pass",
+			types.DefaultParams(),
+			[]types.ValidatorPriceStats{
+				{ConsAddress: val1, ReportCount: 3, MissCount: 2, DeviationCount: 1},
+				{ConsAddress: val2},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.DefaultGenesisState()
+			gs.Params = tc.params
+			gs.ValidatorPriceStats = tc.stats
+
+			err := gs.Validate()
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidatorOracleStatsValidation(t *testing.T) {
+	val1 := sdk.ConsAddress("val1").String()
+	val2 := sdk.ConsAddress("val2").String()
+
+	tcs := []struct {
+		name       string
+		stats      []types.ValidatorOracleStats
+		expectPass bool
+	}{
+		{
+			"if the stats of a validator are invalid - fail",
+			[]types.ValidatorOracleStats{
+				{ConsAddress: "invalid"},
+			},
+			false,
+		},
+		{
+			"if the stats of a validator are repeated - fail",
+			[]types.ValidatorOracleStats{
+				{ConsAddress: val1},
+				{ConsAddress: val1},
+			},
+			false,
+		},
+		{
+			"if the stats are valid - pass",
+			[]types.ValidatorOracleStats{
+				{ConsAddress: val1, IndexOffset: 1, ParticipationBitmap: []byte{1}, ParticipatedCount: 1},
+				{ConsAddress: val2},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.DefaultGenesisState()
+			gs.ValidatorOracleStats = tc.stats
+
+			err := gs.Validate()
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
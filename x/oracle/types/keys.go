@@ -33,6 +33,21 @@ var (
 	// NumCPsKeyPrefix is the key-prefix under which the number CPs is stored.
 	NumCPsKeyPrefix = collections.NewPrefix(5)
 
+	// ParamsKeyPrefix is the key-prefix under which the module parameters are stored.
+	ParamsKeyPrefix = collections.NewPrefix(6)
+
+	// ValidatorPriceStatsKeyPrefix is the key-prefix under which the price statistics of each
+	// validator are stored.
+	ValidatorPriceStatsKeyPrefix = collections.NewPrefix(7)
+
+	// ValidatorOracleStatsKeyPrefix is the key-prefix under which the oracle participation of each
+	// validator is stored.
+	ValidatorOracleStatsKeyPrefix = collections.NewPrefix(8)
+
+	// PriceHistoryKeyPrefix is the key-prefix under which the historical prices of each
+	// currency-pair are stored.
+	PriceHistoryKeyPrefix = collections.NewPrefix(9)
+
 	// CounterCodec is the collections.KeyCodec value used for the counter values.
 	CounterCodec = codec.KeyToValueCodec[uint64](codec.NewUint64Key[uint64]())
 )
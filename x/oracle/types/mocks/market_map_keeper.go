@@ -23,6 +23,53 @@ func (_m *MarketMapKeeper) EXPECT() *MarketMapKeeper_Expecter {
 	return &MarketMapKeeper_Expecter{mock: &_m.Mock}
 }
 
+// ApplyMarketUpdate provides a mock function with given fields: ctx, market
+func (_m *MarketMapKeeper) ApplyMarketUpdate(ctx types.Context, market marketmaptypes.Market) error {
+	ret := _m.Called(ctx, market)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyMarketUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, marketmaptypes.Market) error); ok {
+		r0 = rf(ctx, market)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarketMapKeeper_ApplyMarketUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyMarketUpdate'
+type MarketMapKeeper_ApplyMarketUpdate_Call struct {
+	*mock.Call
+}
+
+// ApplyMarketUpdate is a helper method to define mock.On call
+//   - ctx types.Context
+//   - market marketmaptypes.Market
+func (_e *MarketMapKeeper_Expecter) ApplyMarketUpdate(ctx interface{}, market interface{}) *MarketMapKeeper_ApplyMarketUpdate_Call {
+	return &MarketMapKeeper_ApplyMarketUpdate_Call{Call: _e.mock.On("ApplyMarketUpdate", ctx, market)}
+}
+
+func (_c *MarketMapKeeper_ApplyMarketUpdate_Call) Run(run func(ctx types.Context, market marketmaptypes.Market)) *MarketMapKeeper_ApplyMarketUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.Context), args[1].(marketmaptypes.Market))
+	})
+	return _c
+}
+
+func (_c *MarketMapKeeper_ApplyMarketUpdate_Call) Return(_a0 error) *MarketMapKeeper_ApplyMarketUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarketMapKeeper_ApplyMarketUpdate_Call) RunAndReturn(run func(types.Context, marketmaptypes.Market) error) *MarketMapKeeper_ApplyMarketUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMarket provides a mock function with given fields: ctx, tickerStr
 func (_m *MarketMapKeeper) GetMarket(ctx types.Context, tickerStr string) (marketmaptypes.Market, error) {
 	ret := _m.Called(ctx, tickerStr)
@@ -80,6 +127,53 @@ func (_c *MarketMapKeeper_GetMarket_Call) RunAndReturn(run func(types.Context, s
 	return _c
 }
 
+// SetLastUpdated provides a mock function with given fields: ctx, height
+func (_m *MarketMapKeeper) SetLastUpdated(ctx types.Context, height uint64) error {
+	ret := _m.Called(ctx, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLastUpdated")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, uint64) error); ok {
+		r0 = rf(ctx, height)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarketMapKeeper_SetLastUpdated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLastUpdated'
+type MarketMapKeeper_SetLastUpdated_Call struct {
+	*mock.Call
+}
+
+// SetLastUpdated is a helper method to define mock.On call
+//   - ctx types.Context
+//   - height uint64
+func (_e *MarketMapKeeper_Expecter) SetLastUpdated(ctx interface{}, height interface{}) *MarketMapKeeper_SetLastUpdated_Call {
+	return &MarketMapKeeper_SetLastUpdated_Call{Call: _e.mock.On("SetLastUpdated", ctx, height)}
+}
+
+func (_c *MarketMapKeeper_SetLastUpdated_Call) Run(run func(ctx types.Context, height uint64)) *MarketMapKeeper_SetLastUpdated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MarketMapKeeper_SetLastUpdated_Call) Return(_a0 error) *MarketMapKeeper_SetLastUpdated_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarketMapKeeper_SetLastUpdated_Call) RunAndReturn(run func(types.Context, uint64) error) *MarketMapKeeper_SetLastUpdated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMarketMapKeeper creates a new instance of MarketMapKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMarketMapKeeper(t interface {
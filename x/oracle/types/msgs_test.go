@@ -105,3 +105,63 @@ func TestValidateBasicMsgRemoveCurrencyPairs(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBasicMsgUpdateParams(t *testing.T) {
+	tcs := []struct {
+		name       string
+		msg        types.MsgUpdateParams
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.NewMsgUpdateParams("abc", types.DefaultParams()),
+			false,
+		},
+		{
+			"if the params are invalid - fail",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), types.Params{ValidatorStatsWindow: 1}),
+			false,
+		},
+		{
+			"if the price history is too long - fail",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), types.Params{PriceHistoryLength: types.MaxPriceHistoryLength + 1}),
+			false,
+		},
+		{
+			"if the circuit breaker mode is invalid - fail",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), types.Params{CircuitBreakerMode: 2}),
+			false,
+		},
+		{
+			"if the circuit breaker rejects prices without a reanchor count - fail",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), types.Params{CircuitBreakerMode: types.CircuitBreakerMode_CIRCUIT_BREAKER_MODE_REJECT}),
+			false,
+		},
+		{
+			"if the decimals change mode is invalid - fail",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), types.Params{DecimalsChangeMode: 2}),
+			false,
+		},
+		{
+			"if tracking is disabled - pass",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), types.Params{}),
+			true,
+		},
+		{
+			"if the params + authority are valid - pass",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), types.DefaultParams()),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// DefaultValidatorStatsWindow is the default number of blocks over which the miss and
+	// deviation counters of each validator are accumulated.
+	DefaultValidatorStatsWindow = 10_000
+	// DefaultMaxPriceDeviationBps is the default maximum deviation (in basis points) of the
+	// price reported by a validator from the aggregated price.
+	DefaultMaxPriceDeviationBps = 100
+	// DefaultParticipationWindow is the default number of most recent heights for which the oracle
+	// participation of each validator is tracked.
+	DefaultParticipationWindow = 1_000
+	// DefaultPriceHistoryLength is the default number of most recent prices that are stored per
+	// currency pair. The storage of historical prices is disabled by default, as it grows the state
+	// of the module with every currency pair.
+	DefaultPriceHistoryLength = 0
+	// DefaultMaxPriceChangeBps is the default maximum change (in basis points) of the price of a
+	// currency pair from one update to the next. The circuit breaker is disabled by default.
+	DefaultMaxPriceChangeBps = 0
+	// DefaultMaxPriceAge is the default number of blocks after which a price that has not been
+	// updated is considered stale.
+	DefaultMaxPriceAge = 10
+	// DefaultEmitPriceEvents is whether price events are emitted by default. They are disabled by
+	// default, as they add an event per currency pair to every block.
+	DefaultEmitPriceEvents = false
+	// DefaultMaxMissedHeights is the default number of consecutive heights a currency pair may miss a
+	// price for before its market is disabled. Markets are not disabled automatically by default.
+	DefaultMaxMissedHeights = 0
+	// DefaultCircuitBreakerReanchorCount is the default number of consecutive, mutually consistent price updates
+	// that the circuit breaker rejects before it accepts the latest of them as the new price.
+	DefaultCircuitBreakerReanchorCount = 10
+	// MaxPriceHistoryLength is the maximum number of most recent prices that can be stored per
+	// currency pair.
+	MaxPriceHistoryLength = 10_000
+)
+
+// DefaultParams returns default x/oracle parameters.
+func DefaultParams() Params {
+	return Params{
+		ValidatorStatsWindow:        DefaultValidatorStatsWindow,
+		MaxPriceDeviationBps:        DefaultMaxPriceDeviationBps,
+		ParticipationWindow:         DefaultParticipationWindow,
+		PriceHistoryLength:          DefaultPriceHistoryLength,
+		MaxPriceChangeBps:           DefaultMaxPriceChangeBps,
+		CircuitBreakerMode:          CircuitBreakerMode_CIRCUIT_BREAKER_MODE_CLAMP,
+		MaxPriceAge:                 DefaultMaxPriceAge,
+		EmitPriceEvents:             DefaultEmitPriceEvents,
+		DecimalsChangeMode:          DecimalsChangeMode_DECIMALS_CHANGE_MODE_RESCALE,
+		MaxMissedHeights:            DefaultMaxMissedHeights,
+		CircuitBreakerReanchorCount: DefaultCircuitBreakerReanchorCount,
+	}
+}
+
+// NewParams returns a new Params instance.
+func NewParams(
+	validatorStatsWindow, maxPriceDeviationBps, participationWindow, priceHistoryLength, maxPriceChangeBps uint64,
+	circuitBreakerMode CircuitBreakerMode,
+	maxPriceAge uint64,
+	emitPriceEvents bool,
+	decimalsChangeMode DecimalsChangeMode,
+	maxMissedHeights uint64,
+	circuitBreakerReanchorCount uint64,
+) Params {
+	return Params{
+		ValidatorStatsWindow:        validatorStatsWindow,
+		MaxPriceDeviationBps:        maxPriceDeviationBps,
+		ParticipationWindow:         participationWindow,
+		PriceHistoryLength:          priceHistoryLength,
+		MaxPriceChangeBps:           maxPriceChangeBps,
+		CircuitBreakerMode:          circuitBreakerMode,
+		MaxPriceAge:                 maxPriceAge,
+		EmitPriceEvents:             emitPriceEvents,
+		DecimalsChangeMode:          decimalsChangeMode,
+		MaxMissedHeights:            maxMissedHeights,
+		CircuitBreakerReanchorCount: circuitBreakerReanchorCount,
+	}
+}
+
+// ValidateBasic performs stateless validation of the Params.
+func (p *Params) ValidateBasic() error {
+	if p.ValidatorStatsWindow > 0 && p.MaxPriceDeviationBps == 0 {
+		return fmt.Errorf("max price deviation must be positive when validator price stats are tracked")
+	}
+
+	if p.PriceHistoryLength > MaxPriceHistoryLength {
+		return fmt.Errorf("price history length %d exceeds the maximum of %d", p.PriceHistoryLength, MaxPriceHistoryLength)
+	}
+
+	if _, ok := CircuitBreakerMode_name[int32(p.CircuitBreakerMode)]; !ok {
+		return fmt.Errorf("invalid circuit breaker mode: %d", p.CircuitBreakerMode)
+	}
+
+	if p.CircuitBreakerMode == CircuitBreakerMode_CIRCUIT_BREAKER_MODE_REJECT && p.CircuitBreakerReanchorCount == 0 {
+		return fmt.Errorf("circuit breaker reanchor count must be positive in the reject mode")
+	}
+
+	if _, ok := DecimalsChangeMode_name[int32(p.DecimalsChangeMode)]; !ok {
+		return fmt.Errorf("invalid decimals change mode: %d", p.DecimalsChangeMode)
+	}
+
+	return nil
+}
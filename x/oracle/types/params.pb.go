@@ -0,0 +1,784 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: slinky/oracle/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CircuitBreakerMode determines how price updates that exceed the maximum price
+// change are handled.
+type CircuitBreakerMode int32
+
+const (
+	// CIRCUIT_BREAKER_MODE_CLAMP clamps the price to the maximum price change.
+	CircuitBreakerMode_CIRCUIT_BREAKER_MODE_CLAMP CircuitBreakerMode = 0
+	// CIRCUIT_BREAKER_MODE_REJECT rejects the price update, the previous price is
+	// kept.
+	CircuitBreakerMode_CIRCUIT_BREAKER_MODE_REJECT CircuitBreakerMode = 1
+)
+
+var CircuitBreakerMode_name = map[int32]string{
+	0: "CIRCUIT_BREAKER_MODE_CLAMP",
+	1: "CIRCUIT_BREAKER_MODE_REJECT",
+}
+
+var CircuitBreakerMode_value = map[string]int32{
+	"CIRCUIT_BREAKER_MODE_CLAMP":  0,
+	"CIRCUIT_BREAKER_MODE_REJECT": 1,
+}
+
+func (x CircuitBreakerMode) String() string {
+	return proto.EnumName(CircuitBreakerMode_name, int32(x))
+}
+
+func (CircuitBreakerMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ea9f96c7d261f44a, []int{0}
+}
+
+// DecimalsChangeMode determines how the latest price of a currency pair is
+// handled when the decimals of its market are changed.
+type DecimalsChangeMode int32
+
+const (
+	// DECIMALS_CHANGE_MODE_RESCALE rescales the price to the new decimals. Prices
+	// that are rescaled to fewer decimals are truncated.
+	DecimalsChangeMode_DECIMALS_CHANGE_MODE_RESCALE DecimalsChangeMode = 0
+	// DECIMALS_CHANGE_MODE_INVALIDATE removes the price, until a price is written
+	// with the new decimals.
+	DecimalsChangeMode_DECIMALS_CHANGE_MODE_INVALIDATE DecimalsChangeMode = 1
+)
+
+var DecimalsChangeMode_name = map[int32]string{
+	0: "DECIMALS_CHANGE_MODE_RESCALE",
+	1: "DECIMALS_CHANGE_MODE_INVALIDATE",
+}
+
+var DecimalsChangeMode_value = map[string]int32{
+	"DECIMALS_CHANGE_MODE_RESCALE":    0,
+	"DECIMALS_CHANGE_MODE_INVALIDATE": 1,
+}
+
+func (x DecimalsChangeMode) String() string {
+	return proto.EnumName(DecimalsChangeMode_name, int32(x))
+}
+
+func (DecimalsChangeMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ea9f96c7d261f44a, []int{1}
+}
+
+// Params defines the parameters for the x/oracle module.
+type Params struct {
+	// ValidatorStatsWindow is the number of most recent blocks over which the
+	// miss and deviation counters of each validator are accumulated. The window
+	// slides in buckets of at most 1% of the window. A window of zero disables
+	// the tracking of validator price statistics.
+	ValidatorStatsWindow uint64 `protobuf:"varint,1,opt,name=validator_stats_window,json=validatorStatsWindow,proto3" json:"validator_stats_window,omitempty"`
+	// MaxPriceDeviationBps is the maximum deviation (in basis points) of the
+	// price reported by a validator from the aggregated price of a currency
+	// pair. Reported prices that deviate further are counted as deviations.
+	MaxPriceDeviationBps uint64 `protobuf:"varint,2,opt,name=max_price_deviation_bps,json=maxPriceDeviationBps,proto3" json:"max_price_deviation_bps,omitempty"`
+	// ParticipationWindow is the number of most recent heights for which it is
+	// tracked whether each validator included a valid oracle vote extension. A
+	// window of zero disables the tracking of validator oracle participation.
+	ParticipationWindow uint64 `protobuf:"varint,3,opt,name=participation_window,json=participationWindow,proto3" json:"participation_window,omitempty"`
+	// PriceHistoryLength is the number of most recent prices that are stored per
+	// currency pair, in addition to its latest price. Reducing the length prunes
+	// the stored prices, and a length of zero disables the storage of historical
+	// prices.
+	PriceHistoryLength uint64 `protobuf:"varint,4,opt,name=price_history_length,json=priceHistoryLength,proto3" json:"price_history_length,omitempty"`
+	// MaxPriceChangeBps is the maximum change (in basis points) of the price of
+	// a currency pair from one update to the next. Updates that exceed it trip
+	// the circuit breaker of the currency pair. A maximum of zero disables the
+	// circuit breaker. If the circuit breaker is enabled, it can be overridden
+	// per market by the max_price_change_bps field of the ticker metadata in
+	// x/marketmap.
+	MaxPriceChangeBps uint64 `protobuf:"varint,5,opt,name=max_price_change_bps,json=maxPriceChangeBps,proto3" json:"max_price_change_bps,omitempty"`
+	// CircuitBreakerMode determines how price updates that trip the circuit
+	// breaker are handled.
+	CircuitBreakerMode CircuitBreakerMode `protobuf:"varint,6,opt,name=circuit_breaker_mode,json=circuitBreakerMode,proto3,enum=slinky.oracle.v1.CircuitBreakerMode" json:"circuit_breaker_mode,omitempty"`
+	// MaxPriceAge is the number of blocks after which the price of a currency
+	// pair that has not been updated is considered stale. A maximum age of zero
+	// disables the staleness guard.
+	MaxPriceAge uint64 `protobuf:"varint,7,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
+	// EmitPriceEvents determines whether an event is emitted for every price of a
+	// currency pair that is updated, or missed, while applying the oracle vote
+	// extensions of a block. See EventPriceUpdated and EventPriceMissed.
+	EmitPriceEvents bool `protobuf:"varint,8,opt,name=emit_price_events,json=emitPriceEvents,proto3" json:"emit_price_events,omitempty"`
+	// DecimalsChangeMode determines how the latest price of a currency pair is
+	// handled when the decimals of its market are changed in x/marketmap.
+	DecimalsChangeMode DecimalsChangeMode `protobuf:"varint,9,opt,name=decimals_change_mode,json=decimalsChangeMode,proto3,enum=slinky.oracle.v1.DecimalsChangeMode" json:"decimals_change_mode,omitempty"`
+	// MaxMissedHeights is the number of consecutive heights a currency pair may
+	// miss a price for, before its market is disabled in x/marketmap. Disabled
+	// markets must be re-enabled by a market authority. Markets that enabled
+	// markets are normalized by or derived from are not disabled. A maximum of
+	// zero disables the automatic disabling of markets.
+	MaxMissedHeights uint64 `protobuf:"varint,10,opt,name=max_missed_heights,json=maxMissedHeights,proto3" json:"max_missed_heights,omitempty"`
+	// CircuitBreakerReanchorCount is the number of consecutive price updates of
+	// a currency pair that the circuit breaker rejects in the reject mode, each
+	// within the maximum price change of the previously rejected price, after
+	// which the latest of them is accepted as the new price of the currency
+	// pair. It must be positive in the reject mode, so that a currency pair
+	// whose price moved for good is not halted forever.
+	CircuitBreakerReanchorCount uint64 `protobuf:"varint,11,opt,name=circuit_breaker_reanchor_count,json=circuitBreakerReanchorCount,proto3" json:"circuit_breaker_reanchor_count,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea9f96c7d261f44a, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetValidatorStatsWindow() uint64 {
+	if m != nil {
+		return m.ValidatorStatsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPriceDeviationBps() uint64 {
+	if m != nil {
+		return m.MaxPriceDeviationBps
+	}
+	return 0
+}
+
+func (m *Params) GetParticipationWindow() uint64 {
+	if m != nil {
+		return m.ParticipationWindow
+	}
+	return 0
+}
+
+func (m *Params) GetPriceHistoryLength() uint64 {
+	if m != nil {
+		return m.PriceHistoryLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPriceChangeBps() uint64 {
+	if m != nil {
+		return m.MaxPriceChangeBps
+	}
+	return 0
+}
+
+func (m *Params) GetCircuitBreakerMode() CircuitBreakerMode {
+	if m != nil {
+		return m.CircuitBreakerMode
+	}
+	return CircuitBreakerMode_CIRCUIT_BREAKER_MODE_CLAMP
+}
+
+func (m *Params) GetMaxPriceAge() uint64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *Params) GetEmitPriceEvents() bool {
+	if m != nil {
+		return m.EmitPriceEvents
+	}
+	return false
+}
+
+func (m *Params) GetDecimalsChangeMode() DecimalsChangeMode {
+	if m != nil {
+		return m.DecimalsChangeMode
+	}
+	return DecimalsChangeMode_DECIMALS_CHANGE_MODE_RESCALE
+}
+
+func (m *Params) GetMaxMissedHeights() uint64 {
+	if m != nil {
+		return m.MaxMissedHeights
+	}
+	return 0
+}
+
+func (m *Params) GetCircuitBreakerReanchorCount() uint64 {
+	if m != nil {
+		return m.CircuitBreakerReanchorCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("slinky.oracle.v1.CircuitBreakerMode", CircuitBreakerMode_name, CircuitBreakerMode_value)
+	proto.RegisterEnum("slinky.oracle.v1.DecimalsChangeMode", DecimalsChangeMode_name, DecimalsChangeMode_value)
+	proto.RegisterType((*Params)(nil), "slinky.oracle.v1.Params")
+}
+
+func init() { proto.RegisterFile("slinky/oracle/v1/params.proto", fileDescriptor_ea9f96c7d261f44a) }
+
+var fileDescriptor_ea9f96c7d261f44a = []byte{
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xdf, 0x6e, 0xd3, 0x3c,
+	0x18, 0xc6, 0x9b, 0xef, 0x1b, 0x63, 0x78, 0x02, 0x32, 0x53, 0x41, 0xc4, 0x20, 0xab, 0x06, 0x07,
+	0xd3, 0xc4, 0x12, 0x36, 0xe0, 0x02, 0xd2, 0x34, 0xda, 0x0a, 0xed, 0x98, 0xb2, 0x3f, 0x48, 0x70,
+	0x60, 0xb9, 0x8e, 0x95, 0x58, 0x6d, 0xe2, 0xc8, 0x76, 0xb3, 0xf6, 0x2e, 0xb8, 0x2c, 0x0e, 0x77,
+	0xc8, 0x21, 0x6a, 0xef, 0x03, 0xa1, 0x38, 0x0d, 0xd3, 0xd6, 0x71, 0xfa, 0xfe, 0x9e, 0x47, 0xfe,
+	0xbd, 0xaf, 0x64, 0xf0, 0x52, 0x8e, 0x58, 0x36, 0x9c, 0xba, 0x5c, 0x60, 0x32, 0xa2, 0x6e, 0xb1,
+	0xef, 0xe6, 0x58, 0xe0, 0x54, 0x3a, 0xb9, 0xe0, 0x8a, 0x43, 0xb3, 0xc2, 0x4e, 0x85, 0x9d, 0x62,
+	0x7f, 0xfb, 0xf7, 0x0a, 0x58, 0x3d, 0xd1, 0x11, 0xf8, 0x1e, 0x3c, 0x2d, 0xf0, 0x88, 0x45, 0x58,
+	0x71, 0x81, 0xa4, 0xc2, 0x4a, 0xa2, 0x4b, 0x96, 0x45, 0xfc, 0xd2, 0x32, 0x5a, 0xc6, 0xce, 0x4a,
+	0xd8, 0xfc, 0x4b, 0x4f, 0x4b, 0xf8, 0x45, 0x33, 0xf8, 0x01, 0x3c, 0x4b, 0xf1, 0x04, 0xe5, 0x82,
+	0x11, 0x8a, 0x22, 0x5a, 0x30, 0xac, 0x18, 0xcf, 0xd0, 0x20, 0x97, 0xd6, 0x7f, 0x55, 0x2d, 0xc5,
+	0x93, 0x93, 0x92, 0x76, 0x6a, 0xd8, 0xce, 0x25, 0xdc, 0x07, 0xcd, 0x1c, 0x0b, 0xc5, 0x08, 0xcb,
+	0xab, 0xc2, 0xe2, 0xa9, 0xff, 0x75, 0xe7, 0xc9, 0x0d, 0xb6, 0x78, 0xe9, 0x2d, 0x68, 0x56, 0xaf,
+	0x24, 0x4c, 0x2a, 0x2e, 0xa6, 0x68, 0x44, 0xb3, 0x58, 0x25, 0xd6, 0x8a, 0xae, 0x40, 0xcd, 0x8e,
+	0x2a, 0xd4, 0xd3, 0x04, 0xba, 0xa0, 0x79, 0xed, 0x46, 0x12, 0x9c, 0xc5, 0x54, 0x8b, 0xdd, 0xd3,
+	0x8d, 0x8d, 0x5a, 0xcc, 0xd7, 0xa4, 0xb4, 0xba, 0x00, 0x4d, 0xc2, 0x04, 0x19, 0x33, 0x85, 0x06,
+	0x82, 0xe2, 0x21, 0x15, 0x28, 0xe5, 0x11, 0xb5, 0x56, 0x5b, 0xc6, 0xce, 0xa3, 0x83, 0xd7, 0xce,
+	0xed, 0xf3, 0x39, 0x7e, 0x95, 0x6e, 0x57, 0xe1, 0x3e, 0x8f, 0x68, 0x08, 0xc9, 0xd2, 0x0c, 0x6e,
+	0x83, 0x87, 0xd7, 0x22, 0x38, 0xa6, 0xd6, 0x7d, 0x6d, 0xb0, 0x5e, 0x1b, 0x78, 0x31, 0x85, 0xbb,
+	0x60, 0x83, 0xa6, 0x4c, 0x2d, 0x42, 0xb4, 0xa0, 0x99, 0x92, 0xd6, 0x5a, 0xcb, 0xd8, 0x59, 0x0b,
+	0x1f, 0x97, 0x40, 0x07, 0x03, 0x3d, 0x2e, 0x3d, 0x23, 0x4a, 0x58, 0x8a, 0x47, 0xb2, 0xde, 0x4b,
+	0x7b, 0x3e, 0xf8, 0x97, 0x67, 0x67, 0x91, 0xae, 0x56, 0xad, 0x3c, 0xa3, 0xa5, 0x19, 0x7c, 0x03,
+	0x60, 0xe9, 0x99, 0x32, 0x29, 0x69, 0x84, 0x12, 0xca, 0xe2, 0x44, 0x49, 0x0b, 0x68, 0x59, 0x33,
+	0xc5, 0x93, 0xbe, 0x06, 0x47, 0xd5, 0x1c, 0xfa, 0xc0, 0xbe, 0x7d, 0x2d, 0x41, 0x71, 0x46, 0x12,
+	0x2e, 0x10, 0xe1, 0xe3, 0x4c, 0x59, 0xeb, 0xba, 0xb9, 0x79, 0xf3, 0x22, 0xe1, 0x22, 0xe3, 0x97,
+	0x91, 0xdd, 0x73, 0x00, 0x97, 0x8f, 0x08, 0x6d, 0xf0, 0xdc, 0xef, 0x86, 0xfe, 0x79, 0xf7, 0x0c,
+	0xb5, 0xc3, 0xc0, 0xfb, 0x14, 0x84, 0xa8, 0xff, 0xb9, 0x13, 0x20, 0xbf, 0xe7, 0xf5, 0x4f, 0xcc,
+	0x06, 0xdc, 0x02, 0x9b, 0x77, 0xf2, 0x30, 0xf8, 0x18, 0xf8, 0x67, 0xa6, 0xb1, 0xfb, 0x0d, 0xc0,
+	0xe5, 0x9d, 0x61, 0x0b, 0xbc, 0xe8, 0x04, 0x7e, 0xb7, 0xef, 0xf5, 0x4e, 0x91, 0x7f, 0xe4, 0x1d,
+	0x1f, 0x06, 0x75, 0xed, 0xd4, 0xf7, 0x7a, 0x81, 0xd9, 0x80, 0xaf, 0xc0, 0xd6, 0x9d, 0x89, 0xee,
+	0xf1, 0x85, 0xd7, 0xeb, 0x76, 0xbc, 0xb3, 0xc0, 0x34, 0xda, 0x87, 0x3f, 0x66, 0xb6, 0x71, 0x35,
+	0xb3, 0x8d, 0x5f, 0x33, 0xdb, 0xf8, 0x3e, 0xb7, 0x1b, 0x57, 0x73, 0xbb, 0xf1, 0x73, 0x6e, 0x37,
+	0xbe, 0xee, 0xc5, 0x4c, 0x25, 0xe3, 0x81, 0x43, 0x78, 0xea, 0xca, 0x21, 0xcb, 0xf7, 0x52, 0x5a,
+	0xb8, 0x84, 0x67, 0x19, 0x25, 0xca, 0x2d, 0x0e, 0xdc, 0x49, 0xfd, 0x33, 0xd5, 0x34, 0xa7, 0x72,
+	0xb0, 0xaa, 0xbf, 0xe5, 0xbb, 0x3f, 0x03, 0x00, 0xb2, 0xd9, 0x7c, 0xbb, 0xb7, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CircuitBreakerReanchorCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CircuitBreakerReanchorCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxMissedHeights != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMissedHeights))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DecimalsChangeMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DecimalsChangeMode))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EmitPriceEvents {
+		i--
+		if m.EmitPriceEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxPriceAge != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPriceAge))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CircuitBreakerMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CircuitBreakerMode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxPriceChangeBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPriceChangeBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PriceHistoryLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PriceHistoryLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ParticipationWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ParticipationWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxPriceDeviationBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPriceDeviationBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorStatsWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ValidatorStatsWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorStatsWindow != 0 {
+		n += 1 + sovParams(uint64(m.ValidatorStatsWindow))
+	}
+	if m.MaxPriceDeviationBps != 0 {
+		n += 1 + sovParams(uint64(m.MaxPriceDeviationBps))
+	}
+	if m.ParticipationWindow != 0 {
+		n += 1 + sovParams(uint64(m.ParticipationWindow))
+	}
+	if m.PriceHistoryLength != 0 {
+		n += 1 + sovParams(uint64(m.PriceHistoryLength))
+	}
+	if m.MaxPriceChangeBps != 0 {
+		n += 1 + sovParams(uint64(m.MaxPriceChangeBps))
+	}
+	if m.CircuitBreakerMode != 0 {
+		n += 1 + sovParams(uint64(m.CircuitBreakerMode))
+	}
+	if m.MaxPriceAge != 0 {
+		n += 1 + sovParams(uint64(m.MaxPriceAge))
+	}
+	if m.EmitPriceEvents {
+		n += 2
+	}
+	if m.DecimalsChangeMode != 0 {
+		n += 1 + sovParams(uint64(m.DecimalsChangeMode))
+	}
+	if m.MaxMissedHeights != 0 {
+		n += 1 + sovParams(uint64(m.MaxMissedHeights))
+	}
+	if m.CircuitBreakerReanchorCount != 0 {
+		n += 1 + sovParams(uint64(m.CircuitBreakerReanchorCount))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorStatsWindow", wireType)
+			}
+			m.ValidatorStatsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorStatsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviationBps", wireType)
+			}
+			m.MaxPriceDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceDeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationWindow", wireType)
+			}
+			m.ParticipationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipationWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryLength", wireType)
+			}
+			m.PriceHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChangeBps", wireType)
+			}
+			m.MaxPriceChangeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceChangeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerMode", wireType)
+			}
+			m.CircuitBreakerMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerMode |= CircuitBreakerMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			m.MaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitPriceEvents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmitPriceEvents = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalsChangeMode", wireType)
+			}
+			m.DecimalsChangeMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecimalsChangeMode |= DecimalsChangeMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedHeights", wireType)
+			}
+			m.MaxMissedHeights = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedHeights |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerReanchorCount", wireType)
+			}
+			m.CircuitBreakerReanchorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerReanchorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParticipationBitmapLen returns the number of bytes of the participation bitmap required to track
+// the participation of a validator over the given window.
+func ParticipationBitmapLen(window uint64) int {
+	return int((window + 7) / 8) //nolint:gosec
+}
+
+// NewValidatorOracleStats returns a new ValidatorOracleStats for the given validator, that starts
+// tracking its participation at the given height over the given window.
+func NewValidatorOracleStats(consAddress sdk.ConsAddress, startHeight, window uint64) ValidatorOracleStats {
+	return ValidatorOracleStats{
+		ConsAddress:         consAddress.String(),
+		StartHeight:         startHeight,
+		ParticipationBitmap: make([]byte, ParticipationBitmapLen(window)),
+	}
+}
+
+// RecordParticipation records whether the validator included a valid oracle vote extension at the
+// given height. The participation of the oldest height in the window is overwritten once the window
+// is full. The window must be the one the participation bitmap was created with.
+func (s *ValidatorOracleStats) RecordParticipation(height, window uint64, participated bool) {
+	index := s.IndexOffset % window
+
+	// once the window is full, the oldest participation is evicted from the window
+	if s.IndexOffset >= window {
+		if s.participated(index) {
+			s.ParticipatedCount--
+		} else {
+			s.MissedCount--
+		}
+	}
+
+	s.setParticipated(index, participated)
+	if participated {
+		s.ParticipatedCount++
+	} else {
+		s.MissedCount++
+	}
+
+	s.IndexOffset++
+	s.LastHeight = height
+}
+
+func (s *ValidatorOracleStats) participated(index uint64) bool {
+	return s.ParticipationBitmap[index/8]&(1<<(index%8)) != 0
+}
+
+func (s *ValidatorOracleStats) setParticipated(index uint64, participated bool) {
+	if participated {
+		s.ParticipationBitmap[index/8] |= 1 << (index % 8)
+	} else {
+		s.ParticipationBitmap[index/8] &^= 1 << (index % 8)
+	}
+}
+
+// ValidateBasic performs stateless validation of the ValidatorOracleStats.
+func (s *ValidatorOracleStats) ValidateBasic() error {
+	if _, err := sdk.ConsAddressFromBech32(s.ConsAddress); err != nil {
+		return fmt.Errorf("invalid validator consensus address %s: %w", s.ConsAddress, err)
+	}
+
+	if s.LastHeight < s.StartHeight {
+		return fmt.Errorf(
+			"last height %d is before start height %d for validator %s",
+			s.LastHeight,
+			s.StartHeight,
+			s.ConsAddress,
+		)
+	}
+
+	recorded := s.ParticipatedCount + s.MissedCount
+	if recorded > s.IndexOffset || recorded > uint64(len(s.ParticipationBitmap))*8 {
+		return fmt.Errorf(
+			"participated count %d and missed count %d exceed the recorded heights for validator %s",
+			s.ParticipatedCount,
+			s.MissedCount,
+			s.ConsAddress,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestRecordParticipation(t *testing.T) {
+	const window = 10
+
+	stats := types.NewValidatorOracleStats(sdk.ConsAddress("val1"), 1, window)
+	require.Len(t, stats.ParticipationBitmap, 2)
+
+	// participate at every other height, filling the window
+	for height := uint64(1); height <= window; height++ {
+		stats.RecordParticipation(height, window, height%2 == 0)
+	}
+	require.Equal(t, []byte{0b1010_1010, 0b10}, stats.ParticipationBitmap)
+	require.Equal(t, uint64(5), stats.ParticipatedCount)
+	require.Equal(t, uint64(5), stats.MissedCount)
+
+	// participate at the next three heights, evicting the first three heights
+	for height := uint64(window + 1); height <= window+3; height++ {
+		stats.RecordParticipation(height, window, true)
+	}
+	require.Equal(t, []byte{0b1010_1111, 0b10}, stats.ParticipationBitmap)
+	require.Equal(t, uint64(7), stats.ParticipatedCount)
+	require.Equal(t, uint64(3), stats.MissedCount)
+	require.Equal(t, uint64(window+3), stats.IndexOffset)
+	require.Equal(t, uint64(window+3), stats.LastHeight)
+	require.NoError(t, stats.ValidateBasic())
+}
+
+func TestValidatorOracleStatsValidateBasic(t *testing.T) {
+	val := sdk.ConsAddress("val1").String()
+
+	tcs := []struct {
+		name       string
+		stats      types.ValidatorOracleStats
+		expectPass bool
+	}{
+		{
+			"invalid consensus address - fail",
+			types.ValidatorOracleStats{ConsAddress: "invalid"},
+			false,
+		},
+		{
+			"last height before start height - fail",
+			types.ValidatorOracleStats{ConsAddress: val, StartHeight: 2, LastHeight: 1},
+			false,
+		},
+		{
+			"counts exceed the recorded heights - fail",
+			types.ValidatorOracleStats{
+				ConsAddress:         val,
+				IndexOffset:         1,
+				ParticipationBitmap: []byte{0},
+				ParticipatedCount:   1,
+				MissedCount:         1,
+			},
+			false,
+		},
+		{
+			"counts exceed the bitmap - fail",
+			types.ValidatorOracleStats{
+				ConsAddress:         val,
+				IndexOffset:         20,
+				ParticipationBitmap: []byte{0},
+				MissedCount:         9,
+			},
+			false,
+		},
+		{
+			"valid stats - pass",
+			types.ValidatorOracleStats{
+				ConsAddress:         val,
+				StartHeight:         1,
+				LastHeight:          20,
+				IndexOffset:         20,
+				ParticipationBitmap: []byte{0b11},
+				ParticipatedCount:   2,
+				MissedCount:         6,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.stats.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -225,47 +225,148 @@ func (m *MsgRemoveCurrencyPairsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveCurrencyPairsResponse proto.InternalMessageInfo
 
+// MsgUpdateParams defines the Msg/UpdateParams request type. It contains the
+// new parameters for the x/oracle module.
+type MsgUpdateParams struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's parameters
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Params defines the new parameters for the x/oracle module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddCurrencyPairs)(nil), "slinky.oracle.v1.MsgAddCurrencyPairs")
 	proto.RegisterType((*MsgAddCurrencyPairsResponse)(nil), "slinky.oracle.v1.MsgAddCurrencyPairsResponse")
 	proto.RegisterType((*MsgRemoveCurrencyPairs)(nil), "slinky.oracle.v1.MsgRemoveCurrencyPairs")
 	proto.RegisterType((*MsgRemoveCurrencyPairsResponse)(nil), "slinky.oracle.v1.MsgRemoveCurrencyPairsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "slinky.oracle.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "slinky.oracle.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("slinky/oracle/v1/tx.proto", fileDescriptor_1cd987ee4c7d5236) }
 
 var fileDescriptor_1cd987ee4c7d5236 = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0xcf, 0xb8, 0x2a, 0x74, 0x44, 0xdd, 0xcd, 0x2e, 0xda, 0x8d, 0x6c, 0xb6, 0x54, 0x84, 0x52,
-	0xe8, 0x8c, 0xad, 0xe0, 0x61, 0x6f, 0x5b, 0x0f, 0xa2, 0x50, 0x90, 0xec, 0xcd, 0x4b, 0xc9, 0x26,
-	0xc3, 0x74, 0xd8, 0x4d, 0x26, 0xce, 0x9b, 0x86, 0xcd, 0x4d, 0x3c, 0x7a, 0xf2, 0x23, 0xf8, 0x11,
-	0x7a, 0xf0, 0xe0, 0x47, 0xd8, 0xe3, 0x22, 0x08, 0x9e, 0x44, 0xda, 0x43, 0xf5, 0x5b, 0x48, 0x93,
-	0x09, 0x6d, 0xdd, 0x1c, 0x56, 0xd8, 0x4b, 0xc8, 0x7b, 0xef, 0x37, 0xef, 0xf7, 0x27, 0x13, 0xbc,
-	0x0b, 0xa7, 0x22, 0x3e, 0xc9, 0xa8, 0x54, 0x7e, 0x70, 0xca, 0x68, 0xda, 0xa5, 0xfa, 0x8c, 0x24,
-	0x4a, 0x6a, 0x69, 0x6f, 0x16, 0x23, 0x52, 0x8c, 0x48, 0xda, 0x75, 0xdc, 0x4b, 0x60, 0xce, 0x62,
-	0x06, 0x02, 0x8a, 0x13, 0xce, 0x6e, 0x20, 0x21, 0x92, 0x30, 0xcc, 0x2b, 0x5a, 0x14, 0x66, 0xf4,
-	0xb0, 0xa8, 0x68, 0x04, 0x7c, 0x71, 0x2e, 0x02, 0x6e, 0x06, 0x5b, 0x7e, 0x24, 0x62, 0x49, 0xf3,
-	0xa7, 0x69, 0xed, 0x70, 0xc9, 0x65, 0xb1, 0x63, 0xf1, 0x66, 0xba, 0x8f, 0x0d, 0xb9, 0xce, 0x12,
-	0x06, 0x8b, 0x1d, 0xc1, 0x58, 0x29, 0x16, 0x07, 0xd9, 0x30, 0xf1, 0x85, 0x2a, 0x40, 0xcd, 0xef,
-	0x08, 0x6f, 0x0f, 0x80, 0x1f, 0x86, 0xe1, 0x0b, 0x33, 0x7d, 0xe3, 0x0b, 0x05, 0xf6, 0x73, 0x5c,
-	0xf3, 0xc7, 0x7a, 0x24, 0x95, 0xd0, 0x59, 0x1d, 0x35, 0x50, 0xab, 0xd6, 0xaf, 0x7f, 0xfb, 0xd2,
-	0xd9, 0x31, 0x1a, 0x0f, 0xc3, 0x50, 0x31, 0x80, 0x23, 0xad, 0x44, 0xcc, 0xbd, 0x25, 0xd4, 0x7e,
-	0x8d, 0xef, 0xad, 0xd1, 0x40, 0xfd, 0x46, 0x63, 0xa3, 0x75, 0xa7, 0xb7, 0x47, 0x4c, 0x38, 0xb9,
-	0x1a, 0x92, 0x76, 0xc9, 0x2a, 0x5f, 0xff, 0xe6, 0xf9, 0xcf, 0x7d, 0xcb, 0xbb, 0x1b, 0xac, 0x6a,
-	0x38, 0x38, 0xf8, 0xfd, 0x79, 0xdf, 0xfa, 0x30, 0x9f, 0xb4, 0x97, 0xfb, 0x3f, 0xce, 0x27, 0xed,
-	0xd2, 0xd7, 0x59, 0x19, 0x6b, 0x85, 0xfe, 0xe6, 0x1e, 0x7e, 0x54, 0xd1, 0xf6, 0x18, 0x24, 0x32,
-	0x06, 0xd6, 0xfc, 0x8a, 0xf0, 0x83, 0x01, 0x70, 0x8f, 0x45, 0x32, 0x65, 0xd7, 0xe3, 0xbc, 0x8d,
-	0xb7, 0xd6, 0x9c, 0x0f, 0x45, 0x58, 0x98, 0xaf, 0x79, 0xf7, 0x57, 0x7d, 0xbd, 0x0a, 0xff, 0xcb,
-	0xd9, 0x11, 0xd3, 0xeb, 0xce, 0x1a, 0xd8, 0xad, 0x56, 0x5e, 0x9a, 0xeb, 0xfd, 0x41, 0x78, 0x63,
-	0x00, 0xdc, 0x1e, 0xe1, 0xcd, 0x4b, 0xdf, 0xf5, 0x09, 0xf9, 0xf7, 0x92, 0x92, 0x8a, 0x9c, 0x9c,
-	0xce, 0x95, 0x60, 0x25, 0xa3, 0xfd, 0x0e, 0x6f, 0x57, 0x45, 0xd9, 0xaa, 0xdc, 0x52, 0x81, 0x74,
-	0x9e, 0x5e, 0x15, 0x59, 0x52, 0x3a, 0xb7, 0xde, 0xcf, 0x27, 0x6d, 0xd4, 0x7f, 0x79, 0x3e, 0x75,
-	0xd1, 0xc5, 0xd4, 0x45, 0xbf, 0xa6, 0x2e, 0xfa, 0x34, 0x73, 0xad, 0x8b, 0x99, 0x6b, 0xfd, 0x98,
-	0xb9, 0xd6, 0xdb, 0x0e, 0x17, 0x7a, 0x34, 0x3e, 0x26, 0x81, 0x8c, 0x28, 0x9c, 0x88, 0xa4, 0x13,
-	0xb1, 0x94, 0x06, 0x32, 0x8e, 0x59, 0xa0, 0x69, 0xda, 0x5b, 0x86, 0x9c, 0xdf, 0xc8, 0xe3, 0xdb,
-	0xf9, 0xff, 0xf0, 0xec, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3f, 0xab, 0x45, 0x62, 0xe0, 0x03,
-	0x00, 0x00,
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0xa9, 0x16, 0x32, 0x55, 0xdb, 0xa6, 0xc5, 0x26, 0x2b, 0xd9, 0xc4, 0x88, 0x10,
+	0x03, 0xd9, 0x35, 0x11, 0x72, 0xc8, 0xad, 0xf1, 0x20, 0x0a, 0x81, 0xb2, 0xc5, 0x8b, 0x08, 0x61,
+	0xbb, 0x3b, 0x4c, 0x86, 0x76, 0x77, 0xd6, 0x99, 0xc9, 0xd2, 0xdc, 0xc4, 0xa3, 0x27, 0x3f, 0x82,
+	0x1f, 0x21, 0x07, 0x0f, 0xfa, 0x09, 0xec, 0xb1, 0x08, 0x82, 0x27, 0x91, 0xe4, 0x10, 0x3f, 0x86,
+	0x64, 0x77, 0xb6, 0xd9, 0x34, 0x03, 0x56, 0xe9, 0x65, 0xd9, 0x79, 0xef, 0x3f, 0xef, 0xfd, 0x7f,
+	0xfb, 0x66, 0x16, 0x16, 0xf9, 0x09, 0xf1, 0x8f, 0x47, 0x26, 0x65, 0xb6, 0x73, 0x82, 0xcc, 0xb0,
+	0x69, 0x8a, 0x53, 0x23, 0x60, 0x54, 0xd0, 0xfc, 0x56, 0x9c, 0x32, 0xe2, 0x94, 0x11, 0x36, 0x35,
+	0x7d, 0x45, 0x8c, 0x91, 0x8f, 0x38, 0xe1, 0xf1, 0x0e, 0xad, 0xe8, 0x50, 0xee, 0x51, 0xde, 0x8f,
+	0x56, 0x66, 0xbc, 0x90, 0xa9, 0xbd, 0x78, 0x65, 0x7a, 0x1c, 0xcf, 0xf7, 0x79, 0x1c, 0xcb, 0xc4,
+	0xb6, 0xed, 0x11, 0x9f, 0x9a, 0xd1, 0x53, 0x86, 0x76, 0x31, 0xc5, 0x34, 0xae, 0x31, 0x7f, 0x93,
+	0xd1, 0x07, 0xb2, 0xb9, 0x18, 0x05, 0x88, 0xcf, 0x6b, 0x38, 0x43, 0xc6, 0x90, 0xef, 0x8c, 0xfa,
+	0x81, 0x4d, 0x98, 0x14, 0x95, 0x56, 0x1c, 0x06, 0x36, 0xb3, 0x3d, 0xe9, 0xa2, 0xfa, 0x1d, 0xc0,
+	0x9d, 0x1e, 0xc7, 0xfb, 0xae, 0xfb, 0x54, 0x6e, 0x3e, 0xb0, 0x09, 0xe3, 0xf9, 0x36, 0xcc, 0xd9,
+	0x43, 0x31, 0xa0, 0x8c, 0x88, 0x51, 0x01, 0x54, 0x40, 0x2d, 0xd7, 0x2d, 0x7c, 0xfb, 0xd4, 0xd8,
+	0x95, 0x08, 0xfb, 0xae, 0xcb, 0x10, 0xe7, 0x87, 0x82, 0x11, 0x1f, 0x5b, 0x0b, 0x69, 0xfe, 0x05,
+	0xbc, 0xb3, 0xe4, 0x82, 0x17, 0xb2, 0x95, 0xb5, 0xda, 0x46, 0xab, 0x64, 0xc8, 0x6f, 0x17, 0x99,
+	0x35, 0xc2, 0xa6, 0x91, 0xee, 0xd7, 0xbd, 0x71, 0xf6, 0xb3, 0x9c, 0xb1, 0x6e, 0x3b, 0x69, 0x0f,
+	0x9d, 0xce, 0xef, 0x8f, 0xe5, 0xcc, 0xbb, 0xd9, 0xb8, 0xbe, 0xa8, 0xff, 0x7e, 0x36, 0xae, 0x27,
+	0xd8, 0xa7, 0x09, 0x93, 0xc2, 0x7f, 0xb5, 0x04, 0xef, 0x29, 0xc2, 0x16, 0xe2, 0x01, 0xf5, 0x39,
+	0xaa, 0x7e, 0x06, 0xf0, 0x6e, 0x8f, 0x63, 0x0b, 0x79, 0x34, 0x44, 0xd7, 0x43, 0x5e, 0x87, 0xdb,
+	0x4b, 0xe4, 0x7d, 0xe2, 0xc6, 0xf0, 0x39, 0x6b, 0x33, 0xcd, 0xf5, 0xdc, 0xfd, 0x27, 0xb2, 0x43,
+	0x24, 0x96, 0xc9, 0x2a, 0x50, 0x57, 0x3b, 0xbf, 0x80, 0xfb, 0x02, 0xe0, 0x66, 0x8f, 0xe3, 0x97,
+	0x81, 0x6b, 0x0b, 0x74, 0x10, 0x4d, 0xfb, 0xbf, 0xa9, 0xda, 0x70, 0x3d, 0x3e, 0x2f, 0x85, 0x6c,
+	0x05, 0xd4, 0x36, 0x5a, 0x05, 0xe3, 0xf2, 0x1d, 0x30, 0xe2, 0x0e, 0x72, 0x84, 0x52, 0xdd, 0x69,
+	0xab, 0x09, 0xcb, 0x0a, 0xc2, 0xb4, 0xcf, 0x6a, 0x11, 0xee, 0x5d, 0x0a, 0x25, 0x58, 0xad, 0xaf,
+	0x59, 0xb8, 0xd6, 0xe3, 0x38, 0x3f, 0x80, 0x5b, 0x2b, 0xc7, 0xf5, 0xe1, 0xaa, 0x2d, 0xc5, 0xf8,
+	0xb5, 0xc6, 0x95, 0x64, 0x49, 0xc7, 0xfc, 0x1b, 0xb8, 0xa3, 0x3a, 0x21, 0x35, 0x65, 0x15, 0x85,
+	0x52, 0x7b, 0x7c, 0x55, 0xe5, 0x45, 0xcb, 0xd7, 0xf0, 0xd6, 0xd2, 0xdc, 0xee, 0x2b, 0x2b, 0xa4,
+	0x25, 0xda, 0xa3, 0xbf, 0x4a, 0x92, 0xea, 0xda, 0xcd, 0xb7, 0xb3, 0x71, 0x1d, 0x74, 0x9f, 0x9d,
+	0x4d, 0x74, 0x70, 0x3e, 0xd1, 0xc1, 0xaf, 0x89, 0x0e, 0x3e, 0x4c, 0xf5, 0xcc, 0xf9, 0x54, 0xcf,
+	0xfc, 0x98, 0xea, 0x99, 0x57, 0x0d, 0x4c, 0xc4, 0x60, 0x78, 0x64, 0x38, 0xd4, 0x33, 0xf9, 0x31,
+	0x09, 0x1a, 0x1e, 0x0a, 0x4d, 0x87, 0xfa, 0x3e, 0x72, 0x84, 0x19, 0xb6, 0x16, 0x73, 0x8b, 0xae,
+	0xf1, 0xd1, 0x7a, 0xf4, 0x13, 0x79, 0xf2, 0x67, 0x00, 0x05, 0xd9, 0xc2, 0xdc, 0x34, 0x05, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -288,6 +389,9 @@ type MsgClient interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams will be used only by governance to update the parameters of
+	// the x/oracle module.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -316,6 +420,15 @@ func (c *msgClient) RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurren
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AddCurrencyPairs will be used only by governance to update the set of
@@ -326,6 +439,9 @@ type MsgServer interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams will be used only by governance to update the parameters of
+	// the x/oracle module.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -338,6 +454,9 @@ func (*UnimplementedMsgServer) AddCurrencyPairs(ctx context.Context, req *MsgAdd
 func (*UnimplementedMsgServer) RemoveCurrencyPairs(ctx context.Context, req *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCurrencyPairs not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -379,6 +498,24 @@ func _Msg_RemoveCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "slinky.oracle.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -391,6 +528,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCurrencyPairs",
 			Handler:    _Msg_RemoveCurrencyPairs_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/oracle/v1/tx.proto",
@@ -525,6 +666,69 @@ func (m *MsgRemoveCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -592,6 +796,30 @@ func (m *MsgRemoveCurrencyPairsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -928,6 +1156,171 @@ func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
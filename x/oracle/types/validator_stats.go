@@ -0,0 +1,137 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// PriceDeviation describes how far the price reported by a validator for a currency pair is from
+// the aggregated (stake-weighted median) price of the currency pair.
+type PriceDeviation struct {
+	// Missed is true if the validator did not report a (valid) price for the currency pair.
+	Missed bool
+	// DeviationBps is the absolute deviation of the reported price from the aggregated price, in
+	// basis points of the aggregated price. This is zero if the price was missed.
+	DeviationBps uint64
+}
+
+// MaxValidatorPriceStatsBuckets is the maximum number of buckets the validator stats window is divided
+// into. The window slides one bucket at a time, so each bucket covers at least 1% of the window.
+const MaxValidatorPriceStatsBuckets = 100
+
+// ValidatorPriceStatsBucketHeights returns the number of consecutive heights whose counters are accumulated
+// in each bucket of the given validator stats window.
+func ValidatorPriceStatsBucketHeights(window uint64) uint64 {
+	return (window + MaxValidatorPriceStatsBuckets - 1) / MaxValidatorPriceStatsBuckets
+}
+
+// ValidatorPriceStatsBucketsLen returns the number of buckets the given validator stats window is divided
+// into.
+func ValidatorPriceStatsBucketsLen(window uint64) int {
+	bucketHeights := ValidatorPriceStatsBucketHeights(window)
+	if bucketHeights == 0 {
+		return 0
+	}
+
+	return int((window + bucketHeights - 1) / bucketHeights) //nolint:gosec
+}
+
+// NewValidatorPriceStats returns a new, empty ValidatorPriceStats for the given validator, that starts
+// tracking its stats at the given height over the given window.
+func NewValidatorPriceStats(consAddress sdk.ConsAddress, startHeight, window uint64) ValidatorPriceStats {
+	return ValidatorPriceStats{
+		ConsAddress:   consAddress.String(),
+		StartHeight:   startHeight,
+		BucketHeights: ValidatorPriceStatsBucketHeights(window),
+		Buckets:       make([]ValidatorPriceStatsBucket, ValidatorPriceStatsBucketsLen(window)),
+	}
+}
+
+// RecordDeviations records the deviations of the prices reported by the validator at the given height. The
+// buckets of the heights that have fallen out of the window since the stats were last updated are evicted
+// first. The stats must have been created with the current window.
+func (s *ValidatorPriceStats) RecordDeviations(
+	height uint64,
+	maxPriceDeviationBps uint64,
+	deviations map[slinkytypes.CurrencyPair]PriceDeviation,
+) {
+	var (
+		numBuckets = uint64(len(s.Buckets))
+		bucket     = height / s.BucketHeights
+		lastBucket = s.LastHeight / s.BucketHeights
+	)
+
+	// evict every bucket that has been passed since the last update, at most once
+	for b := lastBucket + 1; b <= bucket && b <= lastBucket+numBuckets; b++ {
+		s.evict(b % numBuckets)
+	}
+
+	current := &s.Buckets[bucket%numBuckets]
+	for _, deviation := range deviations {
+		current.ReportCount++
+		s.ReportCount++
+
+		switch {
+		case deviation.Missed:
+			current.MissCount++
+			s.MissCount++
+		case deviation.DeviationBps > maxPriceDeviationBps:
+			current.DeviationCount++
+			s.DeviationCount++
+		}
+	}
+
+	s.LastHeight = height
+}
+
+// evict removes the counters of the bucket at the given index from the window.
+func (s *ValidatorPriceStats) evict(index uint64) {
+	evicted := s.Buckets[index]
+
+	s.ReportCount -= evicted.ReportCount
+	s.MissCount -= evicted.MissCount
+	s.DeviationCount -= evicted.DeviationCount
+	s.Buckets[index] = ValidatorPriceStatsBucket{}
+}
+
+// ValidateBasic performs stateless validation of the ValidatorPriceStats.
+func (s *ValidatorPriceStats) ValidateBasic() error {
+	if _, err := sdk.ConsAddressFromBech32(s.ConsAddress); err != nil {
+		return fmt.Errorf("invalid validator consensus address %s: %w", s.ConsAddress, err)
+	}
+
+	if s.MissCount+s.DeviationCount > s.ReportCount {
+		return fmt.Errorf(
+			"miss count %d and deviation count %d exceed report count %d for validator %s",
+			s.MissCount,
+			s.DeviationCount,
+			s.ReportCount,
+			s.ConsAddress,
+		)
+	}
+
+	// stats without buckets are tracked from scratch once they are updated
+	if len(s.Buckets) == 0 {
+		return nil
+	}
+
+	if s.BucketHeights == 0 {
+		return fmt.Errorf("bucket heights cannot be zero for validator %s", s.ConsAddress)
+	}
+
+	var total ValidatorPriceStatsBucket
+	for _, bucket := range s.Buckets {
+		total.ReportCount += bucket.ReportCount
+		total.MissCount += bucket.MissCount
+		total.DeviationCount += bucket.DeviationCount
+	}
+
+	if total.ReportCount != s.ReportCount || total.MissCount != s.MissCount || total.DeviationCount != s.DeviationCount {
+		return fmt.Errorf("counters do not match the counters of the buckets for validator %s", s.ConsAddress)
+	}
+
+	return nil
+}
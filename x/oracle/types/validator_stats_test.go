@@ -0,0 +1,137 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	slinkytypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestValidatorPriceStatsBuckets(t *testing.T) {
+	require.Equal(t, uint64(1), types.ValidatorPriceStatsBucketHeights(10))
+	require.Equal(t, 10, types.ValidatorPriceStatsBucketsLen(10))
+
+	require.Equal(t, uint64(100), types.ValidatorPriceStatsBucketHeights(10_000))
+	require.Equal(t, 100, types.ValidatorPriceStatsBucketsLen(10_000))
+
+	require.Equal(t, uint64(2), types.ValidatorPriceStatsBucketHeights(150))
+	require.Equal(t, 75, types.ValidatorPriceStatsBucketsLen(150))
+
+	require.Equal(t, 0, types.ValidatorPriceStatsBucketsLen(0))
+}
+
+func TestRecordDeviations(t *testing.T) {
+	const (
+		window          = 1000
+		maxDeviationBps = 50
+	)
+
+	var (
+		cp      = slinkytypes.NewCurrencyPair("BTC", "USD")
+		missed  = map[slinkytypes.CurrencyPair]types.PriceDeviation{cp: {Missed: true}}
+		deviant = map[slinkytypes.CurrencyPair]types.PriceDeviation{cp: {DeviationBps: 51}}
+	)
+
+	stats := types.NewValidatorPriceStats(sdk.ConsAddress("val1"), 1, window)
+	require.Equal(t, uint64(10), stats.BucketHeights)
+	require.Len(t, stats.Buckets, 100)
+
+	// miss at heights 1 to 10, which share the first two buckets
+	for height := uint64(1); height <= 10; height++ {
+		stats.RecordDeviations(height, maxDeviationBps, missed)
+	}
+
+	// deviate at heights 500 to 509
+	for height := uint64(500); height < 510; height++ {
+		stats.RecordDeviations(height, maxDeviationBps, deviant)
+	}
+	require.Equal(t, uint64(20), stats.ReportCount)
+	require.Equal(t, uint64(10), stats.MissCount)
+	require.Equal(t, uint64(10), stats.DeviationCount)
+
+	// the bucket of heights 0 to 9 is evicted, but not the bucket of height 10
+	stats.RecordDeviations(1000, maxDeviationBps, map[slinkytypes.CurrencyPair]types.PriceDeviation{cp: {}})
+	require.Equal(t, uint64(12), stats.ReportCount)
+	require.Equal(t, uint64(1), stats.MissCount)
+	require.Equal(t, uint64(10), stats.DeviationCount)
+	require.NoError(t, stats.ValidateBasic())
+
+	// the misses are evicted one bucket later, while the deviations remain
+	stats.RecordDeviations(1010, maxDeviationBps, map[slinkytypes.CurrencyPair]types.PriceDeviation{cp: {}})
+	require.Equal(t, uint64(12), stats.ReportCount)
+	require.Equal(t, uint64(0), stats.MissCount)
+	require.Equal(t, uint64(10), stats.DeviationCount)
+	require.NoError(t, stats.ValidateBasic())
+
+	// after a gap longer than the window, only the latest report remains
+	stats.RecordDeviations(5000, maxDeviationBps, missed)
+	require.Equal(t, uint64(1), stats.ReportCount)
+	require.Equal(t, uint64(1), stats.MissCount)
+	require.Equal(t, uint64(0), stats.DeviationCount)
+	require.Equal(t, uint64(5000), stats.LastHeight)
+	require.NoError(t, stats.ValidateBasic())
+}
+
+func TestValidatorPriceStatsValidateBasic(t *testing.T) {
+	val := sdk.ConsAddress("val1").String()
+
+	tcs := []struct {
+		name       string
+		stats      types.ValidatorPriceStats
+		expectPass bool
+	}{
+		{
+			"stats without buckets - pass",
+			types.ValidatorPriceStats{ConsAddress: val, ReportCount: 2, MissCount: 1},
+			true,
+		},
+		{
+			"buckets without bucket heights - fail",
+			types.ValidatorPriceStats{
+				ConsAddress: val,
+				Buckets:     []types.ValidatorPriceStatsBucket{{}},
+			},
+			false,
+		},
+		{
+			"counters do not match the buckets - fail",
+			types.ValidatorPriceStats{
+				ConsAddress:   val,
+				ReportCount:   2,
+				MissCount:     1,
+				BucketHeights: 1,
+				Buckets:       []types.ValidatorPriceStatsBucket{{ReportCount: 2}},
+			},
+			false,
+		},
+		{
+			"counters match the buckets - pass",
+			types.ValidatorPriceStats{
+				ConsAddress:    val,
+				ReportCount:    3,
+				MissCount:      1,
+				DeviationCount: 1,
+				BucketHeights:  1,
+				Buckets: []types.ValidatorPriceStatsBucket{
+					{ReportCount: 2, MissCount: 1},
+					{ReportCount: 1, DeviationCount: 1},
+				},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.stats.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}